@@ -77,6 +77,11 @@ type ComponentSpec struct {
 	// +optional
 	Traits []ComponentTrait `json:"traits,omitempty"`
 
+	// DisabledTraits lists the instance names of default traits of the ComponentType or the
+	// Environment that must not be applied to this component. Required traits cannot be disabled.
+	// +optional
+	DisabledTraits []string `json:"disabledTraits,omitempty"`
+
 	// Workflow defines the component workflow configuration for building the component.
 	// This references a ComponentWorkflow CR and provides both system parameters (repository info)
 	// and developer-configured parameter values.
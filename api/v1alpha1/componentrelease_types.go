@@ -53,6 +53,10 @@ type ComponentProfile struct {
 	// Each trait can be instantiated multiple times with different instanceNames
 	// +optional
 	Traits []ComponentTrait `json:"traits,omitempty"`
+
+	// DisabledTraits lists the instance names of the default traits the component turned off
+	// +optional
+	DisabledTraits []string `json:"disabledTraits,omitempty"`
 }
 
 // ComponentReleaseStatus defines the observed state of ComponentRelease.
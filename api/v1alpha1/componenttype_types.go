@@ -41,6 +41,57 @@ type ComponentTypeSpec struct {
 	// At least one resource must be defined with an id matching the workloadType
 	// +kubebuilder:validation:MinItems=1
 	Resources []ResourceTemplate `json:"resources"`
+
+	// Retention limits the release and build history kept for components of this type.
+	// A Project can override it for its components.
+	// +optional
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Traits are attached to every component of this type, in addition to the traits the component lists.
+	// +optional
+	// +listType=map
+	// +listMapKey=instanceName
+	Traits []PlatformTrait `json:"traits,omitempty"`
+}
+
+// PlatformTrait is a trait instance that a ComponentType or an Environment attaches to components.
+//
+// Example:
+//
+//	traits:
+//	  - name: pod-disruption-budget
+//	    instanceName: pdb
+//	    required: true
+//	    parameters:
+//	      minAvailable: 1
+//	    lockedParameters:
+//	      enabled: true
+type PlatformTrait struct {
+	// Name is the name of the Trait resource
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// InstanceName uniquely identifies this trait instance within a component.
+	// A component can set the parameters of the instance by listing a trait with the same instanceName.
+	// +kubebuilder:validation:MinLength=1
+	InstanceName string `json:"instanceName"`
+
+	// Required traits are always applied. Traits that are not required are defaults
+	// that a component can turn off with spec.disabledTraits.
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// Parameters are the default parameters of the instance. A component can override them.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+
+	// LockedParameters are applied over the parameters of the component and cannot be overridden.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	LockedParameters *runtime.RawExtension `json:"lockedParameters,omitempty"`
 }
 
 // ComponentTypeSchema defines the configurable parameters for a component type
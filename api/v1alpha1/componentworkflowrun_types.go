@@ -92,7 +92,10 @@ type RepositoryValues struct {
 // RepositoryRevisionValues contains the actual repository revision values.
 type RepositoryRevisionValues struct {
 	// Branch is the Git branch to build from.
-	// Example: "main"
+	// For components with auto-build enabled, a value of the form refs/tags/<glob>
+	// instead builds on pushes of tags whose name matches the glob, and ignores
+	// branch pushes. The webhook build then checks out the tagged commit.
+	// Example: "main" or "refs/tags/v*"
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	Branch string `json:"branch"`
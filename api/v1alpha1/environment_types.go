@@ -17,8 +17,134 @@ type EnvironmentSpec struct {
 	DataPlaneRef string        `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewayConfig `json:"gateway,omitempty"`
+
+	// DriftPolicy sets how releases deployed to this environment handle resources that were
+	// changed directly in the data plane. Defaults to AutoHeal.
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// Rollout is the default rollout strategy for releases bound to this environment.
+	// A ReleaseBinding can override it with its own strategy.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+
+	// ChangeCalendar restricts when releases may be rolled out to this environment.
+	// Changes are allowed at any time when it is not set.
+	// +optional
+	ChangeCalendar *ChangeCalendar `json:"changeCalendar,omitempty"`
+
+	// Traits are attached to every component deployed to this environment.
+	// An environment trait replaces a ComponentType trait with the same instanceName.
+	// +optional
+	// +listType=map
+	// +listMapKey=instanceName
+	Traits []PlatformTrait `json:"traits,omitempty"`
+
+	// NetworkIsolation isolates the components deployed to this environment from other projects.
+	// Components are isolated when either their environment or their project enables it.
+	// +optional
+	NetworkIsolation *NetworkIsolation `json:"networkIsolation,omitempty"`
+}
+
+// NetworkIsolation renders an ingress NetworkPolicy for every component, admitting its own project,
+// the data plane gateway, the observability plane and the components that declare a connection to it
+type NetworkIsolation struct {
+	// Enabled turns on the isolation
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AllowedNamespaces are data plane namespaces whose pods reach the components on every port,
+	// such as ingress controllers or monitoring agents installed outside OpenChoreo
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// ChangeCalendar declares when changes may be rolled out to an environment
+type ChangeCalendar struct {
+	// TimeZone is the IANA time zone the allowed windows are evaluated in. Defaults to UTC.
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// AllowedWindows are the recurring windows in which changes may be rolled out.
+	// Changes are allowed at any time outside a blackout when no window is set.
+	// +optional
+	AllowedWindows []RecurringWindow `json:"allowedWindows,omitempty"`
+
+	// Blackouts are periods in which no change may be rolled out, even inside an allowed window
+	// +optional
+	Blackouts []BlackoutPeriod `json:"blackouts,omitempty"`
+
+	// OutsideWindowAction decides what happens to a change requested while the calendar is closed.
+	// Defaults to Reject. Changes that reach a ReleaseBinding some other way, such as a direct edit,
+	// are always held until the calendar opens.
+	// +kubebuilder:default=Reject
+	// +optional
+	OutsideWindowAction ChangeWindowAction `json:"outsideWindowAction,omitempty"`
 }
 
+// RecurringWindow is a window of time that recurs on the given days of every week
+type RecurringWindow struct {
+	// Days the window opens on. The window opens every day when empty.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day the window opens, as HH:MM
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window closes, as HH:MM.
+	// A window that ends at or before its start closes on the following day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// Weekday is a day of the week
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// BlackoutPeriod is a fixed period in which changes are frozen, such as a holiday freeze
+// +kubebuilder:validation:XValidation:rule="self.end > self.start",message="end must be after start"
+type BlackoutPeriod struct {
+	// Name identifies the blackout in status messages
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Start is when the blackout begins
+	Start metav1.Time `json:"start"`
+
+	// End is when the blackout ends
+	End metav1.Time `json:"end"`
+
+	// Reason explains why changes are frozen
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ChangeWindowAction is what happens to a change requested outside the change windows of an environment
+// +kubebuilder:validation:Enum=Reject;Queue
+type ChangeWindowAction string
+
+const (
+	// ChangeWindowActionReject refuses the change. A break-glass override still lets it through.
+	ChangeWindowActionReject ChangeWindowAction = "Reject"
+	// ChangeWindowActionQueue accepts the change and holds it until the next window opens
+	ChangeWindowActionQueue ChangeWindowAction = "Queue"
+)
+
+// DriftPolicy is how a release reacts when its live resources drift from the applied spec
+// +kubebuilder:validation:Enum=AutoHeal;Report;Pause
+type DriftPolicy string
+
+const (
+	// DriftPolicyAutoHeal reports drift and reverts it by reapplying the spec
+	DriftPolicyAutoHeal DriftPolicy = "AutoHeal"
+	// DriftPolicyReport reports drift and leaves drifted resources alone until the spec changes
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyPause reports drift and stops applying the release until the drift is resolved
+	DriftPolicyPause DriftPolicy = "Pause"
+)
+
 // EnvironmentStatus defines the observed state of Environment.
 type EnvironmentStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -11,8 +11,8 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // NotificationChannelType defines the type of notification channel
-// Currently "email" and "webhook" are supported. Other channel types will be added in the future.
-// +kubebuilder:validation:Enum=email;webhook
+// Currently "email", "webhook", "slack", "msteams" and "pagerduty" are supported.
+// +kubebuilder:validation:Enum=email;webhook;slack;msteams;pagerduty
 type NotificationChannelType string
 
 const (
@@ -20,6 +20,12 @@ const (
 	NotificationChannelTypeEmail NotificationChannelType = "email"
 	// NotificationChannelTypeWebhook represents a webhook notification channel
 	NotificationChannelTypeWebhook NotificationChannelType = "webhook"
+	// NotificationChannelTypeSlack represents a Slack notification channel
+	NotificationChannelTypeSlack NotificationChannelType = "slack"
+	// NotificationChannelTypeMSTeams represents a Microsoft Teams notification channel
+	NotificationChannelTypeMSTeams NotificationChannelType = "msteams"
+	// NotificationChannelTypePagerDuty represents a PagerDuty notification channel
+	NotificationChannelTypePagerDuty NotificationChannelType = "pagerduty"
 )
 
 // SecretValueFrom defines how to obtain a secret value
@@ -144,6 +150,51 @@ type WebhookConfig struct {
 	PayloadTemplate string `json:"payloadTemplate,omitempty"`
 }
 
+// SlackConfig defines the configuration for Slack notification channels
+type SlackConfig struct {
+	// WebhookURL is the Slack incoming webhook URL
+	// Provided via secret reference since the URL grants access to post to the workspace
+	// +kubebuilder:validation:Required
+	WebhookURL *SecretValueFrom `json:"webhookUrl"`
+
+	// Channel overrides the channel the incoming webhook posts to (e.g., "#alerts")
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// Username overrides the name the messages are posted as
+	// +optional
+	Username string `json:"username,omitempty"`
+}
+
+// MSTeamsConfig defines the configuration for Microsoft Teams notification channels
+type MSTeamsConfig struct {
+	// WebhookURL is the Teams incoming webhook or workflow URL
+	// Provided via secret reference since the URL grants access to post to the channel
+	// +kubebuilder:validation:Required
+	WebhookURL *SecretValueFrom `json:"webhookUrl"`
+}
+
+// PagerDutySeverity is the severity of a PagerDuty event
+// +kubebuilder:validation:Enum=critical;error;warning;info
+type PagerDutySeverity string
+
+// PagerDutyConfig defines the configuration for PagerDuty notification channels
+type PagerDutyConfig struct {
+	// RoutingKey is the integration key of the PagerDuty Events API v2 integration
+	// +kubebuilder:validation:Required
+	RoutingKey *SecretValueFrom `json:"routingKey"`
+
+	// SeverityMapping maps alert rule severities (info, warning, critical) to PagerDuty severities
+	// Severities that are not mapped are sent as the PagerDuty severity of the same name
+	// +optional
+	SeverityMapping map[string]PagerDutySeverity `json:"severityMapping,omitempty"`
+
+	// Source is the unique location of the affected system reported to PagerDuty
+	// Defaults to the component, project and environment of the alert
+	// +optional
+	Source string `json:"source,omitempty"`
+}
+
 // NotificationChannelConfig is deprecated. Use EmailConfig and WebhookConfig directly in the spec instead.
 // This type is kept for backward compatibility but should not be used in new code.
 type NotificationChannelConfig struct {
@@ -159,6 +210,9 @@ type NotificationChannelConfig struct {
 // ObservabilityAlertsNotificationChannelSpec defines the desired state of ObservabilityAlertsNotificationChannel.
 // +kubebuilder:validation:XValidation:rule="self.type == 'email' ? has(self.emailConfig) : true",message="emailConfig is required when type is email"
 // +kubebuilder:validation:XValidation:rule="self.type == 'webhook' ? has(self.webhookConfig) : true",message="webhookConfig is required when type is webhook"
+// +kubebuilder:validation:XValidation:rule="self.type == 'slack' ? has(self.slackConfig) : true",message="slackConfig is required when type is slack"
+// +kubebuilder:validation:XValidation:rule="self.type == 'msteams' ? has(self.msteamsConfig) : true",message="msteamsConfig is required when type is msteams"
+// +kubebuilder:validation:XValidation:rule="self.type == 'pagerduty' ? has(self.pagerdutyConfig) : true",message="pagerdutyConfig is required when type is pagerduty"
 type ObservabilityAlertsNotificationChannelSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -175,7 +229,7 @@ type ObservabilityAlertsNotificationChannelSpec struct {
 	IsEnvDefault bool `json:"isEnvDefault,omitempty"`
 
 	// Type specifies the type of notification channel
-	// Currently "email" and "webhook" are supported
+	// Currently "email", "webhook", "slack", "msteams" and "pagerduty" are supported
 	// +kubebuilder:validation:Required
 	Type NotificationChannelType `json:"type"`
 
@@ -188,12 +242,33 @@ type ObservabilityAlertsNotificationChannelSpec struct {
 	// Required when type is "webhook"
 	// +optional
 	WebhookConfig *WebhookConfig `json:"webhookConfig,omitempty"`
+
+	// SlackConfig contains the Slack notification channel configuration
+	// Required when type is "slack"
+	// +optional
+	SlackConfig *SlackConfig `json:"slackConfig,omitempty"`
+
+	// MSTeamsConfig contains the Microsoft Teams notification channel configuration
+	// Required when type is "msteams"
+	// +optional
+	MSTeamsConfig *MSTeamsConfig `json:"msteamsConfig,omitempty"`
+
+	// PagerDutyConfig contains the PagerDuty notification channel configuration
+	// Required when type is "pagerduty"
+	// +optional
+	PagerDutyConfig *PagerDutyConfig `json:"pagerdutyConfig,omitempty"`
 }
 
 // ObservabilityAlertsNotificationChannelStatus defines the observed state of ObservabilityAlertsNotificationChannel.
 type ObservabilityAlertsNotificationChannelStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ObservedGeneration is the generation most recently observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations, including whether notifications
+	// are being delivered to the channel
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -201,9 +276,10 @@ type ObservabilityAlertsNotificationChannelStatus struct {
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Notifications",type=integer,JSONPath=`.status.notificationCount`
+// +kubebuilder:printcolumn:name="Delivering",type=string,JSONPath=`.status.conditions[?(@.type=="Delivering")].status`
 
 // ObservabilityAlertsNotificationChannel is the Schema for the observabilityalertsnotificationchannels API.
-// It defines a channel for sending alert notifications. Currently email and webhook notifications are supported.
+// It defines a channel for sending alert notifications to email, webhooks, Slack, Microsoft Teams or PagerDuty.
 type ObservabilityAlertsNotificationChannel struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -221,6 +297,16 @@ type ObservabilityAlertsNotificationChannelList struct {
 	Items           []ObservabilityAlertsNotificationChannel `json:"items"`
 }
 
+// GetConditions returns the conditions slice
+func (c *ObservabilityAlertsNotificationChannel) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (c *ObservabilityAlertsNotificationChannel) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&ObservabilityAlertsNotificationChannel{}, &ObservabilityAlertsNotificationChannelList{})
 }
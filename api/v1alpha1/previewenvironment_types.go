@@ -0,0 +1,161 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreviewEnvironmentPhase is a high level summary of where the PreviewEnvironment is in its lifecycle.
+type PreviewEnvironmentPhase string
+
+const (
+	// PreviewEnvironmentPhasePending indicates the project reached its concurrent preview cap and the
+	// preview waits for an older preview to be removed
+	PreviewEnvironmentPhasePending PreviewEnvironmentPhase = "Pending"
+	// PreviewEnvironmentPhaseBuilding indicates the pull request head commit is being built
+	PreviewEnvironmentPhaseBuilding PreviewEnvironmentPhase = "Building"
+	// PreviewEnvironmentPhaseDeploying indicates the build finished and the release is being deployed
+	PreviewEnvironmentPhaseDeploying PreviewEnvironmentPhase = "Deploying"
+	// PreviewEnvironmentPhaseReady indicates the preview is deployed and reachable
+	PreviewEnvironmentPhaseReady PreviewEnvironmentPhase = "Ready"
+	// PreviewEnvironmentPhaseFailed indicates the preview could not be built or deployed
+	PreviewEnvironmentPhaseFailed PreviewEnvironmentPhase = "Failed"
+	// PreviewEnvironmentPhaseExpired indicates the TTL elapsed and the preview is being torn down
+	PreviewEnvironmentPhaseExpired PreviewEnvironmentPhase = "Expired"
+)
+
+// PullRequestRef identifies the pull request (or merge request) a preview is built from.
+type PullRequestRef struct {
+	// Number is the pull request number (or merge request IID) in the git provider
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Number int64 `json:"number"`
+
+	// HeadCommit is the commit SHA at the head of the pull request.
+	// Updating it rebuilds and redeploys the preview.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{7,40}$`
+	HeadCommit string `json:"headCommit"`
+
+	// HeadBranch is the source branch of the pull request
+	// +optional
+	HeadBranch string `json:"headBranch,omitempty"`
+
+	// BaseBranch is the branch the pull request targets
+	// +optional
+	BaseBranch string `json:"baseBranch,omitempty"`
+
+	// RepositoryURL is the repository holding the head commit when the pull request
+	// comes from a fork. The preview is built from the component's repository when empty.
+	// +optional
+	RepositoryURL string `json:"repositoryURL,omitempty"`
+
+	// Provider is the git provider that reported the pull request (github, gitlab, bitbucket, gitea)
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// URL is the web URL of the pull request
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// PreviewEnvironmentSpec defines the desired state of PreviewEnvironment.
+type PreviewEnvironmentSpec struct {
+	// Owner identifies the component and project the preview is created for
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
+	Owner ReleaseBindingOwner `json:"owner"`
+
+	// PullRequest identifies the pull request being previewed
+	// +kubebuilder:validation:Required
+	PullRequest PullRequestRef `json:"pullRequest"`
+
+	// TTL is how long the preview is kept after it was created.
+	// The preview is deleted once it elapses, even if the pull request is still open.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// PreviewEnvironmentStatus defines the observed state of PreviewEnvironment.
+type PreviewEnvironmentStatus struct {
+	// Phase is a high level summary of the preview lifecycle
+	// +optional
+	Phase PreviewEnvironmentPhase `json:"phase,omitempty"`
+
+	// Environment is the name of the ephemeral Environment created for the preview
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// WorkflowRun is the ComponentWorkflowRun building the current head commit
+	// +optional
+	WorkflowRun string `json:"workflowRun,omitempty"`
+
+	// ReleaseName is the ComponentRelease built from the current head commit
+	// +optional
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// ReleaseBinding is the ReleaseBinding deploying the preview release
+	// +optional
+	ReleaseBinding string `json:"releaseBinding,omitempty"`
+
+	// URL is the public address of the deployed preview, when the component exposes one
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ExpiresAt is the time the preview will be deleted
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the PreviewEnvironment's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=preview;previews
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="PR",type=integer,JSONPath=`.spec.pullRequest.number`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.status.expiresAt`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PreviewEnvironment is the Schema for the previewenvironments API.
+// It deploys the head commit of an open pull request to an ephemeral Environment.
+type PreviewEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreviewEnvironmentSpec   `json:"spec,omitempty"`
+	Status PreviewEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PreviewEnvironmentList contains a list of PreviewEnvironment.
+type PreviewEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreviewEnvironment `json:"items"`
+}
+
+// GetConditions returns the conditions from the status
+func (p *PreviewEnvironment) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (p *PreviewEnvironment) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&PreviewEnvironment{}, &PreviewEnvironmentList{})
+}
@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,6 +18,109 @@ type ProjectSpec struct {
 
 	// Foo is an example field of Project. Edit project_types.go to remove/update
 	DeploymentPipelineRef string `json:"deploymentPipelineRef"`
+
+	// Previews configures pull request preview environments for the project's components.
+	// Previews are disabled when not set.
+	// +optional
+	Previews *ProjectPreviewConfig `json:"previews,omitempty"`
+
+	// Retention limits the release and build history kept for the project's components.
+	// It overrides the retention of the components' ComponentTypes.
+	// +optional
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// ResourcePolicy limits the compute the project's components can use in each environment.
+	// It is rendered as a ResourceQuota and a LimitRange in the project's data plane namespaces.
+	// +optional
+	ResourcePolicy *ProjectResourcePolicy `json:"resourcePolicy,omitempty"`
+
+	// NetworkIsolation isolates the project's components from other projects in every environment
+	// +optional
+	NetworkIsolation *NetworkIsolation `json:"networkIsolation,omitempty"`
+}
+
+// ProjectResourcePolicy is the quota and container limits of a project, with overrides per environment.
+type ProjectResourcePolicy struct {
+	// Quota is the total amount of each resource the project can use in an environment,
+	// using ResourceQuota resource names such as requests.cpu, limits.memory and pods.
+	// +optional
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+
+	// ContainerLimits are the defaults and bounds applied to each container.
+	// +optional
+	ContainerLimits *ContainerLimits `json:"containerLimits,omitempty"`
+
+	// Environments overrides the policy in individual environments.
+	// +optional
+	// +listType=map
+	// +listMapKey=environment
+	Environments []EnvironmentResourcePolicy `json:"environments,omitempty"`
+}
+
+// EnvironmentResourcePolicy overrides the resource policy of a project in one environment.
+// Quota entries replace the project's entries of the same resource, and container limits
+// replace the project's container limits as a whole.
+type EnvironmentResourcePolicy struct {
+	// Environment is the name of the environment the override applies to
+	// +kubebuilder:validation:MinLength=1
+	Environment string `json:"environment"`
+
+	// Quota overrides entries of the project's quota
+	// +optional
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+
+	// ContainerLimits replaces the project's container limits
+	// +optional
+	ContainerLimits *ContainerLimits `json:"containerLimits,omitempty"`
+}
+
+// ContainerLimits are the defaults and bounds of the resources of a container, rendered as a LimitRange.
+type ContainerLimits struct {
+	// Default is the limit of a container that does not set one
+	// +optional
+	Default corev1.ResourceList `json:"default,omitempty"`
+
+	// DefaultRequest is the request of a container that does not set one
+	// +optional
+	DefaultRequest corev1.ResourceList `json:"defaultRequest,omitempty"`
+
+	// Max is the largest limit a container can set
+	// +optional
+	Max corev1.ResourceList `json:"max,omitempty"`
+
+	// Min is the smallest request a container can set
+	// +optional
+	Min corev1.ResourceList `json:"min,omitempty"`
+}
+
+// ProjectPreviewConfig configures pull request preview environments.
+type ProjectPreviewConfig struct {
+	// Enabled turns on preview environments for pull requests against the components' repositories
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// BaseEnvironment is the environment previews are modelled on; they use its data plane.
+	// Defaults to the first environment of the project's deployment pipeline.
+	// +optional
+	BaseEnvironment string `json:"baseEnvironment,omitempty"`
+
+	// TTL is how long a preview is kept before it is deleted, even if the pull request is still open.
+	// +optional
+	// +kubebuilder:default="72h"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// MaxConcurrent caps the number of previews that can exist in the project at the same time.
+	// Pull requests opened beyond the cap do not get a preview.
+	// +optional
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrent int32 `json:"maxConcurrent,omitempty"`
+
+	// AllowUntrustedPullRequests also builds previews for pull requests from forks and from
+	// authors who are not owners, members or collaborators of the repository. Their code is
+	// built and deployed with the project's credentials, so this is off by default.
+	// +optional
+	AllowUntrustedPullRequests bool `json:"allowUntrustedPullRequests,omitempty"`
 }
 
 // ProjectStatus defines the observed state of Project.
@@ -25,6 +129,25 @@ type ProjectStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// ResourceUsage is the quota and the resources requested by the project's releases in each
+	// environment that has a quota
+	// +optional
+	ResourceUsage []EnvironmentResourceUsage `json:"resourceUsage,omitempty"`
+}
+
+// EnvironmentResourceUsage is the quota usage of a project in one environment.
+type EnvironmentResourceUsage struct {
+	// Environment is the name of the environment
+	Environment string `json:"environment"`
+
+	// Hard is the effective quota of the project in the environment
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// Used is the amount of each quota resource requested by the project's releases in the environment
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
 }
 
 // +kubebuilder:object:root=true
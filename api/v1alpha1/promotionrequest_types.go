@@ -0,0 +1,155 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PromotionDecisionAction is the outcome recorded by a reviewer on a PromotionRequest.
+// +kubebuilder:validation:Enum=Approve;Reject
+type PromotionDecisionAction string
+
+const (
+	// PromotionDecisionApprove allows the promotion to proceed
+	PromotionDecisionApprove PromotionDecisionAction = "Approve"
+	// PromotionDecisionReject blocks the promotion permanently
+	PromotionDecisionReject PromotionDecisionAction = "Reject"
+)
+
+// PromotionRequestPhase is a high level summary of where the PromotionRequest is in its lifecycle.
+type PromotionRequestPhase string
+
+const (
+	// PromotionRequestPhasePending indicates the request is waiting for a decision
+	PromotionRequestPhasePending PromotionRequestPhase = "Pending"
+	// PromotionRequestPhaseApproved indicates the request is approved but the target binding is not yet updated
+	PromotionRequestPhaseApproved PromotionRequestPhase = "Approved"
+	// PromotionRequestPhaseRejected indicates the request was rejected
+	PromotionRequestPhaseRejected PromotionRequestPhase = "Rejected"
+	// PromotionRequestPhasePromoted indicates the target ReleaseBinding now points to the requested release
+	PromotionRequestPhasePromoted PromotionRequestPhase = "Promoted"
+	// PromotionRequestPhaseFailed indicates the approved promotion could not be applied
+	PromotionRequestPhaseFailed PromotionRequestPhase = "Failed"
+)
+
+// PromotionRequestSpec defines the desired state of PromotionRequest.
+// +kubebuilder:validation:XValidation:rule="self.sourceEnvironment != self.targetEnvironment",message="sourceEnvironment and targetEnvironment must differ"
+type PromotionRequestSpec struct {
+	// Owner identifies the component and project being promoted
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
+	Owner ReleaseBindingOwner `json:"owner"`
+
+	// SourceEnvironment is the environment the release is promoted from
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.sourceEnvironment is immutable"
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment is the environment the release is promoted to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.targetEnvironment is immutable"
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ReleaseName is the ComponentRelease that was bound to the source environment
+	// when the request was raised. This is the release that will be bound to the
+	// target environment after approval.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.releaseName is immutable"
+	ReleaseName string `json:"releaseName"`
+
+	// RequestedBy is the identifier of the subject that raised the request
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// PromotionDecision records who decided on a PromotionRequest and how.
+type PromotionDecision struct {
+	// Action is the decision taken by the reviewer
+	// +kubebuilder:validation:Required
+	Action PromotionDecisionAction `json:"action"`
+
+	// DecidedBy is the identifier of the subject that made the decision
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DecidedBy string `json:"decidedBy"`
+
+	// DecidedAt is the time the decision was recorded
+	// +optional
+	DecidedAt metav1.Time `json:"decidedAt,omitempty"`
+
+	// Comment is an optional note from the reviewer
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// PromotionRequestStatus defines the observed state of PromotionRequest.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.decision) || has(self.decision)",message="status.decision cannot be removed once set"
+type PromotionRequestStatus struct {
+	// Decision records the approval or rejection of this request.
+	// It is written through the status subresource by the OpenChoreo API after the
+	// reviewer has been authorized, so editing the spec cannot approve a request.
+	// Once set, the decision cannot be changed.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="status.decision is immutable once set"
+	Decision *PromotionDecision `json:"decision,omitempty"`
+
+	// Phase is a high level summary of the request lifecycle
+	// +optional
+	Phase PromotionRequestPhase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the PromotionRequest's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=promreq
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceEnvironment`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetEnvironment`
+// +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.spec.releaseName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PromotionRequest is the Schema for the promotionrequests API.
+// It gates the promotion of a ComponentRelease into an environment that requires approval.
+type PromotionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PromotionRequestSpec   `json:"spec,omitempty"`
+	Status PromotionRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionRequestList contains a list of PromotionRequest.
+type PromotionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromotionRequest `json:"items"`
+}
+
+// GetConditions returns the conditions from the status
+func (p *PromotionRequest) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (p *PromotionRequest) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&PromotionRequest{}, &PromotionRequestList{})
+}
@@ -56,6 +56,28 @@ type ReleaseStatus struct {
 	// Conditions represent the latest available observations of the Release's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the spec that was last applied to the target plane.
+	// Drift is only detected once the current spec has been applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ResourcePolicy records the data plane namespaces the project's resource policy was applied to,
+	// so that the objects can be removed once the policy no longer sets them
+	// +optional
+	ResourcePolicy *AppliedResourcePolicy `json:"resourcePolicy,omitempty"`
+}
+
+// AppliedResourcePolicy lists the namespaces holding the ResourceQuota and the LimitRange rendered
+// from the resource policy of the Release's project.
+type AppliedResourcePolicy struct {
+	// ResourceQuotaNamespaces are the namespaces the ResourceQuota was applied to
+	// +optional
+	ResourceQuotaNamespaces []string `json:"resourceQuotaNamespaces,omitempty"`
+
+	// LimitRangeNamespaces are the namespaces the LimitRange was applied to
+	// +optional
+	LimitRangeNamespaces []string `json:"limitRangeNamespaces,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -140,6 +162,25 @@ type ResourceStatus struct {
 	// LastObservedTime stores the last time the status was observed
 	// +optional
 	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// Drift lists the fields of the live resource that no longer match the applied spec.
+	// Unset when the resource matches.
+	// +optional
+	Drift *ResourceDrift `json:"drift,omitempty"`
+}
+
+// ResourceDrift describes how a live resource differs from the spec it was applied with
+type ResourceDrift struct {
+	// Fields are the paths of the drifted fields, e.g. "spec.template.spec.containers[main].image"
+	// +kubebuilder:validation:MaxItems=20
+	Fields []string `json:"fields"`
+
+	// DetectedAt is when the drift was first detected
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// Reverted is true when the drift was overwritten with the applied spec
+	// +optional
+	Reverted bool `json:"reverted,omitempty"`
 }
 
 // HealthStatus represents the health of a resource
@@ -61,6 +61,12 @@ type ReleaseBindingSpec struct {
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	WorkloadOverrides *WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
+
+	// Rollout sets how a new release replaces the current one in this environment.
+	// It takes precedence over the Environment's rollout strategy. Without either,
+	// the new release replaces the current one in a single update.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 }
 
 // ReleaseBindingOwner identifies the component this ReleaseBinding belongs to
@@ -81,6 +87,76 @@ type ReleaseBindingStatus struct {
 	// Conditions represent the latest available observations of the ReleaseBinding's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// History lists the ComponentReleases this binding has pointed at, most recent first.
+	// The list is bounded and the oldest entries are dropped once it is full.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	History []ReleaseHistoryEntry `json:"history,omitempty"`
+
+	// Rollout tracks the progressive rollout of the bound release, when a rollout strategy applies
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// RolloutPhase is the state of a progressive rollout
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing indicates traffic is being shifted to the new release
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhaseSucceeded indicates the new release was promoted and serves all traffic
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// RolloutPhaseAborted indicates the rollout failed its analysis and traffic was moved
+	// back to the stable release
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+)
+
+// RolloutStatus is the observed state of a progressive rollout
+type RolloutStatus struct {
+	// Phase is the state of the rollout
+	Phase RolloutPhase `json:"phase"`
+
+	// StableRelease is the ComponentRelease serving traffic outside of the rollout
+	// +optional
+	StableRelease string `json:"stableRelease,omitempty"`
+
+	// CanaryRelease is the ComponentRelease being rolled out
+	// +optional
+	CanaryRelease string `json:"canaryRelease,omitempty"`
+
+	// Step is the index of the current rollout step
+	// +optional
+	Step int32 `json:"step,omitempty"`
+
+	// Weight is the percentage of traffic currently sent to the canary release
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// StepStartedAt is when the canary became ready at the current step's weight
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// Message describes the last transition or analysis result
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReleaseHistoryEntry records a ComponentRelease that was bound to the environment
+type ReleaseHistoryEntry struct {
+	// ReleaseName is the name of the ComponentRelease that was bound
+	ReleaseName string `json:"releaseName"`
+
+	// BoundAt is when the binding started pointing at the release
+	BoundAt metav1.Time `json:"boundAt"`
+
+	// BoundBy identifies who bound the release, e.g. a user or a controller
+	// +optional
+	BoundBy string `json:"boundBy,omitempty"`
+
+	// Ready is true once the release reached Ready in the environment
+	// +optional
+	Ready bool `json:"ready,omitempty"`
 }
 
 // +kubebuilder:object:root=true
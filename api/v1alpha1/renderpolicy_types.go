@@ -0,0 +1,148 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RenderPolicyMode decides what happens to the rendered resources that violate a render policy
+// +kubebuilder:validation:Enum=Enforce;Warn;Audit
+type RenderPolicyMode string
+
+const (
+	// RenderPolicyModeEnforce blocks the ReleaseBinding until the violations are fixed
+	RenderPolicyModeEnforce RenderPolicyMode = "Enforce"
+
+	// RenderPolicyModeWarn deploys the resources and reports the violations as render warnings
+	RenderPolicyModeWarn RenderPolicyMode = "Warn"
+
+	// RenderPolicyModeAudit deploys the resources and only records the violations on the ReleaseBinding status
+	RenderPolicyModeAudit RenderPolicyMode = "Audit"
+)
+
+// RenderPolicySpec defines the guardrail rules that the rendered resources of components must follow
+type RenderPolicySpec struct {
+	// Mode decides whether violations block the ReleaseBinding, are reported as warnings or are only audited.
+	// Defaults to Enforce.
+	// +kubebuilder:default=Enforce
+	// +optional
+	Mode RenderPolicyMode `json:"mode,omitempty"`
+
+	// Match selects the rendered resources the rules apply to. All resources are matched when it is not set.
+	// +optional
+	Match RenderPolicyMatch `json:"match,omitempty"`
+
+	// Rules are evaluated on every matched resource
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Rules []RenderPolicyRule `json:"rules"`
+}
+
+// RenderPolicyMatch selects the rendered resources a render policy applies to
+type RenderPolicyMatch struct {
+	// Kinds of the resources to check, e.g. Deployment. All kinds are checked when empty.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Environments whose resources are checked. All environments are checked when empty.
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+}
+
+// RenderPolicyRule is a CEL expression that every matched resource must satisfy
+type RenderPolicyRule struct {
+	// Name of the rule, unique within the policy
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Expression is a CEL expression that evaluates to true when the resource complies with the rule.
+	// The rendered resource is available as resource, the component metadata as metadata and the
+	// environment as environment.name and environment.isProduction.
+	// Example: ${!environment.isProduction || resource.spec.replicas >= 2}
+	// +kubebuilder:validation:Pattern=`^\$\{[\s\S]+\}\s*$`
+	Expression string `json:"expression"`
+
+	// Message describes the violation. Defaults to the expression.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RenderPolicyStatus defines the observed state of a render policy
+type RenderPolicyStatus struct {
+	// Conditions represent the latest available observations of the policy's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=rpol;rpols
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RenderPolicy holds guardrail rules that the rendered resources of the components of an organization
+// must follow.
+type RenderPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RenderPolicySpec   `json:"spec,omitempty"`
+	Status RenderPolicyStatus `json:"status,omitempty"`
+}
+
+func (p *RenderPolicy) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *RenderPolicy) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// RenderPolicyList contains a list of RenderPolicy.
+type RenderPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RenderPolicy `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=crpol;crpols
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterRenderPolicy holds guardrail rules that the rendered resources of the components of every
+// organization must follow.
+type ClusterRenderPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RenderPolicySpec   `json:"spec,omitempty"`
+	Status RenderPolicyStatus `json:"status,omitempty"`
+}
+
+func (p *ClusterRenderPolicy) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *ClusterRenderPolicy) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterRenderPolicyList contains a list of ClusterRenderPolicy.
+type ClusterRenderPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRenderPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RenderPolicy{}, &RenderPolicyList{}, &ClusterRenderPolicy{}, &ClusterRenderPolicyList{})
+}
@@ -90,15 +90,98 @@ type SecretStoreReference struct {
 	Kind string `json:"kind"`
 }
 
+// SecretValidationPhase describes whether the remote references resolve in a secret store.
+// +kubebuilder:validation:Enum=Valid;Invalid;Pending
+type SecretValidationPhase string
+
+const (
+	// SecretValidationValid means every remote reference resolved in the secret store
+	SecretValidationValid SecretValidationPhase = "Valid"
+	// SecretValidationInvalid means the secret store is unavailable or a remote reference did not resolve
+	SecretValidationInvalid SecretValidationPhase = "Invalid"
+	// SecretValidationPending means the secret store has not reported a result yet
+	SecretValidationPending SecretValidationPhase = "Pending"
+)
+
+// ExternalSecretSyncStatus reports the sync state of an ExternalSecret generated from a SecretReference.
+type ExternalSecretSyncStatus struct {
+	// Name of the ExternalSecret in the data plane
+	Name string `json:"name"`
+
+	// Namespace of the ExternalSecret in the data plane
+	Namespace string `json:"namespace"`
+
+	// Release is the name of the Release that applied the ExternalSecret
+	Release string `json:"release"`
+
+	// Synced reports whether the last sync of the ExternalSecret succeeded
+	Synced bool `json:"synced"`
+
+	// Reason is the reason of the ExternalSecret's Ready condition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the ExternalSecret's Ready condition
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RefreshTime is when the secret store was last read
+	// +optional
+	RefreshTime *metav1.Time `json:"refreshTime,omitempty"`
+}
+
+// SecretReferenceEnvironmentStatus reports the state of a SecretReference in one environment.
+type SecretReferenceEnvironmentStatus struct {
+	// Environment is the name of the environment
+	Environment string `json:"environment"`
+
+	// DataPlane is the name of the DataPlane the environment deploys to
+	DataPlane string `json:"dataPlane"`
+
+	// SecretStore is the ClusterSecretStore the remote references are resolved against
+	// +optional
+	SecretStore string `json:"secretStore,omitempty"`
+
+	// Validation reports whether the remote references resolve in the secret store
+	Validation SecretValidationPhase `json:"validation"`
+
+	// ValidationMessage explains a failed or pending validation
+	// +optional
+	ValidationMessage string `json:"validationMessage,omitempty"`
+
+	// ExternalSecrets are the ExternalSecrets deployed to the environment that read this reference
+	// +optional
+	ExternalSecrets []ExternalSecretSyncStatus `json:"externalSecrets,omitempty"`
+
+	// ContentHash is a hash of the secret data synced to the environment.
+	// It is stamped onto the pod templates of consuming workloads so that they roll when the secret changes.
+	// +optional
+	ContentHash string `json:"contentHash,omitempty"`
+
+	// LastRotationTime is when the ContentHash last changed
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
 // SecretReferenceStatus defines the observed state of SecretReference.
 type SecretReferenceStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// ObservedGeneration is the generation of the spec that was last validated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Conditions represent the latest available observations
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// Environments reports validation and sync state per environment
+	// +optional
+	// +listType=map
+	// +listMapKey=environment
+	Environments []SecretReferenceEnvironmentStatus `json:"environments,omitempty"`
+
 	// LastRefreshTime indicates when the secret reference was last processed
 	// +optional
 	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
@@ -110,6 +193,9 @@ type SecretReferenceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Validated",type=string,JSONPath=`.status.conditions[?(@.type=="Validated")].status`
+// +kubebuilder:printcolumn:name="Synced",type=string,JSONPath=`.status.conditions[?(@.type=="Synced")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // SecretReference is the Schema for the secretreferences API.
 type SecretReference struct {
@@ -129,6 +215,16 @@ type SecretReferenceList struct {
 	Items           []SecretReference `json:"items"`
 }
 
+// GetConditions returns the conditions slice
+func (s *SecretReference) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+// SetConditions sets the conditions slice
+func (s *SecretReference) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&SecretReference{}, &SecretReferenceList{})
 }
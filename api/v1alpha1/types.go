@@ -3,6 +3,10 @@
 
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // This file contains common types shared across multiple OpenChoreo CRDs
 
 // EndpointStatus represents the observed state of an endpoint
@@ -86,3 +90,99 @@ const (
 	// The Release resource is deleted, triggering cleanup of all data plane resources.
 	ReleaseStateUndeploy ReleaseState = "Undeploy"
 )
+
+// RetentionPolicy limits how much build and release history is kept for components.
+// It can be set on a ComponentType and on a Project; each history set on the Project
+// takes precedence over the one set on the ComponentType.
+type RetentionPolicy struct {
+	// ComponentReleases limits the ComponentReleases kept per component.
+	// A release is never deleted while a ReleaseBinding or a pending PromotionRequest
+	// references it, or while it is the component's latest release.
+	// +optional
+	ComponentReleases *HistoryLimit `json:"componentReleases,omitempty"`
+
+	// WorkflowRuns limits the ComponentWorkflowRuns kept per component, and the WorkflowRuns
+	// labelled with the project kept per workflow. Runs that have not completed are never deleted.
+	// +optional
+	WorkflowRuns *HistoryLimit `json:"workflowRuns,omitempty"`
+}
+
+// HistoryLimit bounds a history of objects by count and by age.
+// Objects that fall outside either bound are deleted.
+type HistoryLimit struct {
+	// KeepLast is the number of most recently created objects to keep
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// MaxAge is how long an object is kept after it was created, e.g. "720h"
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// RolloutStrategyType is the kind of progressive rollout used to move traffic to a new release
+// +kubebuilder:validation:Enum=Canary;BlueGreen
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyCanary shifts traffic to the new release in weighted steps
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+
+	// RolloutStrategyBlueGreen starts the new release next to the current one without traffic
+	// and switches all traffic to it at once
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutStrategy configures how a ReleaseBinding moves from its current release to a new one.
+// Traffic is split between the two releases through the weights of the component's HTTPRoutes,
+// so only deployment based component types with a Service can be rolled out progressively.
+// +kubebuilder:validation:XValidation:rule="self.type != 'BlueGreen' || !has(self.steps) || self.steps.all(s, s.weight == 0 || s.weight == 100)",message="blue/green steps can only use weights of 0 or 100"
+type RolloutStrategy struct {
+	// Type is the rollout strategy
+	Type RolloutStrategyType `json:"type"`
+
+	// Steps are the traffic weights the new release goes through, in order.
+	// Defaults to 10%, 50% and 100% for canaries, and to 0% and 100% for blue/green.
+	// The new release is promoted once the last step passes.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	Steps []RolloutStep `json:"steps,omitempty"`
+
+	// Analysis is the gate evaluated at the end of every step.
+	// Without it a step passes once its pause has elapsed.
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// RolloutStep is one traffic weight of a rollout
+type RolloutStep struct {
+	// Weight is the percentage of traffic sent to the new release
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long the step runs before its analysis gate is evaluated, e.g. "10m"
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// RolloutAnalysis defines the HTTP metric thresholds a rollout step must stay within.
+// Metrics are read from the observer over the time the step was running and cover every
+// pod of the component in the environment.
+type RolloutAnalysis struct {
+	// MaxErrorRate is the highest tolerated share of unsuccessful requests, e.g. "0.05" for 5%
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	MaxErrorRate string `json:"maxErrorRate,omitempty"`
+
+	// MaxLatencyP99 is the highest tolerated 99th percentile latency, e.g. "500ms"
+	// +optional
+	MaxLatencyP99 *metav1.Duration `json:"maxLatencyP99,omitempty"`
+
+	// MinRequests is the number of requests the canary must serve before a step is judged.
+	// The step waits for more traffic while fewer requests were observed.
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	MinRequests int32 `json:"minRequests,omitempty"`
+}
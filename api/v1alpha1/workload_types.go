@@ -115,6 +115,28 @@ func (e EndpointType) String() string {
 	return string(e)
 }
 
+// EndpointVisibility is the scope from which an endpoint can be reached
+// +kubebuilder:validation:Enum=Project;Organization;Public
+type EndpointVisibility string
+
+const (
+	EndpointVisibilityProject      EndpointVisibility = "Project"
+	EndpointVisibilityOrganization EndpointVisibility = "Organization"
+	EndpointVisibilityPublic       EndpointVisibility = "Public"
+)
+
+// AllowsOtherProjects reports whether components of other projects can reach the endpoint.
+// An unset visibility is Project.
+func (v EndpointVisibility) AllowsOtherProjects() bool {
+	return v == EndpointVisibilityOrganization || v == EndpointVisibilityPublic
+}
+
+// ExposedThroughGateway reports whether the endpoint can be reached through the data plane gateway.
+// Only Public endpoints are routed through the gateway.
+func (v EndpointVisibility) ExposedThroughGateway() bool {
+	return v == EndpointVisibilityPublic
+}
+
 // WorkloadEndpoint represents a simple network endpoint for basic exposure.
 type WorkloadEndpoint struct {
 	// Type indicates the protocol/technology of the endpoint (HTTP, REST, gRPC, GraphQL, Websocket, TCP, UDP).
@@ -128,6 +150,14 @@ type WorkloadEndpoint struct {
 	// +kubebuilder:validation:Maximum=65535
 	Port int32 `json:"port"`
 
+	// Visibility decides which components can reach the endpoint directly in the data plane.
+	// Project endpoints are reachable only from components of the same project. Organization
+	// endpoints are also reachable from components of other projects that declare a connection
+	// to them, and Public endpoints can additionally be connected to through the public gateway.
+	// +optional
+	// +kubebuilder:default=Project
+	Visibility EndpointVisibility `json:"visibility,omitempty"`
+
 	// Optional schema for the endpoint.
 	// This can be used to define the actual API definition of the endpoint that is exposed by the workload.
 	// +optional
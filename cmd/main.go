@@ -43,9 +43,13 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/observabilityalertsnotificationchannel"
 	"github.com/openchoreo/openchoreo/internal/controller/observabilityplane"
 	"github.com/openchoreo/openchoreo/internal/controller/organization"
+	"github.com/openchoreo/openchoreo/internal/controller/previewenvironment"
 	"github.com/openchoreo/openchoreo/internal/controller/project"
+	"github.com/openchoreo/openchoreo/internal/controller/promotionrequest"
 	"github.com/openchoreo/openchoreo/internal/controller/release"
 	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/controller/renderpolicy"
+	"github.com/openchoreo/openchoreo/internal/controller/retention"
 	"github.com/openchoreo/openchoreo/internal/controller/secretreference"
 	"github.com/openchoreo/openchoreo/internal/controller/trait"
 	"github.com/openchoreo/openchoreo/internal/controller/workflow"
@@ -58,10 +62,12 @@ import (
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	componentworkflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/componentworkflow"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+	"github.com/openchoreo/openchoreo/internal/schema/resourceschema"
 	"github.com/openchoreo/openchoreo/internal/version"
 	componentwebhook "github.com/openchoreo/openchoreo/internal/webhook/component"
 	componentreleasewebhook "github.com/openchoreo/openchoreo/internal/webhook/componentrelease"
 	componenttypewebhook "github.com/openchoreo/openchoreo/internal/webhook/componenttype"
+	environmentwebhook "github.com/openchoreo/openchoreo/internal/webhook/environment"
 	projectwebhook "github.com/openchoreo/openchoreo/internal/webhook/project"
 	releasebindingwebhook "github.com/openchoreo/openchoreo/internal/webhook/releasebinding"
 	traitwebhook "github.com/openchoreo/openchoreo/internal/webhook/trait"
@@ -189,10 +195,56 @@ func setupControlPlaneControllers(
 		return err
 	}
 
+	// Rendered custom resources are validated against the CRDs of their data plane, read through the gateway
+	var resourceValidatorOpts []resourceschema.Option
+	if clusterGatewayURL != "" {
+		resourceValidatorOpts = append(resourceValidatorOpts, resourceschema.WithCRDSource(
+			resourceschema.NewDataPlaneCRDs(k8sClientMgr, clusterGatewayURL, resourceschema.DefaultCRDCacheTTL)))
+	}
+	renderPipeline := componentpipeline.NewPipeline(componentpipeline.WithResourceValidator(
+		resourceschema.NewValidator(resourceValidatorOpts...)))
 	if err := (&releasebinding.Reconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
-		Pipeline: componentpipeline.NewPipeline(),
+		Pipeline: renderPipeline,
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	// Render policies report whether their rules compile, the ones that do not are skipped on render
+	if err := (&renderpolicy.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Pipeline: renderPipeline,
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	if err := (&renderpolicy.ClusterReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Pipeline: renderPipeline,
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	if err := (&promotionrequest.Reconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	if err := (&previewenvironment.Reconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	if err := (&retention.Reconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
@@ -250,8 +302,10 @@ func setupControlPlaneControllers(
 	}
 
 	if err := (&secretreference.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		K8sClientMgr: k8sClientMgr,
+		GatewayURL:   clusterGatewayURL,
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
@@ -490,6 +544,13 @@ func main() {
 		}
 	}
 	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := environmentwebhook.SetupEnvironmentWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Environment")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err := componentwebhook.SetupComponentWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Component")
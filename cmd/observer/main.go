@@ -16,9 +16,11 @@ import (
 	"time"
 
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
 	k8s "github.com/openchoreo/openchoreo/internal/observer/clients"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/delivery"
 	"github.com/openchoreo/openchoreo/internal/observer/handlers"
 	"github.com/openchoreo/openchoreo/internal/observer/mcp"
 	observermiddleware "github.com/openchoreo/openchoreo/internal/observer/middleware"
@@ -77,6 +79,28 @@ func main() {
 	// Initialize logging service
 	loggingService := service.NewLoggingService(osClient, metricsService, k8sClient, cfg, logger)
 
+	// Graceful shutdown using signal context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Persist alert states and silences so that deduplication and silences survive restarts
+	storeCtx, storeCancel := context.WithTimeout(ctx, 30*time.Second)
+	alertStore, err := alerting.NewOpenSearchStore(storeCtx, osClient)
+	if err == nil {
+		err = loggingService.UseAlertStore(storeCtx, alertStore)
+	}
+	if err != nil {
+		logger.Warn("Failed to initialize alert store, alert states will only be kept in memory", "error", err)
+	}
+	if deliveryLog, err := delivery.NewOpenSearchLog(storeCtx, osClient); err != nil {
+		logger.Warn("Failed to initialize notification delivery log, deliveries will only be kept in memory", "error", err)
+	} else {
+		loggingService.UseDeliveryLog(deliveryLog)
+	}
+	storeCancel()
+	go loggingService.RunNotificationDelivery(ctx)
+	go loggingService.RunAlertLifecycle(ctx)
+
 	// Initialize authz client
 	var authzPDP authzcore.PDP
 	if cfg.Authz.Enabled {
@@ -151,10 +175,21 @@ func main() {
 	api.HandleFunc("POST /api/metrics/component/http", handler.GetComponentHTTPMetrics)
 	api.HandleFunc("POST /api/metrics/component/usage", handler.GetComponentResourceMetrics)
 
+	// API routes - Alerts and Silences
+	api.HandleFunc("GET /api/alerting/alerts", handler.ListAlerts)
+	api.HandleFunc("GET /api/alerting/silences", handler.ListSilences)
+	api.HandleFunc("POST /api/alerting/silences", handler.CreateSilence)
+	api.HandleFunc("DELETE /api/alerting/silences/{silenceId}", handler.ExpireSilence)
+	api.HandleFunc("GET /api/alerting/deliveries", handler.ListDeliveries)
+
 	// MCP endpoint with chained middleware (logger -> recovery -> auth401 -> jwt -> handler)
 	mcpMiddleware := initMCPMiddleware(logger)
 	mcpRoutes := routes.Group(mcpMiddleware, jwtAuth)
-	mcpRoutes.Handle("/mcp", mcp.NewHTTPServer(&mcp.MCPHandler{Service: loggingService}))
+	mcpRoutes.Handle("/mcp", mcp.NewHTTPServer(&mcp.MCPHandler{
+		Service:  loggingService,
+		AuthzPDP: authzPDP,
+		Logger:   logger.With("component", "mcp"),
+	}))
 
 	// Create HTTP server
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
@@ -173,10 +208,6 @@ func main() {
 		}
 	}()
 
-	// Graceful shutdown using signal context
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	// Wait for interrupt signal
 	<-ctx.Done()
 
@@ -18,11 +18,14 @@ import (
 	"github.com/openchoreo/openchoreo/internal/cmdutil"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	openapihandlers "github.com/openchoreo/openchoreo/internal/openchoreo-api/api/handlers"
+	apiaudit "github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
 	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/handlers"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/tokens"
 	"github.com/openchoreo/openchoreo/internal/server"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/router"
@@ -79,11 +82,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Initialize the durable audit trail
+	auditSinks, auditStore, err := apiaudit.NewSinks(ctx, cfg.Audit, baseLogger.With("component", "audit"))
+	if err != nil {
+		baseLogger.Error("Failed to initialize audit sinks", slog.Any("error", err))
+		os.Exit(1)
+	}
+	auditLogger := audit.NewLogger(baseLogger.With("component", "audit"), "openchoreo-api", auditSinks...)
+
+	// Initialize the API token store, which shares the authorization database
+	var tokenStore *tokens.Store
+	if db := authz.Database(pap); db != nil {
+		tokenStore, err = tokens.NewStore(db)
+		if err != nil {
+			baseLogger.Error("Failed to initialize API token store", slog.Any("error", err))
+			os.Exit(1)
+		}
+	} else {
+		baseLogger.Info("API tokens disabled - they require authorization to be enabled")
+	}
+	tokenPolicy := services.TokenPolicy{
+		DefaultLifetime: cfg.Security.APITokens.DefaultLifetime,
+		MaxLifetime:     cfg.Security.APITokens.MaxLifetime,
+	}
+	tokenPolicy.ServiceAccountType, tokenPolicy.ServiceAccountClaim, _ = cfg.Security.ServiceKeySubject()
+
 	// Initialize services with PAP and PDP
-	services := services.NewServices(k8sClient, kubernetesClient.NewManager(), pap, pdp, baseLogger)
+	services := services.NewServices(k8sClient, kubernetesClient.NewManager(), pap, pdp, auditStore, tokenStore, tokenPolicy, baseLogger)
 
 	// Initialize legacy HTTP handlers with config for user type management
-	legacyHandler := handlers.New(services, cfg, baseLogger.With("component", "legacy-handlers"))
+	legacyHandler := handlers.New(services, cfg, auditLogger, baseLogger.With("component", "legacy-handlers"))
 	legacyRoutes := legacyHandler.Routes()
 
 	// Initialize OpenAPI handlers
@@ -92,12 +120,14 @@ func main() {
 
 	// Initialize middlewares for OpenAPI handler
 	loggerMiddleware := apilogger.LoggerMiddleware(baseLogger.With("component", "openapi"))
-	jwtMiddleware := legacyHandler.InitJWTMiddleware()
-	authMiddleware := auth.OpenAPIAuth(jwtMiddleware, gen.BearerAuthScopes)
+	authnMiddleware := legacyHandler.InitAuthMiddleware()
+	authMiddleware := auth.OpenAPIAuth(authnMiddleware, gen.BearerAuthScopes)
+	auditMiddleware := legacyHandler.InitAuditMiddleware()
 
-	// Create OpenAPI handler with middleware chain (order: logger → auth → handler)
+	// Create OpenAPI handler with middleware chain. Each middleware wraps the ones listed before it,
+	// so the order is auth → logger → audit → handler and audit sees the subject and the request ID.
 	openapiRoutes := gen.HandlerWithOptions(strictHandler, gen.StdHTTPServerOptions{
-		Middlewares: []gen.MiddlewareFunc{loggerMiddleware, authMiddleware},
+		Middlewares: []gen.MiddlewareFunc{auditMiddleware, loggerMiddleware, authMiddleware},
 	})
 
 	// Create migration router that routes based on X-Use-OpenAPI header
@@ -120,6 +150,9 @@ func main() {
 		baseLogger.Error("Server error", slog.Any("error", err))
 	}
 
+	// Flush the audit events still queued for the sinks
+	auditLogger.Close()
+
 	// Close authorization database connection
 	if casbinEnforcer, ok := pap.(interface{ Close() error }); ok {
 		if err := casbinEnforcer.Close(); err != nil {
@@ -218,6 +218,14 @@ func prepareMappingRecords(mappingDefinitions []authzcore.RoleEntitlementMapping
 		// Determine role_ns: "*" for cluster roles, namespace for namespace-scoped roles
 		roleNs := normalizeNamespace(mappingDef.RoleRef.Namespace)
 
+		if err := validateCondition(mappingDef.Context.Condition); err != nil {
+			return nil, err
+		}
+		policyCtx, err := encodePolicyContext(mappingDef.Context)
+		if err != nil {
+			return nil, err
+		}
+
 		policyRecords = append(policyRecords, CasbinRule{
 			Ptype:      "p",
 			V0:         entitlement,
@@ -225,7 +233,7 @@ func prepareMappingRecords(mappingDefinitions []authzcore.RoleEntitlementMapping
 			V2:         mappingDef.RoleRef.Name,
 			V3:         roleNs,
 			V4:         string(mappingDef.Effect),
-			V5:         emptyContextJSON,
+			V5:         policyCtx,
 			IsInternal: mappingDef.IsInternal,
 		})
 	}
@@ -40,7 +40,6 @@ type CasbinConfig struct {
 
 const (
 	// emptyContextJSON represents an empty context used when no contextual conditions are applied
-	// TODO: Replace with proper context handling when context matching is implemented
 	emptyContextJSON = "{}"
 )
 
@@ -204,6 +203,7 @@ type policyInfo struct {
 	roleName      string
 	roleNamespace string
 	effect        string
+	condition     string
 }
 
 // filterPoliciesBySubjectAndScope retrieves and filters policies relevant to the subject and scope
@@ -230,7 +230,11 @@ func (ce *CasbinEnforcer) filterPoliciesBySubjectAndScope(subjectCtx *authzcore.
 			roleName := policy[2]
 			roleNamespace := policy[3] // Capture role namespace
 			effect := policy[4]
-			// policy[5] is context
+			mappingCtx, err := decodePolicyContext(policy[5])
+			if err != nil {
+				ce.logger.Warn("skipping policy with malformed context", "policy", policy, "error", err)
+				continue
+			}
 
 			if !isWithinScope(resourcePath, scopePath) {
 				continue
@@ -241,6 +245,7 @@ func (ce *CasbinEnforcer) filterPoliciesBySubjectAndScope(subjectCtx *authzcore.
 				roleName:      roleName,
 				roleNamespace: roleNamespace,
 				effect:        effect,
+				condition:     mappingCtx.Condition,
 			})
 		}
 	}
@@ -251,8 +256,9 @@ func (ce *CasbinEnforcer) filterPoliciesBySubjectAndScope(subjectCtx *authzcore.
 // buildCapabilitiesFromPolicies constructs the capabilities map from filtered policies
 func (ce *CasbinEnforcer) buildCapabilitiesFromPolicies(policies []policyInfo, actionIdx actionIndex) (map[string]*authzcore.ActionCapability, error) {
 	type resourceKey struct {
-		path   string
-		effect string
+		path      string
+		effect    string
+		condition string
 	}
 
 	roleToActions := make(map[authzcore.RoleRef][]string)
@@ -290,7 +296,7 @@ func (ce *CasbinEnforcer) buildCapabilitiesFromPolicies(policies []policyInfo, a
 			if actionResources[action] == nil {
 				actionResources[action] = make(map[resourceKey]bool)
 			}
-			actionResources[action][resourceKey{path: p.resourcePath, effect: p.effect}] = true
+			actionResources[action][resourceKey{path: p.resourcePath, effect: p.effect, condition: p.condition}] = true
 		}
 	}
 
@@ -306,6 +312,13 @@ func (ce *CasbinEnforcer) buildCapabilitiesFromPolicies(policies []policyInfo, a
 			capRes := &authzcore.CapabilityResource{
 				Path:        res.path,
 				Constraints: nil,
+				Hierarchy:   resourcePathToHierarchy(res.path),
+			}
+			// Conditional permissions depend on attributes of the resource instance, so the
+			// condition is returned for clients to tell them apart from unconditional ones
+			if res.condition != "" {
+				var constraints interface{} = map[string]string{"condition": res.condition}
+				capRes.Constraints = &constraints
 			}
 			if res.effect == string(authzcore.PolicyEffectAllow) {
 				capability.Allowed = append(capability.Allowed, capRes)
@@ -658,6 +671,11 @@ func (ce *CasbinEnforcer) AddRoleEntitlementMapping(ctx context.Context, mapping
 
 	roleNs := normalizeNamespace(mapping.RoleRef.Namespace)
 
+	policyCtx, err := encodePolicyContext(mapping.Context)
+	if err != nil {
+		return err
+	}
+
 	// policy: p, subject, resourcePath, role, role_ns, eft, context (6 fields)
 	ok, err := ce.enforcer.AddPolicy(
 		subject,
@@ -665,7 +683,7 @@ func (ce *CasbinEnforcer) AddRoleEntitlementMapping(ctx context.Context, mapping
 		mapping.RoleRef.Name,
 		roleNs,
 		string(mapping.Effect),
-		emptyContextJSON,
+		policyCtx,
 	)
 	// if err is nil and ok is false, some mappings already exist
 	if !ok {
@@ -691,11 +709,15 @@ func (ce *CasbinEnforcer) UpdateRoleEntitlementMapping(ctx context.Context, mapp
 		"entitlement_claim", mapping.Entitlement.Claim,
 		"entitlement_value", mapping.Entitlement.Value,
 		"hierarchy", mapping.Hierarchy,
-		"effect", mapping.Effect)
+		"effect", mapping.Effect,
+		"context", mapping.Context)
 
 	if mapping.ID == 0 {
 		return fmt.Errorf("mapping ID is required for update")
 	}
+	if err := validateCondition(mapping.Context.Condition); err != nil {
+		return err
+	}
 
 	// Get existing policy to verify it exists and check if it's internal
 	existingRule, err := ce.getPoliciesByID(mapping.ID)
@@ -716,6 +738,11 @@ func (ce *CasbinEnforcer) UpdateRoleEntitlementMapping(ctx context.Context, mapp
 	// Determine role_ns from RoleRef.Namespace
 	roleNs := normalizeNamespace(mapping.RoleRef.Namespace)
 
+	policyCtx, err := encodePolicyContext(mapping.Context)
+	if err != nil {
+		return err
+	}
+
 	// Old policy uses 6-field format (V0-V5)
 	oldPolicy := []string{
 		existingRule.V0,
@@ -732,7 +759,7 @@ func (ce *CasbinEnforcer) UpdateRoleEntitlementMapping(ctx context.Context, mapp
 		mapping.RoleRef.Name,
 		roleNs,
 		string(mapping.Effect),
-		emptyContextJSON,
+		policyCtx,
 	}
 
 	ok, err := ce.enforcer.UpdatePolicy(oldPolicy, newPolicy)
@@ -759,7 +786,6 @@ func (ce *CasbinEnforcer) RemoveRoleEntitlementMapping(ctx context.Context, mapp
 		return authzcore.ErrCannotDeleteSystemMapping
 	}
 
-	// TODO: Handle context conditions properly in the future
 	ok, err := ce.enforcer.RemovePolicy(
 		rule.V0,
 		rule.V1,
@@ -799,7 +825,11 @@ func (ce *CasbinEnforcer) ListRoleEntitlementMappings(ctx context.Context, filte
 		roleName := rule.V2
 		roleNs := rule.V3 // New field: role_ns
 		effect := authzcore.PolicyEffectType(rule.V4)
-		// V5 is context (currently emptyContextJSON)
+		mappingCtx, err := decodePolicyContext(rule.V5)
+		if err != nil {
+			ce.logger.Warn("skipping mapping with malformed context", "mapping_id", rule.ID, "error", err)
+			continue
+		}
 
 		// Convert role_ns to RoleRef.Namespace
 		// "*" means cluster role (empty namespace)
@@ -820,7 +850,7 @@ func (ce *CasbinEnforcer) ListRoleEntitlementMappings(ctx context.Context, filte
 			},
 			Hierarchy: resourcePathToHierarchy(resourcePath),
 			Effect:    effect,
-			Context:   authzcore.Context{},
+			Context:   mappingCtx,
 		})
 	}
 
@@ -845,7 +875,6 @@ func (ce *CasbinEnforcer) ListActions(ctx context.Context) ([]string, error) {
 	return result, nil
 }
 
-// TODO: once context is properly integrated, pass it to enforcer
 // check performs the actual authorization check using Casbin
 func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
 	resourcePath := hierarchyToResourcePath(request.Resource.Hierarchy)
@@ -864,6 +893,19 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 		"action", request.Action,
 		"context", request.Context)
 
+	// A subject limited to scopes, such as an API token, never exceeds them whatever its role mappings grant
+	if !withinScopes(request.Action, subjectCtx.Scopes) {
+		return &authzcore.Decision{Decision: false,
+			Context: &authzcore.DecisionContext{
+				Reason: fmt.Sprintf("Access denied: action '%s' is outside the scopes of the subject", request.Action),
+			}}, nil
+	}
+
+	requestCtx, err := encodeRequestContext(request, time.Now())
+	if err != nil {
+		return &authzcore.Decision{Decision: false}, err
+	}
+
 	result := false
 	decision := &authzcore.Decision{Decision: false,
 		Context: &authzcore.DecisionContext{
@@ -879,7 +921,7 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 			entitlement,
 			resourcePath,
 			request.Action,
-			emptyContextJSON,
+			requestCtx,
 		)
 		if err != nil {
 			ce.logger.Warn("enforcement failed", "error", err)
@@ -898,6 +940,11 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 	return decision, nil
 }
 
+// DB returns the database that stores the policies, so that other stores can share its connection
+func (ce *CasbinEnforcer) DB() *gorm.DB {
+	return ce.db
+}
+
 // Close closes the database connection and cleans up resources
 func (ce *CasbinEnforcer) Close() error {
 	if ce.db != nil {
@@ -0,0 +1,183 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/utils/lru"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+// conditionInput is the request side of condition evaluation. It is serialized into the ctx field of the
+// casbin request, since custom matcher functions only receive the request and policy fields.
+type conditionInput struct {
+	Context  map[string]interface{} `json:"context"`
+	Resource map[string]interface{} `json:"resource"`
+	Action   string                 `json:"action"`
+	Subject  map[string]interface{} `json:"subject"`
+	// Now is truncated to the minute so that the cached enforcer can reuse decisions within a minute
+	Now time.Time `json:"now"`
+}
+
+// policyContext is the stored form of a mapping context in the ctx field of a policy
+type policyContext struct {
+	Condition string `json:"condition,omitempty"`
+}
+
+const (
+	// conditionCacheSize bounds the number of compiled conditions kept, since conditions come from user input
+	conditionCacheSize = 1000
+	// conditionCostLimit bounds the work a condition does per evaluation. A condition that exceeds it
+	// fails to evaluate, which denies rather than grants access.
+	conditionCostLimit = 100000
+)
+
+var (
+	conditionEnvOnce sync.Once
+	conditionEnv     *cel.Env
+	conditionEnvErr  error
+
+	// conditionPrograms caches compiled conditions by expression
+	conditionPrograms = lru.New(conditionCacheSize)
+)
+
+func getConditionEnv() (*cel.Env, error) {
+	conditionEnvOnce.Do(func() {
+		conditionEnv, conditionEnvErr = cel.NewEnv(
+			cel.Variable("context", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("action", cel.StringType),
+			cel.Variable("subject", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("now", cel.TimestampType),
+		)
+	})
+	return conditionEnv, conditionEnvErr
+}
+
+// compileCondition parses and type-checks a condition, which must evaluate to a bool
+func compileCondition(expression string) (cel.Program, error) {
+	if cached, ok := conditionPrograms.Get(expression); ok {
+		return cached.(cel.Program), nil
+	}
+	env, err := getConditionEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create condition environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("condition must evaluate to a bool, got %s", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(conditionCostLimit))
+	if err != nil {
+		return nil, err
+	}
+	conditionPrograms.Add(expression, program)
+	return program, nil
+}
+
+// validateCondition checks that a mapping condition compiles
+func validateCondition(expression string) error {
+	if expression == "" {
+		return nil
+	}
+	if _, err := compileCondition(expression); err != nil {
+		return fmt.Errorf("%w: invalid condition %q: %w", authzcore.ErrInvalidRequest, expression, err)
+	}
+	return nil
+}
+
+// evaluateCondition evaluates a condition against the serialized request input
+func evaluateCondition(expression, requestCtx string) (bool, error) {
+	program, err := compileCondition(expression)
+	if err != nil {
+		return false, err
+	}
+	input := conditionInput{}
+	if requestCtx != "" {
+		if err := json.Unmarshal([]byte(requestCtx), &input); err != nil {
+			return false, fmt.Errorf("failed to decode request context: %w", err)
+		}
+	}
+	if input.Context == nil {
+		input.Context = map[string]interface{}{}
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		"context":  input.Context,
+		"resource": input.Resource,
+		"action":   input.Action,
+		"subject":  input.Subject,
+		"now":      input.Now,
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition evaluated to %T, not a bool", out.Value())
+	}
+	return result, nil
+}
+
+// encodePolicyContext converts a mapping context into the ctx field of a policy
+func encodePolicyContext(mappingCtx authzcore.Context) (string, error) {
+	if mappingCtx.Condition == "" {
+		return emptyContextJSON, nil
+	}
+	data, err := json.Marshal(policyContext{Condition: mappingCtx.Condition})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode mapping context: %w", err)
+	}
+	return string(data), nil
+}
+
+// decodePolicyContext converts the ctx field of a policy back into a mapping context
+func decodePolicyContext(policyCtx string) (authzcore.Context, error) {
+	if policyCtx == "" || policyCtx == emptyContextJSON {
+		return authzcore.Context{}, nil
+	}
+	var stored policyContext
+	if err := json.Unmarshal([]byte(policyCtx), &stored); err != nil {
+		return authzcore.Context{}, fmt.Errorf("failed to decode policy context: %w", err)
+	}
+	return authzcore.Context{Condition: stored.Condition}, nil
+}
+
+// encodeRequestContext serializes the request attributes that conditions can refer to
+func encodeRequestContext(request *authzcore.EvaluateRequest, now time.Time) (string, error) {
+	subject := map[string]interface{}{}
+	if request.SubjectContext != nil {
+		subject["id"] = request.SubjectContext.ID
+		subject["type"] = request.SubjectContext.Type
+		subject["entitlementClaim"] = request.SubjectContext.EntitlementClaim
+		subject["entitlements"] = request.SubjectContext.EntitlementValues
+		subject["scopes"] = request.SubjectContext.Scopes
+	}
+	input := conditionInput{
+		Context: request.Context.Attributes,
+		Resource: map[string]interface{}{
+			"type":      request.Resource.Type,
+			"id":        request.Resource.ID,
+			"namespace": request.Resource.Hierarchy.Namespace,
+			"project":   request.Resource.Hierarchy.Project,
+			"component": request.Resource.Hierarchy.Component,
+		},
+		Action:  request.Action,
+		Subject: subject,
+		Now:     now.UTC().Truncate(time.Minute),
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request context: %w", err)
+	}
+	return string(data), nil
+}
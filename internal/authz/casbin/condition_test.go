@@ -0,0 +1,207 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+func TestCtxMatch(t *testing.T) {
+	requestCtx, err := encodeRequestContext(&authzcore.EvaluateRequest{
+		SubjectContext: &authzcore.SubjectContext{Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"dev"}},
+		Resource: authzcore.Resource{
+			Type:      "component",
+			ID:        "c1",
+			Hierarchy: authzcore.ResourceHierarchy{Namespace: "acme", Project: "p1", Component: "c1"},
+		},
+		Action: "component:deploy",
+		Context: authzcore.Context{Attributes: map[string]interface{}{
+			authzcore.ContextAttributeEnvironment: map[string]interface{}{"name": "dev", "isProduction": false},
+		}},
+	}, time.Date(2025, 1, 6, 10, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("encodeRequestContext() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		condition string
+		effect    string
+		want      bool
+	}{
+		{name: "no condition", condition: "", effect: "allow", want: true},
+		{name: "condition on attributes holds", condition: "!context.environment.isProduction", effect: "allow", want: true},
+		{name: "condition on attributes fails", condition: "context.environment.name == 'prod'", effect: "allow", want: false},
+		{name: "condition on resource", condition: "resource.project == 'p1' && action == 'component:deploy'", effect: "allow", want: true},
+		{name: "condition on subject", condition: "'dev' in subject.entitlements", effect: "allow", want: true},
+		{name: "condition on time", condition: "now.getHours() >= 9 && now.getHours() < 17", effect: "allow", want: true},
+		{name: "missing attribute does not grant", condition: "context.component.labels.team == 'a'", effect: "allow", want: false},
+		{name: "missing attribute still denies", condition: "context.component.labels.team == 'a'", effect: "deny", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyCtx, err := encodePolicyContext(authzcore.Context{Condition: tt.condition})
+			if err != nil {
+				t.Fatalf("encodePolicyContext() error = %v", err)
+			}
+			if got := ctxMatch(requestCtx, policyCtx, tt.effect); got != tt.want {
+				t.Errorf("ctxMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		wantErr   bool
+	}{
+		{name: "empty", condition: "", wantErr: false},
+		{name: "valid", condition: "has(context.component) && context.component.labels.team == 'payments'", wantErr: false},
+		{name: "syntax error", condition: "context.environment.isProduction ==", wantErr: true},
+		{name: "unknown variable", condition: "request.user == 'a'", wantErr: true},
+		{name: "not a bool", condition: "resource.project", wantErr: false},
+		{name: "string literal", condition: "'yes'", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCondition(tt.condition)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, authzcore.ErrInvalidRequest) {
+				t.Errorf("validateCondition() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
+
+func TestEvaluateCondition_CostLimit(t *testing.T) {
+	// Six nested comprehensions over ten elements take a million steps
+	digits := "[0, 1, 2, 3, 4, 5, 6, 7, 8, 9]"
+	expression := "true"
+	for _, variable := range []string{"a", "b", "c", "d", "e", "f"} {
+		expression = fmt.Sprintf("%s.all(%s, %s)", digits, variable, expression)
+	}
+	if err := validateCondition(expression); err != nil {
+		t.Fatalf("validateCondition() error = %v", err)
+	}
+	if _, err := evaluateCondition(expression, ""); err == nil {
+		t.Error("expected a condition over the cost limit to fail to evaluate")
+	}
+}
+
+func TestCompileCondition_BoundedCache(t *testing.T) {
+	for i := range conditionCacheSize + 10 {
+		if _, err := compileCondition(fmt.Sprintf("resource.id == 'c%d'", i)); err != nil {
+			t.Fatalf("compileCondition() error = %v", err)
+		}
+	}
+	if got := conditionPrograms.Len(); got > conditionCacheSize {
+		t.Errorf("condition cache holds %d programs, want at most %d", got, conditionCacheSize)
+	}
+}
+
+// TestCasbinEnforcer_Evaluate_Conditions tests that mapping conditions restrict grants to matching requests
+func TestCasbinEnforcer_Evaluate_Conditions(t *testing.T) {
+	enforcer := setupTestEnforcer(t)
+	ctx := context.Background()
+
+	if err := enforcer.AddRole(ctx, &authzcore.Role{Name: "deployer", Actions: []string{"component:deploy"}}); err != nil {
+		t.Fatalf("failed to add deployer role: %v", err)
+	}
+	mapping := &authzcore.RoleEntitlementMapping{
+		Entitlement: authzcore.Entitlement{Claim: "groups", Value: "dev-group"},
+		RoleRef:     authzcore.RoleRef{Name: "deployer"},
+		Hierarchy:   authzcore.ResourceHierarchy{Namespace: "acme"},
+		Effect:      authzcore.PolicyEffectAllow,
+		Context:     authzcore.Context{Condition: "!context.environment.isProduction"},
+	}
+	if err := enforcer.AddRoleEntitlementMapping(ctx, mapping); err != nil {
+		t.Fatalf("failed to add conditional mapping: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		want       bool
+	}{
+		{
+			name: "non-production environment",
+			attributes: map[string]interface{}{
+				authzcore.ContextAttributeEnvironment: map[string]interface{}{"name": "staging", "isProduction": false},
+			},
+			want: true,
+		},
+		{
+			name: "production environment",
+			attributes: map[string]interface{}{
+				authzcore.ContextAttributeEnvironment: map[string]interface{}{"name": "production", "isProduction": true},
+			},
+			want: false,
+		},
+		{
+			name:       "environment unknown",
+			attributes: nil,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := enforcer.Evaluate(ctx, &authzcore.EvaluateRequest{
+				SubjectContext: &authzcore.SubjectContext{
+					Type:              "user",
+					EntitlementClaim:  "groups",
+					EntitlementValues: []string{"dev-group"},
+				},
+				Resource: authzcore.Resource{
+					Type:      "component",
+					Hierarchy: authzcore.ResourceHierarchy{Namespace: "acme", Project: "p1", Component: "c1"},
+				},
+				Action:  "component:deploy",
+				Context: authzcore.Context{Attributes: tt.attributes},
+			})
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if decision.Decision != tt.want {
+				t.Errorf("Evaluate() decision = %v, want %v", decision.Decision, tt.want)
+			}
+		})
+	}
+
+	mappings, err := enforcer.ListRoleEntitlementMappings(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListRoleEntitlementMappings() error = %v", err)
+	}
+	found := false
+	for _, m := range mappings {
+		if m.Entitlement.Value == "dev-group" {
+			found = true
+			if m.Context.Condition != mapping.Context.Condition {
+				t.Errorf("ListRoleEntitlementMappings() condition = %q, want %q", m.Context.Condition, mapping.Context.Condition)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("ListRoleEntitlementMappings() did not return the conditional mapping")
+	}
+
+	invalid := *mapping
+	invalid.Entitlement.Value = "other-group"
+	invalid.Context.Condition = "context.environment.isProduction =="
+	if err := enforcer.AddRoleEntitlementMapping(ctx, &invalid); !errors.Is(err, authzcore.ErrInvalidRequest) {
+		t.Errorf("AddRoleEntitlementMapping() error = %v, want ErrInvalidRequest", err)
+	}
+}
@@ -36,18 +36,22 @@ func resourceMatch(requestResource, policyResource string) bool {
 	return strings.HasPrefix(requestResource, policyResource+"/")
 }
 
-// ctxMatch checks if the request context matches the policy context.
-// Currently a placeholder - you can implement custom context matching logic here.
-// For example, matching based on environment, time, or other conditional attributes.
-func ctxMatch(requestCtx, policyCtx string) bool {
-	// Empty policy context means no constraints - always matches
-	if policyCtx == "" {
+// ctxMatch checks if the condition stored in a policy context holds for the request context.
+// A policy without a condition always matches. Conditions that cannot be evaluated fail closed:
+// they do not grant access through allow policies, and they do apply deny policies.
+func ctxMatch(requestCtx, policyCtx, effect string) bool {
+	mappingCtx, err := decodePolicyContext(policyCtx)
+	if err != nil {
+		return effect == string(authzcore.PolicyEffectDeny)
+	}
+	if mappingCtx.Condition == "" {
 		return true
 	}
-
-	// TODO: Implement context matching logic based on your requirements
-	// For now, we'll just check for exact match or empty request context
-	return requestCtx == policyCtx || requestCtx == ""
+	matched, err := evaluateCondition(mappingCtx.Condition, requestCtx)
+	if err != nil {
+		return effect == string(authzcore.PolicyEffectDeny)
+	}
+	return matched
 }
 
 // resourceMatchWrapper is a wrapper for resourceMatch to work with Casbin's function interface
@@ -71,8 +75,8 @@ func resourceMatchWrapper(args ...interface{}) (interface{}, error) {
 
 // ctxMatchWrapper is a wrapper for ctxMatch to work with Casbin's function interface
 func ctxMatchWrapper(args ...interface{}) (interface{}, error) {
-	if len(args) != 2 {
-		return false, fmt.Errorf("ctxMatch requires exactly 2 arguments")
+	if len(args) != 3 {
+		return false, fmt.Errorf("ctxMatch requires exactly 3 arguments")
 	}
 
 	requestCtx, ok := args[0].(string)
@@ -85,7 +89,12 @@ func ctxMatchWrapper(args ...interface{}) (interface{}, error) {
 		return false, fmt.Errorf("second argument must be a string")
 	}
 
-	return ctxMatch(requestCtx, policyCtx), nil
+	effect, ok := args[2].(string)
+	if !ok {
+		return false, fmt.Errorf("third argument must be a string")
+	}
+
+	return ctxMatch(requestCtx, policyCtx, effect), nil
 }
 
 // actionMatch checks if a requested action matches a role's action pattern with wildcard support.
@@ -110,6 +119,20 @@ func actionMatch(requestAction, roleAction string) bool {
 	return false
 }
 
+// withinScopes reports whether an action is permitted by the scopes of a subject, which are action
+// patterns in the same form as role actions. A subject without scopes is not limited.
+func withinScopes(action string, scopes []string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		if actionMatch(action, scope) {
+			return true
+		}
+	}
+	return false
+}
+
 func roleActionMatchWrapper(requestValue, storedRuleValue string) bool {
 	// If storedRuleValue looks like an action (contains ":" or is a wildcard "*"),
 	// use action matching with wildcard support
@@ -321,7 +344,7 @@ func validateRoleEntitlementMapping(mapping *authzcore.RoleEntitlementMapping) e
 	if mapping.RoleRef.Namespace != "" && mapping.RoleRef.Namespace != mapping.Hierarchy.Namespace {
 		return fmt.Errorf("%w: role namespace and mapping hierarchy namespace must match for namespace-scoped roles", authzcore.ErrInvalidRequest)
 	}
-	return nil
+	return validateCondition(mapping.Context.Condition)
 }
 
 // formatSubject creates a subject string from claim and value
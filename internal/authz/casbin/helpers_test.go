@@ -138,6 +138,47 @@ func TestActionMatch(t *testing.T) {
 	}
 }
 
+func TestWithinScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		scopes []string
+		want   bool
+	}{
+		{
+			name:   "no scopes is unrestricted",
+			action: "component:deploy",
+			want:   true,
+		},
+		{
+			name:   "exact scope",
+			action: "component:view",
+			scopes: []string{"project:view", "component:view"},
+			want:   true,
+		},
+		{
+			name:   "wildcard scope",
+			action: "component:deploy",
+			scopes: []string{"component:*"},
+			want:   true,
+		},
+		{
+			name:   "action outside scopes",
+			action: "component:deploy",
+			scopes: []string{"component:view"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withinScopes(tt.action, tt.scopes); got != tt.want {
+				t.Errorf("withinScopes(%q, %v) = %v, want %v", tt.action, tt.scopes, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestHierarchyToResourcePath(t *testing.T) {
 	tests := []struct {
 		name      string
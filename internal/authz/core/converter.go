@@ -14,8 +14,10 @@ func GetAuthzSubjectContext(authCtx *auth.SubjectContext) *SubjectContext {
 	}
 
 	return &SubjectContext{
+		ID:                authCtx.ID,
 		Type:              authCtx.Type,
 		EntitlementClaim:  authCtx.EntitlementClaim,
 		EntitlementValues: authCtx.EntitlementValues,
+		Scopes:            authCtx.Scopes,
 	}
 }
@@ -18,9 +18,12 @@ const (
 
 // SubjectContext represents the authenticated subject making the authorization request
 type SubjectContext struct {
+	ID                string   `json:"id,omitempty"`
 	Type              string   `json:"type"`
 	EntitlementClaim  string   `json:"entitlement_claim"`
 	EntitlementValues []string `json:"entitlement_values"`
+	// Scopes limits the subject to the matching actions, on top of its role mappings. Empty means unrestricted.
+	Scopes []string `json:"scopes,omitempty"`
 }
 
 // ResourceHierarchy represents a single item in a resource hierarchy
@@ -37,11 +40,32 @@ type Resource struct {
 	Hierarchy ResourceHierarchy `json:"hierarchy"`
 }
 
-// Context additional resource instance level context
+// Context carries the attribute-based part of authorization.
+// On an evaluate request it holds attributes of the resource instance, populated by the caller.
+// On a role-entitlement mapping it holds the condition under which the mapping applies.
 type Context struct {
-	// This field is used for storing arbitrary key-value pairs that can be used for policy evaluation
-	// TODO: Define specific context fields as needed
-}
+	// Attributes describe the resource instance of a request, e.g.
+	// {"environment": {"name": "prod", "isProduction": true}, "component": {"labels": {"team": "payments"}}}
+	Attributes map[string]interface{} `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+
+	// Condition is a CEL expression a mapping applies under. It can refer to:
+	//   - context: the attributes of the request
+	//   - resource: type, id, namespace, project and component of the requested resource
+	//   - action: the requested action
+	//   - subject: id, type, entitlements and scopes of the subject
+	//   - now: the evaluation time as a timestamp
+	// An empty condition always applies. A condition that fails to evaluate, for example because the request
+	// lacks an attribute it refers to, is treated as not granting an allow mapping and as applying a deny mapping.
+	Condition string `json:"condition,omitempty" yaml:"condition,omitempty"`
+}
+
+// Context attribute keys populated by the API server
+const (
+	// ContextAttributeEnvironment holds the target environment of deploy and promote requests
+	ContextAttributeEnvironment = "environment"
+	// ContextAttributeComponent holds the component of component scoped requests
+	ContextAttributeComponent = "component"
+)
 
 // Decision represents the authorization decision response
 type Decision struct {
@@ -135,7 +159,7 @@ type RoleEntitlementMapping struct {
 	// Effect indicates whether the mapping is to allow or deny access
 	Effect PolicyEffectType `json:"effect" yaml:"effect"`
 
-	// Context provides optional additional context metadata for this mapping
+	// Context holds the optional condition that restricts when this mapping applies
 	Context Context `json:"context" yaml:"context,omitempty"`
 
 	// IsInternal indicates if this mapping should be hidden from public listings
@@ -173,7 +197,8 @@ type ActionCapability struct {
 type CapabilityResource struct {
 	Path        string       `json:"path"`        // Full resource path: "org/acme/project/payment"
 	Constraints *interface{} `json:"constraints"` // represents additional instance level restrictions
-
+	// Hierarchy is the resource hierarchy the path refers to, for evaluating requests on the same resources
+	Hierarchy ResourceHierarchy `json:"-"`
 }
 
 // UserCapabilitiesResponse represents the complete capabilities response
@@ -54,6 +54,11 @@ var systemActions = []ActionData{
 	{Name: "releasebinding:view", IsInternal: false},
 	{Name: "releasebinding:update", IsInternal: false},
 
+	// PromotionRequest
+	{Name: "promotionrequest:view", IsInternal: false},
+	{Name: "promotionrequest:create", IsInternal: false},
+	{Name: "promotionrequest:approve", IsInternal: false},
+
 	// ComponentType
 	{Name: "componenttype:view", IsInternal: false},
 
@@ -73,6 +78,7 @@ var systemActions = []ActionData{
 	// Environment
 	{Name: "environment:view", IsInternal: false},
 	{Name: "environment:create", IsInternal: false},
+	{Name: "environment:breakglass", IsInternal: false},
 
 	// DataPlane
 	{Name: "dataplane:view", IsInternal: false},
@@ -103,6 +109,14 @@ var systemActions = []ActionData{
 	{Name: "rolemapping:delete", IsInternal: false},
 	{Name: "rolemapping:update", IsInternal: false},
 
+	// audit trail
+	{Name: "audit:view", IsInternal: false},
+
+	// service account keys
+	{Name: "apikey:view", IsInternal: false},
+	{Name: "apikey:create", IsInternal: false},
+	{Name: "apikey:revoke", IsInternal: false},
+
 	// logs
 	{Name: "logs:view", IsInternal: false},
 
@@ -114,6 +128,7 @@ var systemActions = []ActionData{
 
 	// alerts
 	{Name: "alerts:view", IsInternal: false},
+	{Name: "alerts:silence", IsInternal: false},
 
 	// RCA Report
 	{Name: "rcareport:view", IsInternal: false},
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 
+	"gorm.io/gorm"
+
 	"github.com/openchoreo/openchoreo/internal/authz/casbin"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 )
@@ -48,3 +50,12 @@ func Initialize(config AuthZConfig, logger *slog.Logger) (authzcore.PAP, authzco
 
 	return casbinAuthz, casbinAuthz, nil
 }
+
+// Database returns the database of the authorization policies, so that other stores such as the API
+// token store can share it. It returns nil when authorization is disabled.
+func Database(pap authzcore.PAP) *gorm.DB {
+	if enforcer, ok := pap.(*casbin.CasbinEnforcer); ok {
+		return enforcer.DB()
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package changewindow evaluates the change calendars of environments, which decide when
+// releases may be rolled out to them.
+package changewindow
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database, the controller images do not ship one
+	_ "time/tzdata"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// maxSteps bounds the search for the next open time across windows and blackouts
+const maxSteps = 64
+
+// Decision is the state of a change calendar at a point in time
+type Decision struct {
+	// Open reports whether changes may be rolled out
+	Open bool
+	// Reason explains why the calendar is closed. Empty when Open.
+	Reason string
+	// NextOpen is when the calendar opens next. Zero when Open or when it never opens again.
+	NextOpen time.Time
+}
+
+// Message describes a closed calendar for errors and status conditions
+func (d Decision) Message() string {
+	if d.Open {
+		return ""
+	}
+	if d.NextOpen.IsZero() {
+		return d.Reason
+	}
+	return fmt.Sprintf("%s; the next change window opens at %s", d.Reason, d.NextOpen.UTC().Format(time.RFC3339))
+}
+
+// Queues reports whether changes requested while the calendar is closed are held instead of refused
+func Queues(calendar *openchoreov1alpha1.ChangeCalendar) bool {
+	return calendar != nil && calendar.OutsideWindowAction == openchoreov1alpha1.ChangeWindowActionQueue
+}
+
+// Evaluate decides whether changes may be rolled out at the given time.
+// A nil calendar is always open.
+func Evaluate(calendar *openchoreov1alpha1.ChangeCalendar, now time.Time) (Decision, error) {
+	if calendar == nil {
+		return Decision{Open: true}, nil
+	}
+	c, err := compile(calendar)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	var decision Decision
+	if blackout := c.blackoutAt(now); blackout != nil {
+		decision.Reason = fmt.Sprintf("changes are frozen by blackout %q until %s", blackout.Name,
+			blackout.End.UTC().Format(time.RFC3339))
+		if blackout.Reason != "" {
+			decision.Reason += ": " + blackout.Reason
+		}
+	} else if !c.inWindow(now) {
+		decision.Reason = "changes are outside the allowed change windows"
+	} else {
+		return Decision{Open: true}, nil
+	}
+	decision.NextOpen = c.nextOpen(now)
+	return decision, nil
+}
+
+// calendar is a ChangeCalendar with its windows parsed
+type calendar struct {
+	location  *time.Location
+	windows   []window
+	blackouts []openchoreov1alpha1.BlackoutPeriod
+}
+
+// window is a RecurringWindow with its times of day in minutes after midnight
+type window struct {
+	days  map[time.Weekday]bool
+	start int
+	end   int
+}
+
+func compile(c *openchoreov1alpha1.ChangeCalendar) (*calendar, error) {
+	timeZone := c.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+
+	compiled := &calendar{location: location, blackouts: c.Blackouts}
+	for i, w := range c.AllowedWindows {
+		start, err := parseTimeOfDay(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("allowedWindows[%d].start: %w", i, err)
+		}
+		end, err := parseTimeOfDay(w.End)
+		if err != nil {
+			return nil, fmt.Errorf("allowedWindows[%d].end: %w", i, err)
+		}
+		days := make(map[time.Weekday]bool, len(w.Days))
+		for _, day := range w.Days {
+			weekday, err := parseWeekday(day)
+			if err != nil {
+				return nil, fmt.Errorf("allowedWindows[%d].days: %w", i, err)
+			}
+			days[weekday] = true
+		}
+		compiled.windows = append(compiled.windows, window{days: days, start: start, end: end})
+	}
+	return compiled, nil
+}
+
+// blackoutAt returns the blackout in effect at the given time, preferring the one that ends last
+func (c *calendar) blackoutAt(t time.Time) *openchoreov1alpha1.BlackoutPeriod {
+	var found *openchoreov1alpha1.BlackoutPeriod
+	for i := range c.blackouts {
+		b := &c.blackouts[i]
+		if t.Before(b.Start.Time) || !t.Before(b.End.Time) {
+			continue
+		}
+		if found == nil || b.End.After(found.End.Time) {
+			found = b
+		}
+	}
+	return found
+}
+
+// inWindow reports whether the given time falls in any allowed window
+func (c *calendar) inWindow(t time.Time) bool {
+	if len(c.windows) == 0 {
+		return true
+	}
+	local := t.In(c.location)
+	minute := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+	for _, w := range c.windows {
+		if w.start < w.end {
+			if w.opensOn(today) && minute >= w.start && minute < w.end {
+				return true
+			}
+			continue
+		}
+		// The window closes on the day after it opens
+		if (w.opensOn(today) && minute >= w.start) || (w.opensOn(yesterday) && minute < w.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextWindowStart returns the first time after t at which an allowed window opens
+func (c *calendar) nextWindowStart(t time.Time) time.Time {
+	local := t.In(c.location)
+	var next time.Time
+	for offset := 0; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, c.location)
+		for _, w := range c.windows {
+			if !w.opensOn(day.Weekday()) {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), w.start/60, w.start%60, 0, 0, c.location)
+			if start.After(t) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+		if !next.IsZero() {
+			return next
+		}
+	}
+	return next
+}
+
+// nextOpen returns the first time at or after t at which the calendar is open
+func (c *calendar) nextOpen(t time.Time) time.Time {
+	for range maxSteps {
+		if blackout := c.blackoutAt(t); blackout != nil {
+			t = blackout.End.Time
+			continue
+		}
+		if !c.inWindow(t) {
+			t = c.nextWindowStart(t)
+			if t.IsZero() {
+				return t
+			}
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (w window) opensOn(day time.Weekday) bool {
+	return len(w.days) == 0 || w.days[day]
+}
+
+// parseTimeOfDay parses an HH:MM time of day into minutes after midnight
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseWeekday(day openchoreov1alpha1.Weekday) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if weekday.String() == string(day) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day %q", day)
+}
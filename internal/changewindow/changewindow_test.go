@@ -0,0 +1,145 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changewindow
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	businessHours := []openchoreov1alpha1.RecurringWindow{{
+		Days:  []openchoreov1alpha1.Weekday{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+		Start: "09:00",
+		End:   "17:00",
+	}}
+	holidayFreeze := []openchoreov1alpha1.BlackoutPeriod{{
+		Name:   "holidays",
+		Start:  metav1.NewTime(mustParse(t, "2025-12-22T00:00:00Z")),
+		End:    metav1.NewTime(mustParse(t, "2026-01-05T00:00:00Z")),
+		Reason: "holiday freeze",
+	}}
+
+	tests := []struct {
+		name         string
+		calendar     *openchoreov1alpha1.ChangeCalendar
+		now          string
+		wantOpen     bool
+		wantNextOpen string
+		wantReason   string
+	}{
+		{
+			name:     "no calendar",
+			now:      "2025-12-25T12:00:00Z",
+			wantOpen: true,
+		},
+		{
+			name:     "inside business hours",
+			calendar: &openchoreov1alpha1.ChangeCalendar{AllowedWindows: businessHours},
+			now:      "2025-11-12T10:30:00Z",
+			wantOpen: true,
+		},
+		{
+			name:         "friday evening waits for monday",
+			calendar:     &openchoreov1alpha1.ChangeCalendar{AllowedWindows: businessHours},
+			now:          "2025-11-14T17:00:00Z",
+			wantNextOpen: "2025-11-17T09:00:00Z",
+			wantReason:   "outside the allowed change windows",
+		},
+		{
+			name: "windows follow the time zone",
+			calendar: &openchoreov1alpha1.ChangeCalendar{
+				TimeZone:       "Asia/Colombo",
+				AllowedWindows: businessHours,
+			},
+			// 10:00 in Colombo
+			now:      "2025-11-12T04:30:00Z",
+			wantOpen: true,
+		},
+		{
+			name: "window spanning midnight",
+			calendar: &openchoreov1alpha1.ChangeCalendar{AllowedWindows: []openchoreov1alpha1.RecurringWindow{{
+				Days:  []openchoreov1alpha1.Weekday{"Saturday"},
+				Start: "22:00",
+				End:   "02:00",
+			}}},
+			now:      "2025-11-16T01:00:00Z",
+			wantOpen: true,
+		},
+		{
+			name:         "blackout inside a window",
+			calendar:     &openchoreov1alpha1.ChangeCalendar{AllowedWindows: businessHours, Blackouts: holidayFreeze},
+			now:          "2025-12-23T10:00:00Z",
+			wantNextOpen: "2026-01-05T09:00:00Z",
+			wantReason:   `blackout "holidays"`,
+		},
+		{
+			name:         "blackout without windows",
+			calendar:     &openchoreov1alpha1.ChangeCalendar{Blackouts: holidayFreeze},
+			now:          "2025-12-23T10:00:00Z",
+			wantNextOpen: "2026-01-05T00:00:00Z",
+			wantReason:   "holiday freeze",
+		},
+		{
+			name:     "after the blackout",
+			calendar: &openchoreov1alpha1.ChangeCalendar{Blackouts: holidayFreeze},
+			now:      "2026-01-05T00:00:00Z",
+			wantOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Evaluate(tt.calendar, mustParse(t, tt.now))
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if got.Open != tt.wantOpen {
+				t.Fatalf("Evaluate().Open = %v, want %v (%s)", got.Open, tt.wantOpen, got.Reason)
+			}
+			if tt.wantOpen {
+				return
+			}
+			if want := mustParse(t, tt.wantNextOpen); !got.NextOpen.Equal(want) {
+				t.Errorf("Evaluate().NextOpen = %v, want %v", got.NextOpen, want)
+			}
+			if !strings.Contains(got.Reason, tt.wantReason) {
+				t.Errorf("Evaluate().Reason = %q, want it to contain %q", got.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestEvaluateInvalidCalendar(t *testing.T) {
+	t.Parallel()
+
+	calendars := map[string]*openchoreov1alpha1.ChangeCalendar{
+		"time zone": {TimeZone: "Mars/Olympus"},
+		"start":     {AllowedWindows: []openchoreov1alpha1.RecurringWindow{{Start: "9am", End: "17:00"}}},
+		"day":       {AllowedWindows: []openchoreov1alpha1.RecurringWindow{{Days: []openchoreov1alpha1.Weekday{"Funday"}, Start: "09:00", End: "17:00"}}},
+	}
+	for name, calendar := range calendars {
+		if _, err := Evaluate(calendar, time.Now()); err == nil {
+			t.Errorf("Evaluate() with invalid %s: expected an error", name)
+		}
+	}
+}
+
+func mustParse(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", value, err)
+	}
+	return parsed
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -56,6 +57,7 @@ func init() {
 	_ = egv1a1.AddToScheme(scheme.Scheme)
 	_ = csisecretv1.Install(scheme.Scheme)
 	_ = argo.AddToScheme(scheme.Scheme)
+	_ = apiextensionsv1.AddToScheme(scheme.Scheme)
 }
 
 // GetOrAddClient returns a cached client or creates one using the provided create function.
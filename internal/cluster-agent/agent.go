@@ -8,8 +8,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 	"net/url"
 	"os"
 	"sync"
@@ -30,6 +33,8 @@ type Agent struct {
 	k8sClient  client.Client
 	router     *Router
 	mu         sync.Mutex
+	streams    map[string]*messaging.Stream
+	streamsMu  sync.Mutex
 	logger     *slog.Logger
 	stopChan   chan struct{}
 }
@@ -73,6 +78,7 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 		serverCA:   serverCertPool,
 		k8sClient:  k8sClient,
 		router:     router,
+		streams:    make(map[string]*messaging.Stream),
 		logger:     logger.With("component", "agent", "planeID", cfg.PlaneID),
 		stopChan:   make(chan struct{}),
 	}, nil
@@ -188,6 +194,10 @@ func (a *Agent) handleConnection(ctx context.Context) {
 		return a.conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(time.Second))
 	})
 
+	// Streams are bound to this connection; they cannot resume on a new one
+	connCtx, abortStreams := context.WithCancel(ctx)
+	defer abortStreams()
+
 	// Handle context cancellation asynchronously by closing the connection
 	// This causes ReadMessage() to unblock with an error, terminating the loop
 	go func() {
@@ -208,19 +218,153 @@ func (a *Agent) handleConnection(ctx context.Context) {
 			return
 		}
 
-		// Parse as HTTPTunnelRequest
-		var httpReq messaging.HTTPTunnelRequest
-		if err := json.Unmarshal(message, &httpReq); err != nil {
-			a.logger.Warn("failed to parse HTTP tunnel request", "error", err)
+		var envelope messaging.Envelope
+		if err := json.Unmarshal(message, &envelope); err != nil {
+			a.logger.Warn("failed to parse tunnel message", "error", err)
 			continue
 		}
 
-		if httpReq.RequestID == "" {
-			a.logger.Warn("received HTTP tunnel request without requestID")
+		if envelope.RequestID == "" {
+			a.logger.Warn("received tunnel message without requestID")
 			continue
 		}
 
-		go a.handleHTTPTunnelRequest(&httpReq)
+		switch envelope.Type {
+		case "":
+			var httpReq messaging.HTTPTunnelRequest
+			if err := json.Unmarshal(message, &httpReq); err != nil {
+				a.logger.Warn("failed to parse HTTP tunnel request", "error", err)
+				continue
+			}
+
+			go a.handleHTTPTunnelRequest(&httpReq)
+		case messaging.MessageTypeStreamInit:
+			var init messaging.HTTPTunnelStreamInit
+			if err := json.Unmarshal(message, &init); err != nil {
+				a.logger.Warn("failed to parse stream init", "error", err)
+				continue
+			}
+
+			// Register before handing off so chunks that follow the init are not dropped
+			stream := messaging.NewStream(connCtx, init.RequestID, a.sendMessage)
+			a.streamsMu.Lock()
+			a.streams[init.RequestID] = stream
+			a.streamsMu.Unlock()
+
+			go a.handleStream(stream, &init)
+		default:
+			a.handleStreamMessage(envelope, message)
+		}
+	}
+}
+
+// handleStreamMessage dispatches a chunk, ack or cancel message to its stream
+func (a *Agent) handleStreamMessage(envelope messaging.Envelope, message []byte) {
+	a.streamsMu.Lock()
+	stream, ok := a.streams[envelope.RequestID]
+	a.streamsMu.Unlock()
+
+	if !ok {
+		// Late messages for streams that already finished are expected
+		a.logger.Debug("received stream message for unknown stream",
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+		)
+		return
+	}
+
+	var err error
+	switch envelope.Type {
+	case messaging.MessageTypeStreamChunk:
+		var chunk messaging.HTTPTunnelStreamChunk
+		if err = json.Unmarshal(message, &chunk); err == nil {
+			stream.HandleChunk(&chunk)
+		}
+	case messaging.MessageTypeStreamAck:
+		var ack messaging.HTTPTunnelStreamAck
+		if err = json.Unmarshal(message, &ack); err == nil {
+			stream.HandleAck(&ack)
+		}
+	case messaging.MessageTypeStreamCancel:
+		var cancel messaging.HTTPTunnelStreamCancel
+		if err = json.Unmarshal(message, &cancel); err == nil {
+			stream.HandleCancel(&cancel)
+		}
+	default:
+		a.logger.Warn("received stream message of unknown type",
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+		)
+		return
+	}
+
+	if err != nil {
+		a.logger.Warn("failed to parse stream message",
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+			"error", err,
+		)
+		stream.Cancel(fmt.Errorf("%w: %w", messaging.ErrInvalidMessageType, err))
+	}
+}
+
+// handleStream serves a streaming request: it sends the backend status line, then relays
+// the backend body to the gateway until it ends. For protocol upgrades, bytes from the
+// gateway are relayed to the backend connection as well.
+func (a *Agent) handleStream(stream *messaging.Stream, init *messaging.HTTPTunnelStreamInit) {
+	defer a.releaseStream(stream)
+
+	logger := a.logger.With("requestID", init.RequestID)
+	logger.Info("received HTTP tunnel stream",
+		"target", init.Target,
+		"method", init.Method,
+		"path", init.Path,
+		"upgrade", init.IsUpgrade,
+	)
+
+	response, body := a.router.RouteStream(stream.Context(), init)
+	if err := a.sendMessage(response); err != nil {
+		logger.Error("failed to send HTTP tunnel stream response", "error", err)
+		if body != nil {
+			body.Close()
+		}
+		return
+	}
+	if body == nil {
+		return
+	}
+	defer body.Close()
+
+	if backendConn, ok := body.(io.ReadWriteCloser); ok && init.IsUpgrade && response.StatusCode == http.StatusSwitchingProtocols {
+		go func() {
+			if _, err := io.Copy(backendConn, stream); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Debug("relay to backend ended with error", "error", err)
+			}
+		}()
+	}
+
+	written, err := io.Copy(stream, body)
+	if err != nil {
+		// A failed backend read must not look like a clean end of stream to the client
+		stream.Cancel(fmt.Errorf("backend stream failed: %w", err))
+		logger.Debug("HTTP tunnel stream ended with error", "bytes", written, "error", err)
+		return
+	}
+
+	if err := stream.CloseWrite(); err != nil {
+		logger.Debug("failed to half-close HTTP tunnel stream", "error", err)
+	}
+
+	logger.Info("HTTP tunnel stream completed", "bytes", written)
+}
+
+func (a *Agent) releaseStream(stream *messaging.Stream) {
+	a.streamsMu.Lock()
+	delete(a.streams, stream.ID())
+	a.streamsMu.Unlock()
+
+	if err := stream.Close(); err != nil {
+		a.logger.Debug("failed to notify gateway of stream close", "requestID", stream.ID(), "error", err)
 	}
 }
 
@@ -245,6 +389,19 @@ func (a *Agent) handleHTTPTunnelRequest(req *messaging.HTTPTunnelRequest) {
 }
 
 func (a *Agent) sendHTTPTunnelResponse(resp *messaging.HTTPTunnelResponse) error {
+	a.logger.Debug("sending HTTP tunnel response",
+		"requestID", resp.RequestID,
+		"statusCode", resp.StatusCode,
+	)
+
+	if err := a.sendMessage(resp); err != nil {
+		return fmt.Errorf("sendHTTPTunnelResponse: %w", err)
+	}
+	return nil
+}
+
+// sendMessage writes any tunnel message to the gateway, serialized with all other writers
+func (a *Agent) sendMessage(msg any) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
@@ -252,18 +409,13 @@ func (a *Agent) sendHTTPTunnelResponse(resp *messaging.HTTPTunnelResponse) error
 		return messaging.ErrNotConnected
 	}
 
-	data, err := json.Marshal(resp)
+	data, err := json.Marshal(msg)
 	if err != nil {
-		return fmt.Errorf("sendHTTPTunnelResponse: failed to marshal response: %w", err)
+		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	a.logger.Debug("sending HTTP tunnel response",
-		"requestID", resp.RequestID,
-		"statusCode", resp.StatusCode,
-	)
-
 	if err := a.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		return fmt.Errorf("sendHTTPTunnelResponse: failed to write message: %w", err)
+		return fmt.Errorf("failed to write message: %w", err)
 	}
 	return nil
 }
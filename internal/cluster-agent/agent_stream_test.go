@@ -0,0 +1,292 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// fakeGateway plays the gateway end of the tunnel: it opens streams on the agent and
+// dispatches the agent's replies to them.
+type fakeGateway struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	ctx     context.Context
+
+	mu        sync.Mutex
+	streams   map[string]*messaging.Stream
+	responses map[string]chan *messaging.HTTPTunnelStreamResponse
+}
+
+func (fg *fakeGateway) send(msg any) error {
+	fg.writeMu.Lock()
+	defer fg.writeMu.Unlock()
+	return fg.conn.WriteJSON(msg)
+}
+
+// open sends a stream init and waits for the agent's response status line
+func (fg *fakeGateway) open(t *testing.T, init *messaging.HTTPTunnelStreamInit) (*messaging.HTTPTunnelStreamResponse, *messaging.Stream) {
+	t.Helper()
+	init.RequestID = messaging.GenerateMessageID()
+	stream := messaging.NewStream(fg.ctx, init.RequestID, fg.send)
+	response := make(chan *messaging.HTTPTunnelStreamResponse, 1)
+
+	fg.mu.Lock()
+	fg.streams[init.RequestID] = stream
+	fg.responses[init.RequestID] = response
+	fg.mu.Unlock()
+
+	if err := fg.send(init); err != nil {
+		t.Fatalf("failed to send stream init: %v", err)
+	}
+
+	select {
+	case resp := <-response:
+		return resp, stream
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent did not answer the stream init")
+		return nil, nil
+	}
+}
+
+func (fg *fakeGateway) run() {
+	for {
+		_, data, err := fg.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var envelope messaging.Envelope
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			continue
+		}
+
+		fg.mu.Lock()
+		stream := fg.streams[envelope.RequestID]
+		response := fg.responses[envelope.RequestID]
+		fg.mu.Unlock()
+		if stream == nil {
+			continue
+		}
+
+		switch envelope.Type {
+		case messaging.MessageTypeStreamResponse:
+			var resp messaging.HTTPTunnelStreamResponse
+			_ = json.Unmarshal(data, &resp)
+			response <- &resp
+		case messaging.MessageTypeStreamChunk:
+			var chunk messaging.HTTPTunnelStreamChunk
+			_ = json.Unmarshal(data, &chunk)
+			stream.HandleChunk(&chunk)
+		case messaging.MessageTypeStreamAck:
+			var ack messaging.HTTPTunnelStreamAck
+			_ = json.Unmarshal(data, &ack)
+			stream.HandleAck(&ack)
+		case messaging.MessageTypeStreamCancel:
+			var cancel messaging.HTTPTunnelStreamCancel
+			_ = json.Unmarshal(data, &cancel)
+			stream.HandleCancel(&cancel)
+		}
+	}
+}
+
+// newStreamingTestAgent connects an agent whose "k8s" route points at backend to a fake gateway
+func newStreamingTestAgent(t *testing.T, backend http.Handler) (*Agent, *fakeGateway) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	backendServer := httptest.NewServer(backend)
+	t.Cleanup(backendServer.Close)
+
+	gatewayConns := make(chan *websocket.Conn, 1)
+	upgrader := websocket.Upgrader{}
+	tunnel := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		gatewayConns <- conn
+	}))
+	t.Cleanup(tunnel.Close)
+
+	agentConn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(tunnel.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect agent: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	a := &Agent{
+		conn: agentConn,
+		router: &Router{
+			routes: map[string]*Route{
+				"k8s": {
+					Name:             "k8s",
+					Backend:          "http",
+					Endpoint:         backendServer.URL,
+					Transport:        transport,
+					UpgradeTransport: transport,
+				},
+			},
+			logger: logger,
+		},
+		streams:  make(map[string]*messaging.Stream),
+		logger:   logger,
+		stopChan: make(chan struct{}),
+	}
+	go a.handleConnection(ctx)
+
+	gateway := &fakeGateway{
+		conn:      <-gatewayConns,
+		ctx:       ctx,
+		streams:   make(map[string]*messaging.Stream),
+		responses: make(map[string]chan *messaging.HTTPTunnelStreamResponse),
+	}
+	t.Cleanup(func() { gateway.conn.Close() })
+	go gateway.run()
+
+	return a, gateway
+}
+
+func TestAgentStream_RelaysBackendBodyUntilEOF(t *testing.T) {
+	_, gateway := newStreamingTestAgent(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/dp/pods/api/log" || r.URL.Query().Get("follow") != "true" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		for i := 1; i <= 3; i++ {
+			fmt.Fprintf(w, "line %d\n", i)
+			w.(http.Flusher).Flush()
+		}
+	}))
+
+	resp, stream := gateway.open(t, messaging.NewHTTPTunnelStreamInit("k8s", http.MethodGet,
+		"/api/v1/namespaces/dp/pods/api/log", "follow=true", nil, false, ""))
+	if resp.IsError() || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected stream response: %+v", resp)
+	}
+	if got := http.Header(resp.Headers).Get("Content-Type"); got != "text/plain" {
+		t.Errorf("expected backend headers to be relayed, got Content-Type %q", got)
+	}
+	// The agent may already have released a short stream, so the half-close can race its cancel
+	_ = stream.CloseWrite()
+
+	body, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("failed to read stream: %v", err)
+	}
+	if string(body) != "line 1\nline 2\nline 3\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestAgentStream_UnknownTargetIsRejected(t *testing.T) {
+	_, gateway := newStreamingTestAgent(t, http.NotFoundHandler())
+
+	resp, _ := gateway.open(t, messaging.NewHTTPTunnelStreamInit("prometheus", http.MethodGet,
+		"/api/v1/query", "", nil, false, ""))
+	if !resp.IsError() || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a 404 error response, got %+v", resp)
+	}
+}
+
+func TestAgentStream_GatewayCancelStopsBackendRequest(t *testing.T) {
+	backendDone := make(chan struct{})
+	a, gateway := newStreamingTestAgent(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "event 1")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+		close(backendDone)
+	}))
+
+	resp, stream := gateway.open(t, messaging.NewHTTPTunnelStreamInit("k8s", http.MethodGet,
+		"/api/v1/pods", "watch=true", nil, false, ""))
+	if resp.IsError() {
+		t.Fatalf("unexpected stream response: %+v", resp)
+	}
+	line, err := bufio.NewReader(stream).ReadString('\n')
+	if err != nil || line != "event 1\n" {
+		t.Fatalf("unexpected first event %q: %v", line, err)
+	}
+
+	stream.Cancel(errors.New("client disconnected"))
+
+	select {
+	case <-backendDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("canceling the stream did not cancel the backend request")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		a.streamsMu.Lock()
+		n := len(a.streams)
+		a.streamsMu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("agent still tracks %d streams", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAgentStream_UpgradeRelaysBothDirections(t *testing.T) {
+	_, gateway := newStreamingTestAgent(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "SPDY/3.1" {
+			http.Error(w, "upgrade required", http.StatusBadRequest)
+			return
+		}
+		conn, brw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(brw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: SPDY/3.1\r\n\r\n")
+		_ = brw.Flush()
+
+		// Echo one message, then end the backend side of the stream
+		buf := make([]byte, 4)
+		if _, err := io.ReadFull(brw, buf); err != nil {
+			return
+		}
+		_, _ = conn.Write(buf)
+	}))
+
+	headers := map[string][]string{"Connection": {"Upgrade"}, "Upgrade": {"SPDY/3.1"}}
+	resp, stream := gateway.open(t, messaging.NewHTTPTunnelStreamInit("k8s", http.MethodPost,
+		"/api/v1/namespaces/dp/pods/api/exec", "command=sh", headers, true, "SPDY/3.1"))
+	if resp.IsError() || resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("unexpected upgrade response: %+v", resp)
+	}
+
+	if _, err := stream.Write([]byte("ping")); err != nil {
+		t.Fatalf("failed to write to stream: %v", err)
+	}
+	body, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("failed to read stream: %v", err)
+	}
+	if string(body) != "ping" {
+		t.Errorf("unexpected echo %q", body)
+	}
+}
@@ -19,4 +19,10 @@ var (
 	ErrNotConnected = errors.New("not connected to agent server")
 
 	ErrAgentNotFound = errors.New("agent not found")
+
+	ErrStreamCanceled = errors.New("stream canceled by peer")
+
+	ErrStreamClosed = errors.New("write on closed stream")
+
+	ErrStreamWindowExceeded = errors.New("peer exceeded stream flow-control window")
 )
@@ -0,0 +1,246 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// StreamChunkSize is the largest payload carried by a single HTTPTunnelStreamChunk
+	StreamChunkSize = 32 * 1024
+
+	// StreamWindowSize is the number of chunks a receiver buffers per stream. A sender
+	// must wait for an HTTPTunnelStreamAck before exceeding it, so a slow consumer stalls
+	// only its own stream instead of the WebSocket read loop shared by all streams.
+	StreamWindowSize = 32
+
+	// streamAckThreshold batches acknowledgements so the receiver does not send one ack per chunk
+	streamAckThreshold = StreamWindowSize / 4
+)
+
+// SendFunc writes a message to the peer over the shared WebSocket connection
+type SendFunc func(msg any) error
+
+// Stream is one end of a bidirectional byte stream multiplexed over the agent WebSocket.
+// The connection read loop feeds it through HandleChunk, HandleAck and HandleCancel,
+// while the stream owner uses it as an io.ReadWriteCloser.
+type Stream struct {
+	id     string
+	send   SendFunc
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	// incoming holds chunks received from the peer; its capacity is the receive window
+	incoming chan []byte
+	// credits holds one token per chunk the peer is still willing to accept
+	credits chan struct{}
+
+	readMu   sync.Mutex
+	pending  []byte
+	consumed int
+
+	writeMu sync.Mutex
+
+	localClosed  atomic.Bool
+	remoteClosed atomic.Bool
+}
+
+var _ io.ReadWriteCloser = (*Stream)(nil)
+
+// NewStream creates a stream bound to ctx. Canceling ctx (for example when the
+// WebSocket connection drops) aborts the stream.
+func NewStream(ctx context.Context, id string, send SendFunc) *Stream {
+	streamCtx, cancel := context.WithCancelCause(ctx)
+	s := &Stream{
+		id:       id,
+		send:     send,
+		ctx:      streamCtx,
+		cancel:   cancel,
+		incoming: make(chan []byte, StreamWindowSize),
+		credits:  make(chan struct{}, StreamWindowSize),
+	}
+	for range StreamWindowSize {
+		s.credits <- struct{}{}
+	}
+	return s
+}
+
+// ID returns the request ID the stream is multiplexed under
+func (s *Stream) ID() string {
+	return s.id
+}
+
+// Context returns a context that is canceled when the stream is closed or aborted
+func (s *Stream) Context() context.Context {
+	return s.ctx
+}
+
+// HandleChunk queues a chunk received from the peer. It never blocks: a peer that
+// overruns the window is misbehaving and the stream is canceled.
+func (s *Stream) HandleChunk(chunk *HTTPTunnelStreamChunk) {
+	if s.ctx.Err() != nil || s.remoteClosed.Load() {
+		return
+	}
+
+	if len(chunk.Data) > 0 {
+		select {
+		case s.incoming <- chunk.Data:
+		default:
+			s.Cancel(ErrStreamWindowExceeded)
+			return
+		}
+	}
+
+	if chunk.IsClose {
+		s.remoteClosed.Store(true)
+		close(s.incoming)
+	}
+}
+
+// HandleAck returns send credit granted by the peer
+func (s *Stream) HandleAck(ack *HTTPTunnelStreamAck) {
+	for range ack.Credit {
+		select {
+		case s.credits <- struct{}{}:
+		default:
+			return
+		}
+	}
+}
+
+// HandleCancel aborts the stream after the peer canceled its end
+func (s *Stream) HandleCancel(msg *HTTPTunnelStreamCancel) {
+	if msg.Reason != "" {
+		s.Abort(fmt.Errorf("%w: %s", ErrStreamCanceled, msg.Reason))
+		return
+	}
+	s.Abort(ErrStreamCanceled)
+}
+
+// Abort cancels the stream locally without notifying the peer. It is used when the
+// peer already knows, or when the connection to it is gone.
+func (s *Stream) Abort(cause error) {
+	s.cancel(cause)
+}
+
+// Read reads payload sent by the peer. It returns io.EOF once the peer has closed its
+// write side and every chunk sent before that has been consumed.
+func (s *Stream) Read(p []byte) (int, error) {
+	s.readMu.Lock()
+	defer s.readMu.Unlock()
+
+	if len(s.pending) == 0 {
+		data, err := s.next()
+		if err != nil {
+			return 0, err
+		}
+		s.pending = data
+	}
+
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+func (s *Stream) next() ([]byte, error) {
+	select {
+	case data, ok := <-s.incoming:
+		return s.received(data, ok)
+	case <-s.ctx.Done():
+		// Chunks that arrived before the stream was aborted are still delivered
+		select {
+		case data, ok := <-s.incoming:
+			return s.received(data, ok)
+		default:
+			return nil, context.Cause(s.ctx)
+		}
+	}
+}
+
+func (s *Stream) received(data []byte, ok bool) ([]byte, error) {
+	if !ok {
+		return nil, io.EOF
+	}
+
+	s.consumed++
+	if s.consumed >= streamAckThreshold && s.ctx.Err() == nil {
+		if err := s.send(NewHTTPTunnelStreamAck(s.id, s.consumed)); err != nil {
+			s.cancel(fmt.Errorf("failed to acknowledge stream chunks: %w", err))
+		}
+		s.consumed = 0
+	}
+	return data, nil
+}
+
+// Write sends p to the peer, splitting it into chunks of at most StreamChunkSize. It
+// blocks while the peer's receive window is full.
+func (s *Stream) Write(p []byte) (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if s.localClosed.Load() {
+		return 0, ErrStreamClosed
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), StreamChunkSize)
+
+		select {
+		case <-s.credits:
+		case <-s.ctx.Done():
+			return written, context.Cause(s.ctx)
+		}
+		if s.ctx.Err() != nil {
+			return written, context.Cause(s.ctx)
+		}
+
+		if err := s.send(NewHTTPTunnelStreamChunk(s.id, p[:n], false)); err != nil {
+			s.cancel(err)
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// CloseWrite half-closes the stream: the peer reads io.EOF but may keep sending
+func (s *Stream) CloseWrite() error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if s.localClosed.Swap(true) {
+		return nil
+	}
+	if s.ctx.Err() != nil {
+		return context.Cause(s.ctx)
+	}
+	return s.send(NewHTTPTunnelStreamChunk(s.id, nil, true))
+}
+
+// Close releases the stream. If either direction is still open the peer is told to
+// cancel its end, which is how a client disconnect reaches the backend request.
+func (s *Stream) Close() error {
+	var err error
+	if s.ctx.Err() == nil && (!s.localClosed.Load() || !s.remoteClosed.Load()) {
+		err = s.send(NewHTTPTunnelStreamCancel(s.id, "stream closed"))
+	}
+	s.cancel(nil)
+	return err
+}
+
+// Cancel aborts the stream with the given cause and notifies the peer
+func (s *Stream) Cancel(cause error) {
+	if s.ctx.Err() != nil {
+		return
+	}
+	_ = s.send(NewHTTPTunnelStreamCancel(s.id, cause.Error()))
+	s.cancel(cause)
+}
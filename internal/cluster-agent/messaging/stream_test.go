@@ -0,0 +1,149 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// streamPair connects two streams the way the gateway and agent read loops do: every
+// message is JSON-encoded and dispatched in order by a single goroutine per direction.
+func streamPair(t *testing.T) (*Stream, *Stream) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	toA := make(chan []byte, 1024)
+	toB := make(chan []byte, 1024)
+	sender := func(ch chan []byte) SendFunc {
+		return func(msg any) error {
+			data, err := json.Marshal(msg)
+			if err != nil {
+				return err
+			}
+			ch <- data
+			return nil
+		}
+	}
+
+	a := NewStream(ctx, "req-1", sender(toB))
+	b := NewStream(ctx, "req-1", sender(toA))
+	go dispatch(ctx, toA, a)
+	go dispatch(ctx, toB, b)
+	return a, b
+}
+
+func dispatch(ctx context.Context, in chan []byte, s *Stream) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data := <-in:
+			var envelope Envelope
+			if err := json.Unmarshal(data, &envelope); err != nil {
+				panic(err)
+			}
+			switch envelope.Type {
+			case MessageTypeStreamChunk:
+				var chunk HTTPTunnelStreamChunk
+				_ = json.Unmarshal(data, &chunk)
+				s.HandleChunk(&chunk)
+			case MessageTypeStreamAck:
+				var ack HTTPTunnelStreamAck
+				_ = json.Unmarshal(data, &ack)
+				s.HandleAck(&ack)
+			case MessageTypeStreamCancel:
+				var cancel HTTPTunnelStreamCancel
+				_ = json.Unmarshal(data, &cancel)
+				s.HandleCancel(&cancel)
+			}
+		}
+	}
+}
+
+func TestStream_RelaysPayloadUntilClose(t *testing.T) {
+	a, b := streamPair(t)
+
+	payload := bytes.Repeat([]byte("0123456789abcdef"), StreamChunkSize*StreamWindowSize/8)
+	go func() {
+		if _, err := a.Write(payload); err != nil {
+			t.Errorf("write failed: %v", err)
+		}
+		if err := a.CloseWrite(); err != nil {
+			t.Errorf("close write failed: %v", err)
+		}
+	}()
+
+	got, err := io.ReadAll(b)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("payload mismatch: got %d bytes, want %d", len(got), len(payload))
+	}
+}
+
+func TestStream_WriterWaitsForReceiverWindow(t *testing.T) {
+	a, b := streamPair(t)
+
+	written := make(chan int, 1)
+	go func() {
+		n, _ := a.Write(make([]byte, StreamChunkSize*(StreamWindowSize+1)))
+		written <- n
+	}()
+
+	select {
+	case n := <-written:
+		t.Fatalf("write of more than the window completed without a reader (%d bytes)", n)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Draining the receiver returns credit and lets the writer finish
+	buf := make([]byte, StreamChunkSize)
+	for range StreamWindowSize + 1 {
+		if _, err := io.ReadFull(b, buf); err != nil {
+			t.Fatalf("read failed: %v", err)
+		}
+	}
+
+	select {
+	case n := <-written:
+		if n != StreamChunkSize*(StreamWindowSize+1) {
+			t.Fatalf("unexpected write size %d", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("writer still blocked after the receiver drained the window")
+	}
+}
+
+func TestStream_CancelReachesPeer(t *testing.T) {
+	a, b := streamPair(t)
+
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := b.Read(make([]byte, 1))
+		readErr <- err
+	}()
+
+	a.Cancel(errors.New("client disconnected"))
+
+	select {
+	case err := <-readErr:
+		if !errors.Is(err, ErrStreamCanceled) {
+			t.Fatalf("expected ErrStreamCanceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("peer was not canceled")
+	}
+
+	if _, err := a.Write([]byte("late")); err == nil {
+		t.Fatal("expected write on canceled stream to fail")
+	}
+}
@@ -87,33 +87,81 @@ func (r *HTTPTunnelResponse) IsError() bool {
 	return r.Error != nil
 }
 
-type HTTPTunnelStreamInit struct {
-	RequestID    string              `json:"requestID"`
-	Target       string              `json:"target"`
-	Method       string              `json:"method"`
-	Path         string              `json:"path"`
-	Query        string              `json:"query,omitempty"`
-	Headers      map[string][]string `json:"headers,omitempty"`
-	IsUpgrade    bool                `json:"isUpgrade"`              // True for SPDY/WebSocket upgrades
-	UpgradeProto string              `json:"upgradeProto,omitempty"` // "SPDY/3.1", "websocket", etc.
+// MessageType identifies the kind of message multiplexed over the agent WebSocket.
+// HTTPTunnelRequest and HTTPTunnelResponse predate streaming and carry no type, so an
+// empty type is always treated as a plain (buffered) HTTP tunnel message.
+type MessageType string
+
+const (
+	MessageTypeStreamInit     MessageType = "stream.init"
+	MessageTypeStreamResponse MessageType = "stream.response"
+	MessageTypeStreamChunk    MessageType = "stream.chunk"
+	MessageTypeStreamAck      MessageType = "stream.ack"
+	MessageTypeStreamCancel   MessageType = "stream.cancel"
+)
+
+// Envelope holds the fields shared by every tunnel message. Receivers decode it first
+// to decide which concrete message type to unmarshal.
+type Envelope struct {
+	Type      MessageType `json:"type,omitempty"`
+	RequestID string      `json:"requestID"`
 }
 
+// HTTPTunnelStreamInit opens a stream for a long-lived HTTP request (watch, logs -f) or
+// a protocol upgrade (SPDY/WebSocket for exec, attach and port-forward).
+type HTTPTunnelStreamInit struct {
+	Type             MessageType         `json:"type"`
+	RequestID        string              `json:"requestID"`
+	GatewayRequestID string              `json:"gatewayRequestID,omitempty"`
+	Target           string              `json:"target"`
+	Method           string              `json:"method"`
+	Path             string              `json:"path"`
+	Query            string              `json:"query,omitempty"`
+	Headers          map[string][]string `json:"headers,omitempty"`
+	IsUpgrade        bool                `json:"isUpgrade"`              // True for SPDY/WebSocket upgrades
+	UpgradeProto     string              `json:"upgradeProto,omitempty"` // "SPDY/3.1", "websocket", etc.
+	// Body is the complete request body for non-upgrade streams. Upgraded streams send
+	// their payload as chunks once the backend has switched protocols.
+	Body []byte `json:"body,omitempty"`
+}
+
+// HTTPTunnelStreamChunk carries a slice of stream payload in either direction
 type HTTPTunnelStreamChunk struct {
-	RequestID string `json:"requestID"`
-	Data      []byte `json:"data"`
-	StreamID  int    `json:"streamId,omitempty"` // For multiplexed streams (SPDY)
-	IsClose   bool   `json:"isClose,omitempty"`  // True when stream ends
+	Type      MessageType `json:"type"`
+	RequestID string      `json:"requestID"`
+	Data      []byte      `json:"data"`
+	StreamID  int         `json:"streamId,omitempty"` // For multiplexed streams (SPDY)
+	IsClose   bool        `json:"isClose,omitempty"`  // True when stream ends
 }
 
+// HTTPTunnelStreamResponse carries the backend status line and headers. It is sent once,
+// before any response chunks.
 type HTTPTunnelStreamResponse struct {
+	Type       MessageType         `json:"type"`
 	RequestID  string              `json:"requestID"`
 	StatusCode int                 `json:"statusCode"`
 	Headers    map[string][]string `json:"headers,omitempty"`
 	Error      *ErrorDetails       `json:"error,omitempty"`
 }
 
+// HTTPTunnelStreamAck grants the peer credit to send more chunks on a stream.
+// Each side may have at most StreamWindowSize unacknowledged chunks in flight.
+type HTTPTunnelStreamAck struct {
+	Type      MessageType `json:"type"`
+	RequestID string      `json:"requestID"`
+	Credit    int         `json:"credit"`
+}
+
+// HTTPTunnelStreamCancel aborts a stream. The receiver must release the stream without replying.
+type HTTPTunnelStreamCancel struct {
+	Type      MessageType `json:"type"`
+	RequestID string      `json:"requestID"`
+	Reason    string      `json:"reason,omitempty"`
+}
+
 func NewHTTPTunnelStreamInit(target, method, path, query string, headers map[string][]string, isUpgrade bool, upgradeProto string) *HTTPTunnelStreamInit {
 	return &HTTPTunnelStreamInit{
+		Type:         MessageTypeStreamInit,
 		Target:       target,
 		Method:       method,
 		Path:         path,
@@ -126,6 +174,7 @@ func NewHTTPTunnelStreamInit(target, method, path, query string, headers map[str
 
 func NewHTTPTunnelStreamChunk(requestID string, data []byte, isClose bool) *HTTPTunnelStreamChunk {
 	return &HTTPTunnelStreamChunk{
+		Type:      MessageTypeStreamChunk,
 		RequestID: requestID,
 		Data:      data,
 		IsClose:   isClose,
@@ -134,6 +183,7 @@ func NewHTTPTunnelStreamChunk(requestID string, data []byte, isClose bool) *HTTP
 
 func NewHTTPTunnelStreamResponse(req *HTTPTunnelStreamInit, statusCode int, headers map[string][]string) *HTTPTunnelStreamResponse {
 	return &HTTPTunnelStreamResponse{
+		Type:       MessageTypeStreamResponse,
 		RequestID:  req.RequestID,
 		StatusCode: statusCode,
 		Headers:    headers,
@@ -142,6 +192,7 @@ func NewHTTPTunnelStreamResponse(req *HTTPTunnelStreamInit, statusCode int, head
 
 func NewHTTPTunnelStreamErrorResponse(req *HTTPTunnelStreamInit, statusCode int, errMsg string) *HTTPTunnelStreamResponse {
 	return &HTTPTunnelStreamResponse{
+		Type:       MessageTypeStreamResponse,
 		RequestID:  req.RequestID,
 		StatusCode: statusCode,
 		Error: &ErrorDetails{
@@ -150,3 +201,23 @@ func NewHTTPTunnelStreamErrorResponse(req *HTTPTunnelStreamInit, statusCode int,
 		},
 	}
 }
+
+func NewHTTPTunnelStreamAck(requestID string, credit int) *HTTPTunnelStreamAck {
+	return &HTTPTunnelStreamAck{
+		Type:      MessageTypeStreamAck,
+		RequestID: requestID,
+		Credit:    credit,
+	}
+}
+
+func NewHTTPTunnelStreamCancel(requestID, reason string) *HTTPTunnelStreamCancel {
+	return &HTTPTunnelStreamCancel{
+		Type:      MessageTypeStreamCancel,
+		RequestID: requestID,
+		Reason:    reason,
+	}
+}
+
+func (r *HTTPTunnelStreamResponse) IsError() bool {
+	return r.Error != nil
+}
@@ -5,6 +5,7 @@ package clusteragent
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -38,6 +39,8 @@ type Route struct {
 	Endpoint  string
 	Auth      AuthConfig
 	Transport http.RoundTripper
+	// UpgradeTransport is used for SPDY/WebSocket upgrades, which are only defined for HTTP/1.1
+	UpgradeTransport http.RoundTripper
 }
 
 // Router routes HTTP tunnel requests to different backend services
@@ -154,6 +157,85 @@ func (r *Router) Route(req *messaging.HTTPTunnelRequest) *messaging.HTTPTunnelRe
 	return messaging.NewHTTPTunnelSuccessResponse(req, resp.StatusCode, resp.Header, body)
 }
 
+// RouteStream opens a streaming request to the appropriate backend service. It returns
+// the response status and headers together with the body to relay; after a successful
+// protocol upgrade the body is the backend connection and implements io.ReadWriteCloser.
+// If the request could not be routed the body is nil and the response carries the error.
+func (r *Router) RouteStream(ctx context.Context, req *messaging.HTTPTunnelStreamInit) (*messaging.HTTPTunnelStreamResponse, io.ReadCloser) {
+	logger := r.logger
+	if req.GatewayRequestID != "" {
+		logger = r.logger.With("requestId", req.GatewayRequestID)
+	}
+
+	route, exists := r.routes[req.Target]
+	if !exists {
+		logger.Warn("unknown target requested",
+			"target", req.Target,
+			"availableTargets", r.getAvailableTargets(),
+		)
+		return messaging.NewHTTPTunnelStreamErrorResponse(req, http.StatusNotFound,
+			fmt.Sprintf("unknown target: %s", req.Target)), nil
+	}
+
+	fullPath := req.Path
+	if req.Query != "" {
+		fullPath += "?" + req.Query
+	}
+
+	targetURL := route.Endpoint + fullPath
+
+	logger.Info("agent routing streaming request to backend",
+		"target", req.Target,
+		"method", req.Method,
+		"path", req.Path,
+		"url", targetURL,
+		"upgrade", req.IsUpgrade,
+	)
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, targetURL, bytes.NewReader(req.Body))
+	if err != nil {
+		return messaging.NewHTTPTunnelStreamErrorResponse(req, http.StatusInternalServerError,
+			fmt.Sprintf("failed to create request: %v", err)), nil
+	}
+
+	if req.Headers != nil {
+		httpReq.Header = req.Headers
+	}
+
+	route.applyAuth(httpReq)
+
+	transport := route.Transport
+	if req.IsUpgrade {
+		transport = route.UpgradeTransport
+	}
+
+	resp, err := transport.RoundTrip(httpReq)
+	if err != nil {
+		logger.Error("backend streaming request failed",
+			"target", req.Target,
+			"url", targetURL,
+			"error", err,
+		)
+		return messaging.NewHTTPTunnelStreamErrorResponse(req, http.StatusBadGateway,
+			fmt.Sprintf("backend request failed: %v", err)), nil
+	}
+
+	if req.IsUpgrade && resp.StatusCode == http.StatusSwitchingProtocols {
+		if _, ok := resp.Body.(io.ReadWriteCloser); !ok {
+			resp.Body.Close()
+			return messaging.NewHTTPTunnelStreamErrorResponse(req, http.StatusBadGateway,
+				"backend connection does not support protocol upgrades"), nil
+		}
+	}
+
+	logger.Info("agent streaming request established",
+		"target", req.Target,
+		"statusCode", resp.StatusCode,
+	)
+
+	return messaging.NewHTTPTunnelStreamResponse(req, resp.StatusCode, resp.Header), resp.Body
+}
+
 func (r *Router) getAvailableTargets() []string {
 	targets := make([]string, 0, len(r.routes))
 	for name := range r.routes {
@@ -168,6 +250,14 @@ func createK8sRoute(config *rest.Config) (*Route, error) {
 		return nil, fmt.Errorf("failed to create k8s transport: %w", err)
 	}
 
+	// The default transport may negotiate HTTP/2, which cannot carry exec/port-forward upgrades
+	upgradeConfig := rest.CopyConfig(config)
+	upgradeConfig.NextProtos = []string{"http/1.1"}
+	upgradeTransport, err := rest.TransportFor(upgradeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s upgrade transport: %w", err)
+	}
+
 	return &Route{
 		Name:     "k8s",
 		Backend:  "kubernetes",
@@ -176,7 +266,8 @@ func createK8sRoute(config *rest.Config) (*Route, error) {
 			Type:  "serviceaccount",
 			Token: config.BearerToken,
 		},
-		Transport: transport,
+		Transport:        transport,
+		UpgradeTransport: upgradeTransport,
 	}, nil
 }
 
@@ -195,12 +286,14 @@ func createRoute(cfg RouteConfig) *Route {
 		transport.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec // Intentional for internal services
 	}
 
+	// A transport with a custom TLS config only speaks HTTP/1.1, so it handles upgrades as is
 	return &Route{
-		Name:      cfg.Name,
-		Backend:   "http",
-		Endpoint:  cfg.Endpoint,
-		Auth:      cfg.Auth,
-		Transport: transport,
+		Name:             cfg.Name,
+		Backend:          "http",
+		Endpoint:         cfg.Endpoint,
+		Auth:             cfg.Auth,
+		Transport:        transport,
+		UpgradeTransport: transport,
 	}
 }
 
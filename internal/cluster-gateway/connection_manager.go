@@ -259,6 +259,24 @@ func (ac *AgentConnection) SendHTTPTunnelRequest(req *messaging.HTTPTunnelReques
 	return nil
 }
 
+// SendMessage sends a stream message through this connection. All messages of a stream
+// must use the same connection, so streams hold on to the AgentConnection they opened on.
+func (ac *AgentConnection) SendMessage(msg any) error {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("SendMessage: failed to marshal message: %w", err)
+	}
+
+	if err := ac.Conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		return fmt.Errorf("SendMessage: failed to send message: %w", err)
+	}
+
+	return nil
+}
+
 // Close closes the agent connection
 func (ac *AgentConnection) Close() error {
 	ac.mu.Lock()
@@ -42,6 +42,8 @@ type Server struct {
 	connMgr             *ConnectionManager
 	pendingHTTPRequests map[string]chan *messaging.HTTPTunnelResponse
 	requestsMu          sync.Mutex
+	streams             map[string]*gatewayStream
+	streamsMu           sync.Mutex
 	validator           *RequestValidator
 	logger              *slog.Logger
 	k8sClient           client.Client // Kubernetes client for querying DataPlane/BuildPlane CRs
@@ -57,6 +59,7 @@ func New(config *Config, k8sClient client.Client, logger *slog.Logger) *Server {
 		},
 		connMgr:             NewConnectionManager(logger),
 		pendingHTTPRequests: make(map[string]chan *messaging.HTTPTunnelResponse),
+		streams:             make(map[string]*gatewayStream),
 		validator:           NewRequestValidator(),
 		logger:              logger.With("component", "agent-server"),
 		k8sClient:           k8sClient,
@@ -277,6 +280,7 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) handleConnection(planeName, connID string, conn *websocket.Conn) {
 	defer s.connMgr.Unregister(planeName, connID)
+	defer s.abortStreamsForConnection(connID)
 
 	if err := conn.SetReadDeadline(time.Now().Add(s.config.HeartbeatTimeout)); err != nil {
 		s.logger.Warn("failed to set initial read deadline", "plane", planeName, "error", err)
@@ -315,14 +319,25 @@ func (s *Server) handleConnection(planeName, connID string, conn *websocket.Conn
 
 		s.connMgr.UpdateConnectionLastSeen(planeName, connID)
 
-		var httpResp messaging.HTTPTunnelResponse
-		if err := json.Unmarshal(data, &httpResp); err != nil {
-			s.logger.Warn("failed to parse HTTP tunnel response", "plane", planeName, "error", err)
+		var envelope messaging.Envelope
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			s.logger.Warn("failed to parse tunnel message", "plane", planeName, "error", err)
+			continue
+		}
+
+		if envelope.RequestID == "" {
+			s.logger.Warn("received tunnel message without requestID", "plane", planeName)
 			continue
 		}
 
-		if httpResp.RequestID == "" {
-			s.logger.Warn("received HTTP tunnel response without requestID", "plane", planeName)
+		if envelope.Type != "" {
+			s.handleStreamMessage(planeName, envelope, data)
+			continue
+		}
+
+		var httpResp messaging.HTTPTunnelResponse
+		if err := json.Unmarshal(data, &httpResp); err != nil {
+			s.logger.Warn("failed to parse HTTP tunnel response", "plane", planeName, "error", err)
 			continue
 		}
 
@@ -345,6 +360,19 @@ func (s *Server) handleHTTPTunnelResponse(planeName string, resp *messaging.HTTP
 	s.requestsMu.Unlock()
 
 	if !ok {
+		if gs := s.getStream(resp.RequestID); gs != nil {
+			// Agents that predate streaming answer a stream init as a plain request
+			s.logger.Warn("agent does not support streaming", "plane", planeName, "requestID", resp.RequestID)
+			gs.deliverResponse(&messaging.HTTPTunnelStreamResponse{
+				RequestID:  resp.RequestID,
+				StatusCode: http.StatusBadGateway,
+				Error: &messaging.ErrorDetails{
+					Code:    http.StatusBadGateway,
+					Message: "cluster agent does not support streaming requests",
+				},
+			})
+			return
+		}
 		s.logger.Warn("received HTTP tunnel response for unknown request", "requestID", resp.RequestID)
 		return
 	}
@@ -419,7 +447,7 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 	isStreaming := s.isStreamingRequest(r, targetPath)
 
 	if isStreaming {
-		s.handleStreamingProxy(w, r, planeIdentifier, target, targetPath)
+		s.handleStreamingProxy(w, r, requestID, planeIdentifier, target, targetPath)
 		return
 	}
 
@@ -500,28 +528,19 @@ func (s *Server) isStreamingRequest(r *http.Request, path string) bool {
 	return false
 }
 
-// handleStreamingProxy handles streaming HTTP requests (watch, logs, exec, port-forward)
-func (s *Server) handleStreamingProxy(w http.ResponseWriter, r *http.Request, planeIdentifier, target, targetPath string) {
-	requestID := getOrGenerateRequestID(r)
-	logger := s.logger.With("requestId", requestID)
-
-	logger.Info("HTTP streaming proxy request received",
-		"plane", planeIdentifier,
-		"target", target,
-		"path", targetPath,
-		"method", r.Method,
-		"query", r.URL.RawQuery,
-	)
-
-	http.Error(w, "Streaming operations (watch, logs -f, exec, port-forward) are not yet supported through the HTTP proxy. "+
-		"Use the CQRS API (/api/k8s-resources/) for resource operations, or connect directly to the data plane for streaming operations.",
-		http.StatusNotImplemented)
-
-	// TODO: Implement full streaming support
-	// 1. Send HTTPTunnelStreamInit to agent
-	// 2. Set up bidirectional channel for stream chunks
-	// 3. Stream data chunks back and forth
-	// 4. Handle connection close gracefully
+// isUpgradeRequest reports whether the client asked to switch protocols
+func isUpgradeRequest(r *http.Request) bool {
+	if r.Header.Get("Upgrade") == "" {
+		return false
+	}
+	for _, value := range r.Header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // SendHTTPTunnelRequest sends an HTTP tunnel request to an agent and waits for the response
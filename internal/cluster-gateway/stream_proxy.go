@@ -0,0 +1,363 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// streamOpenTimeout bounds how long the gateway waits for the backend status line of a streaming request
+const streamOpenTimeout = 30 * time.Second
+
+var errClientDisconnected = errors.New("client disconnected")
+
+// gatewayStream tracks a stream opened on a specific agent connection
+type gatewayStream struct {
+	stream   *messaging.Stream
+	connID   string
+	response chan *messaging.HTTPTunnelStreamResponse
+}
+
+func (gs *gatewayStream) deliverResponse(resp *messaging.HTTPTunnelStreamResponse) {
+	select {
+	case gs.response <- resp:
+	default:
+	}
+}
+
+// handleStreamingProxy handles streaming HTTP requests (watch, logs, exec, port-forward).
+// Non-upgrade requests stream the backend response body back to the client; upgrade
+// requests hijack the client connection and relay raw bytes in both directions, so the
+// SPDY or WebSocket protocol negotiated with the backend passes through untouched.
+func (s *Server) handleStreamingProxy(w http.ResponseWriter, r *http.Request, requestID, planeIdentifier, target, targetPath string) {
+	logger := s.logger.With("requestId", requestID)
+	isUpgrade := isUpgradeRequest(r)
+
+	logger.Info("HTTP streaming proxy request received",
+		"plane", planeIdentifier,
+		"target", target,
+		"path", targetPath,
+		"method", r.Method,
+		"query", r.URL.RawQuery,
+		"upgrade", isUpgrade,
+	)
+
+	var body []byte
+	if !isUpgrade {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			logger.Warn("failed to read request body", "error", err)
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		defer r.Body.Close()
+	}
+
+	init := messaging.NewHTTPTunnelStreamInit(
+		target,
+		r.Method,
+		targetPath,
+		r.URL.RawQuery,
+		r.Header,
+		isUpgrade,
+		r.Header.Get("Upgrade"),
+	)
+	init.GatewayRequestID = requestID
+	init.Body = body
+
+	gs, err := s.openStream(planeIdentifier, init)
+	if err != nil {
+		logger.Error("failed to open tunnel stream",
+			"plane", planeIdentifier,
+			"target", target,
+			"error", err,
+		)
+		http.Error(w, fmt.Sprintf("proxy request failed: %v", err), http.StatusBadGateway)
+		return
+	}
+	defer s.closeStream(gs)
+
+	// Propagate client disconnects to the agent so the backend request is canceled too
+	stopWatchingClient := context.AfterFunc(r.Context(), func() {
+		gs.stream.Cancel(errClientDisconnected)
+	})
+	defer stopWatchingClient()
+
+	if !isUpgrade {
+		// The request body travelled with the init message; nothing else flows upstream
+		if err := gs.stream.CloseWrite(); err != nil {
+			logger.Warn("failed to half-close tunnel stream", "error", err)
+		}
+	}
+
+	var resp *messaging.HTTPTunnelStreamResponse
+	select {
+	case resp = <-gs.response:
+	case <-gs.stream.Context().Done():
+		logger.Warn("tunnel stream aborted before the backend responded",
+			"plane", planeIdentifier,
+			"error", context.Cause(gs.stream.Context()),
+		)
+		http.Error(w, "proxy request failed: stream aborted", http.StatusBadGateway)
+		return
+	case <-time.After(streamOpenTimeout):
+		logger.Error("tunnel stream open timeout", "plane", planeIdentifier, "target", target)
+		http.Error(w, "proxy request failed: stream open timeout", http.StatusGatewayTimeout)
+		return
+	}
+
+	if resp.IsError() {
+		logger.Warn("backend rejected streaming request",
+			"plane", planeIdentifier,
+			"statusCode", resp.StatusCode,
+			"error", resp.Error.Message,
+		)
+		http.Error(w, fmt.Sprintf("proxy request failed: %s", resp.Error.Message), resp.StatusCode)
+		return
+	}
+
+	if isUpgrade && resp.StatusCode == http.StatusSwitchingProtocols {
+		s.serveUpgradedStream(w, resp, gs.stream, logger)
+	} else {
+		s.serveResponseStream(w, resp, gs.stream, logger)
+	}
+
+	logger.Info("HTTP streaming proxy request completed",
+		"plane", planeIdentifier,
+		"target", target,
+		"statusCode", resp.StatusCode,
+	)
+}
+
+// serveResponseStream writes the backend status and headers, then flushes each chunk to
+// the client as it arrives. A slow client stops the gateway from acknowledging chunks,
+// which in turn pauses the agent's read of the backend body.
+func (s *Server) serveResponseStream(w http.ResponseWriter, resp *messaging.HTTPTunnelStreamResponse, stream *messaging.Stream, logger *slog.Logger) {
+	rc := http.NewResponseController(w)
+	// The server-wide write timeout would otherwise cut off long-running watches and log follows
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Debug("failed to clear write deadline", "error", err)
+	}
+
+	for key, values := range resp.Headers {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	if err := rc.Flush(); err != nil {
+		logger.Debug("failed to flush response headers", "error", err)
+		return
+	}
+
+	buf := make([]byte, messaging.StreamChunkSize)
+	for {
+		n, err := stream.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				logger.Debug("failed to write stream chunk to client", "error", werr)
+				return
+			}
+			if ferr := rc.Flush(); ferr != nil {
+				logger.Debug("failed to flush stream chunk to client", "error", ferr)
+				return
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, errClientDisconnected) {
+				logger.Warn("tunnel stream ended with error", "error", err)
+			}
+			return
+		}
+	}
+}
+
+// serveUpgradedStream completes the protocol switch with the client and relays bytes
+// between the hijacked connection and the tunnel stream until the backend side ends.
+func (s *Server) serveUpgradedStream(w http.ResponseWriter, resp *messaging.HTTPTunnelStreamResponse, stream *messaging.Stream, logger *slog.Logger) {
+	conn, brw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		// HTTP/2 connections cannot be hijacked; SPDY and WebSocket clients negotiate HTTP/1.1
+		logger.Warn("failed to hijack client connection for protocol upgrade", "error", err)
+		http.Error(w, "protocol upgrade is not supported on this connection", http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+
+	// Hijacked connections keep the deadlines set by the server timeouts
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		logger.Debug("failed to clear connection deadline", "error", err)
+	}
+
+	if err := writeSwitchingProtocols(brw.Writer, resp.Headers); err != nil {
+		logger.Warn("failed to complete protocol upgrade with client", "error", err)
+		return
+	}
+
+	go func() {
+		// brw.Reader may already hold bytes the client sent right after the handshake
+		_, err := io.Copy(stream, brw.Reader)
+		if err != nil {
+			stream.Cancel(fmt.Errorf("%w: %w", errClientDisconnected, err))
+			return
+		}
+		if err := stream.CloseWrite(); err != nil {
+			logger.Debug("failed to half-close tunnel stream", "error", err)
+		}
+	}()
+
+	if _, err := io.Copy(conn, stream); err != nil && !errors.Is(err, errClientDisconnected) {
+		logger.Debug("upgraded tunnel stream ended with error", "error", err)
+	}
+}
+
+func writeSwitchingProtocols(w *bufio.Writer, headers map[string][]string) error {
+	if _, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\n", http.StatusSwitchingProtocols, http.StatusText(http.StatusSwitchingProtocols)); err != nil {
+		return err
+	}
+	if err := http.Header(headers).Write(w); err != nil {
+		return err
+	}
+	if _, err := w.WriteString("\r\n"); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// openStream registers a stream and sends its init message. The stream is registered
+// before the init is sent so that no reply from the agent can race the registration.
+func (s *Server) openStream(planeIdentifier string, init *messaging.HTTPTunnelStreamInit) (*gatewayStream, error) {
+	conn, err := s.connMgr.Get(planeIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	init.RequestID = messaging.GenerateMessageID()
+	gs := &gatewayStream{
+		stream:   messaging.NewStream(context.Background(), init.RequestID, conn.SendMessage),
+		connID:   conn.ID,
+		response: make(chan *messaging.HTTPTunnelStreamResponse, 1),
+	}
+
+	s.streamsMu.Lock()
+	s.streams[init.RequestID] = gs
+	s.streamsMu.Unlock()
+
+	s.logger.Debug("opening tunnel stream",
+		"requestID", init.RequestID,
+		"target", init.Target,
+		"method", init.Method,
+		"path", init.Path,
+		"plane", planeIdentifier,
+	)
+
+	if err := conn.SendMessage(init); err != nil {
+		s.removeStream(init.RequestID)
+		gs.stream.Abort(err)
+		return nil, fmt.Errorf("failed to send stream init: %w", err)
+	}
+
+	return gs, nil
+}
+
+func (s *Server) closeStream(gs *gatewayStream) {
+	s.removeStream(gs.stream.ID())
+	if err := gs.stream.Close(); err != nil {
+		s.logger.Debug("failed to notify agent of stream close", "requestID", gs.stream.ID(), "error", err)
+	}
+}
+
+func (s *Server) getStream(requestID string) *gatewayStream {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+
+	return s.streams[requestID]
+}
+
+func (s *Server) removeStream(requestID string) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+
+	delete(s.streams, requestID)
+}
+
+// abortStreamsForConnection aborts every stream opened on a connection that has gone away
+func (s *Server) abortStreamsForConnection(connID string) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+
+	for requestID, gs := range s.streams {
+		if gs.connID != connID {
+			continue
+		}
+		gs.stream.Abort(messaging.ErrNotConnected)
+		delete(s.streams, requestID)
+	}
+}
+
+// handleStreamMessage dispatches a typed stream message from the agent to its stream
+func (s *Server) handleStreamMessage(planeName string, envelope messaging.Envelope, data []byte) {
+	gs := s.getStream(envelope.RequestID)
+	if gs == nil {
+		// Late messages for streams the client already closed are expected
+		s.logger.Debug("received stream message for unknown stream",
+			"plane", planeName,
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+		)
+		return
+	}
+
+	var err error
+	switch envelope.Type {
+	case messaging.MessageTypeStreamResponse:
+		var resp messaging.HTTPTunnelStreamResponse
+		if err = json.Unmarshal(data, &resp); err == nil {
+			gs.deliverResponse(&resp)
+		}
+	case messaging.MessageTypeStreamChunk:
+		var chunk messaging.HTTPTunnelStreamChunk
+		if err = json.Unmarshal(data, &chunk); err == nil {
+			gs.stream.HandleChunk(&chunk)
+		}
+	case messaging.MessageTypeStreamAck:
+		var ack messaging.HTTPTunnelStreamAck
+		if err = json.Unmarshal(data, &ack); err == nil {
+			gs.stream.HandleAck(&ack)
+		}
+	case messaging.MessageTypeStreamCancel:
+		var cancel messaging.HTTPTunnelStreamCancel
+		if err = json.Unmarshal(data, &cancel); err == nil {
+			gs.stream.HandleCancel(&cancel)
+		}
+	default:
+		s.logger.Warn("received stream message of unknown type",
+			"plane", planeName,
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+		)
+		return
+	}
+
+	if err != nil {
+		s.logger.Warn("failed to parse stream message",
+			"plane", planeName,
+			"type", envelope.Type,
+			"requestID", envelope.RequestID,
+			"error", err,
+		)
+		gs.stream.Cancel(fmt.Errorf("%w: %w", messaging.ErrInvalidMessageType, err))
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+const testPlane = "dataplane/dp-1"
+
+// fakeAgent plays the agent end of the tunnel: it answers stream inits with handle and
+// dispatches chunk, ack and cancel messages to the stream they belong to.
+type fakeAgent struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	ctx     context.Context
+	handle  func(fa *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream)
+
+	mu      sync.Mutex
+	streams map[string]*messaging.Stream
+}
+
+func (fa *fakeAgent) send(msg any) error {
+	fa.writeMu.Lock()
+	defer fa.writeMu.Unlock()
+	return fa.conn.WriteJSON(msg)
+}
+
+func (fa *fakeAgent) run() {
+	for {
+		_, data, err := fa.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var envelope messaging.Envelope
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			continue
+		}
+
+		if envelope.Type == messaging.MessageTypeStreamInit {
+			var init messaging.HTTPTunnelStreamInit
+			if err := json.Unmarshal(data, &init); err != nil {
+				continue
+			}
+			stream := messaging.NewStream(fa.ctx, init.RequestID, fa.send)
+			fa.mu.Lock()
+			fa.streams[init.RequestID] = stream
+			fa.mu.Unlock()
+			go fa.handle(fa, &init, stream)
+			continue
+		}
+
+		fa.mu.Lock()
+		stream := fa.streams[envelope.RequestID]
+		fa.mu.Unlock()
+		if stream == nil {
+			continue
+		}
+		switch envelope.Type {
+		case messaging.MessageTypeStreamChunk:
+			var chunk messaging.HTTPTunnelStreamChunk
+			_ = json.Unmarshal(data, &chunk)
+			stream.HandleChunk(&chunk)
+		case messaging.MessageTypeStreamAck:
+			var ack messaging.HTTPTunnelStreamAck
+			_ = json.Unmarshal(data, &ack)
+			stream.HandleAck(&ack)
+		case messaging.MessageTypeStreamCancel:
+			var cancel messaging.HTTPTunnelStreamCancel
+			_ = json.Unmarshal(data, &cancel)
+			stream.HandleCancel(&cancel)
+		}
+	}
+}
+
+// newStreamingTestGateway connects a fake agent to a gateway server and returns the URL of
+// an HTTP endpoint that proxies every request as a streaming request to the "k8s" target.
+func newStreamingTestGateway(t *testing.T,
+	handle func(fa *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream)) (*Server, string) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := New(&Config{HeartbeatInterval: time.Hour, HeartbeatTimeout: time.Hour}, nil, logger)
+
+	tunnel := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := s.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		connID, _ := s.connMgr.Register("dataplane", "dp-1", conn)
+		s.handleConnection(testPlane, connID, conn)
+	}))
+	t.Cleanup(tunnel.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(tunnel.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect fake agent: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	agent := &fakeAgent{conn: conn, ctx: ctx, handle: handle, streams: make(map[string]*messaging.Stream)}
+	go agent.run()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := s.connMgr.Get(testPlane); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("fake agent did not register with the gateway")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handleStreamingProxy(w, r, "gw-test", testPlane, "k8s", r.URL.Path)
+	}))
+	t.Cleanup(proxy.Close)
+
+	return s, proxy.URL
+}
+
+func TestStreamingProxy_RelaysResponseBody(t *testing.T) {
+	inits := make(chan *messaging.HTTPTunnelStreamInit, 1)
+	_, proxyURL := newStreamingTestGateway(t, func(agent *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream) {
+		inits <- init
+		_ = agent.send(messaging.NewHTTPTunnelStreamResponse(init, http.StatusOK,
+			map[string][]string{"Content-Type": {"text/plain"}}))
+		for _, line := range []string{"line 1\n", "line 2\n", "line 3\n"} {
+			if _, err := stream.Write([]byte(line)); err != nil {
+				t.Errorf("agent write failed: %v", err)
+				return
+			}
+		}
+		_ = stream.CloseWrite()
+	})
+
+	resp, err := http.Get(proxyURL + "/api/v1/namespaces/dp/pods/api/log?follow=true")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/plain" {
+		t.Errorf("unexpected response: %d %v", resp.StatusCode, resp.Header)
+	}
+	if string(body) != "line 1\nline 2\nline 3\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+
+	init := <-inits
+	if init.Target != "k8s" || init.Method != http.MethodGet || init.Query != "follow=true" ||
+		init.Path != "/api/v1/namespaces/dp/pods/api/log" || init.IsUpgrade {
+		t.Errorf("unexpected stream init: %+v", init)
+	}
+}
+
+func TestStreamingProxy_BackendErrorIsReturned(t *testing.T) {
+	_, proxyURL := newStreamingTestGateway(t, func(agent *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream) {
+		_ = agent.send(messaging.NewHTTPTunnelStreamErrorResponse(init, http.StatusNotFound, "unknown target: k8s"))
+	})
+
+	resp, err := http.Get(proxyURL + "/api/v1/pods?watch=true")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestStreamingProxy_ClientDisconnectCancelsAgentStream(t *testing.T) {
+	canceled := make(chan error, 1)
+	s, proxyURL := newStreamingTestGateway(t, func(agent *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream) {
+		_ = agent.send(messaging.NewHTTPTunnelStreamResponse(init, http.StatusOK, nil))
+		_, _ = stream.Write([]byte("event 1\n"))
+		<-stream.Context().Done()
+		canceled <- context.Cause(stream.Context())
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, proxyURL+"/api/v1/pods?watch=true", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil || line != "event 1\n" {
+		t.Fatalf("unexpected first event %q: %v", line, err)
+	}
+	cancel()
+	resp.Body.Close()
+
+	select {
+	case err := <-canceled:
+		if !errors.Is(err, messaging.ErrStreamCanceled) {
+			t.Errorf("expected the agent stream to be canceled by the gateway, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("client disconnect did not reach the agent")
+	}
+
+	waitForNoStreams(t, s)
+}
+
+func TestStreamingProxy_AgentDisconnectEndsResponse(t *testing.T) {
+	s, proxyURL := newStreamingTestGateway(t, func(agent *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream) {
+		_ = agent.send(messaging.NewHTTPTunnelStreamResponse(init, http.StatusOK, nil))
+		_, _ = stream.Write([]byte("event 1\n"))
+		agent.conn.Close()
+	})
+
+	resp, err := http.Get(proxyURL + "/api/v1/pods?watch=true")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.ReadAll(resp.Body)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("response was not ended after the agent disconnected")
+	}
+
+	waitForNoStreams(t, s)
+}
+
+func TestStreamingProxy_UpgradeRelaysBothDirections(t *testing.T) {
+	_, proxyURL := newStreamingTestGateway(t, func(agent *fakeAgent, init *messaging.HTTPTunnelStreamInit, stream *messaging.Stream) {
+		if !init.IsUpgrade || init.UpgradeProto != "SPDY/3.1" {
+			_ = agent.send(messaging.NewHTTPTunnelStreamErrorResponse(init, http.StatusBadRequest, "expected upgrade"))
+			return
+		}
+		_ = agent.send(messaging.NewHTTPTunnelStreamResponse(init, http.StatusSwitchingProtocols,
+			map[string][]string{"Connection": {"Upgrade"}, "Upgrade": {"SPDY/3.1"}}))
+		// Echo everything back until the client half-closes
+		if _, err := io.Copy(stream, stream); err != nil {
+			t.Errorf("agent echo failed: %v", err)
+		}
+		_ = stream.CloseWrite()
+	})
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(proxyURL, "http://"))
+	if err != nil {
+		t.Fatalf("failed to dial proxy: %v", err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	fmt.Fprintf(conn, "POST /api/v1/namespaces/dp/pods/api/exec?command=sh HTTP/1.1\r\n"+
+		"Host: gateway\r\nConnection: Upgrade\r\nUpgrade: SPDY/3.1\r\n\r\n")
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatalf("failed to read upgrade response: %v", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Upgrade") != "SPDY/3.1" {
+		t.Fatalf("unexpected upgrade response: %d %v", resp.StatusCode, resp.Header)
+	}
+
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatalf("failed to write to upgraded connection: %v", err)
+	}
+	echo := make([]byte, 4)
+	if _, err := io.ReadFull(reader, echo); err != nil || string(echo) != "ping" {
+		t.Fatalf("unexpected echo %q: %v", echo, err)
+	}
+
+	if err := conn.(*net.TCPConn).CloseWrite(); err != nil {
+		t.Fatalf("failed to half-close: %v", err)
+	}
+	rest, err := io.ReadAll(reader)
+	if err != nil || len(rest) != 0 {
+		t.Fatalf("expected a clean end of stream, got %q: %v", rest, err)
+	}
+}
+
+// waitForNoStreams waits for the gateway to forget every stream it opened
+func waitForNoStreams(t *testing.T, s *Server) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.streamsMu.Lock()
+		n := len(s.streams)
+		s.streamsMu.Unlock()
+		if n == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("gateway still tracks %d streams", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
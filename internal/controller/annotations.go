@@ -8,4 +8,19 @@ package controller
 const (
 	AnnotationKeyDisplayName = "openchoreo.dev/display-name"
 	AnnotationKeyDescription = "openchoreo.dev/description"
+
+	// AnnotationKeyBoundBy records who last pointed a ReleaseBinding at its current release
+	AnnotationKeyBoundBy = "openchoreo.dev/bound-by"
+
+	// AnnotationKeyPromotionRequest records the PromotionRequest that last pointed a ReleaseBinding at
+	// its current release, so that an approved promotion is written to the binding only once
+	AnnotationKeyPromotionRequest = "openchoreo.dev/promotion-request"
+
+	// AnnotationKeySecretHash is stamped onto pod templates with a hash of the secret data they consume,
+	// so that workloads roll when a referenced secret is rotated
+	AnnotationKeySecretHash = "openchoreo.dev/secret-hash"
+
+	// AnnotationKeyBreakGlass carries the reason for rolling out a ReleaseBinding change while the
+	// environment's change calendar is closed. It is removed once the change reaches the Release.
+	AnnotationKeyBreakGlass = "openchoreo.dev/break-glass"
 )
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,8 +23,12 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 )
 
+// autoDeployBoundBy is recorded as the binder of releases deployed by auto-deploy
+const autoDeployBoundBy = "controller:auto-deploy"
+
 // Reconciler reconciles a Component object
 type Reconciler struct {
 	client.Client
@@ -41,6 +46,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentworkflowruns,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=deploymentpipelines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -171,8 +177,18 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 
 	workload := &workloadList.Items[0]
 
+	// Merge the traits attached by the ComponentType into the traits of the component.
+	// The traits attached by environments are merged in when the component is rendered for them.
+	traitInstances, err := componentpipeline.EffectiveTraits(comp, ct, nil)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid traits: %v", err)
+		controller.MarkFalseCondition(comp, ConditionReady, ReasonInvalidConfiguration, msg)
+		logger.Info(msg, "component", comp.Name)
+		return ctrl.Result{}, nil
+	}
+
 	// Fetch all referenced Traits (in the same namespace as the Component)
-	traits, err := r.fetchTraits(ctx, comp.Spec.Traits, comp.Namespace)
+	traits, err := r.fetchTraits(ctx, traitInstances, comp.Namespace)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			// Extract trait name from custom error type
@@ -234,7 +250,7 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 	}
 
 	// Find the root environment using pure function
-	firstEnv, err := findRootEnvironment(pipeline)
+	firstEnv, err := FindRootEnvironment(pipeline)
 	if err != nil {
 		// Configuration errors are non-retryable
 		msg := fmt.Sprintf("Invalid deployment pipeline configuration: %v", err)
@@ -246,6 +262,13 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 	// Handle autoDeploy if enabled
 	if comp.Spec.AutoDeploy {
 		if err := r.handleAutoDeploy(ctx, comp, ct, workload, traits, firstEnv); err != nil {
+			var closed *changeWindowClosedError
+			if errors.As(err, &closed) {
+				msg := fmt.Sprintf("Auto-deploy is waiting for the change window: %v", err)
+				controller.MarkFalseCondition(comp, ConditionReady, ReasonChangeWindowClosed, msg)
+				logger.Info(msg, "component", comp.Name, "environment", firstEnv)
+				return ctrl.Result{RequeueAfter: closed.requeueAfter(time.Now())}, nil
+			}
 			msg := fmt.Sprintf("Failed to handle autoDeploy: %v", err)
 			controller.MarkFalseCondition(comp, ConditionReady, ReasonAutoDeployFailed, msg)
 			logger.Error(err, "Failed to handle autoDeploy")
@@ -315,11 +338,11 @@ func (r *Reconciler) fetchTraits(ctx context.Context, traitRefs []openchoreov1al
 	return traits, nil
 }
 
-// findRootEnvironment finds the root environment in a deployment pipeline.
+// FindRootEnvironment finds the root environment in a deployment pipeline.
 // The root environment is the source environment that never appears as a target,
 // representing the initial environment where components are first deployed.
 // This is a pure function for easier testing.
-func findRootEnvironment(pipeline *openchoreov1alpha1.DeploymentPipeline) (string, error) {
+func FindRootEnvironment(pipeline *openchoreov1alpha1.DeploymentPipeline) (string, error) {
 	if len(pipeline.Spec.PromotionPaths) == 0 {
 		return "", fmt.Errorf("deployment pipeline %s has no promotion paths defined", pipeline.Name)
 	}
@@ -550,6 +573,10 @@ func (r *Reconciler) ensureReleaseBinding(
 	}
 
 	if len(releaseBindingList.Items) == 0 {
+		if err := r.checkChangeWindow(ctx, comp.Namespace, firstEnv); err != nil {
+			return err
+		}
+
 		// ReleaseBinding doesn't exist, create it
 		releaseBinding := &openchoreov1alpha1.ReleaseBinding{
 			ObjectMeta: metav1.ObjectMeta{
@@ -566,6 +593,7 @@ func (r *Reconciler) ensureReleaseBinding(
 				// No overrides for initial auto-deploy
 			},
 		}
+		controller.SetBoundBy(releaseBinding, autoDeployBoundBy)
 
 		if err := r.Create(ctx, releaseBinding); err != nil {
 			return fmt.Errorf("failed to create ReleaseBinding: %w", err)
@@ -584,7 +612,12 @@ func (r *Reconciler) ensureReleaseBinding(
 
 	// ReleaseBinding exists, patch the release name if different
 	if releaseBinding.Spec.ReleaseName != releaseName {
+		if err := r.checkChangeWindow(ctx, comp.Namespace, firstEnv); err != nil {
+			return err
+		}
+
 		releaseBinding.Spec.ReleaseName = releaseName
+		controller.SetBoundBy(&releaseBinding, autoDeployBoundBy)
 
 		if err := r.Update(ctx, &releaseBinding); err != nil {
 			return fmt.Errorf("failed to update ReleaseBinding: %w", err)
@@ -619,8 +652,9 @@ func buildTraitsMap(traits []openchoreov1alpha1.Trait) map[string]openchoreov1al
 // buildComponentProfile extracts the ComponentProfile from the Component
 func buildComponentProfile(comp *openchoreov1alpha1.Component) openchoreov1alpha1.ComponentProfile {
 	return openchoreov1alpha1.ComponentProfile{
-		Parameters: comp.Spec.Parameters,
-		Traits:     comp.Spec.Traits,
+		Parameters:     comp.Spec.Parameters,
+		Traits:         comp.Spec.Traits,
+		DisabledTraits: comp.Spec.DisabledTraits,
 	}
 }
 
@@ -0,0 +1,62 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changewindow"
+)
+
+// changeWindowClosedError reports that auto-deploy was refused because the change calendar of the
+// first environment is closed
+type changeWindowClosedError struct {
+	environment string
+	decision    changewindow.Decision
+}
+
+func (e *changeWindowClosedError) Error() string {
+	return fmt.Sprintf("environment %q is outside its change window: %s", e.environment, e.decision.Message())
+}
+
+// requeueAfter returns how long until auto-deploy should be retried
+func (e *changeWindowClosedError) requeueAfter(now time.Time) time.Duration {
+	if e.decision.NextOpen.IsZero() {
+		return 0
+	}
+	return e.decision.NextOpen.Sub(now)
+}
+
+// checkChangeWindow refuses auto-deploy to an environment whose change calendar is closed and rejects
+// changes outside its windows. Calendars that queue changes let the ReleaseBinding through, and the
+// ReleaseBinding controller holds the rollout until the window opens.
+func (r *Reconciler) checkChangeWindow(ctx context.Context, namespace, environment string) error {
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: environment, Namespace: namespace}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The ReleaseBinding controller reports the missing environment
+			return nil
+		}
+		return fmt.Errorf("failed to get Environment %q: %w", environment, err)
+	}
+
+	calendar := env.Spec.ChangeCalendar
+	if calendar == nil || changewindow.Queues(calendar) {
+		return nil
+	}
+	decision, err := changewindow.Evaluate(calendar, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid change calendar of Environment %q: %w", environment, err)
+	}
+	if decision.Open {
+		return nil
+	}
+	return &changeWindowClosedError{environment: environment, decision: decision}
+}
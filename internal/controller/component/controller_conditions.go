@@ -53,6 +53,9 @@ const (
 
 	// ReasonAutoDeployFailed indicates failure to handle autoDeploy (ComponentRelease/ReleaseBinding creation)
 	ReasonAutoDeployFailed controller.ConditionReason = "AutoDeployFailed"
+	// ReasonChangeWindowClosed indicates autoDeploy is waiting for the change calendar of the
+	// first environment to open
+	ReasonChangeWindowClosed controller.ConditionReason = "ChangeWindowClosed"
 
 	// ReasonFinalizing indicates the Component is being finalized
 	ReasonFinalizing controller.ConditionReason = "Finalizing"
@@ -81,8 +81,9 @@ func BuildReleaseSpec(
 
 	// Build component profile
 	componentProfile := openchoreov1alpha1.ComponentProfile{
-		Parameters: comp.Spec.Parameters,
-		Traits:     comp.Spec.Traits,
+		Parameters:     comp.Spec.Parameters,
+		Traits:         comp.Spec.Traits,
+		DisabledTraits: comp.Spec.DisabledTraits,
 	}
 
 	// Construct ReleaseSpec
@@ -104,6 +104,28 @@ func TestComputeReleaseHash(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "disabled traits produce different hash",
+			template: &ReleaseSpec{
+				ComponentType: openchoreov1alpha1.ComponentTypeSpec{
+					WorkloadType: "deployment",
+					Traits: []openchoreov1alpha1.PlatformTrait{
+						{Name: "network-policy", InstanceName: "network-policy"},
+					},
+				},
+			},
+			expectDiff: &ReleaseSpec{
+				ComponentType: openchoreov1alpha1.ComponentTypeSpec{
+					WorkloadType: "deployment",
+					Traits: []openchoreov1alpha1.PlatformTrait{
+						{Name: "network-policy", InstanceName: "network-policy"},
+					},
+				},
+				ComponentProfile: openchoreov1alpha1.ComponentProfile{
+					DisabledTraits: []string{"network-policy"},
+				},
+			},
+		},
 		{
 			name: "collision count changes hash",
 			template: &ReleaseSpec{
@@ -25,6 +25,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/controller/build/engines"
 	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	componentworkflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/componentworkflow"
 )
 
@@ -115,7 +116,8 @@ func (r *ComponentWorkflowRunReconciler) Reconcile(ctx context.Context, req ctrl
 	}
 
 	if isWorkflowCompleted(componentWorkflowRun) {
-		if isWorkflowSucceeded(componentWorkflowRun) {
+		// Preview builds only produce an image; applying their Workload would overwrite the component's
+		if isWorkflowSucceeded(componentWorkflowRun) && !isPreviewRun(componentWorkflowRun) {
 			return r.handleWorkloadCreation(ctx, componentWorkflowRun, bpClient), nil
 		}
 		return ctrl.Result{}, nil
@@ -453,6 +455,12 @@ func (r *ComponentWorkflowRunReconciler) createWorkloadFromComponentWorkflowRun(
 	return false, nil
 }
 
+// isPreviewRun reports whether the run builds a pull request for a PreviewEnvironment
+func isPreviewRun(componentWorkflowRun *openchoreodevv1alpha1.ComponentWorkflowRun) bool {
+	_, ok := componentWorkflowRun.Labels[labels.LabelKeyPreviewEnvironment]
+	return ok
+}
+
 func (r *ComponentWorkflowRunReconciler) getBuildPlaneClient(buildPlane *openchoreodevv1alpha1.BuildPlane) (client.Client, error) {
 	bpClient, err := kubernetesClient.GetK8sClientFromBuildPlane(r.K8sClientMgr, buildPlane, r.GatewayURL)
 	if err != nil {
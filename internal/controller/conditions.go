@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -39,19 +40,36 @@ type ConditionedObject interface {
 	SetConditions(conditions []metav1.Condition)
 }
 
+// maxConditionMessageLength is the longest condition message the API server accepts
+const maxConditionMessageLength = 32768
+
 // NewCondition creates a new condition with the last transition time set to the current time.
+// Messages longer than the API server accepts are truncated.
 func NewCondition(conditionType ConditionType, status metav1.ConditionStatus, reason ConditionReason,
 	message string, observedGeneration int64) metav1.Condition {
 	return metav1.Condition{
 		Type:               string(conditionType),
 		Status:             status,
 		Reason:             string(reason),
-		Message:            message,
+		Message:            truncateConditionMessage(message),
 		LastTransitionTime: metav1.Now(),
 		ObservedGeneration: observedGeneration,
 	}
 }
 
+// truncateConditionMessage shortens a message to the longest condition message, on a character boundary
+func truncateConditionMessage(message string) string {
+	if len(message) <= maxConditionMessageLength {
+		return message
+	}
+	const suffix = "... (truncated)"
+	cut := maxConditionMessageLength - len(suffix)
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+	return message[:cut] + suffix
+}
+
 func MarkTrueCondition(obj ConditionedObject, ct ConditionType, reason ConditionReason, message string) (changed bool) {
 	return markCondition(obj, ct, metav1.ConditionTrue, reason, message)
 }
@@ -4,7 +4,9 @@
 package controller
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -258,3 +260,17 @@ func TestNeedConditionUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConditionTruncatesLongMessages(t *testing.T) {
+	short := NewCondition("Ready", metav1.ConditionFalse, "Failed", "short message", 1)
+	if short.Message != "short message" {
+		t.Errorf("Message = %q, want it unchanged", short.Message)
+	}
+
+	long := NewCondition("Ready", metav1.ConditionFalse, "Failed", strings.Repeat("violation é; ", 5000), 1)
+	if len(long.Message) > maxConditionMessageLength || !utf8.ValidString(long.Message) ||
+		!strings.HasSuffix(long.Message, "(truncated)") {
+		t.Errorf("Message of %d bytes, want a valid truncated message of at most %d bytes",
+			len(long.Message), maxConditionMessageLength)
+	}
+}
@@ -61,6 +61,48 @@ func GetDescription(obj client.Object) string {
 	return getAnnotationValueOrEmpty(obj, AnnotationKeyDescription)
 }
 
+// GetBoundBy returns who last pointed a ReleaseBinding at its current release.
+func GetBoundBy(obj client.Object) string {
+	return getAnnotationValueOrEmpty(obj, AnnotationKeyBoundBy)
+}
+
+// SetBoundBy records who is pointing a ReleaseBinding at a release.
+// An empty value removes the annotation so a previous actor is not carried over.
+func SetBoundBy(obj client.Object, boundBy string) {
+	annotations := obj.GetAnnotations()
+	if boundBy == "" {
+		delete(annotations, AnnotationKeyBoundBy)
+		obj.SetAnnotations(annotations)
+		return
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationKeyBoundBy] = boundBy
+	obj.SetAnnotations(annotations)
+}
+
+// GetBreakGlass returns the reason a ReleaseBinding change overrides the environment's change calendar.
+func GetBreakGlass(obj client.Object) string {
+	return getAnnotationValueOrEmpty(obj, AnnotationKeyBreakGlass)
+}
+
+// SetBreakGlass records that a ReleaseBinding change overrides the environment's change calendar.
+// An empty reason removes the override.
+func SetBreakGlass(obj client.Object, reason string) {
+	annotations := obj.GetAnnotations()
+	if reason == "" {
+		delete(annotations, AnnotationKeyBreakGlass)
+		obj.SetAnnotations(annotations)
+		return
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationKeyBreakGlass] = reason
+	obj.SetAnnotations(annotations)
+}
+
 func getLabelValueOrEmpty(obj client.Object, labelKey string) string {
 	if obj.GetLabels() == nil {
 		return ""
@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,12 +20,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 const (
-	defaultObserverBaseURL = "http://observer.openchoreo-observability-plane:8080"
-	alertingRulePath       = "/api/alerting/rule"
-	conditionTypeSynced    = "Synced"
+	alertingRulePath    = "/api/alerting/rule"
+	conditionTypeSynced = "Synced"
 	// AlertRuleCleanupFinalizer is used to ensure alert rules are deleted from the backend before the CR is removed
 	AlertRuleCleanupFinalizer = "openchoreo.dev/alertrule-cleanup"
 )
@@ -124,7 +123,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.updateStatusWithError(ctx, alertRule, err)
 	}
 
-	observerURL := getObserverBaseURL()
+	observerURL := controller.GetObserverBaseURL()
 	url := fmt.Sprintf("%s%s/%s/%s", observerURL, alertingRulePath, alertRule.Spec.Source.Type, alertRule.Name)
 
 	bodyBytes, err := json.Marshal(requestPayload)
@@ -249,14 +248,6 @@ func buildAlertingRuleRequest(rule *openchoreov1alpha1.ObservabilityAlertRule) (
 	return req, nil
 }
 
-// getObserverBaseURL returns the observer base URL, allowing override via environment variable.
-func getObserverBaseURL() string {
-	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
-		return v
-	}
-	return defaultObserverBaseURL
-}
-
 // updateStatusWithError sets the rule status to Error and records a failing condition.
 // nolint:unparam // Result is always empty but required by controller-runtime interface
 func (r *Reconciler) updateStatusWithError(ctx context.Context, rule *openchoreov1alpha1.ObservabilityAlertRule, err error) (ctrl.Result, error) {
@@ -307,7 +298,7 @@ func (r *Reconciler) finalize(ctx context.Context, alertRule *openchoreov1alpha1
 	logger.Info("Deleting alert rule from observer backend", "name", alertRule.Name, "sourceType", alertRule.Spec.Source.Type)
 
 	// Call DELETE endpoint on observer
-	observerURL := getObserverBaseURL()
+	observerURL := controller.GetObserverBaseURL()
 	url := fmt.Sprintf("%s%s/%s/%s", observerURL, alertingRulePath, alertRule.Spec.Source.Type, alertRule.Name)
 
 	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,6 +28,9 @@ import (
 const (
 	// NotificationChannelCleanupFinalizer is the finalizer that is used to clean up notification channel resources.
 	NotificationChannelCleanupFinalizer = "openchoreo.dev/notification-channel-cleanup"
+
+	// deliveryRefreshInterval is how often the delivery health recorded by the observer is copied to the status
+	deliveryRefreshInterval = 2 * time.Minute
 )
 
 // Reconciler reconciles a ObservabilityAlertsNotificationChannel object
@@ -124,7 +129,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	logger.Info("Successfully applied ConfigMap and Secret to observability plane",
 		"name", channel.Name, "namespace", channel.Namespace)
-	return ctrl.Result{}, nil
+
+	// The applied ConfigMap carries the delivery health annotations recorded by the observer
+	previous := channel.Status.DeepCopy()
+	channel.Status.ObservedGeneration = channel.Generation
+	setDeliveringCondition(channel, configMap)
+	if !equality.Semantic.DeepEqual(previous, &channel.Status) {
+		if err := r.Status().Update(ctx, channel); err != nil {
+			logger.Error(err, "Failed to update ObservabilityAlertsNotificationChannel status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Poll so that the status follows delivery failures and recoveries reported by the observer
+	return ctrl.Result{RequeueAfter: deliveryRefreshInterval}, nil
 }
 
 // getObservabilityPlaneClient gets the observability plane client by deriving it from the environment.
@@ -231,6 +249,26 @@ func (r *Reconciler) createConfigMap(channel *openchoreodevv1alpha1.Observabilit
 		}
 	}
 
+	// Add Slack overrides if type is slack, the webhook URL is stored in the Secret
+	if channel.Spec.Type == openchoreodevv1alpha1.NotificationChannelTypeSlack && channel.Spec.SlackConfig != nil {
+		if channel.Spec.SlackConfig.Channel != "" {
+			configMap.Data["slack.channel"] = channel.Spec.SlackConfig.Channel
+		}
+		if channel.Spec.SlackConfig.Username != "" {
+			configMap.Data["slack.username"] = channel.Spec.SlackConfig.Username
+		}
+	}
+
+	// Add PagerDuty event settings if type is pagerduty, the routing key is stored in the Secret
+	if channel.Spec.Type == openchoreodevv1alpha1.NotificationChannelTypePagerDuty && channel.Spec.PagerDutyConfig != nil {
+		if channel.Spec.PagerDutyConfig.Source != "" {
+			configMap.Data["pagerduty.source"] = channel.Spec.PagerDutyConfig.Source
+		}
+		for alertSeverity, pagerDutySeverity := range channel.Spec.PagerDutyConfig.SeverityMapping {
+			configMap.Data[fmt.Sprintf("pagerduty.severity.%s", alertSeverity)] = string(pagerDutySeverity)
+		}
+	}
+
 	return configMap
 }
 
@@ -317,6 +355,33 @@ func (r *Reconciler) createSecret(ctx context.Context, channel *openchoreodevv1a
 		}
 	}
 
+	// Add the credential of the Slack, Microsoft Teams and PagerDuty channels
+	var credentialKey string
+	var credential *openchoreodevv1alpha1.SecretValueFrom
+	switch {
+	case channel.Spec.Type == openchoreodevv1alpha1.NotificationChannelTypeSlack && channel.Spec.SlackConfig != nil:
+		credentialKey, credential = "slack.webhookUrl", channel.Spec.SlackConfig.WebhookURL
+	case channel.Spec.Type == openchoreodevv1alpha1.NotificationChannelTypeMSTeams && channel.Spec.MSTeamsConfig != nil:
+		credentialKey, credential = "msteams.webhookUrl", channel.Spec.MSTeamsConfig.WebhookURL
+	case channel.Spec.Type == openchoreodevv1alpha1.NotificationChannelTypePagerDuty && channel.Spec.PagerDutyConfig != nil:
+		credentialKey, credential = "pagerduty.routingKey", channel.Spec.PagerDutyConfig.RoutingKey
+	}
+	if credential != nil && credential.SecretKeyRef != nil {
+		ref := credential.SecretKeyRef
+		logger.Info("Resolving notification channel credential from secret",
+			"type", channel.Spec.Type,
+			"secretName", ref.Name,
+			"secretKey", ref.Key,
+			"namespace", channel.Namespace)
+
+		value, err := r.resolveSecretKeyRef(ctx, channel.Namespace, ref)
+		if err != nil {
+			logger.Error(err, "Failed to resolve notification channel credential secret reference")
+			return nil, fmt.Errorf("failed to resolve %s secret: %w", credentialKey, err)
+		}
+		secret.Data[credentialKey] = []byte(value)
+	}
+
 	return secret, nil
 }
 
@@ -0,0 +1,53 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observabilityalertsnotificationchannel
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionDelivering represents whether the observer delivers notifications to the channel
+	ConditionDelivering controller.ConditionType = "Delivering"
+)
+
+// Constants for condition reasons
+
+const (
+	// Reasons for Delivering condition type
+
+	// ReasonDeliverySucceeded the latest notification with a final outcome was delivered
+	ReasonDeliverySucceeded controller.ConditionReason = "DeliverySucceeded"
+	// ReasonDeliveryFailed a notification exhausted its delivery attempts, and none was delivered since
+	ReasonDeliveryFailed controller.ConditionReason = "DeliveryFailed"
+	// ReasonNoDeliveries the observer has not reported a delivery to the channel yet
+	ReasonNoDeliveries controller.ConditionReason = "NoDeliveries"
+)
+
+// setDeliveringCondition copies the delivery health the observer recorded on the channel ConfigMap
+func setDeliveringCondition(channel *openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel, configMap *corev1.ConfigMap) {
+	failing, reported := configMap.Annotations[labels.AnnotationKeyNotificationDeliveryFailing]
+	message := configMap.Annotations[labels.AnnotationKeyNotificationDeliveryMessage]
+	if reportedAt := configMap.Annotations[labels.AnnotationKeyNotificationDeliveryTime]; reportedAt != "" && message != "" {
+		message = fmt.Sprintf("%s (reported at %s)", message, reportedAt)
+	}
+
+	switch {
+	case !reported:
+		controller.MarkUnknownCondition(channel, ConditionDelivering, ReasonNoDeliveries,
+			"No notification has been delivered to the channel yet")
+	case failing == "true":
+		controller.MarkFalseCondition(channel, ConditionDelivering, ReasonDeliveryFailed, message)
+	default:
+		controller.MarkTrueCondition(channel, ConditionDelivering, ReasonDeliverySucceeded, message)
+	}
+}
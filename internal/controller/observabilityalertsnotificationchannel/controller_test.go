@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -279,7 +280,7 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			})
 
 			Expect(err).NotTo(HaveOccurred())
-			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: deliveryRefreshInterval}))
 
 			// Verify finalizer is added
 			updatedChannel := &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{}
@@ -337,7 +338,7 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			})
 
 			Expect(err).NotTo(HaveOccurred())
-			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: deliveryRefreshInterval}))
 
 			Eventually(func() bool {
 				updatedChannel := &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{}
@@ -510,7 +511,7 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			})
 
 			Expect(err2).NotTo(HaveOccurred())
-			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: deliveryRefreshInterval}))
 
 			// Verify ConfigMap has TLS config
 			configMap := &corev1.ConfigMap{}
@@ -573,6 +574,98 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			Expect(configMap.Labels["app.kubernetes.io/managed-by"]).To(Equal("observabilityalertsnotificationchannel-controller"))
 			Expect(configMap.Labels[labels.LabelKeyNotificationChannelName]).To(Equal(channel.Name))
 		})
+
+		It("should create ConfigMap with PagerDuty settings and without the routing key", func() {
+			channel := &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pagerduty-channel",
+					Namespace: namespace,
+				},
+				Spec: openchoreodevv1alpha1.ObservabilityAlertsNotificationChannelSpec{
+					Environment: "development",
+					Type:        openchoreodevv1alpha1.NotificationChannelTypePagerDuty,
+					PagerDutyConfig: &openchoreodevv1alpha1.PagerDutyConfig{
+						RoutingKey: &openchoreodevv1alpha1.SecretValueFrom{
+							SecretKeyRef: &openchoreodevv1alpha1.SecretKeyRef{Name: "pagerduty", Key: "routingKey"},
+						},
+						SeverityMapping: map[string]openchoreodevv1alpha1.PagerDutySeverity{"warning": "error"},
+						Source:          "checkout",
+					},
+				},
+			}
+
+			reconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			configMap := reconciler.createConfigMap(channel)
+
+			Expect(configMap.Data["type"]).To(Equal("pagerduty"))
+			Expect(configMap.Data["pagerduty.severity.warning"]).To(Equal("error"))
+			Expect(configMap.Data["pagerduty.source"]).To(Equal("checkout"))
+			Expect(configMap.Data).NotTo(HaveKey("pagerduty.routingKey"))
+		})
+	})
+
+	Context("When setting the Delivering condition", func() {
+		newChannel := func() *openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel {
+			return &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-channel", Namespace: namespace, Generation: 2},
+			}
+		}
+		deliveringCondition := func(channel *openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel) *metav1.Condition {
+			return meta.FindStatusCondition(channel.Status.Conditions, string(ConditionDelivering))
+		}
+
+		It("should be unknown before the observer reports a delivery", func() {
+			channel := newChannel()
+			setDeliveringCondition(channel, &corev1.ConfigMap{})
+
+			condition := deliveringCondition(channel)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(string(ReasonNoDeliveries)))
+		})
+
+		It("should report the failure recorded by the observer", func() {
+			channel := newChannel()
+			setDeliveringCondition(channel, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					labels.AnnotationKeyNotificationDeliveryFailing: "true",
+					labels.AnnotationKeyNotificationDeliveryMessage: "Delivery of alert high-latency failed after 6 attempts: connection refused",
+					labels.AnnotationKeyNotificationDeliveryTime:    "2025-01-01T10:00:00Z",
+				}},
+			})
+
+			condition := deliveringCondition(channel)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(ReasonDeliveryFailed)))
+			Expect(condition.Message).To(ContainSubstring("connection refused"))
+			Expect(condition.Message).To(ContainSubstring("2025-01-01T10:00:00Z"))
+			Expect(condition.ObservedGeneration).To(Equal(int64(2)))
+		})
+
+		It("should recover once the observer reports a delivery", func() {
+			channel := newChannel()
+			setDeliveringCondition(channel, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					labels.AnnotationKeyNotificationDeliveryFailing: "true",
+				}},
+			})
+			setDeliveringCondition(channel, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					labels.AnnotationKeyNotificationDeliveryFailing: "false",
+					labels.AnnotationKeyNotificationDeliveryMessage: "Delivery of alert high-latency succeeded",
+				}},
+			})
+
+			condition := deliveringCondition(channel)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(ReasonDeliverySucceeded)))
+		})
 	})
 
 	Context("When testing createSecret", func() {
@@ -615,6 +708,42 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			Expect(secret.Labels["app.kubernetes.io/managed-by"]).To(Equal("observabilityalertsnotificationchannel-controller"))
 			Expect(secret.Labels[labels.LabelKeyNotificationChannelName]).To(Equal(channel.Name))
 		})
+
+		It("should resolve the Microsoft Teams webhook URL from its secret reference", func() {
+			webhookSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "msteams-webhook", Namespace: namespace},
+				Data:       map[string][]byte{"url": []byte("https://example.webhook.office.com/webhook\n")},
+			}
+			Expect(k8sClient.Create(testCtx, webhookSecret)).To(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(testCtx, webhookSecret) })
+
+			channel := &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-msteams-channel", Namespace: namespace},
+				Spec: openchoreodevv1alpha1.ObservabilityAlertsNotificationChannelSpec{
+					Environment: "development",
+					Type:        openchoreodevv1alpha1.NotificationChannelTypeMSTeams,
+					MSTeamsConfig: &openchoreodevv1alpha1.MSTeamsConfig{
+						WebhookURL: &openchoreodevv1alpha1.SecretValueFrom{
+							SecretKeyRef: &openchoreodevv1alpha1.SecretKeyRef{Name: "msteams-webhook", Key: "url"},
+						},
+					},
+				},
+			}
+
+			reconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			secret, err := reconciler.createSecret(testCtx, channel)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["msteams.webhookUrl"])).To(Equal("https://example.webhook.office.com/webhook"))
+
+			By("failing when the referenced key is missing")
+			channel.Spec.MSTeamsConfig.WebhookURL.SecretKeyRef.Key = "missing"
+			_, err = reconciler.createSecret(testCtx, channel)
+			Expect(err).To(MatchError(ContainSubstring("msteams.webhookUrl")))
+		})
 	})
 
 	Context("When testing finalizers", func() {
@@ -758,7 +887,7 @@ var _ = Describe("ObservabilityAlertsNotificationChannel Controller", func() {
 			})
 
 			Expect(err).NotTo(HaveOccurred())
-			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: deliveryRefreshInterval}))
 
 			// Verify finalizer is added
 			updatedChannel := &openchoreodevv1alpha1.ObservabilityAlertsNotificationChannel{}
@@ -0,0 +1,17 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import "os"
+
+// DefaultObserverBaseURL is the in-cluster address of the observer
+const DefaultObserverBaseURL = "http://observer.openchoreo-observability-plane:8080"
+
+// GetObserverBaseURL returns the observer base URL, allowing override via the OBSERVER_ENDPOINT environment variable.
+func GetObserverBaseURL() string {
+	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
+		return v
+	}
+	return DefaultObserverBaseURL
+}
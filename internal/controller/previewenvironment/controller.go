@@ -0,0 +1,246 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package previewenvironment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/componentworkflowrun"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+)
+
+// DefaultTTL is how long a preview is kept when its spec does not set a TTL
+const DefaultTTL = 72 * time.Hour
+
+// previewLimitRequeue is how often a preview waiting under the project's preview cap checks for a free slot
+const previewLimitRequeue = time.Minute
+
+// Reconciler reconciles a PreviewEnvironment object.
+// It builds the pull request head commit with a ComponentWorkflowRun, turns the built image
+// into a ComponentRelease and binds it to an ephemeral Environment. Everything it creates is
+// owned by the PreviewEnvironment, so deleting the preview tears the whole stack down.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=previewenvironments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=previewenvironments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=previewenvironments/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentworkflowruns,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=components;projects;deploymentpipelines,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	preview := &openchoreov1alpha1.PreviewEnvironment{}
+	if err := r.Get(ctx, req.NamespacedName, preview); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get PreviewEnvironment")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Owned resources are garbage collected once the preview is gone
+	if !preview.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := preview.DeepCopy()
+
+	// Deferred status update
+	defer func() {
+		preview.Status.ObservedGeneration = preview.Generation
+		if apiequality.Semantic.DeepEqual(old.Status, preview.Status) {
+			return
+		}
+
+		// An expired preview is already gone
+		if err := r.Status().Update(ctx, preview); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to update PreviewEnvironment status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	expiresAt := preview.CreationTimestamp.Add(previewTTL(preview))
+	preview.Status.ExpiresAt = &metav1.Time{Time: expiresAt}
+	remaining := time.Until(expiresAt)
+	if remaining <= 0 {
+		preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhaseExpired
+		logger.Info("PreviewEnvironment TTL elapsed, deleting", "expiresAt", expiresAt)
+		if err := r.Delete(ctx, preview); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete expired PreviewEnvironment: %w", err)
+		}
+		return ctrl.Result{}, nil
+	}
+	// Come back when the TTL elapses even if nothing else changes
+	result = ctrl.Result{RequeueAfter: remaining}
+
+	// Previews created concurrently can exceed the cap checked by the webhook handler. The oldest previews
+	// of the project are provisioned and the others wait until a slot frees up.
+	if preview.Status.Environment == "" {
+		msg, err := r.checkPreviewLimit(ctx, preview)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if msg != "" {
+			preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhasePending
+			controller.MarkFalseCondition(preview, ConditionDeployed, ReasonPreviewLimitReached, msg)
+			return ctrl.Result{RequeueAfter: min(previewLimitRequeue, remaining)}, nil
+		}
+	}
+
+	component := &openchoreov1alpha1.Component{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      preview.Spec.Owner.ComponentName,
+		Namespace: preview.Namespace,
+	}, component); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.markFailed(preview, ConditionBuilt, ReasonComponentNotFound,
+				fmt.Sprintf("Component %q not found", preview.Spec.Owner.ComponentName))
+			return result, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get Component: %w", err)
+	}
+
+	if component.Spec.Workflow == nil {
+		r.markFailed(preview, ConditionBuilt, ReasonInvalidConfiguration,
+			fmt.Sprintf("Component %q has no build workflow configured", component.Name))
+		return result, nil
+	}
+
+	baseEnv, msg, err := r.resolveBaseEnvironment(ctx, preview)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if msg != "" {
+		r.markFailed(preview, ConditionDeployed, ReasonInvalidConfiguration, msg)
+		return result, nil
+	}
+
+	environment, err := r.ensureEnvironment(ctx, preview, baseEnv)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	preview.Status.Environment = environment.Name
+
+	run, err := r.ensureWorkflowRun(ctx, preview, component)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	preview.Status.WorkflowRun = run.Name
+
+	if !meta.IsStatusConditionTrue(run.Status.Conditions, string(componentworkflowrun.ConditionWorkflowCompleted)) {
+		// A previously deployed commit keeps serving until the new build is ready
+		preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhaseBuilding
+		controller.MarkFalseCondition(preview, ConditionBuilt, ReasonBuildInProgress,
+			fmt.Sprintf("Building commit %s with ComponentWorkflowRun %q", preview.Spec.PullRequest.HeadCommit, run.Name))
+		if preview.Status.ReleaseBinding == "" {
+			controller.MarkFalseCondition(preview, ConditionDeployed, ReasonWaitingForBuild, "Waiting for the build to finish")
+		}
+		return result, nil
+	}
+
+	if !meta.IsStatusConditionTrue(run.Status.Conditions, string(componentworkflowrun.ConditionWorkflowSucceeded)) {
+		r.markFailed(preview, ConditionBuilt, ReasonBuildFailed,
+			fmt.Sprintf("ComponentWorkflowRun %q failed", run.Name))
+		return result, nil
+	}
+
+	image := run.Status.ImageStatus.Image
+	if image == "" {
+		r.markFailed(preview, ConditionBuilt, ReasonImageNotFound,
+			fmt.Sprintf("ComponentWorkflowRun %q did not report a built image", run.Name))
+		return result, nil
+	}
+
+	componentRelease, msg, err := r.ensureComponentRelease(ctx, preview, component, image)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if msg != "" {
+		r.markFailed(preview, ConditionBuilt, ReasonBaseReleaseNotFound, msg)
+		return result, nil
+	}
+	preview.Status.ReleaseName = componentRelease.Name
+	controller.MarkTrueCondition(preview, ConditionBuilt, ReasonBuildSucceeded,
+		fmt.Sprintf("Built commit %s into ComponentRelease %q", preview.Spec.PullRequest.HeadCommit, componentRelease.Name))
+
+	binding, err := r.ensureReleaseBinding(ctx, preview, environment.Name, componentRelease.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	preview.Status.ReleaseBinding = binding.Name
+
+	if !isReleaseBindingReady(binding) {
+		preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhaseDeploying
+		controller.MarkFalseCondition(preview, ConditionDeployed, ReasonDeploying,
+			fmt.Sprintf("Waiting for ReleaseBinding %q to become ready", binding.Name))
+		return result, nil
+	}
+
+	url, err := r.findPreviewURL(ctx, preview, environment.Name)
+	if err != nil {
+		logger.Error(err, "Failed to determine preview URL")
+	}
+	preview.Status.URL = url
+	preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhaseReady
+	controller.MarkTrueCondition(preview, ConditionDeployed, ReasonDeployed,
+		fmt.Sprintf("ComponentRelease %q is deployed to Environment %q", componentRelease.Name, environment.Name))
+
+	return result, nil
+}
+
+func (r *Reconciler) markFailed(preview *openchoreov1alpha1.PreviewEnvironment, ct controller.ConditionType,
+	reason controller.ConditionReason, msg string) {
+	preview.Status.Phase = openchoreov1alpha1.PreviewEnvironmentPhaseFailed
+	controller.MarkFalseCondition(preview, ct, reason, msg)
+}
+
+// previewTTL returns the TTL of the preview, falling back to DefaultTTL
+func previewTTL(preview *openchoreov1alpha1.PreviewEnvironment) time.Duration {
+	if preview.Spec.TTL != nil && preview.Spec.TTL.Duration > 0 {
+		return preview.Spec.TTL.Duration
+	}
+	return DefaultTTL
+}
+
+// isReleaseBindingReady reports whether the binding is ready for its current spec.
+// A Ready condition from an earlier generation belongs to the previously deployed release.
+func isReleaseBindingReady(binding *openchoreov1alpha1.ReleaseBinding) bool {
+	cond := meta.FindStatusCondition(binding.Status.Conditions, string(releasebinding.ConditionReady))
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == binding.Generation
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.PreviewEnvironment{}).
+		Owns(&openchoreov1alpha1.Environment{}).
+		Owns(&openchoreov1alpha1.ComponentWorkflowRun{}).
+		Owns(&openchoreov1alpha1.ReleaseBinding{}).
+		Named("previewenvironment").
+		Complete(r)
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package previewenvironment
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionBuilt indicates whether the pull request head commit has been built
+	// into a ComponentRelease for the preview
+	ConditionBuilt controller.ConditionType = "Built"
+
+	// ConditionDeployed indicates whether the preview release is deployed and ready
+	// in the ephemeral Environment
+	ConditionDeployed controller.ConditionType = "Deployed"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonBuildInProgress indicates the ComponentWorkflowRun for the head commit has not completed
+	ReasonBuildInProgress controller.ConditionReason = "BuildInProgress"
+	// ReasonBuildSucceeded indicates the head commit was built and a preview release was created
+	ReasonBuildSucceeded controller.ConditionReason = "BuildSucceeded"
+	// ReasonBuildFailed indicates the ComponentWorkflowRun for the head commit failed
+	ReasonBuildFailed controller.ConditionReason = "BuildFailed"
+	// ReasonImageNotFound indicates the build succeeded without reporting a container image
+	ReasonImageNotFound controller.ConditionReason = "ImageNotFound"
+
+	// ReasonComponentNotFound indicates the previewed Component doesn't exist
+	ReasonComponentNotFound controller.ConditionReason = "ComponentNotFound"
+	// ReasonInvalidConfiguration indicates the component or project cannot be previewed as configured
+	ReasonInvalidConfiguration controller.ConditionReason = "InvalidConfiguration"
+	// ReasonBaseReleaseNotFound indicates the component has no ComponentRelease to base the preview on
+	ReasonBaseReleaseNotFound controller.ConditionReason = "BaseReleaseNotFound"
+	// ReasonPreviewLimitReached indicates the project already runs as many previews as its cap allows
+	ReasonPreviewLimitReached controller.ConditionReason = "PreviewLimitReached"
+
+	// ReasonDeploying indicates the preview ReleaseBinding is not ready yet
+	ReasonDeploying controller.ConditionReason = "Deploying"
+	// ReasonDeployed indicates the preview ReleaseBinding is ready
+	ReasonDeployed controller.ConditionReason = "Deployed"
+	// ReasonWaitingForBuild indicates deployment is waiting for the build to finish
+	ReasonWaitingForBuild controller.ConditionReason = "WaitingForBuild"
+)
@@ -0,0 +1,403 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package previewenvironment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/component"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// previewContainerName is the container that receives the preview image when a workload
+// has more than one container. It matches the container name produced by the build workflows.
+const previewContainerName = "main"
+
+// previewBoundBy is recorded as the binder of releases deployed to preview environments
+const previewBoundBy = "controller:preview-environment"
+
+// previewLabels returns the labels set on every resource created for a preview
+func previewLabels(preview *openchoreov1alpha1.PreviewEnvironment) map[string]string {
+	return map[string]string{
+		labels.LabelKeyOrganizationName:   preview.Namespace,
+		labels.LabelKeyProjectName:        preview.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName:      preview.Spec.Owner.ComponentName,
+		labels.LabelKeyPreviewEnvironment: preview.Name,
+	}
+}
+
+// shortCommit returns the abbreviated commit SHA used in generated resource names
+func shortCommit(commit string) string {
+	if len(commit) > 8 {
+		return commit[:8]
+	}
+	return commit
+}
+
+// resolveBaseEnvironment returns the environment previews of the project are modelled on.
+// A non-empty message is returned when the project is not configured for previews.
+func (r *Reconciler) resolveBaseEnvironment(ctx context.Context,
+	preview *openchoreov1alpha1.PreviewEnvironment) (*openchoreov1alpha1.Environment, string, error) {
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      preview.Spec.Owner.ProjectName,
+		Namespace: preview.Namespace,
+	}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("Project %q not found", preview.Spec.Owner.ProjectName), nil
+		}
+		return nil, "", fmt.Errorf("failed to get Project: %w", err)
+	}
+
+	envName := ""
+	if project.Spec.Previews != nil {
+		envName = project.Spec.Previews.BaseEnvironment
+	}
+	if envName == "" {
+		pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+		if err := r.Get(ctx, types.NamespacedName{
+			Name:      project.Spec.DeploymentPipelineRef,
+			Namespace: project.Namespace,
+		}, pipeline); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Sprintf("DeploymentPipeline %q not found", project.Spec.DeploymentPipelineRef), nil
+			}
+			return nil, "", fmt.Errorf("failed to get DeploymentPipeline: %w", err)
+		}
+		rootEnv, err := component.FindRootEnvironment(pipeline)
+		if err != nil {
+			return nil, err.Error(), nil
+		}
+		envName = rootEnv
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: envName, Namespace: preview.Namespace}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("base Environment %q not found", envName), nil
+		}
+		return nil, "", fmt.Errorf("failed to get Environment %q: %w", envName, err)
+	}
+	if env.Spec.DataPlaneRef == "" {
+		return nil, fmt.Sprintf("base Environment %q has no dataPlaneRef", envName), nil
+	}
+	return env, "", nil
+}
+
+// checkPreviewLimit returns a message when the project already runs as many previews as its cap
+// allows. Previews are ranked by creation time, and previews being deleted do not count.
+func (r *Reconciler) checkPreviewLimit(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment) (string, error) {
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      preview.Spec.Owner.ProjectName,
+		Namespace: preview.Namespace,
+	}, project); err != nil {
+		// A missing project is reported when the base environment is resolved
+		return "", client.IgnoreNotFound(err)
+	}
+	if project.Spec.Previews == nil || project.Spec.Previews.MaxConcurrent <= 0 {
+		return "", nil
+	}
+
+	previews := &openchoreov1alpha1.PreviewEnvironmentList{}
+	if err := r.List(ctx, previews,
+		client.InNamespace(preview.Namespace),
+		client.MatchingLabels{labels.LabelKeyProjectName: project.Name},
+	); err != nil {
+		return "", fmt.Errorf("failed to list PreviewEnvironments: %w", err)
+	}
+
+	var older int32
+	for i := range previews.Items {
+		other := &previews.Items[i]
+		if other.Name == preview.Name || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.CreationTimestamp.Before(&preview.CreationTimestamp) ||
+			(other.CreationTimestamp.Equal(&preview.CreationTimestamp) && other.Name < preview.Name) {
+			older++
+		}
+	}
+	if limit := project.Spec.Previews.MaxConcurrent; older >= limit {
+		return fmt.Sprintf("Project %q already runs %d previews, the maximum it allows", project.Name, limit), nil
+	}
+	return "", nil
+}
+
+// ensureEnvironment creates the ephemeral Environment of the preview on the base environment's data plane
+func (r *Reconciler) ensureEnvironment(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment,
+	baseEnv *openchoreov1alpha1.Environment) (*openchoreov1alpha1.Environment, error) {
+	env := &openchoreov1alpha1.Environment{}
+	err := r.Get(ctx, types.NamespacedName{Name: preview.Name, Namespace: preview.Namespace}, env)
+	if err == nil {
+		if !metav1.IsControlledBy(env, preview) {
+			return nil, fmt.Errorf("environment %q already exists and is not owned by the preview", env.Name)
+		}
+		return env, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get Environment %q: %w", preview.Name, err)
+	}
+
+	envLabels := previewLabels(preview)
+	envLabels[labels.LabelKeyName] = preview.Name
+	env = &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      preview.Name,
+			Namespace: preview.Namespace,
+			Labels:    envLabels,
+			Annotations: map[string]string{
+				controller.AnnotationKeyDisplayName: fmt.Sprintf("PR #%d preview of %s", preview.Spec.PullRequest.Number, preview.Spec.Owner.ComponentName),
+				controller.AnnotationKeyDescription: fmt.Sprintf("Ephemeral preview environment for %s", preview.Spec.PullRequest.URL),
+			},
+		},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: baseEnv.Spec.DataPlaneRef,
+			IsProduction: false,
+			Gateway: openchoreov1alpha1.GatewayConfig{
+				Security:  baseEnv.Spec.Gateway.Security,
+				DNSPrefix: preview.Name,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(preview, env, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on Environment: %w", err)
+	}
+	if err := r.Create(ctx, env); err != nil {
+		return nil, fmt.Errorf("failed to create Environment %q: %w", env.Name, err)
+	}
+	log.FromContext(ctx).Info("Created preview Environment", "environment", env.Name, "dataPlane", env.Spec.DataPlaneRef)
+	return env, nil
+}
+
+// ensureWorkflowRun creates the ComponentWorkflowRun that builds the pull request head commit.
+// Each head commit gets its own run, so pushing to the pull request triggers a rebuild.
+func (r *Reconciler) ensureWorkflowRun(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment,
+	component *openchoreov1alpha1.Component) (*openchoreov1alpha1.ComponentWorkflowRun, error) {
+	name := fmt.Sprintf("%s-%s", preview.Name, shortCommit(preview.Spec.PullRequest.HeadCommit))
+
+	run := &openchoreov1alpha1.ComponentWorkflowRun{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: preview.Namespace}, run)
+	if err == nil {
+		return run, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get ComponentWorkflowRun %q: %w", name, err)
+	}
+
+	systemParams := component.Spec.Workflow.SystemParameters
+	systemParams.Repository.Revision.Commit = preview.Spec.PullRequest.HeadCommit
+	if preview.Spec.PullRequest.HeadBranch != "" {
+		systemParams.Repository.Revision.Branch = preview.Spec.PullRequest.HeadBranch
+	}
+	if preview.Spec.PullRequest.RepositoryURL != "" {
+		systemParams.Repository.URL = preview.Spec.PullRequest.RepositoryURL
+	}
+
+	run = &openchoreov1alpha1.ComponentWorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: preview.Namespace,
+			Labels:    previewLabels(preview),
+		},
+		Spec: openchoreov1alpha1.ComponentWorkflowRunSpec{
+			Owner: openchoreov1alpha1.ComponentWorkflowOwner{
+				ProjectName:   preview.Spec.Owner.ProjectName,
+				ComponentName: preview.Spec.Owner.ComponentName,
+			},
+			Workflow: openchoreov1alpha1.ComponentWorkflowRunConfig{
+				Name:             component.Spec.Workflow.Name,
+				SystemParameters: systemParams,
+				Parameters:       component.Spec.Workflow.Parameters,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(preview, run, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on ComponentWorkflowRun: %w", err)
+	}
+	if err := r.Create(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create ComponentWorkflowRun %q: %w", name, err)
+	}
+	log.FromContext(ctx).Info("Created preview ComponentWorkflowRun", "workflowRun", name,
+		"commit", preview.Spec.PullRequest.HeadCommit)
+	return run, nil
+}
+
+// ensureComponentRelease creates the preview ComponentRelease. It is the component's latest
+// release with the built image swapped in, so the preview runs the pull request code with the
+// same component type, traits and configuration as the base branch.
+// A non-empty message is returned when no release can be derived.
+func (r *Reconciler) ensureComponentRelease(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment,
+	component *openchoreov1alpha1.Component, image string) (*openchoreov1alpha1.ComponentRelease, string, error) {
+	name := fmt.Sprintf("%s-%s", preview.Name, shortCommit(preview.Spec.PullRequest.HeadCommit))
+
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: preview.Namespace}, componentRelease)
+	if err == nil {
+		return componentRelease, "", nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, "", fmt.Errorf("failed to get ComponentRelease %q: %w", name, err)
+	}
+
+	if component.Status.LatestRelease == nil || component.Status.LatestRelease.Name == "" {
+		return nil, fmt.Sprintf("Component %q has no release to base the preview on", component.Name), nil
+	}
+
+	base := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      component.Status.LatestRelease.Name,
+		Namespace: preview.Namespace,
+	}, base); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("ComponentRelease %q not found", component.Status.LatestRelease.Name), nil
+		}
+		return nil, "", fmt.Errorf("failed to get ComponentRelease %q: %w", component.Status.LatestRelease.Name, err)
+	}
+
+	spec := base.Spec.DeepCopy()
+	containerName, ok := previewContainer(spec.Workload.Containers)
+	if !ok {
+		return nil, fmt.Sprintf("cannot choose the container to run the preview image in; name it %q", previewContainerName), nil
+	}
+	container := spec.Workload.Containers[containerName]
+	container.Image = image
+	spec.Workload.Containers[containerName] = container
+
+	componentRelease = &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: preview.Namespace,
+			Labels:    previewLabels(preview),
+		},
+		Spec: *spec,
+	}
+	if err := controllerutil.SetControllerReference(preview, componentRelease, r.Scheme); err != nil {
+		return nil, "", fmt.Errorf("failed to set owner reference on ComponentRelease: %w", err)
+	}
+	if err := r.Create(ctx, componentRelease); err != nil {
+		return nil, "", fmt.Errorf("failed to create ComponentRelease %q: %w", name, err)
+	}
+	log.FromContext(ctx).Info("Created preview ComponentRelease", "componentRelease", name, "image", image)
+	return componentRelease, "", nil
+}
+
+// previewContainer returns the container that runs the preview image
+func previewContainer(containers map[string]openchoreov1alpha1.Container) (string, bool) {
+	if _, ok := containers[previewContainerName]; ok {
+		return previewContainerName, true
+	}
+	if len(containers) == 1 {
+		for name := range containers {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// ensureReleaseBinding binds the preview release to the preview environment
+func (r *Reconciler) ensureReleaseBinding(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment,
+	environment, releaseName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	// Release binding name format: {component}-{environment}
+	name := fmt.Sprintf("%s-%s", preview.Spec.Owner.ComponentName, environment)
+
+	binding := &openchoreov1alpha1.ReleaseBinding{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: preview.Namespace}, binding)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get ReleaseBinding %q: %w", name, err)
+	}
+
+	if apierrors.IsNotFound(err) {
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: preview.Namespace,
+				Labels:    previewLabels(preview),
+			},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       preview.Spec.Owner,
+				Environment: environment,
+				ReleaseName: releaseName,
+			},
+		}
+		controller.SetBoundBy(binding, previewBoundBy)
+		if err := controllerutil.SetControllerReference(preview, binding, r.Scheme); err != nil {
+			return nil, fmt.Errorf("failed to set owner reference on ReleaseBinding: %w", err)
+		}
+		if err := r.Create(ctx, binding); err != nil {
+			return nil, fmt.Errorf("failed to create ReleaseBinding %q: %w", name, err)
+		}
+		return binding, nil
+	}
+
+	if binding.Spec.ReleaseName == releaseName {
+		return binding, nil
+	}
+	binding.Spec.ReleaseName = releaseName
+	controller.SetBoundBy(binding, previewBoundBy)
+	if err := r.Update(ctx, binding); err != nil {
+		return nil, fmt.Errorf("failed to update ReleaseBinding %q: %w", name, err)
+	}
+	return binding, nil
+}
+
+// findPreviewURL returns the public URL of the preview from the HTTPRoute rendered into the
+// data plane Release, or an empty string when the component is not exposed.
+func (r *Reconciler) findPreviewURL(ctx context.Context, preview *openchoreov1alpha1.PreviewEnvironment,
+	environment string) (string, error) {
+	// Release name format: {component}-{environment}
+	release := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      fmt.Sprintf("%s-%s", preview.Spec.Owner.ComponentName, environment),
+		Namespace: preview.Namespace,
+	}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get Release: %w", err)
+	}
+
+	for _, resource := range release.Spec.Resources {
+		if resource.Object == nil {
+			continue
+		}
+		var route struct {
+			Kind string `json:"kind"`
+			Spec struct {
+				Hostnames []string `json:"hostnames"`
+				Rules     []struct {
+					Matches []struct {
+						Path *struct {
+							Value string `json:"value"`
+						} `json:"path"`
+					} `json:"matches"`
+				} `json:"rules"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(resource.Object.Raw, &route); err != nil || route.Kind != "HTTPRoute" {
+			continue
+		}
+		if len(route.Spec.Hostnames) == 0 {
+			continue
+		}
+
+		path := ""
+		if len(route.Spec.Rules) > 0 && len(route.Spec.Rules[0].Matches) > 0 && route.Spec.Rules[0].Matches[0].Path != nil {
+			path = route.Spec.Rules[0].Matches[0].Path.Value
+		}
+		return fmt.Sprintf("https://%s%s", route.Spec.Hostnames[0], path), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package previewenvironment
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var _ = Describe("PreviewEnvironment Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-component-pr-42"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind PreviewEnvironment")
+			err := k8sClient.Get(ctx, typeNamespacedName, &openchoreodevv1alpha1.PreviewEnvironment{})
+			if err != nil && errors.IsNotFound(err) {
+				resource := &openchoreodevv1alpha1.PreviewEnvironment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: openchoreodevv1alpha1.PreviewEnvironmentSpec{
+						Owner: openchoreodevv1alpha1.ReleaseBindingOwner{
+							ProjectName:   "test-project",
+							ComponentName: "test-component",
+						},
+						PullRequest: openchoreodevv1alpha1.PullRequestRef{
+							Number:     42,
+							HeadCommit: "0123456789abcdef",
+							HeadBranch: "feature",
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &openchoreodevv1alpha1.PreviewEnvironment{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if errors.IsNotFound(err) {
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance PreviewEnvironment")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should fail when the component does not exist", func() {
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+			resource := &openchoreodevv1alpha1.PreviewEnvironment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Phase).To(Equal(openchoreodevv1alpha1.PreviewEnvironmentPhaseFailed))
+			Expect(resource.Status.ExpiresAt).NotTo(BeNil())
+			cond := meta.FindStatusCondition(resource.Status.Conditions, string(ConditionBuilt))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(string(ReasonComponentNotFound)))
+		})
+
+		It("should delete the preview once its TTL has elapsed", func() {
+			resource := &openchoreodevv1alpha1.PreviewEnvironment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.TTL = &metav1.Duration{Duration: time.Nanosecond}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When the project reached its preview cap", func() {
+		ctx := context.Background()
+
+		newPreview := func(name string) *openchoreodevv1alpha1.PreviewEnvironment {
+			return &openchoreodevv1alpha1.PreviewEnvironment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					Labels:    map[string]string{labels.LabelKeyProjectName: "capped-project"},
+				},
+				Spec: openchoreodevv1alpha1.PreviewEnvironmentSpec{
+					Owner: openchoreodevv1alpha1.ReleaseBindingOwner{
+						ProjectName:   "capped-project",
+						ComponentName: "test-component",
+					},
+					PullRequest: openchoreodevv1alpha1.PullRequestRef{Number: 1, HeadCommit: "0123456789abcdef"},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			project := &openchoreodevv1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "capped-project", Namespace: "default"},
+				Spec: openchoreodevv1alpha1.ProjectSpec{
+					DeploymentPipelineRef: "default",
+					Previews:              &openchoreodevv1alpha1.ProjectPreviewConfig{Enabled: true, MaxConcurrent: 1},
+				},
+			}
+			Expect(k8sClient.Create(ctx, project)).To(Succeed())
+			Expect(k8sClient.Create(ctx, newPreview("capped-pr-1"))).To(Succeed())
+			Expect(k8sClient.Create(ctx, newPreview("capped-pr-2"))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			for _, name := range []string{"capped-pr-1", "capped-pr-2"} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, newPreview(name)))).To(Succeed())
+			}
+			project := &openchoreodevv1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "capped-project", Namespace: "default"}}
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, project))).To(Succeed())
+		})
+
+		It("should keep the newer preview pending", func() {
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			key := types.NamespacedName{Name: "capped-pr-2", Namespace: "default"}
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(previewLimitRequeue))
+
+			resource := &openchoreodevv1alpha1.PreviewEnvironment{}
+			Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+			Expect(resource.Status.Phase).To(Equal(openchoreodevv1alpha1.PreviewEnvironmentPhasePending))
+			cond := meta.FindStatusCondition(resource.Status.Conditions, string(ConditionDeployed))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(string(ReasonPreviewLimitReached)))
+		})
+	})
+
+	Context("choosing the preview container", func() {
+		It("should prefer the main container", func() {
+			name, ok := previewContainer(map[string]openchoreodevv1alpha1.Container{"main": {}, "sidecar": {}})
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("main"))
+		})
+
+		It("should use the only container", func() {
+			name, ok := previewContainer(map[string]openchoreodevv1alpha1.Container{"app": {}})
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("app"))
+		})
+
+		It("should refuse to guess between several containers", func() {
+			_, ok := previewContainer(map[string]openchoreodevv1alpha1.Container{"app": {}, "sidecar": {}})
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
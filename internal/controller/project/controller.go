@@ -7,14 +7,17 @@ import (
 	"context"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
@@ -81,8 +84,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		NewProjectCreatedCondition(project.Generation),
 	)
 
+	// Record the quota usage of the project's releases in each environment
+	resourceUsage, err := r.computeResourceUsage(ctx, project)
+	if err != nil {
+		logger.Error(err, "Failed to compute resource usage")
+		return ctrl.Result{}, err
+	}
+	project.Status.ResourceUsage = resourceUsage
+
 	// Update status if needed
-	if err := controller.UpdateStatusConditions(ctx, r.Client, old, project); err != nil {
+	if !apiequality.Semantic.DeepEqual(old.Status.ResourceUsage, project.Status.ResourceUsage) {
+		if err := r.Status().Update(ctx, project); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if err := controller.UpdateStatusConditions(ctx, r.Client, old, project); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -104,5 +119,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Named("project").
 		Watches(&openchoreov1alpha1.Component{},
 			handler.EnqueueRequestsFromMapFunc(r.findProjectForComponent)).
+		Watches(&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.findProjectForRelease),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
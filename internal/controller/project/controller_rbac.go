@@ -8,4 +8,5 @@ package project
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=projects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=projects/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -0,0 +1,73 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/resourcepolicy"
+)
+
+// computeResourceUsage sums the resources requested by the project's data plane releases in each
+// environment where the project has a quota
+func (r *Reconciler) computeResourceUsage(ctx context.Context, project *openchoreov1alpha1.Project) (
+	[]openchoreov1alpha1.EnvironmentResourceUsage, error) {
+	policy := project.Spec.ResourcePolicy
+	if policy == nil {
+		return nil, nil
+	}
+
+	releases := &openchoreov1alpha1.ReleaseList{}
+	if err := r.List(ctx, releases, client.InNamespace(project.Namespace),
+		client.MatchingLabels{labels.LabelKeyProjectName: project.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	releasesByEnvironment := make(map[string][]*openchoreov1alpha1.Release)
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane {
+			continue
+		}
+		releasesByEnvironment[release.Spec.EnvironmentName] = append(releasesByEnvironment[release.Spec.EnvironmentName], release)
+	}
+
+	// Report the environments the project is deployed to and those with an override, even before the first deployment
+	environments := make(map[string]bool, len(releasesByEnvironment)+len(policy.Environments))
+	for name := range releasesByEnvironment {
+		environments[name] = true
+	}
+	for _, override := range policy.Environments {
+		environments[override.Environment] = true
+	}
+
+	usage := make([]openchoreov1alpha1.EnvironmentResourceUsage, 0, len(environments))
+	for environmentName := range environments {
+		hard, limits := resourcepolicy.Effective(policy, environmentName)
+		if len(hard) == 0 {
+			continue
+		}
+		usages := make([]corev1.ResourceList, 0, len(releasesByEnvironment[environmentName]))
+		for _, release := range releasesByEnvironment[environmentName] {
+			used, err := resourcepolicy.ReleaseUsage(release.Spec.Resources, limits)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute resource usage of release %s: %w", release.Name, err)
+			}
+			usages = append(usages, used)
+		}
+		usage = append(usage, openchoreov1alpha1.EnvironmentResourceUsage{
+			Environment: environmentName,
+			Hard:        hard,
+			Used:        resourcepolicy.Filter(resourcepolicy.Sum(usages...), hard),
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].Environment < usage[j].Environment })
+	return usage, nil
+}
@@ -25,3 +25,17 @@ func (r *Reconciler) findProjectForComponent(ctx context.Context, obj client.Obj
 		},
 	}}
 }
+
+// findProjectForRelease maps a data plane Release to the Project it belongs to
+func (r *Reconciler) findProjectForRelease(ctx context.Context, obj client.Object) []ctrl.Request {
+	release := obj.(*openchoreov1alpha1.Release)
+	if release.Spec.Owner.ProjectName == "" {
+		return nil
+	}
+	return []ctrl.Request{{
+		NamespacedName: client.ObjectKey{
+			Name:      release.Spec.Owner.ProjectName,
+			Namespace: release.Namespace,
+		},
+	}}
+}
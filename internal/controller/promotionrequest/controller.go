@@ -0,0 +1,221 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotionrequest
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Reconciler reconciles a PromotionRequest object.
+// It is the only component that moves a ReleaseBinding's releaseName for environments
+// that require approval, and it only does so once the request carries an Approve decision.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=promotionrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=promotionrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=promotionrequests/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{}
+	if err := r.Get(ctx, req.NamespacedName, promotionRequest); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get PromotionRequest")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	old := promotionRequest.DeepCopy()
+
+	// Deferred status update
+	defer func() {
+		promotionRequest.Status.ObservedGeneration = promotionRequest.Generation
+		if apiequality.Semantic.DeepEqual(old.Status, promotionRequest.Status) {
+			return
+		}
+
+		if err := r.Status().Update(ctx, promotionRequest); err != nil {
+			logger.Error(err, "Failed to update PromotionRequest status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	decision := promotionRequest.Status.Decision
+	if decision == nil {
+		promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePending
+		controller.MarkFalseCondition(promotionRequest, ConditionApproved, ReasonAwaitingApproval,
+			fmt.Sprintf("Promotion to %q is waiting for approval", promotionRequest.Spec.TargetEnvironment))
+		controller.MarkFalseCondition(promotionRequest, ConditionPromoted, ReasonNotApproved,
+			"Promotion is not approved yet")
+		return ctrl.Result{}, nil
+	}
+
+	if decision.Action == openchoreov1alpha1.PromotionDecisionReject {
+		promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseRejected
+		controller.MarkFalseCondition(promotionRequest, ConditionApproved, ReasonRejected,
+			fmt.Sprintf("Promotion rejected by %s", decision.DecidedBy))
+		controller.MarkFalseCondition(promotionRequest, ConditionPromoted, ReasonNotApproved,
+			"Promotion was rejected")
+		return ctrl.Result{}, nil
+	}
+
+	controller.MarkTrueCondition(promotionRequest, ConditionApproved, ReasonApproved,
+		fmt.Sprintf("Promotion approved by %s", decision.DecidedBy))
+
+	// An approved request is applied exactly once. Re-applying it on later reconciles
+	// would revert any subsequent promotion or rollback of the target environment.
+	// applyPromotion is also keyed on the request, so a failed status write after the
+	// binding was updated does not apply the promotion a second time.
+	if promotionRequest.Status.Phase == openchoreov1alpha1.PromotionRequestPhasePromoted {
+		return ctrl.Result{}, nil
+	}
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseApproved
+
+	// Make sure the release still exists before pointing the target environment at it
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      promotionRequest.Spec.ReleaseName,
+		Namespace: promotionRequest.Namespace,
+	}, componentRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			msg := fmt.Sprintf("ComponentRelease %q not found", promotionRequest.Spec.ReleaseName)
+			promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseFailed
+			controller.MarkFalseCondition(promotionRequest, ConditionPromoted, ReasonComponentReleaseNotFound, msg)
+			logger.Info(msg, "componentRelease", promotionRequest.Spec.ReleaseName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ComponentRelease", "componentRelease", promotionRequest.Spec.ReleaseName)
+		return ctrl.Result{}, err
+	}
+
+	bindingName, err := r.applyPromotion(ctx, promotionRequest)
+	if err != nil {
+		controller.MarkFalseCondition(promotionRequest, ConditionPromoted, ReasonReleaseBindingUpdateFailed,
+			fmt.Sprintf("Failed to update target ReleaseBinding: %v", err))
+		logger.Error(err, "Failed to apply promotion")
+		return ctrl.Result{}, err
+	}
+
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePromoted
+	controller.MarkTrueCondition(promotionRequest, ConditionPromoted, ReasonReleaseBindingUpdated,
+		fmt.Sprintf("ReleaseBinding %q now references ComponentRelease %q", bindingName, promotionRequest.Spec.ReleaseName))
+	logger.Info("Promotion applied", "releaseBinding", bindingName, "release", promotionRequest.Spec.ReleaseName)
+
+	return ctrl.Result{}, nil
+}
+
+// applyPromotion creates or updates the ReleaseBinding of the target environment so that it
+// references the release captured in the PromotionRequest. It returns the binding name.
+// The binding is stamped with the request name, and a binding that already carries it is
+// left untouched even if it has since been moved to another release.
+func (r *Reconciler) applyPromotion(ctx context.Context, pr *openchoreov1alpha1.PromotionRequest) (string, error) {
+	target, err := r.findTargetReleaseBinding(ctx, pr)
+	if err != nil {
+		return "", err
+	}
+
+	if target == nil {
+		target = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", pr.Spec.Owner.ComponentName, pr.Spec.TargetEnvironment),
+				Namespace: pr.Namespace,
+				Labels: map[string]string{
+					labels.LabelKeyProjectName:   pr.Spec.Owner.ProjectName,
+					labels.LabelKeyComponentName: pr.Spec.Owner.ComponentName,
+				},
+				Annotations: map[string]string{
+					controller.AnnotationKeyPromotionRequest: pr.Name,
+				},
+			},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   pr.Spec.Owner.ProjectName,
+					ComponentName: pr.Spec.Owner.ComponentName,
+				},
+				Environment: pr.Spec.TargetEnvironment,
+				ReleaseName: pr.Spec.ReleaseName,
+			},
+		}
+		controller.SetBoundBy(target, promotionBoundBy(pr))
+		if err := r.Create(ctx, target); err != nil {
+			return "", fmt.Errorf("failed to create ReleaseBinding %q: %w", target.Name, err)
+		}
+		return target.Name, nil
+	}
+
+	if target.Spec.ReleaseName == pr.Spec.ReleaseName ||
+		target.GetAnnotations()[controller.AnnotationKeyPromotionRequest] == pr.Name {
+		return target.Name, nil
+	}
+
+	target.Spec.ReleaseName = pr.Spec.ReleaseName
+	controller.SetBoundBy(target, promotionBoundBy(pr))
+	annotations := target.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[controller.AnnotationKeyPromotionRequest] = pr.Name
+	target.SetAnnotations(annotations)
+	if err := r.Update(ctx, target); err != nil {
+		return "", fmt.Errorf("failed to update ReleaseBinding %q: %w", target.Name, err)
+	}
+	return target.Name, nil
+}
+
+// promotionBoundBy returns the approver of the promotion, who is recorded as binding the release
+func promotionBoundBy(pr *openchoreov1alpha1.PromotionRequest) string {
+	if pr.Status.Decision == nil {
+		return ""
+	}
+	return pr.Status.Decision.DecidedBy
+}
+
+// findTargetReleaseBinding returns the ReleaseBinding of the promoted component in the
+// target environment, or nil when the component has not been deployed there yet.
+func (r *Reconciler) findTargetReleaseBinding(ctx context.Context,
+	pr *openchoreov1alpha1.PromotionRequest) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindingList, client.InNamespace(pr.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ReleaseBindings: %w", err)
+	}
+
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		if binding.Spec.Owner == pr.Spec.Owner && binding.Spec.Environment == pr.Spec.TargetEnvironment {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.PromotionRequest{}).
+		Named("promotionrequest").
+		Complete(r)
+}
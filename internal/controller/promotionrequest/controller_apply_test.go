@@ -0,0 +1,91 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotionrequest
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var _ = Describe("Applying a promotion", func() {
+	const namespace = "acme"
+
+	var (
+		reconciler *Reconciler
+		k8sClient  client.Client
+		request    *openchoreov1alpha1.PromotionRequest
+		binding    *openchoreov1alpha1.ReleaseBinding
+	)
+
+	BeforeEach(func() {
+		owner := openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "api"}
+		request = &openchoreov1alpha1.PromotionRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production-x1", Namespace: namespace},
+			Spec: openchoreov1alpha1.PromotionRequestSpec{
+				Owner:             owner,
+				SourceEnvironment: "staging",
+				TargetEnvironment: "production",
+				ReleaseName:       "api-2",
+			},
+			Status: openchoreov1alpha1.PromotionRequestStatus{
+				Decision: &openchoreov1alpha1.PromotionDecision{
+					Action:    openchoreov1alpha1.PromotionDecisionApprove,
+					DecidedBy: "bob",
+				},
+			},
+		}
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: namespace},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       owner,
+				Environment: "production",
+				ReleaseName: "api-1",
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(binding).Build()
+		reconciler = &Reconciler{Client: k8sClient, Scheme: scheme}
+	})
+
+	It("points the target binding at the release and stamps the request", func() {
+		name, err := reconciler.applyPromotion(context.Background(), request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("api-production"))
+
+		updated := &openchoreov1alpha1.ReleaseBinding{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(binding), updated)).To(Succeed())
+		Expect(updated.Spec.ReleaseName).To(Equal("api-2"))
+		Expect(updated.Annotations).To(HaveKeyWithValue(controller.AnnotationKeyPromotionRequest, "api-production-x1"))
+		Expect(controller.GetBoundBy(updated)).To(Equal("bob"))
+	})
+
+	It("does not apply the same request twice after the binding was moved on", func() {
+		_, err := reconciler.applyPromotion(context.Background(), request)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("rolling the binding back before the request status recorded the promotion")
+		rolledBack := &openchoreov1alpha1.ReleaseBinding{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(binding), rolledBack)).To(Succeed())
+		rolledBack.Spec.ReleaseName = "api-1"
+		Expect(k8sClient.Update(context.Background(), rolledBack)).To(Succeed())
+
+		_, err = reconciler.applyPromotion(context.Background(), request)
+		Expect(err).NotTo(HaveOccurred())
+
+		current := &openchoreov1alpha1.ReleaseBinding{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(binding), current)).To(Succeed())
+		Expect(current.Spec.ReleaseName).To(Equal("api-1"))
+	})
+})
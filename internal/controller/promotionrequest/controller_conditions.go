@@ -0,0 +1,39 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotionrequest
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionApproved indicates whether a reviewer has approved the PromotionRequest
+	ConditionApproved controller.ConditionType = "Approved"
+
+	// ConditionPromoted indicates whether the target ReleaseBinding has been moved
+	// to the requested ComponentRelease
+	ConditionPromoted controller.ConditionType = "Promoted"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonAwaitingApproval indicates no decision has been recorded yet
+	ReasonAwaitingApproval controller.ConditionReason = "AwaitingApproval"
+	// ReasonApproved indicates a reviewer approved the request
+	ReasonApproved controller.ConditionReason = "Approved"
+	// ReasonRejected indicates a reviewer rejected the request
+	ReasonRejected controller.ConditionReason = "Rejected"
+
+	// ReasonReleaseBindingUpdated indicates the target ReleaseBinding points to the requested release
+	ReasonReleaseBindingUpdated controller.ConditionReason = "ReleaseBindingUpdated"
+	// ReasonNotApproved indicates the promotion is not applied because the request is not approved
+	ReasonNotApproved controller.ConditionReason = "NotApproved"
+	// ReasonComponentReleaseNotFound indicates the requested ComponentRelease no longer exists
+	ReasonComponentReleaseNotFound controller.ConditionReason = "ComponentReleaseNotFound"
+	// ReasonReleaseBindingUpdateFailed indicates the target ReleaseBinding could not be created or updated
+	ReasonReleaseBindingUpdateFailed controller.ConditionReason = "ReleaseBindingUpdateFailed"
+)
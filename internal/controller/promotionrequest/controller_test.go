@@ -0,0 +1,110 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotionrequest
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("PromotionRequest Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-promotion"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind PromotionRequest")
+			err := k8sClient.Get(ctx, typeNamespacedName, &openchoreodevv1alpha1.PromotionRequest{})
+			if err != nil && errors.IsNotFound(err) {
+				resource := &openchoreodevv1alpha1.PromotionRequest{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: openchoreodevv1alpha1.PromotionRequestSpec{
+						Owner: openchoreodevv1alpha1.ReleaseBindingOwner{
+							ProjectName:   "test-project",
+							ComponentName: "test-component",
+						},
+						SourceEnvironment: "staging",
+						TargetEnvironment: "production",
+						ReleaseName:       "test-release",
+						RequestedBy:       "alice",
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &openchoreodevv1alpha1.PromotionRequest{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance PromotionRequest")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should keep the request pending until a decision is recorded", func() {
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &openchoreodevv1alpha1.PromotionRequest{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Phase).To(Equal(openchoreodevv1alpha1.PromotionRequestPhasePending))
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, string(ConditionApproved))).To(BeTrue())
+
+			By("checking that no ReleaseBinding was created for the target environment")
+			bindings := &openchoreodevv1alpha1.ReleaseBindingList{}
+			Expect(k8sClient.List(ctx, bindings)).To(Succeed())
+			for _, b := range bindings.Items {
+				Expect(b.Spec.Environment).NotTo(Equal("production"))
+			}
+		})
+
+		It("should mark the request rejected without touching the target environment", func() {
+			resource := &openchoreodevv1alpha1.PromotionRequest{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Status.Decision = &openchoreodevv1alpha1.PromotionDecision{
+				Action:    openchoreodevv1alpha1.PromotionDecisionReject,
+				DecidedBy: "bob",
+				DecidedAt: metav1.Now(),
+			}
+			Expect(k8sClient.Status().Update(ctx, resource)).To(Succeed())
+
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Phase).To(Equal(openchoreodevv1alpha1.PromotionRequestPhaseRejected))
+			cond := meta.FindStatusCondition(resource.Status.Conditions, string(ConditionApproved))
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(string(ReasonRejected)))
+		})
+	})
+})
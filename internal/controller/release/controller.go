@@ -16,8 +16,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
@@ -46,6 +49,8 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -119,14 +124,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	// PHASE 1: Apply desired resources to the target plane
-	// This ensures all resources in the spec are created/updated with proper tracking labels
-	if err := r.applyResources(ctx, planeClient, desiredResources); err != nil {
-		logger.Error(err, "Failed to apply resources to target plane", "targetPlane", targetPlane)
-		return ctrl.Result{}, err
+	// Apply the project's quota and container limits to the data plane namespaces before the workloads
+	if targetPlane == targetPlaneDataPlane {
+		if err := r.ensureResourcePolicy(ctx, planeClient, release, desiredNamespaces); err != nil {
+			logger.Error(err, "Failed to ensure resource policy")
+			return ctrl.Result{}, err
+		}
 	}
 
-	// PHASE 2: Discover live resources that we manage in the target plane
+	// PHASE 1: Discover live resources that we manage in the target plane
 	// This queries both current resource types (from spec) and previous resource types (from status)
 	// to ensure we find all resources that might need cleanup, preventing resource leaks
 	gvks := findAllKnownGVKs(desiredResources, release.Status.Resources)
@@ -136,18 +142,54 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	// PHASE 3: Find and delete stale resources (cleanup orphaned resources)
+	// PHASE 2: Detect drift between the live resources and the spec that was last applied.
+	// Drift is only meaningful once the current spec has been applied, otherwise the live
+	// resources legitimately differ until the new spec is applied below.
+	driftPolicy, err := r.getDriftPolicy(ctx, release)
+	if err != nil {
+		logger.Error(err, "Failed to get drift policy")
+		return ctrl.Result{}, err
+	}
+	drift := map[string][]string{}
+	if release.Status.ObservedGeneration == release.Generation && len(release.Status.Resources) > 0 {
+		drift = detectDrift(desiredResources, liveResources)
+		if len(drift) > 0 {
+			logger.Info("Detected drift in live resources", "policy", driftPolicy, "resources", len(drift))
+		}
+	}
+	paused := driftPolicy == openchoreov1alpha1.DriftPolicyPause && len(drift) > 0
+
+	// PHASE 3: Apply desired resources to the target plane
+	// This ensures all resources in the spec are created/updated with proper tracking labels.
+	// The Report policy leaves drifted resources as they are and the Pause policy applies nothing.
+	if !paused {
+		resourcesToApply := desiredResources
+		if driftPolicy == openchoreov1alpha1.DriftPolicyReport {
+			resourcesToApply = filterDriftedResources(desiredResources, drift)
+		}
+		if err := r.applyResources(ctx, planeClient, resourcesToApply); err != nil {
+			logger.Error(err, "Failed to apply resources to target plane", "targetPlane", targetPlane)
+			return ctrl.Result{}, err
+		}
+		// The apply returns the live objects, so the listing above is brought up to date without listing again
+		liveResources = mergeAppliedResources(liveResources, resourcesToApply)
+		release.Status.ObservedGeneration = release.Generation
+	}
+
+	// PHASE 4: Find and delete stale resources (cleanup orphaned resources)
 	// Stale = live resources that are no longer in the desired spec (e.g., user removed a ConfigMap)
 	// This implements Flux-style inventory cleanup to prevent resource accumulation over time
-	staleResources := r.findStaleResources(liveResources, desiredResources)
-	if err := r.deleteResources(ctx, planeClient, staleResources); err != nil {
-		logger.Error(err, "Failed to delete stale resources")
-		return ctrl.Result{}, err
+	if !paused {
+		staleResources := r.findStaleResources(liveResources, desiredResources)
+		if err := r.deleteResources(ctx, planeClient, staleResources); err != nil {
+			logger.Error(err, "Failed to delete stale resources")
+			return ctrl.Result{}, err
+		}
 	}
 
-	// PHASE 4: Update status with applied resources inventory (done last after all operations)
+	// PHASE 5: Update status with applied resources inventory and drift (done last after all operations)
 	// This maintains an inventory of what we applied for future cleanup operations
-	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources); err != nil || statusUpdated {
+	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources, drift, driftPolicy); err != nil || statusUpdated {
 		// Return after updating the status to ensure it is persisted before continuing
 		return ctrl.Result{}, err
 	}
@@ -492,6 +534,32 @@ func findAllKnownGVKs(desiredResources []*unstructured.Unstructured, appliedReso
 	return gvks
 }
 
+// mergeAppliedResources replaces the listed live resources with the objects returned by the apply
+// and adds the resources that were created by it
+func mergeAppliedResources(liveResources, appliedResources []*unstructured.Unstructured) []*unstructured.Unstructured {
+	appliedByID := make(map[string]*unstructured.Unstructured, len(appliedResources))
+	for _, obj := range appliedResources {
+		appliedByID[obj.GetLabels()[labels.LabelKeyReleaseResourceID]] = obj
+	}
+
+	merged := make([]*unstructured.Unstructured, 0, len(liveResources)+len(appliedResources))
+	for _, liveObj := range liveResources {
+		resourceID := liveObj.GetLabels()[labels.LabelKeyReleaseResourceID]
+		if appliedObj, found := appliedByID[resourceID]; found {
+			merged = append(merged, appliedObj)
+			delete(appliedByID, resourceID)
+			continue
+		}
+		merged = append(merged, liveObj)
+	}
+	for _, obj := range appliedResources {
+		if _, created := appliedByID[obj.GetLabels()[labels.LabelKeyReleaseResourceID]]; created {
+			merged = append(merged, obj)
+		}
+	}
+	return merged
+}
+
 // listLiveResourcesByGVKs queries specific resource types with label selector
 func (r *Reconciler) listLiveResourcesByGVKs(ctx context.Context, planeClient client.Client, release *openchoreov1alpha1.Release, gvks []schema.GroupVersionKind) ([]*unstructured.Unstructured, error) {
 	logger := log.FromContext(ctx)
@@ -592,5 +660,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.Release{}).
 		Named("release").
+		Watches(&openchoreov1alpha1.Project{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleasesForProject),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
@@ -14,6 +14,9 @@ import (
 const (
 	// ConditionFinalizing represents whether the Release is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionDrifted represents whether live resources were changed outside of the Release
+	ConditionDrifted controller.ConditionType = "Drifted"
 )
 
 // Constants for condition reasons
@@ -25,6 +28,17 @@ const (
 	ReasonCleanupInProgress controller.ConditionReason = "CleanupInProgress"
 	// ReasonCleanupFailed cleanup of dataplane resources failed
 	ReasonCleanupFailed controller.ConditionReason = "CleanupFailed"
+
+	// Reasons for Drifted condition type
+
+	// ReasonNoDrift live resources match the applied spec
+	ReasonNoDrift controller.ConditionReason = "NoDrift"
+	// ReasonDriftReverted drifted resources were reapplied from the spec
+	ReasonDriftReverted controller.ConditionReason = "DriftReverted"
+	// ReasonDriftDetected drifted resources were reported and left unchanged
+	ReasonDriftDetected controller.ConditionReason = "DriftDetected"
+	// ReasonReconciliationPaused applying the Release is paused because of drift
+	ReasonReconciliationPaused controller.ConditionReason = "ReconciliationPaused"
 )
 
 func NewReleaseFinalizingCondition(generation int64) metav1.Condition {
@@ -0,0 +1,270 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// maxDriftFields bounds the drifted field paths recorded per resource
+const maxDriftFields = 20
+
+// getDriftPolicy returns the drift policy of the Release's environment, defaulting to AutoHeal
+func (r *Reconciler) getDriftPolicy(ctx context.Context, release *openchoreov1alpha1.Release) (openchoreov1alpha1.DriftPolicy, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: release.Spec.EnvironmentName, Namespace: release.Namespace}, env); err != nil {
+		return "", fmt.Errorf("failed to get environment %s: %w", release.Spec.EnvironmentName, err)
+	}
+	if env.Spec.DriftPolicy == "" {
+		return openchoreov1alpha1.DriftPolicyAutoHeal, nil
+	}
+	return env.Spec.DriftPolicy, nil
+}
+
+// detectDrift compares the live resources with the desired ones and returns the drifted
+// field paths keyed by resource ID. Resources that do not exist yet are not drifted.
+func detectDrift(desiredResources, liveResources []*unstructured.Unstructured) map[string][]string {
+	liveByID := make(map[string]*unstructured.Unstructured)
+	for _, liveObj := range liveResources {
+		if resourceID := liveObj.GetLabels()[labels.LabelKeyReleaseResourceID]; resourceID != "" {
+			liveByID[resourceID] = liveObj
+		}
+	}
+
+	drift := make(map[string][]string)
+	for _, desiredObj := range desiredResources {
+		resourceID := desiredObj.GetLabels()[labels.LabelKeyReleaseResourceID]
+		liveObj, found := liveByID[resourceID]
+		if !found {
+			continue
+		}
+		var fields []string
+		diffFields(desiredObj.GetKind(), desiredObj.Object, liveObj.Object, "", &fields)
+		if len(fields) > 0 {
+			if len(fields) > maxDriftFields {
+				fields = fields[:maxDriftFields]
+			}
+			drift[resourceID] = fields
+		}
+	}
+	return drift
+}
+
+// diffFields walks the desired object and records the paths whose live value differs.
+// Only fields set in the desired object are compared, so fields defaulted or managed by the
+// server are ignored. Of the metadata, only labels and annotations are compared.
+func diffFields(kind string, desired, live any, path string, fields *[]string) {
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		liveMap, ok := live.(map[string]any)
+		if !ok {
+			if !isEmptyValue(desiredValue) {
+				*fields = append(*fields, path)
+			}
+			return
+		}
+		keys := make([]string, 0, len(desiredValue))
+		for key := range desiredValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if isIgnoredField(kind, path, key) {
+				continue
+			}
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			liveChild, found := liveMap[key]
+			if !found {
+				if !isEmptyValue(desiredValue[key]) {
+					*fields = append(*fields, childPath)
+				}
+				continue
+			}
+			diffFields(kind, desiredValue[key], liveChild, childPath, fields)
+		}
+
+	case []any:
+		liveList, ok := live.([]any)
+		if !ok {
+			if len(desiredValue) > 0 {
+				*fields = append(*fields, path)
+			}
+			return
+		}
+		if names, ok := elementNames(desiredValue); ok {
+			// Lists keyed by name are merged by the server, so entries added by others are not drift
+			liveByName := make(map[string]any)
+			for _, item := range liveList {
+				if m, ok := item.(map[string]any); ok {
+					if name, ok := m["name"].(string); ok {
+						liveByName[name] = item
+					}
+				}
+			}
+			for i, name := range names {
+				childPath := fmt.Sprintf("%s[%s]", path, name)
+				liveItem, found := liveByName[name]
+				if !found {
+					*fields = append(*fields, childPath)
+					continue
+				}
+				diffFields(kind, desiredValue[i], liveItem, childPath, fields)
+			}
+			return
+		}
+		if len(desiredValue) != len(liveList) {
+			*fields = append(*fields, path)
+			return
+		}
+		for i := range desiredValue {
+			diffFields(kind, desiredValue[i], liveList[i], fmt.Sprintf("%s[%d]", path, i), fields)
+		}
+
+	default:
+		if !scalarsEqual(desired, live) {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+// isIgnoredField reports whether a field of the desired object is left out of drift detection
+func isIgnoredField(kind, path, key string) bool {
+	switch path {
+	case "":
+		// Secrets never return stringData, it is merged into data by the server
+		return key == "status" || key == "apiVersion" || key == "kind" || (kind == "Secret" && key == "stringData")
+	case "metadata":
+		return key != "labels" && key != "annotations"
+	}
+	return false
+}
+
+// elementNames returns the names of the list entries when every entry is an object with a name
+func elementNames(list []any) ([]string, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+	names := make([]string, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// isEmptyValue reports whether a desired value is empty and may be omitted by the server
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// scalarsEqual compares two scalar values, treating numbers of different types and
+// equivalent resource quantities (e.g. "1024Mi" and "1Gi") as equal
+func scalarsEqual(desired, live any) bool {
+	if desiredNumber, ok := toFloat(desired); ok {
+		liveNumber, ok := toFloat(live)
+		return ok && desiredNumber == liveNumber
+	}
+	desiredString, ok := desired.(string)
+	if !ok {
+		return desired == live
+	}
+	liveString, ok := live.(string)
+	if !ok {
+		return false
+	}
+	if desiredString == liveString {
+		return true
+	}
+	desiredQuantity, err := resource.ParseQuantity(desiredString)
+	if err != nil {
+		return false
+	}
+	liveQuantity, err := resource.ParseQuantity(liveString)
+	return err == nil && desiredQuantity.Cmp(liveQuantity) == 0
+}
+
+// toFloat converts a JSON number to float64
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// filterDriftedResources drops the drifted resources from the resources to apply
+func filterDriftedResources(desiredResources []*unstructured.Unstructured, drift map[string][]string) []*unstructured.Unstructured {
+	filtered := make([]*unstructured.Unstructured, 0, len(desiredResources))
+	for _, obj := range desiredResources {
+		if _, drifted := drift[obj.GetLabels()[labels.LabelKeyReleaseResourceID]]; !drifted {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// setDriftedCondition records the outcome of the drift check on the Release
+func setDriftedCondition(release *openchoreov1alpha1.Release, policy openchoreov1alpha1.DriftPolicy, drift map[string][]string) {
+	if len(drift) == 0 {
+		controller.MarkFalseCondition(release, ConditionDrifted, ReasonNoDrift,
+			"Live resources match the applied spec")
+		return
+	}
+
+	ids := make([]string, 0, len(drift))
+	for id := range drift {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	summary := fmt.Sprintf("%d resource(s) drifted from the applied spec: %s", len(ids), strings.Join(ids, ", "))
+
+	switch policy {
+	case openchoreov1alpha1.DriftPolicyReport:
+		controller.MarkTrueCondition(release, ConditionDrifted, ReasonDriftDetected,
+			summary+"; drifted resources are not reapplied until the release changes")
+	case openchoreov1alpha1.DriftPolicyPause:
+		controller.MarkTrueCondition(release, ConditionDrifted, ReasonReconciliationPaused,
+			summary+"; the release is not applied until the drift is resolved or the release changes")
+	default:
+		controller.MarkTrueCondition(release, ConditionDrifted, ReasonDriftReverted,
+			summary+"; the drift was reverted")
+	}
+}
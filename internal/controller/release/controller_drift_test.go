@@ -0,0 +1,156 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var _ = Describe("Drift detection", func() {
+	newObject := func(id string, obj map[string]any) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: obj}
+		objLabels := u.GetLabels()
+		if objLabels == nil {
+			objLabels = map[string]string{}
+		}
+		objLabels[labels.LabelKeyReleaseResourceID] = id
+		u.SetLabels(objLabels)
+		return u
+	}
+
+	deployment := func(replicas any, memory string) map[string]any {
+		return map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "api", "namespace": "dp-ns"},
+			"spec": map[string]any{
+				"replicas": replicas,
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{map[string]any{
+							"name":      "main",
+							"image":     "api:v1",
+							"resources": map[string]any{"limits": map[string]any{"memory": memory}},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	It("should not report drift when the live state matches", func() {
+		desired := newObject("deployment", deployment(int64(2), "1Gi"))
+		live := newObject("deployment", deployment(float64(2), "1024Mi"))
+		live.SetResourceVersion("42")
+		live.SetUID("uid")
+		live.Object["status"] = map[string]any{"readyReplicas": int64(2)}
+
+		Expect(detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})).To(BeEmpty())
+	})
+
+	It("should report the drifted fields by path", func() {
+		desired := newObject("deployment", deployment(int64(2), "1Gi"))
+		live := newObject("deployment", deployment(int64(5), "2Gi"))
+
+		drift := detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})
+		Expect(drift).To(HaveKeyWithValue("deployment", []string{
+			"spec.replicas",
+			"spec.template.spec.containers[main].resources.limits.memory",
+		}))
+	})
+
+	It("should match named list entries by name and ignore entries added by others", func() {
+		desired := newObject("deployment", deployment(int64(1), "1Gi"))
+		liveObj := deployment(int64(1), "1Gi")
+		containers := liveObj["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+		containers["containers"] = []any{
+			map[string]any{"name": "sidecar", "image": "proxy:v1"},
+			map[string]any{"name": "main", "image": "api:v1",
+				"resources": map[string]any{"limits": map[string]any{"memory": "1Gi"}}},
+		}
+		live := newObject("deployment", liveObj)
+
+		Expect(detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})).To(BeEmpty())
+
+		containers["containers"] = []any{map[string]any{"name": "sidecar", "image": "proxy:v1"}}
+		drift := detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})
+		Expect(drift).To(HaveKeyWithValue("deployment", []string{"spec.template.spec.containers[main]"}))
+	})
+
+	It("should compare labels and annotations but not other metadata", func() {
+		desired := newObject("config", map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "cfg", "annotations": map[string]any{"team": "a"}},
+			"data":       map[string]any{"key": "value"},
+		})
+		live := newObject("config", map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{"name": "cfg", "generation": int64(3),
+				"annotations": map[string]any{"team": "b", "kubectl.kubernetes.io/last-applied-configuration": "{}"}},
+			"data": map[string]any{"key": "value"},
+		})
+
+		drift := detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})
+		Expect(drift).To(HaveKeyWithValue("config", []string{"metadata.annotations.team"}))
+	})
+
+	It("should ignore the stringData of Secrets", func() {
+		desired := newObject("secret", map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "creds"},
+			"stringData": map[string]any{"password": "secret"},
+		})
+		live := newObject("secret", map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "creds"},
+			"data":       map[string]any{"password": "c2VjcmV0"},
+		})
+
+		Expect(detectDrift([]*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})).To(BeEmpty())
+	})
+
+	It("should not report resources that do not exist yet", func() {
+		desired := newObject("deployment", deployment(int64(2), "1Gi"))
+		Expect(detectDrift([]*unstructured.Unstructured{desired}, nil)).To(BeEmpty())
+	})
+
+	It("should leave drifted resources out of the apply", func() {
+		drifted := newObject("deployment", deployment(int64(2), "1Gi"))
+		clean := newObject("config", map[string]any{"apiVersion": "v1", "kind": "ConfigMap"})
+		filtered := filterDriftedResources([]*unstructured.Unstructured{drifted, clean},
+			map[string][]string{"deployment": {"spec.replicas"}})
+		Expect(filtered).To(ConsistOf(clean))
+	})
+
+	It("should bring the listed live resources up to date with the applied ones", func() {
+		listed := newObject("deployment", deployment(int64(5), "1Gi"))
+		stale := newObject("old-config", map[string]any{"apiVersion": "v1", "kind": "ConfigMap"})
+		applied := newObject("deployment", deployment(int64(2), "1Gi"))
+		created := newObject("config", map[string]any{"apiVersion": "v1", "kind": "ConfigMap"})
+
+		merged := mergeAppliedResources([]*unstructured.Unstructured{listed, stale},
+			[]*unstructured.Unstructured{applied, created})
+		Expect(merged).To(Equal([]*unstructured.Unstructured{applied, stale, created}))
+	})
+
+	It("should set the Drifted condition according to the policy", func() {
+		release := &openchoreov1alpha1.Release{}
+		setDriftedCondition(release, openchoreov1alpha1.DriftPolicyPause, map[string][]string{"deployment": {"spec.replicas"}})
+		Expect(release.Status.Conditions).To(HaveLen(1))
+		Expect(release.Status.Conditions[0].Type).To(Equal(string(ConditionDrifted)))
+		Expect(release.Status.Conditions[0].Reason).To(Equal(string(ReasonReconciliationPaused)))
+
+		setDriftedCondition(release, openchoreov1alpha1.DriftPolicyPause, nil)
+		Expect(release.Status.Conditions[0].Reason).To(Equal(string(ReasonNoDrift)))
+	})
+})
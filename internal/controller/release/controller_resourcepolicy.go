@@ -0,0 +1,132 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/resourcepolicy"
+)
+
+// ensureResourcePolicy renders the resource policy of the Release's project into the data plane namespaces
+// as a ResourceQuota and a LimitRange. The namespaces they were applied to are recorded in the status, and
+// the objects are removed from them once the policy no longer sets them. Projects without a policy make no
+// calls to the data plane. Every Release of the project in the environment renders the same objects, so they
+// converge regardless of which Release reconciles first.
+func (r *Reconciler) ensureResourcePolicy(ctx context.Context, planeClient client.Client,
+	release *openchoreov1alpha1.Release, namespaces []*corev1.Namespace) error {
+	if release.Spec.Owner.ProjectName == "" {
+		return nil
+	}
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, client.ObjectKey{Name: release.Spec.Owner.ProjectName, Namespace: release.Namespace}, project); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get project %s: %w", release.Spec.Owner.ProjectName, err)
+		}
+		// The project is being deleted along with its releases, leave the namespaces as they are
+		return nil
+	}
+	hard, limits := resourcepolicy.Effective(project.Spec.ResourcePolicy, release.Spec.EnvironmentName)
+
+	previous := release.Status.ResourcePolicy
+	if len(hard) == 0 && limits == nil && previous == nil {
+		return nil
+	}
+
+	applied := &openchoreov1alpha1.AppliedResourcePolicy{}
+	for _, namespace := range namespaces {
+		if len(hard) > 0 {
+			desired := resourcepolicy.MakeResourceQuota(namespace.Name, hard)
+			quota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+			if _, err := controllerutil.CreateOrUpdate(ctx, planeClient, quota, func() error {
+				quota.Labels = desired.Labels
+				quota.Spec = desired.Spec
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to apply ResourceQuota in namespace %s: %w", namespace.Name, err)
+			}
+			applied.ResourceQuotaNamespaces = append(applied.ResourceQuotaNamespaces, namespace.Name)
+		}
+
+		if limits != nil {
+			desired := resourcepolicy.MakeLimitRange(namespace.Name, limits)
+			limitRange := &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+			if _, err := controllerutil.CreateOrUpdate(ctx, planeClient, limitRange, func() error {
+				limitRange.Labels = desired.Labels
+				limitRange.Spec = desired.Spec
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to apply LimitRange in namespace %s: %w", namespace.Name, err)
+			}
+			applied.LimitRangeNamespaces = append(applied.LimitRangeNamespaces, namespace.Name)
+		}
+	}
+
+	// Remove the objects this Release applied that the policy no longer sets
+	if previous != nil {
+		for _, namespace := range previous.ResourceQuotaNamespaces {
+			if slices.Contains(applied.ResourceQuotaNamespaces, namespace) {
+				continue
+			}
+			if err := deleteIfExists(ctx, planeClient, &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: resourcepolicy.ResourceQuotaName, Namespace: namespace},
+			}); err != nil {
+				return err
+			}
+		}
+		for _, namespace := range previous.LimitRangeNamespaces {
+			if slices.Contains(applied.LimitRangeNamespaces, namespace) {
+				continue
+			}
+			if err := deleteIfExists(ctx, planeClient, &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{Name: resourcepolicy.LimitRangeName, Namespace: namespace},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(applied.ResourceQuotaNamespaces) == 0 && len(applied.LimitRangeNamespaces) == 0 {
+		applied = nil
+	}
+	release.Status.ResourcePolicy = applied
+	return nil
+}
+
+func deleteIfExists(ctx context.Context, planeClient client.Client, obj client.Object) error {
+	if err := planeClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s in namespace %s: %w", obj.GetName(), obj.GetNamespace(), err)
+	}
+	return nil
+}
+
+// findReleasesForProject maps a Project to its data plane Releases so that policy changes reach the namespaces
+func (r *Reconciler) findReleasesForProject(ctx context.Context, obj client.Object) []ctrl.Request {
+	project := obj.(*openchoreov1alpha1.Project)
+	releases := &openchoreov1alpha1.ReleaseList{}
+	if err := r.List(ctx, releases, client.InNamespace(project.Namespace),
+		client.MatchingLabels{labels.LabelKeyProjectName: project.Name}); err != nil {
+		return nil
+	}
+	requests := make([]ctrl.Request, 0, len(releases.Items))
+	for _, release := range releases.Items {
+		if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != targetPlaneDataPlane {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&release)})
+	}
+	return requests
+}
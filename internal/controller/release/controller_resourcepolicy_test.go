@@ -0,0 +1,106 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/resourcepolicy"
+)
+
+var _ = Describe("Resource policy", func() {
+	var (
+		ctx         context.Context
+		project     *openchoreov1alpha1.Project
+		release     *openchoreov1alpha1.Release
+		namespaces  []*corev1.Namespace
+		planeCalls  int
+		planeClient client.Client
+	)
+
+	newReconciler := func() *Reconciler {
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+		return &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).Build()}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		project = &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "acme"},
+			Spec: openchoreov1alpha1.ProjectSpec{
+				ResourcePolicy: &openchoreov1alpha1.ProjectResourcePolicy{
+					Quota: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+					ContainerLimits: &openchoreov1alpha1.ContainerLimits{
+						Default: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+					},
+				},
+			},
+		}
+		release = &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: "acme"},
+			Spec: openchoreov1alpha1.ReleaseSpec{
+				Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: "shop", ComponentName: "api"},
+				EnvironmentName: "production",
+			},
+		}
+		namespaces = []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "dp-acme-shop-production"}}}
+
+		planeCalls = 0
+		planeClient = fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				planeCalls++
+				return c.Get(ctx, key, obj, opts...)
+			},
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				planeCalls++
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).Build()
+	})
+
+	It("should apply the policy and remove it once the policy is cleared", func() {
+		Expect(newReconciler().ensureResourcePolicy(ctx, planeClient, release, namespaces)).To(Succeed())
+		Expect(release.Status.ResourcePolicy).NotTo(BeNil())
+		Expect(release.Status.ResourcePolicy.ResourceQuotaNamespaces).To(ConsistOf("dp-acme-shop-production"))
+		Expect(release.Status.ResourcePolicy.LimitRangeNamespaces).To(ConsistOf("dp-acme-shop-production"))
+
+		quotaKey := client.ObjectKey{Name: resourcepolicy.ResourceQuotaName, Namespace: "dp-acme-shop-production"}
+		limitRangeKey := client.ObjectKey{Name: resourcepolicy.LimitRangeName, Namespace: "dp-acme-shop-production"}
+		Expect(planeClient.Get(ctx, quotaKey, &corev1.ResourceQuota{})).To(Succeed())
+		Expect(planeClient.Get(ctx, limitRangeKey, &corev1.LimitRange{})).To(Succeed())
+
+		By("clearing the container limits")
+		project.Spec.ResourcePolicy.ContainerLimits = nil
+		Expect(newReconciler().ensureResourcePolicy(ctx, planeClient, release, namespaces)).To(Succeed())
+		Expect(release.Status.ResourcePolicy.LimitRangeNamespaces).To(BeEmpty())
+		Expect(planeClient.Get(ctx, quotaKey, &corev1.ResourceQuota{})).To(Succeed())
+		Expect(apierrors.IsNotFound(planeClient.Get(ctx, limitRangeKey, &corev1.LimitRange{}))).To(BeTrue())
+
+		By("clearing the policy")
+		project.Spec.ResourcePolicy = nil
+		Expect(newReconciler().ensureResourcePolicy(ctx, planeClient, release, namespaces)).To(Succeed())
+		Expect(release.Status.ResourcePolicy).To(BeNil())
+		Expect(apierrors.IsNotFound(planeClient.Get(ctx, quotaKey, &corev1.ResourceQuota{}))).To(BeTrue())
+	})
+
+	It("should not call the data plane for a project without a policy", func() {
+		project.Spec.ResourcePolicy = nil
+		Expect(newReconciler().ensureResourcePolicy(ctx, planeClient, release, namespaces)).To(Succeed())
+		Expect(release.Status.ResourcePolicy).To(BeNil())
+		Expect(planeCalls).To(BeZero())
+	})
+})
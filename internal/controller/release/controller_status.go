@@ -23,16 +23,19 @@ import (
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
-// updateStatus updates the Release status with applied resources
+// updateStatus updates the Release status with applied resources and the detected drift
 // Returns true if the status was updated, false if unchanged
-func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.Release, appliedResources, liveResources []*unstructured.Unstructured) (bool, error) {
+func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.Release, appliedResources, liveResources []*unstructured.Unstructured,
+	drift map[string][]string, policy openchoreov1alpha1.DriftPolicy) (bool, error) {
 	logger := log.FromContext(ctx)
 
 	// Build resource status from applied and live resources
 	resourceStatuses := r.buildResourceStatus(ctx, old, appliedResources, liveResources)
+	setResourceDrift(old, resourceStatuses, drift, policy == openchoreov1alpha1.DriftPolicyAutoHeal)
 
 	// Update the status
 	release.Status.Resources = resourceStatuses
+	setDriftedCondition(release, policy, drift)
 
 	// Check if the entire status actually changed and skip update if not
 	if apiequality.Semantic.DeepEqual(old.Status, release.Status) {
@@ -147,6 +150,34 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 	return resourceStatuses
 }
 
+// setResourceDrift records the drifted fields on the resource statuses, keeping the time
+// the drift was first detected while it persists
+func setResourceDrift(old *openchoreov1alpha1.Release, resourceStatuses []openchoreov1alpha1.ResourceStatus,
+	drift map[string][]string, reverted bool) {
+	oldDrift := make(map[string]*openchoreov1alpha1.ResourceDrift)
+	for _, oldResource := range old.Status.Resources {
+		if oldResource.Drift != nil {
+			oldDrift[oldResource.ID] = oldResource.Drift
+		}
+	}
+
+	for i := range resourceStatuses {
+		fields, drifted := drift[resourceStatuses[i].ID]
+		if !drifted {
+			continue
+		}
+		detectedAt := metav1.Now()
+		if previous, found := oldDrift[resourceStatuses[i].ID]; found {
+			detectedAt = previous.DetectedAt
+		}
+		resourceStatuses[i].Drift = &openchoreov1alpha1.ResourceDrift{
+			Fields:     fields,
+			DetectedAt: detectedAt,
+			Reverted:   reverted,
+		}
+	}
+}
+
 // hasTransitioningResources checks if any resources are in a transitioning state
 func (r *Reconciler) hasTransitioningResources(resources []openchoreov1alpha1.ResourceStatus) bool {
 	for _, resource := range resources {
@@ -0,0 +1,422 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+// Params understood by connections of type "api"
+const (
+	connectionParamProjectName   = "projectName"
+	connectionParamComponentName = "componentName"
+	connectionParamEndpoint      = "endpoint"
+	connectionParamVisibility    = "visibility"
+
+	// connectionVisibilityProject resolves to the in-cluster Service address (default)
+	connectionVisibilityProject = "project"
+	// connectionVisibilityPublic resolves to the data plane gateway address
+	connectionVisibilityPublic = "public"
+)
+
+// connectionTarget identifies the component a connection points at
+type connectionTarget struct {
+	projectName   string
+	componentName string
+}
+
+func (t connectionTarget) String() string {
+	return t.projectName + "/" + t.componentName
+}
+
+// connectionTargetFor returns the component a connection points at. The project defaults
+// to the project of the consuming component.
+func connectionTargetFor(conn openchoreov1alpha1.WorkloadConnection, consumerProject string) connectionTarget {
+	target := connectionTarget{
+		projectName:   conn.Params[connectionParamProjectName],
+		componentName: conn.Params[connectionParamComponentName],
+	}
+	if target.projectName == "" {
+		target.projectName = consumerProject
+	}
+	return target
+}
+
+// connectionResolution is the outcome of resolving all connections of a workload
+type connectionResolution struct {
+	// env holds the rendered inject variables, in a stable order
+	env []openchoreov1alpha1.EnvVar
+	// unresolved holds one message per connection that could not be resolved
+	unresolved []string
+}
+
+// resolveConnections resolves each connection of the workload to the endpoint exposed by the
+// target component in the same environment and renders its inject templates. Connections
+// that cannot be resolved are reported rather than failing the whole render, so one missing
+// dependency does not block a deployment. Only API server errors are returned.
+func (r *Reconciler) resolveConnections(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	workload *openchoreov1alpha1.Workload, dataPlane *openchoreov1alpha1.DataPlane) (*connectionResolution, error) {
+	resolution := &connectionResolution{}
+	if len(workload.Spec.Connections) == 0 {
+		return resolution, nil
+	}
+
+	// Sort names so the rendered environment, and therefore the Release, is deterministic
+	names := make([]string, 0, len(workload.Spec.Connections))
+	for name := range workload.Spec.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		conn := workload.Spec.Connections[name]
+		properties, reason, err := r.resolveConnection(ctx, releaseBinding, conn, dataPlane)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve connection %q: %w", name, err)
+		}
+		if reason != "" {
+			resolution.unresolved = append(resolution.unresolved, fmt.Sprintf("%s: %s", name, reason))
+			continue
+		}
+
+		env, err := renderConnectionEnv(conn, properties)
+		if err != nil {
+			resolution.unresolved = append(resolution.unresolved, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		resolution.env = append(resolution.env, env...)
+	}
+
+	return resolution, nil
+}
+
+// resolveConnection returns the template properties for a connection, or a reason why it
+// could not be resolved.
+func (r *Reconciler) resolveConnection(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	conn openchoreov1alpha1.WorkloadConnection, dataPlane *openchoreov1alpha1.DataPlane) (map[string]string, string, error) {
+	if conn.Type != openchoreov1alpha1.ConnectionTypeAPI {
+		return nil, fmt.Sprintf("unsupported connection type %q", conn.Type), nil
+	}
+
+	target := connectionTargetFor(conn, releaseBinding.Spec.Owner.ProjectName)
+	if target.componentName == "" {
+		return nil, fmt.Sprintf("missing required param %q", connectionParamComponentName), nil
+	}
+
+	visibility := conn.Params[connectionParamVisibility]
+	if visibility == "" {
+		visibility = connectionVisibilityProject
+	}
+	if visibility != connectionVisibilityProject && visibility != connectionVisibilityPublic {
+		return nil, fmt.Sprintf("unsupported visibility %q", visibility), nil
+	}
+
+	targetBinding, err := r.findTargetReleaseBinding(ctx, releaseBinding.Namespace, target, releaseBinding.Spec.Environment)
+	if err != nil {
+		return nil, "", err
+	}
+	if targetBinding == nil {
+		return nil, fmt.Sprintf("component %s is not deployed to environment %q", target, releaseBinding.Spec.Environment), nil
+	}
+
+	targetRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      targetBinding.Spec.ReleaseName,
+		Namespace: targetBinding.Namespace,
+	}, targetRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("ComponentRelease %q of component %s not found", targetBinding.Spec.ReleaseName, target), nil
+		}
+		return nil, "", err
+	}
+
+	endpointName, endpoint, reason := selectConnectionEndpoint(targetRelease.Spec.Workload.Endpoints, conn.Params[connectionParamEndpoint])
+	if reason != "" {
+		return nil, fmt.Sprintf("component %s %s", target, reason), nil
+	}
+	if reason := checkEndpointVisibility(endpointName, endpoint, target, releaseBinding.Spec.Owner.ProjectName, visibility); reason != "" {
+		return nil, reason, nil
+	}
+
+	// Resource names follow the same conventions as buildMetadataContext for the target component
+	serviceName := dpkubernetes.GenerateK8sName(target.componentName, releaseBinding.Spec.Environment)
+
+	if visibility == connectionVisibilityPublic {
+		if dataPlane.Spec.Gateway.PublicVirtualHost == "" {
+			return nil, fmt.Sprintf("data plane %q has no public virtual host", dataPlane.Name), nil
+		}
+		// Public endpoints are routed by the gateway under https://{environment}.{publicVirtualHost}/{name}
+		host := fmt.Sprintf("%s.%s", releaseBinding.Spec.Environment, dataPlane.Spec.Gateway.PublicVirtualHost)
+		return map[string]string{
+			"host":     host,
+			"port":     "443",
+			"endpoint": endpointName,
+			"url":      fmt.Sprintf("https://%s/%s", host, serviceName),
+		}, "", nil
+	}
+
+	namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(
+		dpkubernetes.MaxNamespaceNameLength,
+		"dp", releaseBinding.Namespace, target.projectName, releaseBinding.Spec.Environment,
+	)
+	host := fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace)
+	servicePort, err := r.resolveServicePort(ctx, targetBinding, targetRelease, serviceName, endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+	port := strconv.Itoa(int(servicePort))
+
+	properties := map[string]string{
+		"host":     host,
+		"port":     port,
+		"endpoint": endpointName,
+		"url":      host + ":" + port,
+	}
+	if scheme := endpointURLScheme(endpoint.Type); scheme != "" {
+		properties["url"] = fmt.Sprintf("%s://%s:%s", scheme, host, port)
+	}
+	return properties, "", nil
+}
+
+// resolveServicePort returns the port the target component's Service exposes for the endpoint.
+// The endpoint port is the container port, which the Service usually maps to a different port
+// (e.g. 80 -> 8080). The rendered Service of the target's data plane Release is preferred; before
+// the target has rendered, the Service template of its ComponentType is used. Endpoints that no
+// Service port maps to resolve to the container port.
+func (r *Reconciler) resolveServicePort(ctx context.Context, targetBinding *openchoreov1alpha1.ReleaseBinding,
+	targetRelease *openchoreov1alpha1.ComponentRelease, serviceName string, endpoint openchoreov1alpha1.WorkloadEndpoint) (int32, error) {
+	// Release name format: {component}-{environment}
+	release := &openchoreov1alpha1.Release{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      fmt.Sprintf("%s-%s", targetBinding.Spec.Owner.ComponentName, targetBinding.Spec.Environment),
+		Namespace: targetBinding.Namespace,
+	}, release)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get Release of component %s: %w", targetBinding.Spec.Owner.ComponentName, err)
+	}
+	if err == nil {
+		for _, resource := range release.Spec.Resources {
+			if port, ok := servicePortFromRaw(resource.Object, serviceName, endpoint.Port); ok {
+				return port, nil
+			}
+		}
+	}
+
+	for _, tmpl := range targetRelease.Spec.ComponentType.Resources {
+		// Template names are expressions, so any Service template is a candidate
+		if port, ok := servicePortFromRaw(tmpl.Template, "", endpoint.Port); ok {
+			return port, nil
+		}
+	}
+	return endpoint.Port, nil
+}
+
+// servicePortFromRaw returns the Service port that maps to containerPort when raw is a Service
+// named name (any name when empty). A Service with a single port maps to the endpoint even when
+// its targetPort is an expression or a named port.
+func servicePortFromRaw(raw *runtime.RawExtension, name string, containerPort int32) (int32, bool) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return 0, false
+	}
+	var service struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Ports []map[string]any `json:"ports"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(raw.Raw, &service); err != nil || service.Kind != "Service" {
+		return 0, false
+	}
+	if name != "" && service.Metadata.Name != name {
+		return 0, false
+	}
+
+	for _, servicePort := range service.Spec.Ports {
+		port, ok := portNumber(servicePort["port"])
+		if !ok {
+			continue
+		}
+		targetPort, hasTarget := servicePort["targetPort"]
+		if !hasTarget {
+			// The target port defaults to the service port
+			targetPort = port
+		}
+		if target, ok := portNumber(targetPort); ok && target == containerPort {
+			return port, true
+		}
+	}
+
+	if len(service.Spec.Ports) == 1 {
+		if _, numeric := portNumber(service.Spec.Ports[0]["targetPort"]); !numeric {
+			return portNumber(service.Spec.Ports[0]["port"])
+		}
+	}
+	return 0, false
+}
+
+// portNumber converts a decoded JSON port value to a port number. Strings (named ports and
+// template expressions) are not port numbers.
+func portNumber(value any) (int32, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int32(v), v == float64(int32(v)) && v > 0
+	case int32:
+		return v, v > 0
+	default:
+		return 0, false
+	}
+}
+
+// findTargetReleaseBinding returns the ReleaseBinding of the target component in the given
+// environment, or nil if the component is not deployed there.
+func (r *Reconciler) findTargetReleaseBinding(ctx context.Context, namespace string, target connectionTarget,
+	environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	opts := []client.ListOption{client.InNamespace(namespace)}
+	if !r.withoutFieldIndexes {
+		opts = append(opts, client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: target.componentName})
+	}
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ReleaseBindings for component %s: %w", target, err)
+	}
+
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.Spec.Owner.ComponentName == target.componentName &&
+			binding.Spec.Owner.ProjectName == target.projectName &&
+			binding.Spec.Environment == environment &&
+			binding.Spec.ReleaseName != "" &&
+			binding.DeletionTimestamp.IsZero() {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
+
+// selectConnectionEndpoint picks the named endpoint, or the only endpoint when no name is given.
+// The returned reason is non-empty when no endpoint can be selected.
+func selectConnectionEndpoint(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint,
+	name string) (string, openchoreov1alpha1.WorkloadEndpoint, string) {
+	if name != "" {
+		endpoint, ok := endpoints[name]
+		if !ok {
+			return "", openchoreov1alpha1.WorkloadEndpoint{}, fmt.Sprintf("has no endpoint %q", name)
+		}
+		return name, endpoint, ""
+	}
+
+	switch len(endpoints) {
+	case 0:
+		return "", openchoreov1alpha1.WorkloadEndpoint{}, "exposes no endpoints"
+	case 1:
+		for endpointName, endpoint := range endpoints {
+			return endpointName, endpoint, ""
+		}
+	}
+	return "", openchoreov1alpha1.WorkloadEndpoint{}, fmt.Sprintf("exposes %d endpoints; set the %q param", len(endpoints), connectionParamEndpoint)
+}
+
+// checkEndpointVisibility returns a reason why the consumer cannot connect to the endpoint, or an empty
+// string when the endpoint's visibility allows the connection
+func checkEndpointVisibility(endpointName string, endpoint openchoreov1alpha1.WorkloadEndpoint,
+	target connectionTarget, consumerProject, visibility string) string {
+	if target.projectName != consumerProject && !endpoint.Visibility.AllowsOtherProjects() {
+		return fmt.Sprintf("endpoint %q of component %s is only visible inside project %q",
+			endpointName, target, target.projectName)
+	}
+	if visibility == connectionVisibilityPublic && endpoint.Visibility != openchoreov1alpha1.EndpointVisibilityPublic {
+		return fmt.Sprintf("endpoint %q of component %s is not public", endpointName, target)
+	}
+	return ""
+}
+
+// endpointURLScheme returns the URL scheme for an endpoint type. Types without a URL form
+// (gRPC, TCP, UDP) resolve to a plain host:port.
+func endpointURLScheme(endpointType openchoreov1alpha1.EndpointType) string {
+	switch endpointType {
+	case openchoreov1alpha1.EndpointTypeHTTP, openchoreov1alpha1.EndpointTypeREST, openchoreov1alpha1.EndpointTypeGraphQL:
+		return "http"
+	case openchoreov1alpha1.EndpointTypeWebsocket:
+		return "ws"
+	default:
+		return ""
+	}
+}
+
+// renderConnectionEnv renders the inject templates of a connection (e.g. "{{ .url }}")
+func renderConnectionEnv(conn openchoreov1alpha1.WorkloadConnection, properties map[string]string) ([]openchoreov1alpha1.EnvVar, error) {
+	env := make([]openchoreov1alpha1.EnvVar, 0, len(conn.Inject.Env))
+	for _, envVar := range conn.Inject.Env {
+		tmpl, err := template.New(envVar.Name).Option("missingkey=error").Parse(envVar.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for env %q: %w", envVar.Name, err)
+		}
+
+		var value strings.Builder
+		if err := tmpl.Execute(&value, properties); err != nil {
+			return nil, fmt.Errorf("failed to render env %q: %w", envVar.Name, err)
+		}
+		env = append(env, openchoreov1alpha1.EnvVar{Key: envVar.Name, Value: value.String()})
+	}
+	return env, nil
+}
+
+// injectConnectionEnv adds the resolved connection variables to every container of the workload.
+// Variables the workload already defines explicitly take precedence.
+func injectConnectionEnv(workload *openchoreov1alpha1.Workload, env []openchoreov1alpha1.EnvVar) {
+	if len(env) == 0 {
+		return
+	}
+
+	for containerName, container := range workload.Spec.Containers {
+		defined := make(map[string]bool, len(container.Env))
+		for _, existing := range container.Env {
+			defined[existing.Key] = true
+		}
+		for _, envVar := range env {
+			if !defined[envVar.Key] {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+		workload.Spec.Containers[containerName] = container
+	}
+}
+
+// setConnectionsResolvedCondition reports unresolved connections on the ReleaseBinding.
+// The condition is only present for workloads that declare connections.
+func setConnectionsResolvedCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding, workload *openchoreov1alpha1.Workload,
+	resolution *connectionResolution) {
+	if len(workload.Spec.Connections) == 0 {
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, string(ConditionConnectionsResolved))
+		return
+	}
+
+	if len(resolution.unresolved) > 0 {
+		controller.MarkFalseCondition(releaseBinding, ConditionConnectionsResolved, ReasonUnresolvedConnections,
+			fmt.Sprintf("Unresolved connections: %s", strings.Join(resolution.unresolved, "; ")))
+		return
+	}
+
+	controller.MarkTrueCondition(releaseBinding, ConditionConnectionsResolved, ReasonConnectionsResolved,
+		fmt.Sprintf("All %d connections resolved", len(workload.Spec.Connections)))
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("Workload connections", func() {
+	Context("selecting the target endpoint", func() {
+		endpoints := map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"api":     {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080},
+			"metrics": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 9090},
+		}
+
+		It("should select the named endpoint", func() {
+			name, endpoint, reason := selectConnectionEndpoint(endpoints, "metrics")
+			Expect(reason).To(BeEmpty())
+			Expect(name).To(Equal("metrics"))
+			Expect(endpoint.Port).To(Equal(int32(9090)))
+		})
+
+		It("should default to the only endpoint", func() {
+			name, _, reason := selectConnectionEndpoint(map[string]openchoreov1alpha1.WorkloadEndpoint{
+				"api": endpoints["api"],
+			}, "")
+			Expect(reason).To(BeEmpty())
+			Expect(name).To(Equal("api"))
+		})
+
+		It("should require a name when several endpoints are exposed", func() {
+			_, _, reason := selectConnectionEndpoint(endpoints, "")
+			Expect(reason).To(ContainSubstring(connectionParamEndpoint))
+		})
+
+		It("should report an unknown endpoint", func() {
+			_, _, reason := selectConnectionEndpoint(endpoints, "grpc")
+			Expect(reason).To(ContainSubstring(`no endpoint "grpc"`))
+		})
+	})
+
+	Context("checking endpoint visibility", func() {
+		target := connectionTarget{projectName: "payments", componentName: "ledger"}
+
+		It("should keep project endpoints inside their project", func() {
+			endpoint := openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080}
+			Expect(checkEndpointVisibility("api", endpoint, target, "payments", connectionVisibilityProject)).To(BeEmpty())
+			Expect(checkEndpointVisibility("api", endpoint, target, "shop", connectionVisibilityProject)).
+				To(ContainSubstring(`only visible inside project "payments"`))
+		})
+
+		It("should let other projects connect to organization endpoints", func() {
+			endpoint := openchoreov1alpha1.WorkloadEndpoint{Port: 8080, Visibility: openchoreov1alpha1.EndpointVisibilityOrganization}
+			Expect(checkEndpointVisibility("api", endpoint, target, "shop", connectionVisibilityProject)).To(BeEmpty())
+			Expect(checkEndpointVisibility("api", endpoint, target, "shop", connectionVisibilityPublic)).
+				To(ContainSubstring("is not public"))
+		})
+
+		It("should allow public connections to public endpoints", func() {
+			endpoint := openchoreov1alpha1.WorkloadEndpoint{Port: 8080, Visibility: openchoreov1alpha1.EndpointVisibilityPublic}
+			Expect(checkEndpointVisibility("api", endpoint, target, "shop", connectionVisibilityPublic)).To(BeEmpty())
+		})
+	})
+
+	Context("resolving the service port", func() {
+		raw := func(doc string) *runtime.RawExtension {
+			return &runtime.RawExtension{Raw: []byte(doc)}
+		}
+
+		It("should map the container port to the service port", func() {
+			port, ok := servicePortFromRaw(raw(`{"kind":"Service","metadata":{"name":"orders-dev"},
+				"spec":{"ports":[{"name":"http","port":80,"targetPort":8080},{"name":"metrics","port":9091,"targetPort":9090}]}}`),
+				"orders-dev", 9090)
+			Expect(ok).To(BeTrue())
+			Expect(port).To(Equal(int32(9091)))
+		})
+
+		It("should default the target port to the service port", func() {
+			port, ok := servicePortFromRaw(raw(`{"kind":"Service","metadata":{"name":"orders-dev"},"spec":{"ports":[{"port":8080}]}}`),
+				"orders-dev", 8080)
+			Expect(ok).To(BeTrue())
+			Expect(port).To(Equal(int32(8080)))
+		})
+
+		It("should use the only port of a templated Service", func() {
+			port, ok := servicePortFromRaw(raw(`{"kind":"Service","metadata":{"name":"${metadata.name}"},
+				"spec":{"ports":[{"name":"http","port":80,"targetPort":"${parameters.port}"}]}}`), "", 8080)
+			Expect(ok).To(BeTrue())
+			Expect(port).To(Equal(int32(80)))
+		})
+
+		It("should ignore other resources and Services", func() {
+			_, ok := servicePortFromRaw(raw(`{"kind":"Deployment","metadata":{"name":"orders-dev"}}`), "orders-dev", 8080)
+			Expect(ok).To(BeFalse())
+			_, ok = servicePortFromRaw(raw(`{"kind":"Service","metadata":{"name":"orders-dev-canary"},"spec":{"ports":[{"port":80}]}}`),
+				"orders-dev", 80)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("rendering inject templates", func() {
+		properties := map[string]string{"host": "svc.ns.svc.cluster.local", "port": "8080", "url": "http://svc.ns.svc.cluster.local:8080"}
+
+		It("should render connection properties", func() {
+			env, err := renderConnectionEnv(openchoreov1alpha1.WorkloadConnection{
+				Inject: openchoreov1alpha1.WorkloadConnectionInject{Env: []openchoreov1alpha1.WorkloadConnectionEnvVar{
+					{Name: "ORDERS_URL", Value: "{{ .url }}/orders"},
+					{Name: "ORDERS_ADDR", Value: "{{ .host }}:{{ .port }}"},
+				}},
+			}, properties)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(env).To(Equal([]openchoreov1alpha1.EnvVar{
+				{Key: "ORDERS_URL", Value: "http://svc.ns.svc.cluster.local:8080/orders"},
+				{Key: "ORDERS_ADDR", Value: "svc.ns.svc.cluster.local:8080"},
+			}))
+		})
+
+		It("should reject unknown properties", func() {
+			_, err := renderConnectionEnv(openchoreov1alpha1.WorkloadConnection{
+				Inject: openchoreov1alpha1.WorkloadConnectionInject{Env: []openchoreov1alpha1.WorkloadConnectionEnvVar{
+					{Name: "ORDERS_TOKEN", Value: "{{ .token }}"},
+				}},
+			}, properties)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("injecting resolved variables", func() {
+		It("should keep variables the container defines explicitly", func() {
+			workload := &openchoreov1alpha1.Workload{}
+			workload.Spec.Containers = map[string]openchoreov1alpha1.Container{
+				"main": {Env: []openchoreov1alpha1.EnvVar{{Key: "ORDERS_URL", Value: "http://override"}}},
+			}
+
+			injectConnectionEnv(workload, []openchoreov1alpha1.EnvVar{
+				{Key: "ORDERS_URL", Value: "http://resolved"},
+				{Key: "ORDERS_ADDR", Value: "resolved:8080"},
+			})
+
+			Expect(workload.Spec.Containers["main"].Env).To(Equal([]openchoreov1alpha1.EnvVar{
+				{Key: "ORDERS_URL", Value: "http://override"},
+				{Key: "ORDERS_ADDR", Value: "resolved:8080"},
+			}))
+		})
+	})
+})
@@ -6,8 +6,12 @@ package releasebinding
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -16,10 +20,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
@@ -34,6 +40,13 @@ type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 
+	// httpClient queries the observer for the metrics of rollout analysis gates
+	httpClient *http.Client
+
+	// withoutFieldIndexes makes lookups filter in memory, for clients that are not backed by
+	// the manager's cache and so have no field indexes
+	withoutFieldIndexes bool
+
 	// Pipeline is the component rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different component types and reconciliations.
 	Pipeline *componentpipeline.Pipeline
@@ -49,6 +62,8 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=traits,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderpolicies;clusterrenderpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -119,6 +134,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	recordReleaseHistory(releaseBinding, metav1.Now())
+
 	// Fetch Environment object
 	environment := &openchoreov1alpha1.Environment{}
 	if err := r.Get(ctx, types.NamespacedName{
@@ -236,57 +253,17 @@ func (r *Reconciler) buildMetadataContext(
 	environment *openchoreov1alpha1.Environment,
 	environmentName string,
 ) pipelinecontext.MetadataContext {
-	// Extract information
-	organizationName := componentRelease.Namespace
-	projectName := componentRelease.Spec.Owner.ProjectName
-	componentName := componentRelease.Spec.Owner.ComponentName
-	componentUID := string(component.UID)
-	projectUID := string(project.UID)
-	dataPlaneName := dataPlane.Name
-	dataPlaneUID := string(dataPlane.UID)
-	environmentUID := string(environment.UID)
-
-	// Generate base name using platform naming conventions
-	// Format: {component}-{env}-{hash}
-	baseName := dpkubernetes.GenerateK8sName(componentName, environmentName)
-
-	// Generate namespace using platform naming conventions
-	// Format: dp-{org}-{project}-{env}-{hash}
-	namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(
-		dpkubernetes.MaxNamespaceNameLength,
-		"dp", organizationName, projectName, environmentName,
-	)
-
-	// Build standard labels
-	standardLabels := map[string]string{
-		labels.LabelKeyOrganizationName: organizationName,
-		labels.LabelKeyProjectName:      projectName,
-		labels.LabelKeyComponentName:    componentName,
-		labels.LabelKeyEnvironmentName:  environmentName,
-	}
-
-	// Build pod selectors
-	podSelectors := map[string]string{
-		labels.LabelKeyComponentUID:   componentUID,
-		labels.LabelKeyEnvironmentUID: environmentUID,
-		labels.LabelKeyProjectUID:     projectUID,
-	}
-
-	return pipelinecontext.MetadataContext{
-		Name:            baseName,
-		Namespace:       namespace,
-		Labels:          standardLabels,
-		Annotations:     map[string]string{},
-		PodSelectors:    podSelectors,
-		ComponentName:   componentName,
-		ComponentUID:    componentUID,
-		ProjectName:     projectName,
-		ProjectUID:      projectUID,
-		DataPlaneName:   dataPlaneName,
-		DataPlaneUID:    dataPlaneUID,
+	return pipelinecontext.BuildMetadataContext(&pipelinecontext.MetadataContextInput{
+		Namespace:       componentRelease.Namespace,
+		ComponentName:   componentRelease.Spec.Owner.ComponentName,
+		ComponentUID:    string(component.UID),
+		ProjectName:     componentRelease.Spec.Owner.ProjectName,
+		ProjectUID:      string(project.UID),
 		EnvironmentName: environmentName,
-		EnvironmentUID:  environmentUID,
-	}
+		EnvironmentUID:  string(environment.UID),
+		DataPlaneName:   dataPlane.Name,
+		DataPlaneUID:    string(dataPlane.UID),
+	})
 }
 
 // collectSecretReferences collects all SecretReferences needed for rendering from workload and releaseBinding.
@@ -361,77 +338,18 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Build MetadataContext with computed names
-	metadataContext := r.buildMetadataContext(componentRelease, component, project, dataPlane, environment, releaseBinding.Spec.Environment)
-
-	// Prepare a render-time copy of the ReleaseBinding with defaults injected (e.g., alert notification channel).
-	renderBinding := releaseBinding.DeepCopy()
-	if err := r.applyDefaultNotificationChannel(ctx, renderBinding, componentRelease); err != nil {
-		msg := fmt.Sprintf("Failed to apply default notification channel: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to apply default notification channel")
-		return ctrl.Result{}, fmt.Errorf("failed to apply default notification channel: %w", err)
-	}
-
-	// Build Component from ComponentRelease for rendering
-	// The pipeline expects a Component object, so we need to reconstruct it from the ComponentRelease
-	snapshotComponent := buildComponentFromRelease(componentRelease)
-	snapshotComponentType := buildComponentTypeFromRelease(componentRelease)
-	snapshotTraits := buildTraitsFromRelease(componentRelease)
-	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
-
-	// Collect all SecretReferences needed for rendering (must be done after workload merge)
-	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
+	dataPlaneResources, observabilityPlaneResources, err := r.renderResources(ctx, releaseBinding, componentRelease,
+		environment, dataPlane, component, project)
 	if err != nil {
-		msg := fmt.Sprintf("Failed to collect SecretReferences: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to collect SecretReferences")
-		return ctrl.Result{}, fmt.Errorf("failed to collect SecretReferences: %w", err)
-	}
-
-	// Prepare RenderInput
-	renderInput := &componentpipeline.RenderInput{
-		ComponentType:    snapshotComponentType,
-		Component:        snapshotComponent,
-		Traits:           snapshotTraits,
-		Workload:         snapshotWorkload,
-		Environment:      environment,
-		ReleaseBinding:   renderBinding,
-		DataPlane:        dataPlane,
-		SecretReferences: secretReferences,
-		Metadata:         metadataContext,
+		return ctrl.Result{}, err
 	}
 
-	// Render resources using the shared pipeline instance
-	renderOutput, err := r.Pipeline.Render(renderInput)
+	// Progressive rollouts split traffic between the stable release and the one being rolled out
+	rollout, err := r.reconcileRollout(ctx, releaseBinding, environment, dataPlane, component, project, dataPlaneResources)
 	if err != nil {
-		msg := fmt.Sprintf("Failed to render resources: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to render resources")
-		return ctrl.Result{}, fmt.Errorf("failed to render resources: %w", err)
-	}
-
-	// Log warnings if any
-	if len(renderOutput.Metadata.Warnings) > 0 {
-		logger.Info("Rendering completed with warnings",
-			"warnings", renderOutput.Metadata.Warnings)
-	}
-
-	// Filter resources by target plane
-	dataPlaneResources := make([]map[string]any, 0, len(renderOutput.Resources))
-	observabilityPlaneResources := make([]map[string]any, 0, len(renderOutput.Resources))
-
-	for _, renderedResource := range renderOutput.Resources {
-		switch renderedResource.TargetPlane {
-		case openchoreov1alpha1.TargetPlaneDataPlane:
-			dataPlaneResources = append(dataPlaneResources, renderedResource.Resource)
-		case openchoreov1alpha1.TargetPlaneObservabilityPlane:
-			observabilityPlaneResources = append(observabilityPlaneResources, renderedResource.Resource)
-		}
+		return ctrl.Result{}, err
 	}
+	dataPlaneResources = rollout.resources
 
 	// Convert filtered dataplane resources to Release format
 	dataPlaneReleaseResources, err := r.convertToReleaseResources(dataPlaneResources)
@@ -456,6 +374,28 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	// Create or update dataplane Release
 	// Release name format: {component}-{environment}
 	dataPlaneReleaseName := fmt.Sprintf("%s-%s", componentRelease.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+
+	// Changes that take the project over its quota in the environment wait for budget to free up
+	overQuota, err := r.holdForQuota(ctx, releaseBinding, project, dataPlaneReleaseName, dataPlaneReleaseResources)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if overQuota {
+		r.setReadyCondition(releaseBinding)
+		return ctrl.Result{RequeueAfter: quotaRecheckInterval}, nil
+	}
+
+	// Changes wait for the environment's change calendar to open
+	hold, err := r.holdForChangeWindow(ctx, releaseBinding, environment, dataPlaneReleaseName,
+		dataPlaneReleaseResources, time.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if hold.held {
+		r.setReadyCondition(releaseBinding)
+		return ctrl.Result{RequeueAfter: hold.requeueAfter}, nil
+	}
+
 	dataPlaneRelease := &openchoreov1alpha1.Release{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dataPlaneReleaseName,
@@ -513,6 +453,10 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, err
 	}
 
+	if err := r.clearBreakGlass(ctx, releaseBinding); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Reconcile observability plane Release (create, update, or cleanup)
 	obsResult, err := r.reconcileObservabilityRelease(ctx, releaseBinding, componentRelease, dataPlane, observabilityPlaneReleaseResources)
 	if err != nil {
@@ -544,8 +488,144 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 
 	// Set overall Ready condition based on ReleaseSynced and ResourcesReady
 	r.setReadyCondition(releaseBinding)
+	markReleaseReady(releaseBinding)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: rollout.requeueAfter}, nil
+}
+
+// renderResources renders the ComponentRelease for the binding's environment and splits
+// the rendered resources by their target plane.
+func (r *Reconciler) renderResources(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment,
+	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component,
+	project *openchoreov1alpha1.Project) (dataPlaneResources, observabilityPlaneResources []map[string]any, err error) {
+	logger := log.FromContext(ctx)
+
+	// Build MetadataContext with computed names
+	metadataContext := r.buildMetadataContext(componentRelease, component, project, dataPlane, environment, releaseBinding.Spec.Environment)
+
+	// Build Component from ComponentRelease for rendering
+	// The pipeline expects a Component object, so we need to reconstruct it from the ComponentRelease
+	snapshotComponent := buildComponentFromRelease(componentRelease)
+	snapshotComponentType := buildComponentTypeFromRelease(componentRelease)
+	snapshotTraits := buildTraitsFromRelease(componentRelease)
+	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
+
+	// Add the definitions of the traits attached by the environment, which are not part of the release
+	snapshotTraits, err = r.addEnvironmentTraits(ctx, snapshotTraits, environment)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to fetch the traits of environment %q: %v", environment.Name, err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to fetch environment traits")
+		return nil, nil, fmt.Errorf("failed to fetch environment traits: %w", err)
+	}
+
+	// Collect all SecretReferences needed for rendering
+	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to collect SecretReferences: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to collect SecretReferences")
+		return nil, nil, fmt.Errorf("failed to collect SecretReferences: %w", err)
+	}
+
+	// Collect the guardrail policies of the organization and the cluster
+	renderPolicies, clusterRenderPolicies, err := r.listRenderPolicies(ctx, releaseBinding.Namespace)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to list render policies: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to list render policies")
+		return nil, nil, fmt.Errorf("failed to list render policies: %w", err)
+	}
+
+	// Prepare RenderInput on a render-time copy of the ReleaseBinding, which receives injected defaults
+	renderInput := &componentpipeline.RenderInput{
+		ComponentType:         snapshotComponentType,
+		Component:             snapshotComponent,
+		Traits:                snapshotTraits,
+		Workload:              snapshotWorkload,
+		Environment:           environment,
+		ReleaseBinding:        releaseBinding.DeepCopy(),
+		DataPlane:             dataPlane,
+		SecretReferences:      secretReferences,
+		Metadata:              metadataContext,
+		RenderPolicies:        renderPolicies,
+		ClusterRenderPolicies: clusterRenderPolicies,
+	}
+
+	// Unresolved connections are reported on the status but do not block the render
+	connections, err := r.prepareRender(ctx, renderInput)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to prepare rendering: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to prepare rendering")
+		return nil, nil, fmt.Errorf("failed to prepare rendering: %w", err)
+	}
+	setConnectionsResolvedCondition(releaseBinding, snapshotWorkload, connections)
+
+	// The stable release of a rollout is rendered too, only the bound release reports its policy compliance
+	boundRelease := componentRelease.Name == releaseBinding.Spec.ReleaseName
+	policyCount := len(renderPolicies) + len(clusterRenderPolicies)
+
+	// Render resources using the shared pipeline instance
+	renderOutput, err := r.Pipeline.RenderWithContext(ctx, renderInput)
+	if err != nil {
+		reason := ReasonRenderingFailed
+		var validationErr *componentpipeline.ResourceValidationError
+		var policyErr *componentpipeline.PolicyViolationError
+		switch {
+		case errors.As(err, &validationErr):
+			reason = ReasonInvalidRenderedResources
+		case errors.As(err, &policyErr):
+			reason = ReasonRenderPolicyViolated
+			if boundRelease {
+				setPolicyCompliantCondition(releaseBinding, policyCount, policyErr.Violations)
+			}
+		}
+		msg := fmt.Sprintf("Failed to render resources: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, reason, msg)
+		logger.Error(err, "Failed to render resources")
+		return nil, nil, fmt.Errorf("failed to render resources: %w", err)
+	}
+	if boundRelease {
+		setPolicyCompliantCondition(releaseBinding, policyCount, renderOutput.Metadata.PolicyViolations)
+	}
+
+	// Log warnings if any
+	if len(renderOutput.Metadata.Warnings) > 0 {
+		logger.Info("Rendering completed with warnings",
+			"warnings", renderOutput.Metadata.Warnings)
+	}
+
+	// Filter resources by target plane
+	dataPlaneResources = make([]map[string]any, 0, len(renderOutput.Resources))
+	observabilityPlaneResources = make([]map[string]any, 0, len(renderOutput.Resources))
+
+	for _, renderedResource := range renderOutput.Resources {
+		switch renderedResource.TargetPlane {
+		case openchoreov1alpha1.TargetPlaneDataPlane:
+			dataPlaneResources = append(dataPlaneResources, renderedResource.Resource)
+		case openchoreov1alpha1.TargetPlaneObservabilityPlane:
+			observabilityPlaneResources = append(observabilityPlaneResources, renderedResource.Resource)
+		}
+	}
+
+	networkPolicy, err := r.finishRender(ctx, renderInput, project, dataPlaneResources)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to finish rendering: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		return nil, nil, fmt.Errorf("failed to finish rendering: %w", err)
+	}
+	if networkPolicy != nil {
+		dataPlaneResources = append(dataPlaneResources, networkPolicy)
+	}
+
+	return dataPlaneResources, observabilityPlaneResources, nil
 }
 
 // observabilityReleaseResult holds the result of reconciling an observability Release.
@@ -717,8 +797,9 @@ func buildComponentFromRelease(componentRelease *openchoreov1alpha1.ComponentRel
 			Owner: openchoreov1alpha1.ComponentOwner{
 				ProjectName: componentRelease.Spec.Owner.ProjectName,
 			},
-			Parameters: componentRelease.Spec.ComponentProfile.Parameters,
-			Traits:     componentRelease.Spec.ComponentProfile.Traits,
+			Parameters:     componentRelease.Spec.ComponentProfile.Parameters,
+			Traits:         componentRelease.Spec.ComponentProfile.Traits,
+			DisabledTraits: componentRelease.Spec.ComponentProfile.DisabledTraits,
 		},
 	}
 }
@@ -751,6 +832,23 @@ func buildTraitsFromRelease(componentRelease *openchoreov1alpha1.ComponentReleas
 	return traits
 }
 
+// addEnvironmentTraits adds the Trait definitions of the traits attached by the environment that are
+// missing from the traits of the release. The definitions in the release take precedence.
+func (r *Reconciler) addEnvironmentTraits(ctx context.Context, traits []openchoreov1alpha1.Trait,
+	environment *openchoreov1alpha1.Environment) ([]openchoreov1alpha1.Trait, error) {
+	for _, envTrait := range environment.Spec.Traits {
+		if slices.ContainsFunc(traits, func(t openchoreov1alpha1.Trait) bool { return t.Name == envTrait.Name }) {
+			continue
+		}
+		trait := &openchoreov1alpha1.Trait{}
+		if err := r.Get(ctx, types.NamespacedName{Name: envTrait.Name, Namespace: environment.Namespace}, trait); err != nil {
+			return nil, fmt.Errorf("failed to get trait %q: %w", envTrait.Name, err)
+		}
+		traits = append(traits, *trait)
+	}
+	return traits, nil
+}
+
 func buildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
 	return &openchoreov1alpha1.Workload{
 		ObjectMeta: metav1.ObjectMeta{
@@ -762,7 +860,8 @@ func buildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRele
 				ProjectName:   componentRelease.Spec.Owner.ProjectName,
 				ComponentName: componentRelease.Spec.Owner.ComponentName,
 			},
-			WorkloadTemplateSpec: componentRelease.Spec.Workload,
+			// Deep copy so render-time changes (e.g. injected connection env) never touch the cached ComponentRelease
+			WorkloadTemplateSpec: *componentRelease.Spec.Workload.DeepCopy(),
 		},
 	}
 }
@@ -818,11 +917,11 @@ func (r *Reconciler) generateResourceID(resource map[string]any, index int) stri
 func (r *Reconciler) applyDefaultNotificationChannel(
 	ctx context.Context,
 	rb *openchoreov1alpha1.ReleaseBinding,
-	componentRelease *openchoreov1alpha1.ComponentRelease,
+	traits []openchoreov1alpha1.ComponentTrait,
 ) error {
-	// Identify observability-alert-rule trait instances in the release.
+	// Identify observability-alert-rule trait instances of the component.
 	alertRuleInstances := make([]openchoreov1alpha1.ComponentTrait, 0)
-	for _, trait := range componentRelease.Spec.ComponentProfile.Traits {
+	for _, trait := range traits {
 		if trait.Name == "observability-alert-rule" {
 			alertRuleInstances = append(alertRuleInstances, trait)
 		}
@@ -896,20 +995,64 @@ func (r *Reconciler) getDefaultNotificationChannelName(ctx context.Context, name
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	ctx := context.Background()
 
+	r.httpClient = &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
 	// Setup field index for SecretReferences
 	if err := r.setupSecretReferencesIndex(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to setup SecretReferences index: %w", err)
 	}
 
+	// Setup field index for connection targets
+	if err := r.setupConnectionTargetsIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup connection targets index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ReleaseBinding{}).
 		Owns(&openchoreov1alpha1.Release{}).
 		Watches(&openchoreov1alpha1.Component{},
 			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForComponent)).
+		// Re-evaluate held changes when the change calendar of an environment is edited
+		Watches(
+			&openchoreov1alpha1.Environment{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForEnvironment),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Re-evaluate the rendered resources when the guardrail policies change
+		Watches(
+			&openchoreov1alpha1.RenderPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForRenderPolicy),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&openchoreov1alpha1.ClusterRenderPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForClusterRenderPolicy),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Watches(
 			&openchoreov1alpha1.SecretReference{},
 			handler.EnqueueRequestsFromMapFunc(r.listReleaseBindingsForSecretReference),
 		).
+		// Re-resolve connections when a component they target is deployed, promoted or removed
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForConnectionTarget),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Re-resolve connection ports when the Service of a target component is rendered
+		Watches(
+			&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForConnectionTargetRelease),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Re-render the network policies of the components a consumer connects to when its connections change
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForConnectionConsumer),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Named("releasebinding").
 		Complete(r)
 }
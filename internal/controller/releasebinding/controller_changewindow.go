@@ -0,0 +1,131 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changewindow"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// changeHold is the outcome of checking a rendered change against the environment's change calendar
+type changeHold struct {
+	held bool
+	// requeueAfter is how long until the calendar opens. Zero when it is not known to open again.
+	requeueAfter time.Duration
+}
+
+// holdForChangeWindow decides whether the rendered data plane resources must wait for the environment's
+// change calendar to open. Only changes are held, so a Release that already matches the rendered
+// resources keeps being reconciled while the calendar is closed. A break-glass override on the binding
+// lets the change through.
+func (r *Reconciler) holdForChangeWindow(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, releaseName string, resources []openchoreov1alpha1.Resource,
+	now time.Time) (changeHold, error) {
+	logger := log.FromContext(ctx)
+
+	decision, err := changewindow.Evaluate(environment.Spec.ChangeCalendar, now)
+	if err != nil {
+		msg := fmt.Sprintf("Environment %q has an invalid change calendar: %v", environment.Name, err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonInvalidChangeCalendar, msg)
+		logger.Info(msg)
+		return changeHold{held: true}, nil
+	}
+	if decision.Open {
+		return changeHold{}, nil
+	}
+
+	if reason := controller.GetBreakGlass(releaseBinding); reason != "" {
+		logger.Info("Rolling out change outside the change window with a break-glass override",
+			"environment", environment.Name, "reason", reason)
+		return changeHold{}, nil
+	}
+
+	changed, err := r.releaseChanged(ctx, releaseBinding.Namespace, releaseName, resources)
+	if err != nil {
+		return changeHold{}, err
+	}
+	if !changed {
+		return changeHold{}, nil
+	}
+
+	msg := fmt.Sprintf("Change to environment %q is queued: %s", environment.Name, decision.Message())
+	controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonChangeWindowClosed, msg)
+	logger.Info("Holding change until the change window opens", "environment", environment.Name,
+		"nextOpen", decision.NextOpen)
+
+	hold := changeHold{held: true}
+	if !decision.NextOpen.IsZero() {
+		hold.requeueAfter = decision.NextOpen.Sub(now)
+	}
+	return hold, nil
+}
+
+// releaseChanged reports whether the resources differ from those of the existing Release
+func (r *Reconciler) releaseChanged(ctx context.Context, namespace, name string,
+	resources []openchoreov1alpha1.Resource) (bool, error) {
+	release := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get Release %q: %w", name, err)
+	}
+	return !releaseResourcesEqual(release.Spec.Resources, resources), nil
+}
+
+// releaseResourcesEqual compares Release resources by their decoded objects, since the stored
+// JSON may be formatted differently from the rendered one
+func releaseResourcesEqual(a, b []openchoreov1alpha1.Resource) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+		if !apiequality.Semantic.DeepEqual(decodeResource(a[i]), decodeResource(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeResource(resource openchoreov1alpha1.Resource) map[string]any {
+	if resource.Object == nil {
+		return nil
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(resource.Object.Raw, &obj); err != nil {
+		return nil
+	}
+	return obj
+}
+
+// clearBreakGlass removes a break-glass override once the change it let through reached the Release,
+// so that later changes are held again
+func (r *Reconciler) clearBreakGlass(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) error {
+	if controller.GetBreakGlass(releaseBinding) == "" {
+		return nil
+	}
+	updated := releaseBinding.DeepCopy()
+	controller.SetBreakGlass(updated, "")
+	if err := r.Patch(ctx, updated, client.MergeFrom(releaseBinding)); err != nil {
+		return fmt.Errorf("failed to clear break-glass override: %w", err)
+	}
+	// Carry the new resource version so the status update of this reconcile does not conflict
+	releaseBinding.Annotations = updated.Annotations
+	releaseBinding.ResourceVersion = updated.ResourceVersion
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var _ = Describe("Change window", func() {
+	const namespace = "acme"
+
+	var (
+		reconciler  *Reconciler
+		k8sClient   client.Client
+		binding     *openchoreov1alpha1.ReleaseBinding
+		environment *openchoreov1alpha1.Environment
+		applied     []openchoreov1alpha1.Resource
+		now         time.Time
+	)
+
+	resource := func(replicas string) openchoreov1alpha1.Resource {
+		return openchoreov1alpha1.Resource{
+			ID:     "deployment",
+			Object: &runtime.RawExtension{Raw: []byte(`{"kind":"Deployment","spec":{"replicas":` + replicas + `}}`)},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2025, time.December, 24, 10, 0, 0, 0, time.UTC)
+		environment = &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: namespace},
+			Spec: openchoreov1alpha1.EnvironmentSpec{ChangeCalendar: &openchoreov1alpha1.ChangeCalendar{
+				Blackouts: []openchoreov1alpha1.BlackoutPeriod{{
+					Name:  "holidays",
+					Start: metav1.NewTime(time.Date(2025, time.December, 22, 0, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)),
+				}},
+			}},
+		}
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: namespace},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "production", ReleaseName: "api-2"},
+		}
+		applied = []openchoreov1alpha1.Resource{resource("1")}
+
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			binding.DeepCopy(),
+			&openchoreov1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: namespace},
+				Spec:       openchoreov1alpha1.ReleaseSpec{Resources: applied},
+			},
+		).Build()
+		reconciler = &Reconciler{Client: k8sClient, Scheme: scheme}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(binding), binding)).To(Succeed())
+	})
+
+	It("should hold a change during a blackout until it ends", func() {
+		hold, err := reconciler.holdForChangeWindow(context.Background(), binding, environment, "api-production",
+			[]openchoreov1alpha1.Resource{resource("3")}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hold.held).To(BeTrue())
+		Expect(hold.requeueAfter).To(Equal(time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC).Sub(now)))
+
+		condition := apimeta.FindStatusCondition(binding.Status.Conditions, string(ConditionReleaseSynced))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(string(ReasonChangeWindowClosed)))
+		Expect(condition.Message).To(ContainSubstring(`blackout "holidays"`))
+	})
+
+	It("should keep reconciling an unchanged release during a blackout", func() {
+		unchanged := []openchoreov1alpha1.Resource{{
+			ID:     "deployment",
+			Object: &runtime.RawExtension{Raw: []byte(`{"spec": {"replicas": 1}, "kind": "Deployment"}`)},
+		}}
+		hold, err := reconciler.holdForChangeWindow(context.Background(), binding, environment, "api-production",
+			unchanged, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hold.held).To(BeFalse())
+	})
+
+	It("should let a break-glass change through and clear the override once applied", func() {
+		controller.SetBreakGlass(binding, "checkout outage")
+		Expect(k8sClient.Update(context.Background(), binding)).To(Succeed())
+
+		hold, err := reconciler.holdForChangeWindow(context.Background(), binding, environment, "api-production",
+			[]openchoreov1alpha1.Resource{resource("3")}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hold.held).To(BeFalse())
+
+		Expect(reconciler.clearBreakGlass(context.Background(), binding)).To(Succeed())
+		Expect(controller.GetBreakGlass(binding)).To(BeEmpty())
+		stored := &openchoreov1alpha1.ReleaseBinding{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(binding), stored)).To(Succeed())
+		Expect(controller.GetBreakGlass(stored)).To(BeEmpty())
+		Expect(binding.ResourceVersion).To(Equal(stored.ResourceVersion))
+	})
+
+	It("should allow changes outside a blackout", func() {
+		hold, err := reconciler.holdForChangeWindow(context.Background(), binding, environment, "api-production",
+			[]openchoreov1alpha1.Resource{resource("3")}, time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hold.held).To(BeFalse())
+	})
+})
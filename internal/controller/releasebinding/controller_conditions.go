@@ -26,6 +26,19 @@ const (
 
 	// ConditionFinalizing indicates that the ReleaseBinding is being finalized (deleted).
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionConnectionsResolved indicates whether every workload connection resolved to
+	// an endpoint of a component deployed in the same environment.
+	// Only set when the workload declares connections.
+	ConditionConnectionsResolved controller.ConditionType = "ConnectionsResolved"
+
+	// ConditionRolledOut indicates whether the bound release serves all traffic.
+	// Only set when a rollout strategy applies to the ReleaseBinding.
+	ConditionRolledOut controller.ConditionType = "RolledOut"
+
+	// ConditionPolicyCompliant indicates whether the rendered resources of the bound release follow
+	// the render policies of the organization and the cluster. Only set when render policies exist.
+	ConditionPolicyCompliant controller.ConditionType = "PolicyCompliant"
 )
 
 // Constants for condition reasons
@@ -61,6 +74,49 @@ const (
 
 	// ReasonRenderingFailed indicates failure to render resources
 	ReasonRenderingFailed controller.ConditionReason = "RenderingFailed"
+	// ReasonInvalidRenderedResources indicates the rendered resources do not match the schemas of their kinds
+	ReasonInvalidRenderedResources controller.ConditionReason = "InvalidRenderedResources"
+	// ReasonRenderPolicyViolated indicates the rendered resources violate a render policy in Enforce mode
+	ReasonRenderPolicyViolated controller.ConditionReason = "RenderPolicyViolated"
+
+	// Render policies
+
+	// ReasonPolicyCompliant indicates the rendered resources follow every render policy
+	ReasonPolicyCompliant controller.ConditionReason = "PolicyCompliant"
+	// ReasonPolicyViolations indicates the rendered resources violate one or more render policies
+	ReasonPolicyViolations controller.ConditionReason = "PolicyViolations"
+
+	// Connection resolution
+
+	// ReasonConnectionsResolved indicates all workload connections were resolved
+	ReasonConnectionsResolved controller.ConditionReason = "ConnectionsResolved"
+	// ReasonUnresolvedConnections indicates one or more connections target a component that is
+	// not deployed in the environment or does not expose the requested endpoint
+	ReasonUnresolvedConnections controller.ConditionReason = "UnresolvedConnections"
+
+	// Progressive rollout
+
+	// ReasonRolloutSucceeded indicates the bound release was promoted and serves all traffic
+	ReasonRolloutSucceeded controller.ConditionReason = "RolloutSucceeded"
+	// ReasonRolloutProgressing indicates traffic is being shifted to the bound release
+	ReasonRolloutProgressing controller.ConditionReason = "RolloutProgressing"
+	// ReasonRolloutAborted indicates the bound release failed its analysis and traffic was moved
+	// back to the stable release
+	ReasonRolloutAborted controller.ConditionReason = "RolloutAborted"
+
+	// Change calendar (Status=False)
+
+	// ReasonChangeWindowClosed indicates a change is held until the environment's change calendar opens
+	ReasonChangeWindowClosed controller.ConditionReason = "ChangeWindowClosed"
+	// ReasonInvalidChangeCalendar indicates the environment's change calendar cannot be evaluated,
+	// so changes are held until it is fixed
+	ReasonInvalidChangeCalendar controller.ConditionReason = "InvalidChangeCalendar"
+
+	// Resource quota (Status=False)
+
+	// ReasonQuotaExceeded indicates a change is held because it would take the project over its quota
+	// in the environment
+	ReasonQuotaExceeded controller.ConditionReason = "QuotaExceeded"
 
 	// Release management issues (Status=False)
 
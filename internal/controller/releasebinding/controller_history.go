@@ -0,0 +1,54 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// MaxReleaseHistory is the number of releases kept in the ReleaseBinding status history
+const MaxReleaseHistory = 10
+
+// recordReleaseHistory adds the currently bound release to the front of the history
+// when the binding has moved to a different release.
+func recordReleaseHistory(releaseBinding *openchoreov1alpha1.ReleaseBinding, now metav1.Time) {
+	releaseName := releaseBinding.Spec.ReleaseName
+	if releaseName == "" {
+		return
+	}
+	history := releaseBinding.Status.History
+	if len(history) > 0 && history[0].ReleaseName == releaseName {
+		return
+	}
+
+	entry := openchoreov1alpha1.ReleaseHistoryEntry{
+		ReleaseName: releaseName,
+		BoundAt:     now,
+		BoundBy:     controller.GetBoundBy(releaseBinding),
+	}
+	history = append([]openchoreov1alpha1.ReleaseHistoryEntry{entry}, history...)
+	if len(history) > MaxReleaseHistory {
+		history = history[:MaxReleaseHistory]
+	}
+	releaseBinding.Status.History = history
+}
+
+// markReleaseReady flags the current history entry once the binding is Ready for it.
+// A release that is still being rolled out is not flagged until it is promoted.
+func markReleaseReady(releaseBinding *openchoreov1alpha1.ReleaseBinding) {
+	history := releaseBinding.Status.History
+	if len(history) == 0 || history[0].ReleaseName != releaseBinding.Spec.ReleaseName {
+		return
+	}
+	if rollout := releaseBinding.Status.Rollout; rollout != nil && rollout.StableRelease != releaseBinding.Spec.ReleaseName {
+		return
+	}
+	if meta.IsStatusConditionTrue(releaseBinding.Status.Conditions, ConditionReady.String()) {
+		history[0].Ready = true
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var _ = Describe("Release history", func() {
+	var binding *openchoreov1alpha1.ReleaseBinding
+	now := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	BeforeEach(func() {
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{ReleaseName: "api-1"},
+		}
+		controller.SetBoundBy(binding, "alice")
+	})
+
+	It("should record a newly bound release with its binder", func() {
+		recordReleaseHistory(binding, now)
+		Expect(binding.Status.History).To(HaveLen(1))
+		Expect(binding.Status.History[0].ReleaseName).To(Equal("api-1"))
+		Expect(binding.Status.History[0].BoundBy).To(Equal("alice"))
+		Expect(binding.Status.History[0].BoundAt).To(Equal(now))
+	})
+
+	It("should not record the same release twice", func() {
+		recordReleaseHistory(binding, now)
+		recordReleaseHistory(binding, metav1.NewTime(now.Add(time.Hour)))
+		Expect(binding.Status.History).To(HaveLen(1))
+		Expect(binding.Status.History[0].BoundAt).To(Equal(now))
+	})
+
+	It("should keep the most recent releases first and drop the oldest", func() {
+		for i := 1; i <= MaxReleaseHistory+2; i++ {
+			binding.Spec.ReleaseName = fmt.Sprintf("api-%d", i)
+			recordReleaseHistory(binding, now)
+		}
+		Expect(binding.Status.History).To(HaveLen(MaxReleaseHistory))
+		Expect(binding.Status.History[0].ReleaseName).To(Equal(fmt.Sprintf("api-%d", MaxReleaseHistory+2)))
+		Expect(binding.Status.History[MaxReleaseHistory-1].ReleaseName).To(Equal("api-3"))
+	})
+
+	It("should mark the current release ready once the binding is Ready", func() {
+		recordReleaseHistory(binding, now)
+		markReleaseReady(binding)
+		Expect(binding.Status.History[0].Ready).To(BeFalse())
+
+		controller.MarkTrueCondition(binding, ConditionReady, ReasonReady, "ReleaseBinding is ready")
+		markReleaseReady(binding)
+		Expect(binding.Status.History[0].Ready).To(BeTrue())
+	})
+})
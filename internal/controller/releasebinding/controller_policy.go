@@ -0,0 +1,87 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+// listRenderPolicies returns the render policies of an organization and the render policies of the cluster
+func (r *Reconciler) listRenderPolicies(ctx context.Context, namespace string) ([]openchoreov1alpha1.RenderPolicy,
+	[]openchoreov1alpha1.ClusterRenderPolicy, error) {
+	policies := &openchoreov1alpha1.RenderPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	clusterPolicies := &openchoreov1alpha1.ClusterRenderPolicyList{}
+	if err := r.List(ctx, clusterPolicies); err != nil {
+		return nil, nil, err
+	}
+	return policies.Items, clusterPolicies.Items, nil
+}
+
+// setPolicyCompliantCondition reports the render policy violations of the bound release.
+// The condition is only present when render policies exist.
+func setPolicyCompliantCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding, policyCount int,
+	violations []componentpipeline.PolicyViolation) {
+	if policyCount == 0 {
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, string(ConditionPolicyCompliant))
+		return
+	}
+
+	if len(violations) > 0 {
+		messages := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			messages = append(messages, fmt.Sprintf("[%s] %s", violation.Mode, violation))
+		}
+		controller.MarkFalseCondition(releaseBinding, ConditionPolicyCompliant, ReasonPolicyViolations,
+			fmt.Sprintf("Render policy violations: %s", strings.Join(messages, "; ")))
+		return
+	}
+
+	controller.MarkTrueCondition(releaseBinding, ConditionPolicyCompliant, ReasonPolicyCompliant,
+		fmt.Sprintf("Rendered resources follow all %d render policies", policyCount))
+}
+
+// findReleaseBindingsForRenderPolicy maps a RenderPolicy to the ReleaseBindings of its organization
+func (r *Reconciler) findReleaseBindingsForRenderPolicy(ctx context.Context, obj client.Object) []ctrl.Request {
+	return r.listReleaseBindingRequests(ctx, client.InNamespace(obj.GetNamespace()))
+}
+
+// findReleaseBindingsForClusterRenderPolicy maps a ClusterRenderPolicy to every ReleaseBinding
+func (r *Reconciler) findReleaseBindingsForClusterRenderPolicy(ctx context.Context, _ client.Object) []ctrl.Request {
+	return r.listReleaseBindingRequests(ctx)
+}
+
+// listReleaseBindingRequests returns reconcile requests for the ReleaseBindings matching the list options
+func (r *Reconciler) listReleaseBindingRequests(ctx context.Context, opts ...client.ListOption) []ctrl.Request {
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, opts...); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for render policy")
+		return nil
+	}
+
+	requests := make([]ctrl.Request, 0, len(bindings.Items))
+	for _, binding := range bindings.Items {
+		requests = append(requests, ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      binding.Name,
+				Namespace: binding.Namespace,
+			},
+		})
+	}
+	return requests
+}
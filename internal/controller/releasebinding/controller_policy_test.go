@@ -0,0 +1,53 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+var _ = Describe("Render policy compliance", func() {
+	violation := componentpipeline.PolicyViolation{
+		Policy:   "ClusterRenderPolicy/baseline",
+		Rule:     "resource-limits",
+		Mode:     openchoreov1alpha1.RenderPolicyModeAudit,
+		Resource: `Deployment/app (from resource "deployment")`,
+		Message:  "every container needs resource limits",
+	}
+
+	It("should report the violations of the bound release", func() {
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		setPolicyCompliantCondition(binding, 2, []componentpipeline.PolicyViolation{violation})
+
+		condition := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionPolicyCompliant))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(ReasonPolicyViolations)))
+		Expect(condition.Message).To(ContainSubstring(
+			`[Audit] Deployment/app (from resource "deployment") violates rule "resource-limits" of ClusterRenderPolicy/baseline`))
+	})
+
+	It("should mark compliant releases", func() {
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		setPolicyCompliantCondition(binding, 2, nil)
+
+		condition := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionPolicyCompliant))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should drop the condition when no render policy exists", func() {
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		setPolicyCompliantCondition(binding, 1, nil)
+		setPolicyCompliantCondition(binding, 0, nil)
+
+		Expect(meta.FindStatusCondition(binding.Status.Conditions, string(ConditionPolicyCompliant))).To(BeNil())
+	})
+})
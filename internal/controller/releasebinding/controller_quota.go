@@ -0,0 +1,83 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/resourcepolicy"
+)
+
+// quotaRecheckInterval is how often a change held by the project's quota is checked again,
+// since the budget is freed by other components' releases
+const quotaRecheckInterval = 2 * time.Minute
+
+// holdForQuota decides whether the rendered data plane resources must wait because they would take the
+// project over its quota in the environment, counting the releases of the project's other components.
+// A change that does not grow the usage of an exceeded resource is let through, so that components can
+// still scale down while the project is over its quota.
+func (r *Reconciler) holdForQuota(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	project *openchoreov1alpha1.Project, releaseName string, resources []openchoreov1alpha1.Resource) (bool, error) {
+	environmentName := releaseBinding.Spec.Environment
+	hard, limits := resourcepolicy.Effective(project.Spec.ResourcePolicy, environmentName)
+	if len(hard) == 0 {
+		return false, nil
+	}
+
+	desired, err := resourcepolicy.ReleaseUsage(resources, limits)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute resource usage: %w", err)
+	}
+
+	releases := &openchoreov1alpha1.ReleaseList{}
+	if err := r.List(ctx, releases, client.InNamespace(releaseBinding.Namespace), client.MatchingLabels{
+		labels.LabelKeyProjectName:     project.Name,
+		labels.LabelKeyEnvironmentName: environmentName,
+	}); err != nil {
+		return false, fmt.Errorf("failed to list releases of project %s: %w", project.Name, err)
+	}
+	usages := []corev1.ResourceList{desired}
+	current := corev1.ResourceList{}
+	for _, release := range releases.Items {
+		if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane {
+			continue
+		}
+		usage, err := resourcepolicy.ReleaseUsage(release.Spec.Resources, limits)
+		if err != nil {
+			return false, fmt.Errorf("failed to compute resource usage of release %s: %w", release.Name, err)
+		}
+		if release.Name == releaseName {
+			current = usage
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	total := resourcepolicy.Sum(usages...)
+
+	var growing []corev1.ResourceName
+	for _, name := range resourcepolicy.Exceeded(hard, total) {
+		if quantity := desired[name]; quantity.Cmp(current[name]) > 0 {
+			growing = append(growing, name)
+		}
+	}
+	if len(growing) == 0 {
+		return false, nil
+	}
+
+	msg := fmt.Sprintf("Change would take project %q over its quota in environment %q: %s",
+		project.Name, environmentName, resourcepolicy.Describe(hard, total, growing))
+	controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonQuotaExceeded, msg)
+	log.FromContext(ctx).Info("Holding change that exceeds the project quota", "environment", environmentName,
+		"resources", growing)
+	return true, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var _ = Describe("Project quota", func() {
+	const namespace = "acme"
+
+	var (
+		reconciler *Reconciler
+		binding    *openchoreov1alpha1.ReleaseBinding
+		project    *openchoreov1alpha1.Project
+	)
+
+	deployment := func(replicas string) []openchoreov1alpha1.Resource {
+		return []openchoreov1alpha1.Resource{{
+			ID: "deployment",
+			Object: &runtime.RawExtension{Raw: []byte(`{"kind":"Deployment","spec":{"replicas":` + replicas +
+				`,"template":{"spec":{"containers":[{"name":"main","resources":{"requests":{"cpu":"1"}}}]}}}}`)},
+		}}
+	}
+	release := func(component, replicas string) *openchoreov1alpha1.Release {
+		return &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      component + "-production",
+				Namespace: namespace,
+				Labels: map[string]string{
+					labels.LabelKeyProjectName:     "shop",
+					labels.LabelKeyEnvironmentName: "production",
+				},
+			},
+			Spec: openchoreov1alpha1.ReleaseSpec{
+				EnvironmentName: "production",
+				TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
+				Resources:       deployment(replicas),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		project = &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: namespace},
+			Spec: openchoreov1alpha1.ProjectSpec{ResourcePolicy: &openchoreov1alpha1.ProjectResourcePolicy{
+				Quota: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+			}},
+		}
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: namespace},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "production"},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			release("api", "2"),
+			release("worker", "2"),
+		).Build()
+		reconciler = &Reconciler{Client: k8sClient, Scheme: scheme}
+	})
+
+	It("should hold a change that takes the project over its quota", func() {
+		held, err := reconciler.holdForQuota(context.Background(), binding, project, "api-production", deployment("3"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(held).To(BeTrue())
+
+		condition := apimeta.FindStatusCondition(binding.Status.Conditions, string(ConditionReleaseSynced))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(string(ReasonQuotaExceeded)))
+		Expect(condition.Message).To(ContainSubstring("requests.cpu 5 of 4"))
+	})
+
+	It("should let a change within the quota through", func() {
+		held, err := reconciler.holdForQuota(context.Background(), binding, project, "api-production", deployment("1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(held).To(BeFalse())
+	})
+
+	It("should let a change through that shrinks a project already over its quota", func() {
+		project.Spec.ResourcePolicy.Environments = []openchoreov1alpha1.EnvironmentResourcePolicy{{
+			Environment: "production",
+			Quota:       corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+		}}
+		held, err := reconciler.holdForQuota(context.Background(), binding, project, "api-production", deployment("1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(held).To(BeFalse())
+	})
+
+	It("should not hold changes in projects without a quota", func() {
+		project.Spec.ResourcePolicy = nil
+		held, err := reconciler.holdForQuota(context.Background(), binding, project, "api-production", deployment("10"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(held).To(BeFalse())
+	})
+})
@@ -0,0 +1,416 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// rolloutRetryInterval is how often a rollout waiting on the canary or on the observer is re-evaluated
+const rolloutRetryInterval = 30 * time.Second
+
+var (
+	defaultCanarySteps = []openchoreov1alpha1.RolloutStep{
+		{Weight: 10},
+		{Weight: 50},
+		{Weight: 100},
+	}
+	defaultBlueGreenSteps = []openchoreov1alpha1.RolloutStep{
+		{Weight: 0},
+		{Weight: 100},
+	}
+)
+
+// rolloutResult holds the outcome of reconciling a progressive rollout
+type rolloutResult struct {
+	// resources are the dataplane resources of the binding's main Release
+	resources []map[string]any
+	// requeueAfter is when the rollout needs to be evaluated again, zero if it does not
+	requeueAfter time.Duration
+}
+
+// effectiveRolloutStrategy returns the rollout strategy of the binding, falling back to the environment's one
+func effectiveRolloutStrategy(releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment) *openchoreov1alpha1.RolloutStrategy {
+	if releaseBinding.Spec.Rollout != nil {
+		return releaseBinding.Spec.Rollout
+	}
+	return environment.Spec.Rollout
+}
+
+// rolloutSteps returns the steps of the strategy, or the defaults of its type when none are set
+func rolloutSteps(strategy *openchoreov1alpha1.RolloutStrategy) []openchoreov1alpha1.RolloutStep {
+	if len(strategy.Steps) > 0 {
+		return strategy.Steps
+	}
+	if strategy.Type == openchoreov1alpha1.RolloutStrategyBlueGreen {
+		return defaultBlueGreenSteps
+	}
+	return defaultCanarySteps
+}
+
+// initialStableRelease picks the release serving traffic when a rollout strategy starts applying to a binding.
+// The previous release is only used when it became ready and the bound one has not yet.
+func initialStableRelease(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	target := releaseBinding.Spec.ReleaseName
+	history := releaseBinding.Status.History
+	if len(history) < 2 || history[0].ReleaseName != target || history[0].Ready {
+		return target
+	}
+	if history[1].Ready {
+		return history[1].ReleaseName
+	}
+	return target
+}
+
+// canaryReleaseName returns the name of the Release holding the canary resources.
+// Format: {component}-{environment}-canary
+func canaryReleaseName(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	return fmt.Sprintf("%s-%s-canary", releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+}
+
+// reconcileRollout moves traffic from the stable release to the bound one according to the rollout strategy.
+// It manages the canary Release and returns the resources of the main Release, which carry the stable
+// workload and the HTTPRoute weights. Without a strategy the rendered resources are returned as they are.
+func (r *Reconciler) reconcileRollout(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project,
+	resources []map[string]any) (rolloutResult, error) {
+	logger := log.FromContext(ctx)
+
+	strategy := effectiveRolloutStrategy(releaseBinding, environment)
+	isDeployment := extractWorkloadType(component.Spec.ComponentType) == WorkloadTypeDeployment
+	if strategy != nil && !isDeployment {
+		logger.Info("Ignoring rollout strategy, only deployment components can be rolled out progressively",
+			"componentType", component.Spec.ComponentType)
+		strategy = nil
+	}
+	if strategy == nil {
+		releaseBinding.Status.Rollout = nil
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, ConditionRolledOut.String())
+		if isDeployment {
+			// Keep the stable track label so that adding a strategy later doesn't restart the pods
+			labeled, err := labelStablePods(resources)
+			if err != nil {
+				return rolloutResult{}, fmt.Errorf("failed to label stable resources: %w", err)
+			}
+			resources = labeled
+		}
+		return rolloutResult{resources: resources}, r.deleteCanaryRelease(ctx, releaseBinding)
+	}
+
+	target := releaseBinding.Spec.ReleaseName
+	if releaseBinding.Status.Rollout == nil {
+		releaseBinding.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Phase:         openchoreov1alpha1.RolloutPhaseSucceeded,
+			StableRelease: initialStableRelease(releaseBinding),
+		}
+	}
+	status := releaseBinding.Status.Rollout
+
+	if target != status.StableRelease {
+		stableResources, err := r.renderStableResources(ctx, releaseBinding, status.StableRelease,
+			environment, dataPlane, component, project)
+		if err != nil {
+			return rolloutResult{}, err
+		}
+		if stableResources != nil {
+			return r.progressRollout(ctx, releaseBinding, strategy, environment, component, project,
+				stableResources, resources)
+		}
+		logger.Info("Stable release no longer exists, deploying the bound release directly",
+			"stableRelease", status.StableRelease, "release", target)
+	}
+
+	// The bound release is the stable one: it serves all traffic and no canary is needed
+	if status.CanaryRelease != "" || status.StableRelease != target {
+		status.Message = fmt.Sprintf("Release %q serves all traffic", target)
+	}
+	status.Phase = openchoreov1alpha1.RolloutPhaseSucceeded
+	status.StableRelease = target
+	status.CanaryRelease = ""
+	status.Step = 0
+	status.Weight = 0
+	status.StepStartedAt = nil
+	controller.MarkTrueCondition(releaseBinding, ConditionRolledOut, ReasonRolloutSucceeded,
+		fmt.Sprintf("Release %q serves all traffic", target))
+
+	stable, err := labelStablePods(resources)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to label stable resources: %w", err)
+	}
+	return rolloutResult{resources: stable}, r.deleteCanaryRelease(ctx, releaseBinding)
+}
+
+// progressRollout runs the current step of the rollout: it keeps the canary deployed at the step's weight,
+// waits for the step's pause and evaluates the analysis gate before moving to the next step.
+// The bound release is promoted after the last step and traffic goes back to the stable release if
+// the analysis fails.
+func (r *Reconciler) progressRollout(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	strategy *openchoreov1alpha1.RolloutStrategy, environment *openchoreov1alpha1.Environment,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project,
+	stableResources, targetResources []map[string]any) (rolloutResult, error) {
+	logger := log.FromContext(ctx)
+	status := releaseBinding.Status.Rollout
+	target := releaseBinding.Spec.ReleaseName
+
+	stablePods, err := labelStablePods(stableResources)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to label stable resources: %w", err)
+	}
+
+	// An aborted rollout keeps serving the stable release until the binding moves to another release
+	if status.Phase == openchoreov1alpha1.RolloutPhaseAborted && status.CanaryRelease == target {
+		return rolloutResult{resources: stablePods}, r.deleteCanaryRelease(ctx, releaseBinding)
+	}
+
+	// The stable Services only select stable pods while the canary runs
+	stable, err := restrictToStableTrack(stablePods)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to restrict stable services: %w", err)
+	}
+
+	if status.Phase != openchoreov1alpha1.RolloutPhaseProgressing || status.CanaryRelease != target {
+		logger.Info("Starting rollout", "strategy", strategy.Type,
+			"stableRelease", status.StableRelease, "release", target)
+		status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		status.CanaryRelease = target
+		status.Step = 0
+		status.StepStartedAt = nil
+	}
+
+	steps := rolloutSteps(strategy)
+	if int(status.Step) >= len(steps) {
+		// The strategy lost steps while the rollout was running
+		status.Step = int32(len(steps) - 1)
+	}
+	status.Weight = steps[status.Step].Weight
+
+	canary, canaryServices, err := canaryResources(targetResources)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to build canary resources: %w", err)
+	}
+	canaryRelease, err := r.applyCanaryRelease(ctx, releaseBinding, canary)
+	if err != nil {
+		return rolloutResult{}, err
+	}
+
+	split, err := splitTraffic(stable, canaryServices, status.Weight)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to split traffic: %w", err)
+	}
+	result := rolloutResult{resources: split}
+
+	stepName := fmt.Sprintf("step %d/%d (%d%% of traffic)", status.Step+1, len(steps), status.Weight)
+	ready, _, message := evaluateDeploymentStatus(canaryRelease.Status.Resources, WorkloadTypeDeployment)
+	if len(canaryRelease.Status.Resources) == 0 || !ready {
+		status.StepStartedAt = nil
+		status.Message = fmt.Sprintf("Rollout of %q at %s is waiting for the canary: %s", target, stepName, message)
+		controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutProgressing, status.Message)
+		result.requeueAfter = rolloutRetryInterval
+		return result, nil
+	}
+
+	now := time.Now()
+	if status.StepStartedAt == nil {
+		started := metav1.NewTime(now)
+		status.StepStartedAt = &started
+	}
+	status.Message = fmt.Sprintf("Rolling out %q at %s", target, stepName)
+	controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutProgressing, status.Message)
+
+	var pause time.Duration
+	if steps[status.Step].Pause != nil {
+		pause = steps[status.Step].Pause.Duration
+	}
+	if elapsed := now.Sub(status.StepStartedAt.Time); elapsed < pause {
+		result.requeueAfter = pause - elapsed
+		return result, nil
+	}
+
+	if strategy.Analysis != nil {
+		metrics, err := r.queryHTTPMetrics(ctx, releaseBinding, environment, component, project,
+			status.StepStartedAt.Time, now)
+		if err != nil {
+			logger.Error(err, "Failed to query metrics for rollout analysis", "release", target)
+			status.Message = fmt.Sprintf("Rollout of %q at %s is waiting for metrics: %v", target, stepName, err)
+			controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutProgressing, status.Message)
+			result.requeueAfter = rolloutRetryInterval
+			return result, nil
+		}
+		outcome, analysis, err := evaluateAnalysis(strategy.Analysis, metrics, now.Sub(status.StepStartedAt.Time))
+		if err != nil {
+			return rolloutResult{}, fmt.Errorf("failed to evaluate rollout analysis: %w", err)
+		}
+		if outcome == analysisInconclusive {
+			// Keep the step running so that the analysis window grows until the canary served enough requests
+			status.Message = fmt.Sprintf("Rollout of %q at %s is waiting for canary traffic: %s", target, stepName, analysis)
+			controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutProgressing, status.Message)
+			result.requeueAfter = rolloutRetryInterval
+			return result, nil
+		}
+		if outcome == analysisFailed {
+			logger.Info("Rollout analysis failed, rolling back", "release", target,
+				"stableRelease", status.StableRelease, "analysis", analysis)
+			status.Phase = openchoreov1alpha1.RolloutPhaseAborted
+			status.Weight = 0
+			status.StepStartedAt = nil
+			status.Message = fmt.Sprintf("Rollout of %q failed its analysis at %s and traffic was moved back to %q: %s",
+				target, stepName, status.StableRelease, analysis)
+			controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutAborted, status.Message)
+			return rolloutResult{resources: stablePods}, r.deleteCanaryRelease(ctx, releaseBinding)
+		}
+		logger.Info("Rollout analysis passed", "release", target, "step", status.Step, "analysis", analysis)
+	}
+
+	status.Step++
+	if int(status.Step) >= len(steps) {
+		logger.Info("Promoting release", "release", target, "stableRelease", status.StableRelease)
+		status.Phase = openchoreov1alpha1.RolloutPhaseSucceeded
+		status.StableRelease = target
+		status.CanaryRelease = ""
+		status.Step = 0
+		status.Weight = 0
+		status.StepStartedAt = nil
+		status.Message = fmt.Sprintf("Release %q was promoted and serves all traffic", target)
+		controller.MarkTrueCondition(releaseBinding, ConditionRolledOut, ReasonRolloutSucceeded, status.Message)
+
+		promoted, err := labelStablePods(targetResources)
+		if err != nil {
+			return rolloutResult{}, fmt.Errorf("failed to label stable resources: %w", err)
+		}
+		return rolloutResult{resources: promoted}, r.deleteCanaryRelease(ctx, releaseBinding)
+	}
+
+	// The canary is already running, so the next step starts as soon as its weight is applied
+	started := metav1.NewTime(now)
+	status.StepStartedAt = &started
+	status.Weight = steps[status.Step].Weight
+	status.Message = fmt.Sprintf("Rolling out %q at step %d/%d (%d%% of traffic)",
+		target, status.Step+1, len(steps), status.Weight)
+	controller.MarkFalseCondition(releaseBinding, ConditionRolledOut, ReasonRolloutProgressing, status.Message)
+
+	split, err = splitTraffic(stable, canaryServices, status.Weight)
+	if err != nil {
+		return rolloutResult{}, fmt.Errorf("failed to split traffic: %w", err)
+	}
+	result = rolloutResult{resources: split}
+	if steps[status.Step].Pause != nil {
+		result.requeueAfter = steps[status.Step].Pause.Duration
+	}
+	return result, nil
+}
+
+// renderStableResources renders the stable ComponentRelease with the binding's current overrides.
+// It returns nil when the ComponentRelease no longer exists.
+func (r *Reconciler) renderStableResources(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	releaseName string, environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) ([]map[string]any, error) {
+	stableRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      releaseName,
+		Namespace: releaseBinding.Namespace,
+	}, stableRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get stable ComponentRelease %q: %w", releaseName, err)
+	}
+
+	resources, _, err := r.renderResources(ctx, releaseBinding, stableRelease, environment, dataPlane, component, project)
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// applyCanaryRelease creates or updates the Release holding the canary resources
+func (r *Reconciler) applyCanaryRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	resources []map[string]any) (*openchoreov1alpha1.Release, error) {
+	releaseResources, err := r.convertToReleaseResources(resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert canary resources: %w", err)
+	}
+
+	canaryRelease := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      canaryReleaseName(releaseBinding),
+			Namespace: releaseBinding.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, canaryRelease, func() error {
+		if canaryRelease.UID != "" {
+			hasOwner, err := controllerutil.HasOwnerReference(canaryRelease.GetOwnerReferences(), releaseBinding, r.Scheme)
+			if err != nil {
+				return fmt.Errorf("failed to check owner reference: %w", err)
+			}
+			if !hasOwner {
+				return fmt.Errorf("release %q exists but is not owned by this ReleaseBinding", canaryRelease.Name)
+			}
+		}
+
+		// Same labels as the main Release so that finalization cleans it up
+		canaryRelease.Labels = map[string]string{
+			labels.LabelKeyOrganizationName: releaseBinding.Namespace,
+			labels.LabelKeyProjectName:      releaseBinding.Spec.Owner.ProjectName,
+			labels.LabelKeyComponentName:    releaseBinding.Spec.Owner.ComponentName,
+			labels.LabelKeyEnvironmentName:  releaseBinding.Spec.Environment,
+		}
+		canaryRelease.Spec = openchoreov1alpha1.ReleaseSpec{
+			Owner: openchoreov1alpha1.ReleaseOwner{
+				ProjectName:   releaseBinding.Spec.Owner.ProjectName,
+				ComponentName: releaseBinding.Spec.Owner.ComponentName,
+			},
+			EnvironmentName: releaseBinding.Spec.Environment,
+			TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
+			Resources:       releaseResources,
+		}
+		return controllerutil.SetControllerReference(releaseBinding, canaryRelease, r.Scheme)
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to reconcile canary Release: %v", err)
+		reason := ReasonReleaseUpdateFailed
+		if strings.Contains(err.Error(), "not owned by") {
+			reason = ReasonReleaseOwnershipConflict
+		}
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, reason, msg)
+		return nil, fmt.Errorf("failed to reconcile canary Release: %w", err)
+	}
+	return canaryRelease, nil
+}
+
+// deleteCanaryRelease removes the canary Release of the binding if it exists
+func (r *Reconciler) deleteCanaryRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) error {
+	canaryRelease := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      canaryReleaseName(releaseBinding),
+		Namespace: releaseBinding.Namespace,
+	}, canaryRelease); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	hasOwner, err := controllerutil.HasOwnerReference(canaryRelease.GetOwnerReferences(), releaseBinding, r.Scheme)
+	if err != nil || !hasOwner {
+		return err
+	}
+	if err := r.Delete(ctx, canaryRelease); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete canary Release: %w", err)
+	}
+	log.FromContext(ctx).Info("Deleted canary Release", "release", canaryRelease.Name)
+	return nil
+}
@@ -21,6 +21,10 @@ const (
 	// secretReferencesIndex is the field index name for SecretReference names used in ReleaseBinding
 	// This index tracks all SecretReferences from both ComponentRelease workload and ReleaseBinding workloadOverrides
 	secretReferencesIndex = "spec.secretReferences"
+
+	// connectionTargetsIndex is the field index name for the components targeted by the
+	// connections of the bound ComponentRelease, keyed as "{project}/{component}"
+	connectionTargetsIndex = "spec.connectionTargets"
 )
 
 // setupSecretReferencesIndex sets up the field index for SecretReference names used by ReleaseBinding.
@@ -156,3 +160,162 @@ func (r *Reconciler) findReleaseBindingsForComponent(ctx context.Context, obj cl
 	}
 	return requests
 }
+
+// findReleaseBindingsForEnvironment maps an Environment to the ReleaseBindings deployed to it
+func (r *Reconciler) findReleaseBindingsForEnvironment(ctx context.Context, obj client.Object) []ctrl.Request {
+	environment := obj.(*openchoreov1alpha1.Environment)
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, client.InNamespace(environment.Namespace)); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for Environment", "environment", environment.Name)
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, binding := range bindings.Items {
+		if binding.Spec.Environment != environment.Name {
+			continue
+		}
+		requests = append(requests, ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      binding.Name,
+				Namespace: binding.Namespace,
+			},
+		})
+	}
+	return requests
+}
+
+// setupConnectionTargetsIndex sets up the field index for the components a ReleaseBinding
+// connects to, keyed as "{project}/{component}". Connections are read from the bound ComponentRelease.
+func (r *Reconciler) setupConnectionTargetsIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		connectionTargetsIndex, func(obj client.Object) []string {
+			releaseBinding := obj.(*openchoreov1alpha1.ReleaseBinding)
+			logger := log.FromContext(ctx)
+
+			if releaseBinding.Spec.ReleaseName == "" {
+				return []string{}
+			}
+
+			componentRelease := &openchoreov1alpha1.ComponentRelease{}
+			if err := r.Get(ctx, types.NamespacedName{
+				Name:      releaseBinding.Spec.ReleaseName,
+				Namespace: releaseBinding.Namespace,
+			}, componentRelease); err != nil {
+				logger.Info("Failed to get ComponentRelease for index",
+					"releaseBinding", releaseBinding.Name,
+					"componentRelease", releaseBinding.Spec.ReleaseName,
+					"error", err)
+				return []string{}
+			}
+
+			targets := make([]string, 0, len(componentRelease.Spec.Workload.Connections))
+			for _, conn := range componentRelease.Spec.Workload.Connections {
+				target := connectionTargetFor(conn, releaseBinding.Spec.Owner.ProjectName)
+				if target.componentName != "" {
+					targets = append(targets, target.String())
+				}
+			}
+			return targets
+		})
+}
+
+// findReleaseBindingsForConnectionTarget maps a ReleaseBinding to the ReleaseBindings in the same
+// environment whose workload connects to its component.
+func (r *Reconciler) findReleaseBindingsForConnectionTarget(ctx context.Context, obj client.Object) []ctrl.Request {
+	target := obj.(*openchoreov1alpha1.ReleaseBinding)
+	key := connectionTarget{
+		projectName:   target.Spec.Owner.ProjectName,
+		componentName: target.Spec.Owner.ComponentName,
+	}
+	return r.connectionConsumerRequests(ctx, target.Namespace, key, target.Spec.Environment)
+}
+
+// findReleaseBindingsForConnectionTargetRelease maps a data plane Release to the ReleaseBindings in
+// the same environment whose workload connects to its component, since connections resolve the
+// port of the Service rendered into the Release.
+func (r *Reconciler) findReleaseBindingsForConnectionTargetRelease(ctx context.Context, obj client.Object) []ctrl.Request {
+	release := obj.(*openchoreov1alpha1.Release)
+	if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane {
+		return nil
+	}
+	key := connectionTarget{
+		projectName:   release.Spec.Owner.ProjectName,
+		componentName: release.Spec.Owner.ComponentName,
+	}
+	return r.connectionConsumerRequests(ctx, release.Namespace, key, release.Spec.EnvironmentName)
+}
+
+// connectionConsumerRequests returns reconcile requests for the ReleaseBindings in an environment
+// whose workload connects to the target component
+func (r *Reconciler) connectionConsumerRequests(ctx context.Context, namespace string, key connectionTarget,
+	environment string) []ctrl.Request {
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(namespace),
+		client.MatchingFields{connectionTargetsIndex: key.String()}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for connection target",
+			"target", key.String())
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, binding := range bindings.Items {
+		if binding.Spec.Environment != environment {
+			continue
+		}
+		requests = append(requests, ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      binding.Name,
+				Namespace: binding.Namespace,
+			},
+		})
+	}
+	return requests
+}
+
+// findReleaseBindingsForConnectionConsumer maps a ReleaseBinding to the ReleaseBindings in the same environment
+// of the components its workload connects to in other projects, whose network policies admit it.
+func (r *Reconciler) findReleaseBindingsForConnectionConsumer(ctx context.Context, obj client.Object) []ctrl.Request {
+	consumer := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if consumer.Spec.ReleaseName == "" {
+		return nil
+	}
+
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      consumer.Spec.ReleaseName,
+		Namespace: consumer.Namespace,
+	}, componentRelease); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, conn := range componentRelease.Spec.Workload.Connections {
+		target := connectionTargetFor(conn, consumer.Spec.Owner.ProjectName)
+		if target.componentName == "" || target.projectName == consumer.Spec.Owner.ProjectName {
+			continue
+		}
+		var bindings openchoreov1alpha1.ReleaseBindingList
+		if err := r.List(ctx, &bindings,
+			client.InNamespace(consumer.Namespace),
+			client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: target.componentName}); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for connection consumer",
+				"releaseBinding", consumer.Name)
+			return nil
+		}
+		for _, binding := range bindings.Items {
+			if binding.Spec.Owner.ProjectName != target.projectName || binding.Spec.Environment != consumer.Spec.Environment {
+				continue
+			}
+			requests = append(requests, ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      binding.Name,
+					Namespace: binding.Namespace,
+				},
+			})
+		}
+	}
+	return requests
+}
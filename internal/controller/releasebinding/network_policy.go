@@ -0,0 +1,241 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+const (
+	// namespaceNameLabel is the label Kubernetes sets on every namespace with its name
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+
+	// observabilityPlaneNamespace is the namespace of the observability plane, which scrapes the components
+	observabilityPlaneNamespace = "openchoreo-observability-plane"
+)
+
+// networkIsolation returns whether the component is isolated from other projects and the extra namespaces
+// its NetworkPolicy admits. Isolation is on when the environment or the project enables it.
+func networkIsolation(environment *openchoreov1alpha1.Environment, project *openchoreov1alpha1.Project) (bool, []string) {
+	enabled := false
+	seen := make(map[string]bool)
+	var namespaces []string
+	for _, isolation := range []*openchoreov1alpha1.NetworkIsolation{
+		environment.Spec.NetworkIsolation, project.Spec.NetworkIsolation,
+	} {
+		if isolation == nil || !isolation.Enabled {
+			continue
+		}
+		enabled = true
+		for _, namespace := range isolation.AllowedNamespaces {
+			if namespace != "" && !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+	sort.Strings(namespaces)
+	return enabled, namespaces
+}
+
+// makeNetworkPolicy builds the ingress NetworkPolicy that isolates the component's pods from other projects.
+// Components of the same project reach every port, since they share the data plane namespace. The data plane
+// gateway reaches the Public endpoints, which are the ones routed through it. The observability
+// plane and the allowed namespaces reach every port. Components of other projects reach the Organization and
+// Public endpoints only when they declare a connection to this component.
+func makeNetworkPolicy(metadata pipelinecontext.MetadataContext, endpoints map[string]openchoreov1alpha1.WorkloadEndpoint,
+	allowedNamespaces []string, consumers []networkingv1.NetworkPolicyPeer) (map[string]any, error) {
+	ingress := []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+	}}
+
+	if ports := endpointPorts(endpoints, openchoreov1alpha1.EndpointVisibility.ExposedThroughGateway); len(ports) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{namespaceNameLabel: dpkubernetes.SystemNamespace},
+				},
+			}},
+			Ports: ports,
+		})
+	}
+
+	trusted := make([]networkingv1.NetworkPolicyPeer, 0, len(allowedNamespaces)+1)
+	for _, namespace := range append([]string{observabilityPlaneNamespace}, allowedNamespaces...) {
+		trusted = append(trusted, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: namespace}},
+		})
+	}
+	ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{From: trusted})
+
+	sharedPorts := endpointPorts(endpoints, openchoreov1alpha1.EndpointVisibility.AllowsOtherProjects)
+	if len(sharedPorts) > 0 && len(consumers) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From:  consumers,
+			Ports: sharedPorts,
+		})
+	}
+
+	policy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      metadata.Name + "-ingress",
+			Namespace: metadata.Namespace,
+			Labels:    metadata.Labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: metadata.PodSelectors},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert NetworkPolicy: %w", err)
+	}
+	// Drop the empty fields the conversion keeps, so the Release matches what the API server stores
+	delete(obj, "status")
+	if objMeta, ok := obj["metadata"].(map[string]any); ok {
+		delete(objMeta, "creationTimestamp")
+	}
+	return obj, nil
+}
+
+// endpointPorts returns the ports of the endpoints whose visibility matches, in a stable order
+func endpointPorts(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint,
+	matches func(openchoreov1alpha1.EndpointVisibility) bool) []networkingv1.NetworkPolicyPort {
+	names := make([]string, 0, len(endpoints))
+	for name, endpoint := range endpoints {
+		if matches(endpoint.Visibility) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	seen := make(map[string]bool, len(names))
+	ports := make([]networkingv1.NetworkPolicyPort, 0, len(names))
+	for _, name := range names {
+		endpoint := endpoints[name]
+		protocol := corev1.ProtocolTCP
+		if endpoint.Type == openchoreov1alpha1.EndpointTypeUDP {
+			protocol = corev1.ProtocolUDP
+		}
+		key := fmt.Sprintf("%s/%d", protocol, endpoint.Port)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		port := intstr.FromInt32(endpoint.Port)
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
+	}
+	return ports
+}
+
+// findConnectionConsumers returns the pods of the components in other projects that declare a connection to
+// the component in the binding's environment. The consumers are looked up through the connection targets index,
+// or filtered in memory for clients that have no field indexes.
+func (r *Reconciler) findConnectionConsumers(ctx context.Context,
+	releaseBinding *openchoreov1alpha1.ReleaseBinding) ([]networkingv1.NetworkPolicyPeer, error) {
+	self := connectionTarget{
+		projectName:   releaseBinding.Spec.Owner.ProjectName,
+		componentName: releaseBinding.Spec.Owner.ComponentName,
+	}
+
+	opts := []client.ListOption{client.InNamespace(releaseBinding.Namespace)}
+	if !r.withoutFieldIndexes {
+		opts = append(opts, client.MatchingFields{connectionTargetsIndex: self.String()})
+	}
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list ReleaseBindings: %w", err)
+	}
+
+	var consumers []networkingv1.NetworkPolicyPeer
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.Spec.Environment != releaseBinding.Spec.Environment ||
+			binding.Spec.Owner.ProjectName == self.projectName ||
+			binding.Spec.ReleaseName == "" ||
+			!binding.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if r.withoutFieldIndexes {
+			connects, err := r.connectsTo(ctx, binding, self)
+			if err != nil {
+				return nil, err
+			}
+			if !connects {
+				continue
+			}
+		}
+
+		component := &openchoreov1alpha1.Component{}
+		if err := r.Get(ctx, types.NamespacedName{Name: binding.Spec.Owner.ComponentName, Namespace: binding.Namespace},
+			component); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get Component %q: %w", binding.Spec.Owner.ComponentName, err)
+		}
+
+		namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxNamespaceNameLength,
+			"dp", binding.Namespace, binding.Spec.Owner.ProjectName, binding.Spec.Environment)
+		consumers = append(consumers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: namespace}},
+			PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+				labels.LabelKeyComponentUID: string(component.UID),
+			}},
+		})
+	}
+
+	// Keep the rendered policy, and therefore the Release, deterministic
+	sort.Slice(consumers, func(i, j int) bool {
+		a, b := consumers[i], consumers[j]
+		if nsA, nsB := a.NamespaceSelector.MatchLabels[namespaceNameLabel], b.NamespaceSelector.MatchLabels[namespaceNameLabel]; nsA != nsB {
+			return nsA < nsB
+		}
+		return a.PodSelector.MatchLabels[labels.LabelKeyComponentUID] < b.PodSelector.MatchLabels[labels.LabelKeyComponentUID]
+	})
+	return consumers, nil
+}
+
+// connectsTo reports whether the workload of the binding's ComponentRelease declares a connection to the target
+func (r *Reconciler) connectsTo(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding,
+	target connectionTarget) (bool, error) {
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{Name: binding.Spec.ReleaseName, Namespace: binding.Namespace},
+		componentRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get ComponentRelease %q: %w", binding.Spec.ReleaseName, err)
+	}
+	for _, conn := range componentRelease.Spec.Workload.Connections {
+		if connectionTargetFor(conn, binding.Spec.Owner.ProjectName) == target {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+var _ = Describe("Network isolation", func() {
+	const namespace = "acme"
+
+	metadata := pipelinecontext.MetadataContext{
+		Name:         "ledger-production-1234",
+		Namespace:    "dp-acme-payments-production-1234",
+		Labels:       map[string]string{labels.LabelKeyComponentName: "ledger"},
+		PodSelectors: map[string]string{labels.LabelKeyComponentUID: "ledger-uid"},
+	}
+	endpoints := map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"admin":  {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 9000},
+		"api":    {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080, Visibility: openchoreov1alpha1.EndpointVisibilityOrganization},
+		"events": {Type: openchoreov1alpha1.EndpointTypeUDP, Port: 5000, Visibility: openchoreov1alpha1.EndpointVisibilityPublic},
+	}
+
+	toPolicy := func(obj map[string]any) *networkingv1.NetworkPolicy {
+		policy := &networkingv1.NetworkPolicy{}
+		Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj, policy)).To(Succeed())
+		return policy
+	}
+	portsOf := func(rule networkingv1.NetworkPolicyIngressRule) []string {
+		ports := make([]string, 0, len(rule.Ports))
+		for _, port := range rule.Ports {
+			ports = append(ports, string(*port.Protocol)+"/"+port.Port.String())
+		}
+		return ports
+	}
+
+	It("should only admit the project, the gateway, the trusted namespaces and declared consumers", func() {
+		consumer := networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "dp-acme-shop-production-5678"}},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{labels.LabelKeyComponentUID: "checkout-uid"}},
+		}
+		obj, err := makeNetworkPolicy(metadata, endpoints, []string{"monitoring"}, []networkingv1.NetworkPolicyPeer{consumer})
+		Expect(err).NotTo(HaveOccurred())
+		policy := toPolicy(obj)
+
+		Expect(policy.Name).To(Equal("ledger-production-1234-ingress"))
+		Expect(policy.Spec.PodSelector.MatchLabels).To(Equal(metadata.PodSelectors))
+		Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+		Expect(policy.Spec.Ingress).To(HaveLen(4))
+
+		// The project's own namespace reaches every port
+		Expect(policy.Spec.Ingress[0].From).To(ConsistOf(networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{}}))
+		Expect(policy.Spec.Ingress[0].Ports).To(BeEmpty())
+
+		// The gateway reaches the public endpoints only, never the project-internal ones
+		Expect(policy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels).
+			To(HaveKeyWithValue(namespaceNameLabel, dpkubernetes.SystemNamespace))
+		Expect(portsOf(policy.Spec.Ingress[1])).To(Equal([]string{"UDP/5000"}))
+		Expect(portsOf(policy.Spec.Ingress[1])).NotTo(ContainElement("TCP/9000"))
+
+		// The observability plane and the allowed namespaces reach every port
+		namespaces := make([]string, 0, len(policy.Spec.Ingress[2].From))
+		for _, peer := range policy.Spec.Ingress[2].From {
+			Expect(peer.PodSelector).To(BeNil())
+			namespaces = append(namespaces, peer.NamespaceSelector.MatchLabels[namespaceNameLabel])
+		}
+		Expect(namespaces).To(Equal([]string{observabilityPlaneNamespace, "monitoring"}))
+		Expect(policy.Spec.Ingress[2].Ports).To(BeEmpty())
+
+		// Consumers from other projects reach the organization and public endpoints only
+		Expect(policy.Spec.Ingress[3].From).To(ConsistOf(consumer))
+		Expect(portsOf(policy.Spec.Ingress[3])).To(Equal([]string{"TCP/8080", "UDP/5000"}))
+	})
+
+	It("should deny the gateway and other projects when the component has only project endpoints", func() {
+		obj, err := makeNetworkPolicy(metadata, map[string]openchoreov1alpha1.WorkloadEndpoint{"admin": endpoints["admin"]}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		policy := toPolicy(obj)
+		Expect(policy.Spec.Ingress).To(HaveLen(2))
+		for _, rule := range policy.Spec.Ingress {
+			for _, peer := range rule.From {
+				if peer.NamespaceSelector != nil {
+					Expect(peer.NamespaceSelector.MatchLabels).
+						NotTo(HaveKeyWithValue(namespaceNameLabel, dpkubernetes.SystemNamespace))
+				}
+			}
+		}
+	})
+
+	It("should isolate only when the environment or the project opts in", func() {
+		environment := &openchoreov1alpha1.Environment{}
+		project := &openchoreov1alpha1.Project{}
+		enabled, namespaces := networkIsolation(environment, project)
+		Expect(enabled).To(BeFalse())
+		Expect(namespaces).To(BeEmpty())
+
+		environment.Spec.NetworkIsolation = &openchoreov1alpha1.NetworkIsolation{
+			Enabled: true, AllowedNamespaces: []string{"tracing", "monitoring"},
+		}
+		project.Spec.NetworkIsolation = &openchoreov1alpha1.NetworkIsolation{
+			Enabled: true, AllowedNamespaces: []string{"monitoring", "batch"},
+		}
+		enabled, namespaces = networkIsolation(environment, project)
+		Expect(enabled).To(BeTrue())
+		Expect(namespaces).To(Equal([]string{"batch", "monitoring", "tracing"}))
+
+		// A disabled setting contributes no namespaces
+		environment.Spec.NetworkIsolation.Enabled = false
+		enabled, namespaces = networkIsolation(environment, project)
+		Expect(enabled).To(BeTrue())
+		Expect(namespaces).To(Equal([]string{"batch", "monitoring"}))
+	})
+
+	DescribeTable("should find the components of other projects that connect to the component", func(indexed bool) {
+		binding := func(project, component, release string) *openchoreov1alpha1.ReleaseBinding {
+			return &openchoreov1alpha1.ReleaseBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: component + "-production", Namespace: namespace},
+				Spec: openchoreov1alpha1.ReleaseBindingSpec{
+					Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: component},
+					Environment: "production",
+					ReleaseName: release,
+				},
+			}
+		}
+		componentRelease := func(name string, connections map[string]openchoreov1alpha1.WorkloadConnection) *openchoreov1alpha1.ComponentRelease {
+			return &openchoreov1alpha1.ComponentRelease{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: openchoreov1alpha1.ComponentReleaseSpec{
+					Workload: openchoreov1alpha1.WorkloadTemplateSpec{Connections: connections},
+				},
+			}
+		}
+		component := func(name string) *openchoreov1alpha1.Component {
+			return &openchoreov1alpha1.Component{ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: namespace, UID: types.UID(name + "-uid"),
+			}}
+		}
+		toLedger := map[string]openchoreov1alpha1.WorkloadConnection{"ledger": {
+			Type:   openchoreov1alpha1.ConnectionTypeAPI,
+			Params: map[string]string{connectionParamProjectName: "payments", connectionParamComponentName: "ledger"},
+		}}
+
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+		builder := fake.NewClientBuilder().WithScheme(scheme)
+		if indexed {
+			// Index the bindings the way the manager would, from the ComponentRelease connections
+			targets := map[string][]string{"checkout-1": {"payments/ledger"}, "billing-1": {"payments/ledger"}}
+			builder = builder.WithIndex(&openchoreov1alpha1.ReleaseBinding{}, connectionTargetsIndex,
+				func(obj client.Object) []string {
+					return targets[obj.(*openchoreov1alpha1.ReleaseBinding).Spec.ReleaseName]
+				})
+		}
+		k8sClient := builder.WithObjects(
+			binding("shop", "checkout", "checkout-1"), componentRelease("checkout-1", toLedger), component("checkout"),
+			binding("shop", "catalog", "catalog-1"), componentRelease("catalog-1", nil), component("catalog"),
+			binding("payments", "billing", "billing-1"), componentRelease("billing-1", toLedger), component("billing"),
+		).Build()
+		reconciler := &Reconciler{Client: k8sClient, Scheme: scheme, withoutFieldIndexes: !indexed}
+
+		consumers, err := reconciler.findConnectionConsumers(context.Background(), binding("payments", "ledger", "ledger-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(consumers).To(HaveLen(1))
+		Expect(consumers[0].PodSelector.MatchLabels).To(HaveKeyWithValue(labels.LabelKeyComponentUID, "checkout-uid"))
+		Expect(consumers[0].NamespaceSelector.MatchLabels[namespaceNameLabel]).To(HavePrefix("dp-acme-shop-production-"))
+	},
+		Entry("through the connection targets index", true),
+		Entry("without field indexes", false),
+	)
+})
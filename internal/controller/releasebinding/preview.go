@@ -0,0 +1,172 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Preview holds the Release resources a ReleaseBinding renders to, without anything being applied
+type Preview struct {
+	// DataPlaneReleaseName is the name of the Release that holds the data plane resources
+	DataPlaneReleaseName string
+	// DataPlaneResources are the rendered data plane resources
+	DataPlaneResources []openchoreov1alpha1.Resource
+	// ObservabilityReleaseName is the name of the Release that holds the observability plane resources
+	ObservabilityReleaseName string
+	// ObservabilityResources are the rendered observability plane resources
+	ObservabilityResources []openchoreov1alpha1.Resource
+	// CanaryReleaseName is the name of the Release that holds the canary resources of a rollout
+	CanaryReleaseName string
+	// CanaryResources are the canary resources, empty when the release is not rolled out progressively
+	CanaryResources []openchoreov1alpha1.Resource
+}
+
+// RenderPreview renders a ReleaseBinding against a ComponentRelease the same way a reconcile does,
+// but without creating or updating any Release. Neither argument is modified. When the binding is
+// rolled out progressively, the resources are those of the rollout step the next reconcile applies.
+// The reconciler's client does not need to be backed by the manager's cache.
+func (r *Reconciler) RenderPreview(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease) (*Preview, error) {
+	renderer := *r
+	renderer.withoutFieldIndexes = true
+	return renderer.renderPreview(ctx, releaseBinding.DeepCopy(), componentRelease.DeepCopy())
+}
+
+func (r *Reconciler) renderPreview(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease) (*Preview, error) {
+
+	if err := r.validateComponentRelease(componentRelease, binding); err != nil {
+		return nil, fmt.Errorf("invalid component release configuration: %w", err)
+	}
+
+	environment := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: binding.Spec.Environment, Namespace: binding.Namespace}, environment); err != nil {
+		return nil, fmt.Errorf("failed to get environment %q: %w", binding.Spec.Environment, err)
+	}
+	if environment.Spec.DataPlaneRef == "" {
+		return nil, fmt.Errorf("environment %q has no DataPlaneRef configured", environment.Name)
+	}
+
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	if err := r.Get(ctx, types.NamespacedName{Name: environment.Spec.DataPlaneRef, Namespace: binding.Namespace}, dataPlane); err != nil {
+		return nil, fmt.Errorf("failed to get dataplane %q: %w", environment.Spec.DataPlaneRef, err)
+	}
+
+	component := &openchoreov1alpha1.Component{}
+	if err := r.Get(ctx, types.NamespacedName{Name: componentRelease.Spec.Owner.ComponentName, Namespace: binding.Namespace}, component); err != nil {
+		return nil, fmt.Errorf("failed to get component %q: %w", componentRelease.Spec.Owner.ComponentName, err)
+	}
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{Name: componentRelease.Spec.Owner.ProjectName, Namespace: binding.Namespace}, project); err != nil {
+		return nil, fmt.Errorf("failed to get project %q: %w", componentRelease.Spec.Owner.ProjectName, err)
+	}
+
+	dataPlaneResources, observabilityPlaneResources, err := r.renderResources(ctx, binding,
+		componentRelease, environment, dataPlane, component, project)
+	if err != nil {
+		return nil, err
+	}
+
+	mainResources, canaryResources, err := r.previewRollout(ctx, binding, environment, dataPlane, component, project,
+		dataPlaneResources)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneReleaseResources, err := r.convertToReleaseResources(mainResources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert dataplane resources: %w", err)
+	}
+	observabilityReleaseResources, err := r.convertToReleaseResources(observabilityPlaneResources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert observability plane resources: %w", err)
+	}
+	canaryReleaseResources, err := r.convertToReleaseResources(canaryResources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert canary resources: %w", err)
+	}
+
+	componentName := componentRelease.Spec.Owner.ComponentName
+	return &Preview{
+		DataPlaneReleaseName:     fmt.Sprintf("%s-%s", componentName, binding.Spec.Environment),
+		DataPlaneResources:       dataPlaneReleaseResources,
+		ObservabilityReleaseName: fmt.Sprintf("%s-%s-observability", componentName, binding.Spec.Environment),
+		ObservabilityResources:   observabilityReleaseResources,
+		CanaryReleaseName:        canaryReleaseName(binding),
+		CanaryResources:          canaryReleaseResources,
+	}, nil
+}
+
+// previewRollout shapes the rendered dataplane resources the way reconcileRollout does for the next
+// reconcile, without touching the binding status or the canary Release. It returns the resources of
+// the main Release and those of the canary Release, if a rollout would run.
+func (r *Reconciler) previewRollout(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project,
+	resources []map[string]any) ([]map[string]any, []map[string]any, error) {
+	if extractWorkloadType(component.Spec.ComponentType) != WorkloadTypeDeployment {
+		return resources, nil, nil
+	}
+	stablePods, err := labelStablePods(resources)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to label stable resources: %w", err)
+	}
+
+	strategy := effectiveRolloutStrategy(binding, environment)
+	if strategy == nil {
+		return stablePods, nil, nil
+	}
+	target := binding.Spec.ReleaseName
+	status := binding.Status.Rollout
+	stableRelease := initialStableRelease(binding)
+	if status != nil {
+		stableRelease = status.StableRelease
+	}
+	if target == stableRelease {
+		return stablePods, nil, nil
+	}
+
+	stableResources, err := r.renderStableResources(ctx, binding, stableRelease, environment, dataPlane, component, project)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stableResources == nil {
+		return stablePods, nil, nil
+	}
+	stable, err := labelStablePods(stableResources)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to label stable resources: %w", err)
+	}
+	if status != nil && status.Phase == openchoreov1alpha1.RolloutPhaseAborted && status.CanaryRelease == target {
+		return stable, nil, nil
+	}
+	stable, err = restrictToStableTrack(stable)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to restrict stable services: %w", err)
+	}
+
+	// A running rollout of the same release stays at its step, any other starts at the first one
+	steps := rolloutSteps(strategy)
+	step := 0
+	if status != nil && status.Phase == openchoreov1alpha1.RolloutPhaseProgressing && status.CanaryRelease == target {
+		step = min(int(status.Step), len(steps)-1)
+	}
+
+	canary, canaryServices, err := canaryResources(resources)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build canary resources: %w", err)
+	}
+	split, err := splitTraffic(stable, canaryServices, steps[step].Weight)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to split traffic: %w", err)
+	}
+	return split, canary, nil
+}
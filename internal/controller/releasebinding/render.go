@@ -0,0 +1,101 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// Render renders the input the way a reconcile renders a ReleaseBinding, including the steps around the
+// pipeline render, so that renders outside the cluster show what will ship. The reconciler's client serves
+// the resources the steps look up and does not need field indexes. The binding and workload of the input
+// are modified; when the input has no binding, an empty binding of the component in the environment is used.
+func (r *Reconciler) Render(ctx context.Context, input *componentpipeline.RenderInput,
+	project *openchoreov1alpha1.Project) (*componentpipeline.RenderOutput, error) {
+	offline := *r
+	offline.withoutFieldIndexes = true
+
+	if input.ReleaseBinding == nil {
+		input.ReleaseBinding = &openchoreov1alpha1.ReleaseBinding{}
+		input.ReleaseBinding.Namespace = input.Component.Namespace
+		input.ReleaseBinding.Spec.Owner.ProjectName = input.Component.Spec.Owner.ProjectName
+		input.ReleaseBinding.Spec.Owner.ComponentName = input.Component.Name
+		input.ReleaseBinding.Spec.Environment = input.Environment.Name
+	}
+	if _, err := offline.prepareRender(ctx, input); err != nil {
+		return nil, err
+	}
+
+	output, err := offline.Pipeline.RenderWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataPlaneResources []map[string]any
+	for _, rendered := range output.Resources {
+		if rendered.TargetPlane == openchoreov1alpha1.TargetPlaneDataPlane {
+			dataPlaneResources = append(dataPlaneResources, rendered.Resource)
+		}
+	}
+	networkPolicy, err := offline.finishRender(ctx, input, project, dataPlaneResources)
+	if err != nil {
+		return nil, err
+	}
+	if networkPolicy != nil {
+		output.Resources = append(output.Resources, renderer.RenderedResource{
+			Resource:    networkPolicy,
+			TargetPlane: openchoreov1alpha1.TargetPlaneDataPlane,
+		})
+	}
+	return output, nil
+}
+
+// prepareRender adds to the render input what every render of a ReleaseBinding adds to the resources it is
+// rendered from: the default notification channel of the environment for the alert rule traits, and the
+// variables of the resolved connections in every container of the workload. The binding and the workload of
+// the input are modified. It returns the resolution, whose unresolved connections do not fail the render.
+func (r *Reconciler) prepareRender(ctx context.Context,
+	input *componentpipeline.RenderInput) (*connectionResolution, error) {
+	if err := r.applyDefaultNotificationChannel(ctx, input.ReleaseBinding, input.Component.Spec.Traits); err != nil {
+		return nil, fmt.Errorf("failed to apply default notification channel: %w", err)
+	}
+
+	connections, err := r.resolveConnections(ctx, input.ReleaseBinding, input.Workload, input.DataPlane)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve connections: %w", err)
+	}
+	injectConnectionEnv(input.Workload, connections.env)
+	return connections, nil
+}
+
+// finishRender completes the rendered data plane resources the way every render of a ReleaseBinding does.
+// The pod templates are stamped with the hash of the referenced secrets, so that workloads roll when a secret
+// changes in the environment. It returns the NetworkPolicy isolating the component when its environment or
+// project enables isolation, or nil.
+func (r *Reconciler) finishRender(ctx context.Context, input *componentpipeline.RenderInput,
+	project *openchoreov1alpha1.Project, dataPlaneResources []map[string]any) (map[string]any, error) {
+	environment := input.ReleaseBinding.Spec.Environment
+	if err := stampSecretHash(dataPlaneResources, secretContentHash(input.SecretReferences, environment)); err != nil {
+		return nil, fmt.Errorf("failed to stamp secret hash: %w", err)
+	}
+
+	isolated, allowedNamespaces := networkIsolation(input.Environment, project)
+	if !isolated {
+		return nil, nil
+	}
+	consumers, err := r.findConnectionConsumers(ctx, input.ReleaseBinding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find connection consumers: %w", err)
+	}
+	networkPolicy, err := makeNetworkPolicy(input.Metadata, input.Workload.Spec.Endpoints, allowedNamespaces, consumers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render network policy: %w", err)
+	}
+	return networkPolicy, nil
+}
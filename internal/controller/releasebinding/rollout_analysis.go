@@ -0,0 +1,192 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// componentHTTPMetricsPath is the observer endpoint serving the HTTP metrics of a component
+	componentHTTPMetricsPath = "/api/metrics/component/http"
+	// defaultMinAnalysisRequests is the number of canary requests judged when the analysis does not set one
+	defaultMinAnalysisRequests = 20
+)
+
+// analysisOutcome is the verdict of a rollout analysis step
+type analysisOutcome int
+
+const (
+	analysisPassed analysisOutcome = iota
+	analysisFailed
+	// analysisInconclusive means the canary has not served enough requests to be judged yet
+	analysisInconclusive
+)
+
+// httpMetricsRequest is the body of the observer's component HTTP metrics query
+type httpMetricsRequest struct {
+	ComponentName   string `json:"componentName"`
+	ComponentID     string `json:"componentId"`
+	EnvironmentName string `json:"environmentName"`
+	EnvironmentID   string `json:"environmentId"`
+	ProjectName     string `json:"projectName"`
+	ProjectID       string `json:"projectId"`
+	OrgName         string `json:"orgName"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	RolloutTrack    string `json:"rolloutTrack,omitempty"`
+}
+
+// metricPoint is a single sample of an observer time series
+type metricPoint struct {
+	Time  string  `json:"time"`
+	Value float64 `json:"value"`
+}
+
+// httpMetrics holds the observer time series used by rollout analysis.
+// Request counts are per second rates and latencies are in seconds.
+type httpMetrics struct {
+	LatencyPercentile99th    []metricPoint `json:"latencyPercentile99th"`
+	RequestCount             []metricPoint `json:"requestCount"`
+	UnsuccessfulRequestCount []metricPoint `json:"unsuccessfulRequestCount"`
+}
+
+// queryHTTPMetrics fetches the HTTP metrics of the canary pods of the component in the binding's
+// environment between start and end. Stable pods are left out so they don't dilute the canary's numbers.
+func (r *Reconciler) queryHTTPMetrics(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, component *openchoreov1alpha1.Component,
+	project *openchoreov1alpha1.Project, start, end time.Time) (*httpMetrics, error) {
+	body, err := json.Marshal(httpMetricsRequest{
+		ComponentName:   component.Name,
+		ComponentID:     string(component.UID),
+		EnvironmentName: environment.Name,
+		EnvironmentID:   string(environment.UID),
+		ProjectName:     project.Name,
+		ProjectID:       string(project.UID),
+		OrgName:         releaseBinding.Namespace,
+		StartTime:       start.UTC().Format(time.RFC3339),
+		EndTime:         end.UTC().Format(time.RFC3339),
+		RolloutTrack:    labels.LabelValueRolloutTrackCanary,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metrics request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPost,
+		controller.GetObserverBaseURL()+componentHTTPMetricsPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call observer metrics API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// Best-effort read of error body for diagnostics.
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("observer metrics API returned status %d: %s", resp.StatusCode, string(b))
+	}
+
+	metrics := &httpMetrics{}
+	if err := json.NewDecoder(resp.Body).Decode(metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode observer metrics response: %w", err)
+	}
+	return metrics, nil
+}
+
+// evaluateAnalysis checks the canary metrics observed over the window against the thresholds of the analysis.
+// It returns the verdict together with a summary of the observed values. The step is inconclusive until the
+// canary served the analysis' minimum number of requests, so that an idle canary is never promoted.
+func evaluateAnalysis(analysis *openchoreov1alpha1.RolloutAnalysis, metrics *httpMetrics,
+	window time.Duration) (analysisOutcome, string, error) {
+	minRequests := float64(analysis.MinRequests)
+	if minRequests <= 0 {
+		minRequests = defaultMinAnalysisRequests
+	}
+	// Request counts are per second rates sampled over the window
+	requests := meanPoints(metrics.RequestCount) * window.Seconds()
+	if requests < minRequests {
+		return analysisInconclusive, fmt.Sprintf("the canary served %.0f of the %.0f requests needed for analysis",
+			requests, minRequests), nil
+	}
+
+	errorRate := sumPoints(metrics.UnsuccessfulRequestCount) / sumPoints(metrics.RequestCount)
+	latency := time.Duration(maxPoint(metrics.LatencyPercentile99th) * float64(time.Second)).Round(time.Millisecond)
+	summary := fmt.Sprintf("error rate %.2f%%, p99 latency %s", errorRate*100, latency)
+
+	if analysis.MaxErrorRate != "" {
+		maxErrorRate, err := strconv.ParseFloat(analysis.MaxErrorRate, 64)
+		if err != nil {
+			return analysisFailed, "", fmt.Errorf("invalid maxErrorRate %q: %w", analysis.MaxErrorRate, err)
+		}
+		if errorRate > maxErrorRate {
+			return analysisFailed, fmt.Sprintf("%s exceeds the maximum error rate of %.2f%%", summary, maxErrorRate*100), nil
+		}
+	}
+	if analysis.MaxLatencyP99 != nil && latency > analysis.MaxLatencyP99.Duration {
+		return analysisFailed, fmt.Sprintf("%s exceeds the maximum p99 latency of %s", summary, analysis.MaxLatencyP99.Duration), nil
+	}
+	return analysisPassed, summary, nil
+}
+
+// sumPoints adds up the values of a time series, skipping missing samples
+func sumPoints(points []metricPoint) float64 {
+	var sum float64
+	for _, p := range points {
+		if !math.IsNaN(p.Value) && !math.IsInf(p.Value, 0) {
+			sum += p.Value
+		}
+	}
+	return sum
+}
+
+// meanPoints returns the average value of a time series, skipping missing samples
+func meanPoints(points []metricPoint) float64 {
+	var sum float64
+	var n int
+	for _, p := range points {
+		if !math.IsNaN(p.Value) && !math.IsInf(p.Value, 0) {
+			sum += p.Value
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// maxPoint returns the highest value of a time series, skipping missing samples
+func maxPoint(points []metricPoint) float64 {
+	var highest float64
+	for _, p := range points {
+		if !math.IsNaN(p.Value) && !math.IsInf(p.Value, 0) && p.Value > highest {
+			highest = p.Value
+		}
+	}
+	return highest
+}
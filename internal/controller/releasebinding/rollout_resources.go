@@ -0,0 +1,332 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	canaryNameSuffix = "canary"
+
+	gatewayAPIGroup      = "gateway.networking.k8s.io"
+	externalSecretsGroup = "external-secrets.io"
+)
+
+// canaryName returns the name of the canary copy of a resource
+func canaryName(name string) string {
+	if len(name)+len(canaryNameSuffix)+1 <= dpkubernetes.MaxServiceNameLength {
+		return name + "-" + canaryNameSuffix
+	}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, name, canaryNameSuffix)
+}
+
+// cloneResources deep copies rendered resources into plain JSON values
+func cloneResources(resources []map[string]any) ([]*unstructured.Unstructured, error) {
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+	var cloned []map[string]any
+	if err := json.Unmarshal(data, &cloned); err != nil {
+		return nil, err
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(cloned))
+	for _, obj := range cloned {
+		objs = append(objs, &unstructured.Unstructured{Object: obj})
+	}
+	return objs, nil
+}
+
+// toResourceMaps returns the objects as rendered resources
+func toResourceMaps(objs []*unstructured.Unstructured) []map[string]any {
+	resources := make([]map[string]any, 0, len(objs))
+	for _, obj := range objs {
+		resources = append(resources, obj.Object)
+	}
+	return resources
+}
+
+// isKind reports whether the object has the given API group and kind
+func isKind(obj *unstructured.Unstructured, group, kind string) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == group && gvk.Kind == kind
+}
+
+// setDeploymentTrack adds the rollout track label to the pod template of a Deployment, and to its selector
+// when asked to. Other resources are left alone.
+func setDeploymentTrack(obj *unstructured.Unstructured, track string, withSelector bool) error {
+	if !isKind(obj, appsAPIGroup, "Deployment") {
+		return nil
+	}
+	if withSelector {
+		if err := unstructured.SetNestedField(obj.Object, track,
+			"spec", "selector", "matchLabels", labels.LabelKeyRolloutTrack); err != nil {
+			return err
+		}
+	}
+	return unstructured.SetNestedField(obj.Object, track,
+		"spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack)
+}
+
+// setServiceTrack adds the rollout track label to the selector of a Service.
+// Services without a selector and other resources are left alone.
+func setServiceTrack(obj *unstructured.Unstructured, track string) error {
+	if !isKind(obj, "", "Service") {
+		return nil
+	}
+	selector, found, err := unstructured.NestedMap(obj.Object, "spec", "selector")
+	if err != nil || !found || len(selector) == 0 {
+		return err
+	}
+	return unstructured.SetNestedField(obj.Object, track, "spec", "selector", labels.LabelKeyRolloutTrack)
+}
+
+// labelStablePods puts the pods of the Deployments on the stable track. Deployment components carry the
+// label whether or not a rollout is running, so starting or finishing a rollout never changes the stable
+// pod template and restarts the stable pods. Deployment selectors are immutable and are left unchanged.
+func labelStablePods(resources []map[string]any) ([]map[string]any, error) {
+	objs, err := cloneResources(resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if err := setDeploymentTrack(obj, labels.LabelValueRolloutTrackStable, false); err != nil {
+			return nil, fmt.Errorf("failed to label deployment %q: %w", obj.GetName(), err)
+		}
+	}
+	return toResourceMaps(objs), nil
+}
+
+// restrictToStableTrack narrows the selectors of the stable Services to the stable pods while a canary
+// runs, so that they do not pick up canary pods. Changing a Service selector does not restart any pod.
+func restrictToStableTrack(resources []map[string]any) ([]map[string]any, error) {
+	objs, err := cloneResources(resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if err := setServiceTrack(obj, labels.LabelValueRolloutTrackStable); err != nil {
+			return nil, fmt.Errorf("failed to label service %q: %w", obj.GetName(), err)
+		}
+	}
+	return toResourceMaps(objs), nil
+}
+
+// canaryResources builds the canary copy of the rendered resources. The Deployments, Services,
+// ConfigMaps, Secrets and ExternalSecrets are renamed and moved to the canary track, and every
+// other resource is dropped since the stable release keeps serving it. It also returns the canary
+// Service name of every stable Service.
+func canaryResources(resources []map[string]any) ([]map[string]any, map[string]string, error) {
+	objs, err := cloneResources(resources)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Renamed resources, keyed by kind and original name
+	renames := make(map[string]string)
+	for _, obj := range objs {
+		switch {
+		case isKind(obj, appsAPIGroup, "Deployment"), isKind(obj, "", "Service"),
+			isKind(obj, "", "ConfigMap"), isKind(obj, "", "Secret"):
+			renames[obj.GetKind()+"/"+obj.GetName()] = canaryName(obj.GetName())
+		case isKind(obj, externalSecretsGroup, "ExternalSecret"):
+			renames[obj.GetKind()+"/"+obj.GetName()] = canaryName(obj.GetName())
+			target := externalSecretTarget(obj)
+			renames["Secret/"+target] = canaryName(target)
+		}
+	}
+
+	canary := make([]*unstructured.Unstructured, 0, len(objs))
+	services := make(map[string]string)
+	for _, obj := range objs {
+		name, ok := renames[obj.GetKind()+"/"+obj.GetName()]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case isKind(obj, appsAPIGroup, "Deployment"):
+			if err := setDeploymentTrack(obj, labels.LabelValueRolloutTrackCanary, true); err != nil {
+				return nil, nil, fmt.Errorf("failed to label deployment %q: %w", obj.GetName(), err)
+			}
+			if podSpec, ok := nestedObject(obj.Object, "spec", "template", "spec"); ok {
+				renamePodReferences(podSpec, renames)
+			}
+		case isKind(obj, "", "Service"):
+			if err := setServiceTrack(obj, labels.LabelValueRolloutTrackCanary); err != nil {
+				return nil, nil, fmt.Errorf("failed to label service %q: %w", obj.GetName(), err)
+			}
+			services[obj.GetName()] = name
+		case isKind(obj, externalSecretsGroup, "ExternalSecret"):
+			target := renames["Secret/"+externalSecretTarget(obj)]
+			if err := unstructured.SetNestedField(obj.Object, target, "spec", "target", "name"); err != nil {
+				return nil, nil, fmt.Errorf("failed to rename external secret target %q: %w", obj.GetName(), err)
+			}
+		}
+
+		obj.SetName(name)
+		canary = append(canary, obj)
+	}
+
+	return toResourceMaps(canary), services, nil
+}
+
+// externalSecretTarget returns the name of the Secret an ExternalSecret creates
+func externalSecretTarget(obj *unstructured.Unstructured) string {
+	if target, found, _ := unstructured.NestedString(obj.Object, "spec", "target", "name"); found && target != "" {
+		return target
+	}
+	return obj.GetName()
+}
+
+// nestedObject returns the map at the given path without copying it
+func nestedObject(obj map[string]any, fields ...string) (map[string]any, bool) {
+	current := obj
+	for _, field := range fields {
+		next, ok := current[field].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+// objectList returns the maps of a list field, skipping entries that are not objects
+func objectList(value any) []map[string]any {
+	list, _ := value.([]any)
+	objs := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		if obj, ok := item.(map[string]any); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// renamePodReferences points the ConfigMap and Secret references of a pod spec at the renamed resources
+func renamePodReferences(podSpec map[string]any, renames map[string]string) {
+	rename := func(parent map[string]any, key, field, kind string) {
+		ref, ok := parent[key].(map[string]any)
+		if !ok {
+			return
+		}
+		if name, ok := ref[field].(string); ok {
+			if renamed, ok := renames[kind+"/"+name]; ok {
+				ref[field] = renamed
+			}
+		}
+	}
+
+	for _, containers := range []string{"containers", "initContainers"} {
+		for _, container := range objectList(podSpec[containers]) {
+			for _, env := range objectList(container["env"]) {
+				if valueFrom, ok := env["valueFrom"].(map[string]any); ok {
+					rename(valueFrom, "configMapKeyRef", "name", "ConfigMap")
+					rename(valueFrom, "secretKeyRef", "name", "Secret")
+				}
+			}
+			for _, envFrom := range objectList(container["envFrom"]) {
+				rename(envFrom, "configMapRef", "name", "ConfigMap")
+				rename(envFrom, "secretRef", "name", "Secret")
+			}
+		}
+	}
+
+	for _, volume := range objectList(podSpec["volumes"]) {
+		rename(volume, "configMap", "name", "ConfigMap")
+		rename(volume, "secret", "secretName", "Secret")
+		if projected, ok := volume["projected"].(map[string]any); ok {
+			for _, source := range objectList(projected["sources"]) {
+				rename(source, "configMap", "name", "ConfigMap")
+				rename(source, "secret", "name", "Secret")
+			}
+		}
+	}
+}
+
+// splitTraffic sends the given percentage of the traffic of every HTTPRoute backend that is a stable
+// Service to its canary Service. The other backends of a split rule are scaled so that the relative
+// weights within the rule are preserved.
+func splitTraffic(resources []map[string]any, canaryServices map[string]string, weight int32) ([]map[string]any, error) {
+	objs, err := cloneResources(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objs {
+		if !isKind(obj, gatewayAPIGroup, "HTTPRoute") {
+			continue
+		}
+		spec, ok := nestedObject(obj.Object, "spec")
+		if !ok {
+			continue
+		}
+		for _, rule := range objectList(spec["rules"]) {
+			if refs, ok := splitBackendRefs(objectList(rule["backendRefs"]), canaryServices, int64(weight)); ok {
+				rule["backendRefs"] = refs
+			}
+		}
+	}
+	return toResourceMaps(objs), nil
+}
+
+// splitBackendRefs splits the stable Service backends of a single HTTPRoute rule.
+// It reports false when the rule has no backend to split.
+func splitBackendRefs(refs []map[string]any, canaryServices map[string]string, weight int64) ([]any, bool) {
+	canaryRef := func(ref map[string]any) (string, bool) {
+		kind, _ := ref["kind"].(string)
+		group, _ := ref["group"].(string)
+		if (kind != "" && kind != "Service") || group != "" {
+			return "", false
+		}
+		name, _ := ref["name"].(string)
+		canary, ok := canaryServices[name]
+		return canary, ok
+	}
+
+	hasCanary := false
+	for _, ref := range refs {
+		if _, ok := canaryRef(ref); ok {
+			hasCanary = true
+			break
+		}
+	}
+	if !hasCanary {
+		return nil, false
+	}
+
+	split := make([]any, 0, len(refs)+1)
+	for _, ref := range refs {
+		// Gateway API backends default to a weight of 1
+		base := int64(1)
+		if w, ok := ref["weight"].(float64); ok {
+			base = int64(w)
+		}
+
+		canary, ok := canaryRef(ref)
+		if !ok {
+			ref["weight"] = base * 100
+			split = append(split, ref)
+			continue
+		}
+
+		canaryBackend := make(map[string]any, len(ref))
+		for k, v := range ref {
+			canaryBackend[k] = v
+		}
+		canaryBackend["name"] = canary
+		canaryBackend["weight"] = base * weight
+		ref["weight"] = base * (100 - weight)
+		split = append(split, ref, canaryBackend)
+	}
+	return split, true
+}
@@ -0,0 +1,266 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var _ = Describe("Progressive rollout resources", func() {
+	var resources []map[string]any
+
+	BeforeEach(func() {
+		resources = []map[string]any{
+			{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": "api-dev-1a2b3c"},
+				"spec": map[string]any{
+					"selector": map[string]any{"matchLabels": map[string]any{"openchoreo.dev/component-uid": "c1"}},
+					"template": map[string]any{
+						"metadata": map[string]any{"labels": map[string]any{"openchoreo.dev/component-uid": "c1"}},
+						"spec": map[string]any{
+							"containers": []any{map[string]any{
+								"name":    "main",
+								"envFrom": []any{map[string]any{"configMapRef": map[string]any{"name": "api-env"}}},
+							}},
+							"volumes": []any{map[string]any{"name": "files", "secret": map[string]any{"secretName": "api-files"}}},
+						},
+					},
+				},
+			},
+			{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]any{"name": "api"},
+				"spec":       map[string]any{"selector": map[string]any{"openchoreo.dev/component-uid": "c1"}},
+			},
+			{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": "api-env"},
+			},
+			{
+				"apiVersion": "external-secrets.io/v1",
+				"kind":       "ExternalSecret",
+				"metadata":   map[string]any{"name": "api-files"},
+			},
+			{
+				"apiVersion": "gateway.networking.k8s.io/v1",
+				"kind":       "HTTPRoute",
+				"metadata":   map[string]any{"name": "api-dev-1a2b3c"},
+				"spec": map[string]any{
+					"rules": []any{map[string]any{
+						"backendRefs": []any{map[string]any{"name": "api", "port": 80}},
+					}},
+				},
+			},
+		}
+	})
+
+	It("should copy the workload into the canary track and drop routing", func() {
+		canary, services, err := canaryResources(resources)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(map[string]string{"api": "api-canary"}))
+		Expect(canary).To(HaveLen(4))
+
+		names := make([]string, 0, len(canary))
+		for _, res := range canary {
+			names = append(names, (&unstructured.Unstructured{Object: res}).GetName())
+		}
+		Expect(names).To(ConsistOf("api-dev-1a2b3c-canary", "api-canary", "api-env-canary", "api-files-canary"))
+
+		deployment := canary[0]
+		track, _, _ := unstructured.NestedString(deployment, "spec", "selector", "matchLabels", labels.LabelKeyRolloutTrack)
+		Expect(track).To(Equal(labels.LabelValueRolloutTrackCanary))
+		track, _, _ = unstructured.NestedString(deployment, "spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack)
+		Expect(track).To(Equal(labels.LabelValueRolloutTrackCanary))
+
+		podSpec, _ := nestedObject(deployment, "spec", "template", "spec")
+		container := objectList(podSpec["containers"])[0]
+		envFrom := objectList(container["envFrom"])[0]
+		Expect(envFrom["configMapRef"]).To(HaveKeyWithValue("name", "api-env-canary"))
+		volume := objectList(podSpec["volumes"])[0]
+		Expect(volume["secret"]).To(HaveKeyWithValue("secretName", "api-files-canary"))
+
+		target, _, _ := unstructured.NestedString(canary[3], "spec", "target", "name")
+		Expect(target).To(Equal("api-files-canary"))
+
+		selector, _, _ := unstructured.NestedStringMap(canary[1], "spec", "selector")
+		Expect(selector).To(HaveKeyWithValue(labels.LabelKeyRolloutTrack, labels.LabelValueRolloutTrackCanary))
+	})
+
+	It("should not modify the rendered resources", func() {
+		_, _, err := canaryResources(resources)
+		Expect(err).NotTo(HaveOccurred())
+		stable, err := labelStablePods(resources)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = restrictToStableTrack(stable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources[1]["metadata"]).To(HaveKeyWithValue("name", "api"))
+		Expect(resources[1]["spec"]).To(Equal(map[string]any{
+			"selector": map[string]any{"openchoreo.dev/component-uid": "c1"},
+		}))
+		_, found, _ := unstructured.NestedString(resources[0], "spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should label stable pods without touching the deployment selector or services", func() {
+		stable, err := labelStablePods(resources)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, found, _ := unstructured.NestedString(stable[0], "spec", "selector", "matchLabels", labels.LabelKeyRolloutTrack)
+		Expect(found).To(BeFalse())
+		track, _, _ := unstructured.NestedString(stable[0], "spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack)
+		Expect(track).To(Equal(labels.LabelValueRolloutTrackStable))
+		_, found, _ = unstructured.NestedString(stable[1], "spec", "selector", labels.LabelKeyRolloutTrack)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should restrict stable services to stable pods without touching the pod template", func() {
+		restricted, err := restrictToStableTrack(resources)
+		Expect(err).NotTo(HaveOccurred())
+
+		selector, _, _ := unstructured.NestedStringMap(restricted[1], "spec", "selector")
+		Expect(selector).To(HaveKeyWithValue(labels.LabelKeyRolloutTrack, labels.LabelValueRolloutTrackStable))
+		Expect(restricted[0]).To(Equal(resources[0]))
+	})
+
+	It("should split the HTTPRoute backends by weight", func() {
+		split, err := splitTraffic(resources, map[string]string{"api": "api-canary"}, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		rules, _, _ := unstructured.NestedSlice(split[4], "spec", "rules")
+		refs := objectList(rules[0].(map[string]any)["backendRefs"])
+		Expect(refs).To(HaveLen(2))
+		Expect(refs[0]).To(HaveKeyWithValue("name", "api"))
+		Expect(refs[0]).To(HaveKeyWithValue("weight", int64(90)))
+		Expect(refs[1]).To(HaveKeyWithValue("name", "api-canary"))
+		Expect(refs[1]).To(HaveKeyWithValue("weight", int64(10)))
+	})
+
+	It("should keep the relative weights of other backends", func() {
+		refs := []map[string]any{
+			{"name": "api", "weight": float64(3)},
+			{"name": "legacy", "weight": float64(1)},
+		}
+		split, ok := splitBackendRefs(refs, map[string]string{"api": "api-canary"}, 50)
+		Expect(ok).To(BeTrue())
+		Expect(split).To(HaveLen(3))
+		Expect(split[0]).To(HaveKeyWithValue("weight", int64(150)))
+		Expect(split[1]).To(HaveKeyWithValue("weight", int64(150)))
+		Expect(split[2]).To(HaveKeyWithValue("weight", int64(100)))
+	})
+
+	It("should leave rules without stable services alone", func() {
+		refs := []map[string]any{{"name": "other"}}
+		_, ok := splitBackendRefs(refs, map[string]string{"api": "api-canary"}, 50)
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Rollout strategy", func() {
+	It("should prefer the binding's strategy over the environment's", func() {
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		env := &openchoreov1alpha1.Environment{}
+		env.Spec.Rollout = &openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyBlueGreen}
+		Expect(effectiveRolloutStrategy(binding, env)).To(Equal(env.Spec.Rollout))
+
+		binding.Spec.Rollout = &openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyCanary}
+		Expect(effectiveRolloutStrategy(binding, env)).To(Equal(binding.Spec.Rollout))
+	})
+
+	It("should default the steps by strategy type", func() {
+		Expect(rolloutSteps(&openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyCanary})).
+			To(Equal(defaultCanarySteps))
+		Expect(rolloutSteps(&openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyBlueGreen})).
+			To(Equal(defaultBlueGreenSteps))
+	})
+
+	It("should roll out from the previous release only when it was ready", func() {
+		binding := &openchoreov1alpha1.ReleaseBinding{
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{ReleaseName: "api-2"},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{History: []openchoreov1alpha1.ReleaseHistoryEntry{
+				{ReleaseName: "api-2"},
+				{ReleaseName: "api-1", Ready: true},
+			}},
+		}
+		Expect(initialStableRelease(binding)).To(Equal("api-1"))
+
+		binding.Status.History[1].Ready = false
+		Expect(initialStableRelease(binding)).To(Equal("api-2"))
+
+		binding.Status.History[0].Ready = true
+		binding.Status.History[1].Ready = true
+		Expect(initialStableRelease(binding)).To(Equal("api-2"))
+	})
+})
+
+var _ = Describe("Rollout analysis", func() {
+	point := func(v float64) []metricPoint {
+		return []metricPoint{{Time: "2025-01-01T00:00:00Z", Value: v}}
+	}
+	analysis := &openchoreov1alpha1.RolloutAnalysis{
+		MaxErrorRate:  "0.05",
+		MaxLatencyP99: &metav1.Duration{Duration: 500 * time.Millisecond},
+		MinRequests:   100,
+	}
+	window := time.Minute
+
+	It("should pass when the metrics are within the thresholds", func() {
+		outcome, summary, err := evaluateAnalysis(analysis, &httpMetrics{
+			RequestCount:             point(100),
+			UnsuccessfulRequestCount: point(1),
+			LatencyPercentile99th:    point(0.2),
+		}, window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome).To(Equal(analysisPassed))
+		Expect(summary).To(Equal("error rate 1.00%, p99 latency 200ms"))
+	})
+
+	It("should fail when the error rate is too high", func() {
+		outcome, summary, err := evaluateAnalysis(analysis, &httpMetrics{
+			RequestCount:             point(100),
+			UnsuccessfulRequestCount: point(10),
+		}, window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome).To(Equal(analysisFailed))
+		Expect(summary).To(ContainSubstring("maximum error rate"))
+	})
+
+	It("should fail when the latency is too high", func() {
+		outcome, summary, err := evaluateAnalysis(analysis, &httpMetrics{
+			RequestCount:          point(100),
+			LatencyPercentile99th: point(1.5),
+		}, window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome).To(Equal(analysisFailed))
+		Expect(summary).To(ContainSubstring("maximum p99 latency"))
+	})
+
+	It("should wait for traffic when no requests were observed", func() {
+		outcome, _, err := evaluateAnalysis(analysis, &httpMetrics{}, window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome).To(Equal(analysisInconclusive))
+	})
+
+	It("should wait for traffic until the minimum number of requests was served", func() {
+		// One request per second over a minute is below the minimum of 100
+		outcome, summary, err := evaluateAnalysis(analysis, &httpMetrics{
+			RequestCount:             point(1),
+			UnsuccessfulRequestCount: point(1),
+		}, window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome).To(Equal(analysisInconclusive))
+		Expect(summary).To(Equal("the canary served 60 of the 100 requests needed for analysis"))
+	})
+})
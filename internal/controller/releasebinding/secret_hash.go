@@ -0,0 +1,78 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// podTemplatePaths are the paths to the pod templates of the workload kinds that roll on a template change.
+// Jobs are left out as their pod template is immutable.
+var podTemplatePaths = map[string][]string{
+	"Deployment":  {"spec", "template"},
+	"StatefulSet": {"spec", "template"},
+	"DaemonSet":   {"spec", "template"},
+	"CronJob":     {"spec", "jobTemplate", "spec", "template"},
+}
+
+// secretContentHash combines the content hashes that the SecretReferences report for an environment.
+// It is empty when none of them has synced content there yet.
+func secretContentHash(secretReferences map[string]*openchoreov1alpha1.SecretReference, environment string) string {
+	names := make([]string, 0, len(secretReferences))
+	for name := range secretReferences {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	found := false
+	for _, name := range names {
+		for _, status := range secretReferences[name].Status.Environments {
+			if status.Environment == environment && status.ContentHash != "" {
+				fmt.Fprintf(h, "%s=%s\n", name, status.ContentHash)
+				found = true
+			}
+		}
+	}
+	if !found {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// stampSecretHash sets the secret hash annotation on the pod templates of the rendered workloads,
+// so that their pods are replaced when a referenced secret is rotated
+func stampSecretHash(resources []map[string]any, hash string) error {
+	if hash == "" {
+		return nil
+	}
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{Object: resource}
+		path, ok := podTemplatePaths[obj.GetKind()]
+		if !ok {
+			continue
+		}
+		annotationsPath := append(append([]string{}, path...), "metadata", "annotations")
+		annotations, _, err := unstructured.NestedStringMap(obj.Object, annotationsPath...)
+		if err != nil {
+			return fmt.Errorf("invalid pod template annotations in %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[controller.AnnotationKeySecretHash] = hash
+		if err := unstructured.SetNestedStringMap(obj.Object, annotations, annotationsPath...); err != nil {
+			return fmt.Errorf("failed to set pod template annotations in %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var _ = Describe("Secret hash", func() {
+	secretRef := func(hashes map[string]string) *openchoreov1alpha1.SecretReference {
+		ref := &openchoreov1alpha1.SecretReference{}
+		for env, hash := range hashes {
+			ref.Status.Environments = append(ref.Status.Environments,
+				openchoreov1alpha1.SecretReferenceEnvironmentStatus{Environment: env, ContentHash: hash})
+		}
+		return ref
+	}
+
+	It("should combine the content hashes of the environment only", func() {
+		refs := map[string]*openchoreov1alpha1.SecretReference{
+			"db":  secretRef(map[string]string{"dev": "aaa", "prod": "bbb"}),
+			"api": secretRef(map[string]string{"dev": "ccc"}),
+		}
+		devHash := secretContentHash(refs, "dev")
+		Expect(devHash).To(HaveLen(16))
+		Expect(secretContentHash(refs, "prod")).NotTo(Equal(devHash))
+		Expect(secretContentHash(refs, "staging")).To(BeEmpty())
+
+		refs["db"] = secretRef(map[string]string{"dev": "aaa", "prod": "rotated"})
+		Expect(secretContentHash(refs, "dev")).To(Equal(devHash))
+		refs["db"] = secretRef(map[string]string{"dev": "rotated", "prod": "rotated"})
+		Expect(secretContentHash(refs, "dev")).NotTo(Equal(devHash))
+	})
+
+	It("should stamp the pod templates of rolling workloads", func() {
+		resources := []map[string]any{
+			{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": "api"},
+				"spec": map[string]any{"template": map[string]any{
+					"metadata": map[string]any{"annotations": map[string]any{"team": "a"}},
+				}},
+			},
+			{
+				"apiVersion": "batch/v1",
+				"kind":       "CronJob",
+				"metadata":   map[string]any{"name": "cleanup"},
+				"spec":       map[string]any{},
+			},
+			{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]any{"name": "migrate"},
+				"spec":       map[string]any{"template": map[string]any{}},
+			},
+		}
+		Expect(stampSecretHash(resources, "0123456789abcdef")).To(Succeed())
+
+		annotations, _, _ := unstructured.NestedStringMap(resources[0], "spec", "template", "metadata", "annotations")
+		Expect(annotations).To(Equal(map[string]string{"team": "a", controller.AnnotationKeySecretHash: "0123456789abcdef"}))
+		annotations, _, _ = unstructured.NestedStringMap(resources[1], "spec", "jobTemplate", "spec", "template", "metadata", "annotations")
+		Expect(annotations).To(HaveKeyWithValue(controller.AnnotationKeySecretHash, "0123456789abcdef"))
+		Expect(resources[2]["spec"]).To(Equal(map[string]any{"template": map[string]any{}}))
+	})
+
+	It("should leave workloads unchanged without a hash", func() {
+		resources := []map[string]any{{"kind": "Deployment", "spec": map[string]any{}}}
+		Expect(stampSecretHash(resources, "")).To(Succeed())
+		Expect(resources[0]["spec"]).To(BeEmpty())
+	})
+})
@@ -0,0 +1,95 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderpolicy
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+// Reconciler reports whether the rules of a RenderPolicy compile in the Ready condition
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Pipeline compiles the rules in the CEL environment they are evaluated in on render
+	Pipeline *componentpipeline.Pipeline
+}
+
+// ClusterReconciler reports whether the rules of a ClusterRenderPolicy compile in the Ready condition
+type ClusterReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Pipeline compiles the rules in the CEL environment they are evaluated in on render
+	Pipeline *componentpipeline.Pipeline
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderpolicies;clusterrenderpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderpolicies/status;clusterrenderpolicies/status,verbs=get;update;patch
+
+// Reconcile validates the rules of a RenderPolicy
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &openchoreov1alpha1.RenderPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	old := policy.DeepCopy()
+	setReadyCondition(r.Pipeline, policy, &policy.Spec)
+	return ctrl.Result{}, controller.UpdateStatusConditions(ctx, r.Client, old, policy)
+}
+
+// Reconcile validates the rules of a ClusterRenderPolicy
+func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &openchoreov1alpha1.ClusterRenderPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	old := policy.DeepCopy()
+	setReadyCondition(r.Pipeline, policy, &policy.Spec)
+	return ctrl.Result{}, controller.UpdateStatusConditions(ctx, r.Client, old, policy)
+}
+
+// setReadyCondition marks the policy ready when all of its rules compile. Policies that are not ready
+// are skipped when components are rendered.
+func setReadyCondition(pipeline *componentpipeline.Pipeline, policy controller.ConditionedObject,
+	spec *openchoreov1alpha1.RenderPolicySpec) {
+	if err := pipeline.ValidateRenderPolicy(spec); err != nil {
+		controller.MarkFalseCondition(policy, ConditionReady, ReasonInvalidRules,
+			"Policy is not enforced: "+err.Error())
+		return
+	}
+	controller.MarkTrueCondition(policy, ConditionReady, ReasonRulesValid, "All rules compile")
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Pipeline == nil {
+		r.Pipeline = componentpipeline.NewPipeline()
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.RenderPolicy{}).
+		Named("renderpolicy").
+		Complete(r)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Pipeline == nil {
+		r.Pipeline = componentpipeline.NewPipeline()
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.ClusterRenderPolicy{}).
+		Named("clusterrenderpolicy").
+		Complete(r)
+}
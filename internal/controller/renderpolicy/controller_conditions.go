@@ -0,0 +1,22 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderpolicy
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+const (
+	// ConditionReady indicates whether the rules of the policy compile, so that the policy is enforced
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+const (
+	// ReasonRulesValid indicates every rule of the policy compiles
+	ReasonRulesValid controller.ConditionReason = "RulesValid"
+	// ReasonInvalidRules indicates a rule of the policy does not compile, and the policy is skipped on render
+	ReasonInvalidRules controller.ConditionReason = "InvalidRules"
+)
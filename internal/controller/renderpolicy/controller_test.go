@@ -0,0 +1,65 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderpolicy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+var _ = Describe("RenderPolicy Controller", func() {
+	spec := func(expression string) openchoreov1alpha1.RenderPolicySpec {
+		return openchoreov1alpha1.RenderPolicySpec{
+			Mode:  openchoreov1alpha1.RenderPolicyModeEnforce,
+			Rules: []openchoreov1alpha1.RenderPolicyRule{{Name: "replicas", Expression: expression}},
+		}
+	}
+
+	It("marks a RenderPolicy with compiling rules ready", func() {
+		policy := &openchoreov1alpha1.RenderPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid-policy", Namespace: "default"},
+			Spec:       spec("${resource.kind != 'Deployment' || resource.spec.replicas >= 2}"),
+		}
+		Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, policy)
+
+		reconciler := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Pipeline: componentpipeline.NewPipeline()}
+		key := types.NamespacedName{Name: policy.Name, Namespace: policy.Namespace}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, key, policy)).To(Succeed())
+		ready := meta.FindStatusCondition(policy.Status.Conditions, string(ConditionReady))
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("reports the rules of a ClusterRenderPolicy that do not compile", func() {
+		policy := &openchoreov1alpha1.ClusterRenderPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-policy"},
+			Spec:       spec("${resource.spec.replicas >}"),
+		}
+		Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, policy)
+
+		reconciler := &ClusterReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Pipeline: componentpipeline.NewPipeline()}
+		key := types.NamespacedName{Name: policy.Name}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, key, policy)).To(Succeed())
+		ready := meta.FindStatusCondition(policy.Status.Conditions, string(ConditionReady))
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(string(ReasonInvalidRules)))
+		Expect(ready.Message).To(ContainSubstring(`rule "replicas"`))
+	})
+})
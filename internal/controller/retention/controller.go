@@ -0,0 +1,345 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/componentworkflowrun"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// ResyncInterval is how often a project with a retention policy is pruned when nothing changes,
+// so that MaxAge limits are enforced without new builds or releases.
+const ResyncInterval = time.Hour
+
+// Reconciler prunes the ComponentReleases, ComponentWorkflowRuns and WorkflowRuns of a project
+// according to the retention policies of the project and its components' ComponentTypes.
+// Runs are deleted normally, so their finalizers clean up the rendered resources on the build plane.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=projects;components;componenttypes;releasebindings;promotionrequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentworkflowruns,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=get;list;watch;delete
+
+// Reconcile prunes the history of a single project
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, req.NamespacedName, project); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !project.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	components := &openchoreov1alpha1.ComponentList{}
+	if err := r.List(ctx, components, client.InNamespace(project.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list components: %w", err)
+	}
+
+	limited := false
+	policies := make(map[string]openchoreov1alpha1.RetentionPolicy)
+	componentTypes := make(map[string]*openchoreov1alpha1.ComponentType)
+	for i := range components.Items {
+		comp := &components.Items[i]
+		if comp.Spec.Owner.ProjectName != project.Name {
+			continue
+		}
+		ct, err := r.getComponentType(ctx, comp, componentTypes)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		var ctPolicy *openchoreov1alpha1.RetentionPolicy
+		if ct != nil {
+			ctPolicy = ct.Spec.Retention
+		}
+		policy := effectivePolicy(project.Spec.Retention, ctPolicy)
+		policies[comp.Name] = policy
+		limited = limited || isLimited(policy.ComponentReleases) || isLimited(policy.WorkflowRuns)
+	}
+	if project.Spec.Retention != nil && isLimited(project.Spec.Retention.WorkflowRuns) {
+		limited = true
+	}
+	if !limited {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	prunable := make([]client.Object, 0)
+
+	releases, err := r.prunableComponentReleases(ctx, project, policies, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	prunable = append(prunable, releases...)
+
+	componentRuns, err := r.prunableComponentWorkflowRuns(ctx, project, policies, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	prunable = append(prunable, componentRuns...)
+
+	if project.Spec.Retention != nil {
+		runs, err := r.prunableWorkflowRuns(ctx, project, project.Spec.Retention.WorkflowRuns, now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		prunable = append(prunable, runs...)
+	}
+
+	for _, obj := range prunable {
+		if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete %T %q: %w", obj, obj.GetName(), err)
+		}
+		logger.Info("Pruned object outside the retention policy",
+			"kind", fmt.Sprintf("%T", obj), "name", obj.GetName(), "created", obj.GetCreationTimestamp())
+	}
+
+	return ctrl.Result{RequeueAfter: ResyncInterval}, nil
+}
+
+// getComponentType returns the ComponentType of the component, or nil for legacy components
+// and missing types. Lookups are cached in the given map.
+func (r *Reconciler) getComponentType(ctx context.Context, comp *openchoreov1alpha1.Component,
+	cache map[string]*openchoreov1alpha1.ComponentType) (*openchoreov1alpha1.ComponentType, error) {
+	// componentType format: {workloadType}/{componentTypeName}
+	_, name, ok := strings.Cut(comp.Spec.ComponentType, "/")
+	if !ok || name == "" {
+		return nil, nil
+	}
+	if ct, ok := cache[name]; ok {
+		return ct, nil
+	}
+
+	ct := &openchoreov1alpha1.ComponentType{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: comp.Namespace}, ct); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ComponentType %q: %w", name, err)
+		}
+		ct = nil
+	}
+	cache[name] = ct
+	return ct, nil
+}
+
+// prunableComponentReleases returns the project's ComponentReleases outside their component's limit.
+// Releases referenced by a ReleaseBinding, a pending PromotionRequest or the component status are kept.
+func (r *Reconciler) prunableComponentReleases(ctx context.Context, project *openchoreov1alpha1.Project,
+	policies map[string]openchoreov1alpha1.RetentionPolicy, now time.Time) ([]client.Object, error) {
+	releaseList := &openchoreov1alpha1.ComponentReleaseList{}
+	if err := r.List(ctx, releaseList, client.InNamespace(project.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component releases: %w", err)
+	}
+
+	referenced, err := r.referencedReleases(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	byComponent := make(map[string][]client.Object)
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if release.Spec.Owner.ProjectName != project.Name || isPreviewResource(release) {
+			continue
+		}
+		byComponent[release.Spec.Owner.ComponentName] = append(byComponent[release.Spec.Owner.ComponentName], release)
+	}
+
+	inUse := func(obj client.Object) bool { return referenced[obj.GetName()] }
+	prunable := make([]client.Object, 0)
+	for componentName, releases := range byComponent {
+		policy, ok := policies[componentName]
+		if !ok {
+			continue
+		}
+		prunable = append(prunable, selectPrunable(releases, policy.ComponentReleases, now, inUse)...)
+	}
+	return prunable, nil
+}
+
+// referencedReleases returns the names of the ComponentReleases that must not be deleted
+func (r *Reconciler) referencedReleases(ctx context.Context, project *openchoreov1alpha1.Project) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindings, client.InNamespace(project.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for _, binding := range bindings.Items {
+		if binding.Spec.ReleaseName != "" {
+			referenced[binding.Spec.ReleaseName] = true
+		}
+		// Releases in the binding history stay available as rollback targets
+		for _, entry := range binding.Status.History {
+			referenced[entry.ReleaseName] = true
+		}
+	}
+
+	promotions := &openchoreov1alpha1.PromotionRequestList{}
+	if err := r.List(ctx, promotions, client.InNamespace(project.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+	for _, promotion := range promotions.Items {
+		switch promotion.Status.Phase {
+		case openchoreov1alpha1.PromotionRequestPhaseRejected, openchoreov1alpha1.PromotionRequestPhasePromoted:
+		default:
+			referenced[promotion.Spec.ReleaseName] = true
+		}
+	}
+
+	components := &openchoreov1alpha1.ComponentList{}
+	if err := r.List(ctx, components, client.InNamespace(project.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	for _, comp := range components.Items {
+		if comp.Status.LatestRelease != nil && comp.Status.LatestRelease.Name != "" {
+			referenced[comp.Status.LatestRelease.Name] = true
+		}
+	}
+
+	return referenced, nil
+}
+
+// prunableComponentWorkflowRuns returns the project's completed ComponentWorkflowRuns outside their component's limit
+func (r *Reconciler) prunableComponentWorkflowRuns(ctx context.Context, project *openchoreov1alpha1.Project,
+	policies map[string]openchoreov1alpha1.RetentionPolicy, now time.Time) ([]client.Object, error) {
+	runList := &openchoreov1alpha1.ComponentWorkflowRunList{}
+	if err := r.List(ctx, runList, client.InNamespace(project.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list component workflow runs: %w", err)
+	}
+
+	byComponent := make(map[string][]client.Object)
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		if run.Spec.Owner.ProjectName != project.Name || isPreviewResource(run) {
+			continue
+		}
+		byComponent[run.Spec.Owner.ComponentName] = append(byComponent[run.Spec.Owner.ComponentName], run)
+	}
+
+	inProgress := func(obj client.Object) bool {
+		run := obj.(*openchoreov1alpha1.ComponentWorkflowRun)
+		return !meta.IsStatusConditionTrue(run.Status.Conditions, string(componentworkflowrun.ConditionWorkflowCompleted))
+	}
+	prunable := make([]client.Object, 0)
+	for componentName, runs := range byComponent {
+		policy, ok := policies[componentName]
+		if !ok {
+			continue
+		}
+		prunable = append(prunable, selectPrunable(runs, policy.WorkflowRuns, now, inProgress)...)
+	}
+	return prunable, nil
+}
+
+// prunableWorkflowRuns returns the completed WorkflowRuns labelled with the project that are
+// outside the limit. Runs are ranked per workflow.
+func (r *Reconciler) prunableWorkflowRuns(ctx context.Context, project *openchoreov1alpha1.Project,
+	limit *openchoreov1alpha1.HistoryLimit, now time.Time) ([]client.Object, error) {
+	if !isLimited(limit) {
+		return nil, nil
+	}
+
+	runList := &openchoreov1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, runList, client.InNamespace(project.Namespace),
+		client.MatchingLabels{labels.LabelKeyProjectName: project.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	byWorkflow := make(map[string][]client.Object)
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		byWorkflow[run.Spec.Workflow.Name] = append(byWorkflow[run.Spec.Workflow.Name], run)
+	}
+
+	inProgress := func(obj client.Object) bool {
+		run := obj.(*openchoreov1alpha1.WorkflowRun)
+		return !meta.IsStatusConditionTrue(run.Status.Conditions, string(workflowrun.ConditionWorkflowCompleted))
+	}
+	prunable := make([]client.Object, 0)
+	for _, runs := range byWorkflow {
+		prunable = append(prunable, selectPrunable(runs, limit, now, inProgress)...)
+	}
+	return prunable, nil
+}
+
+// isPreviewResource reports whether the object belongs to a PreviewEnvironment,
+// which deletes it together with the preview
+func isPreviewResource(obj client.Object) bool {
+	_, ok := obj.GetLabels()[labels.LabelKeyPreviewEnvironment]
+	return ok
+}
+
+// enqueueOwnerProject maps an object to the project named by its owner or project label
+func enqueueOwnerProject(projectName func(client.Object) string) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		name := projectName(obj)
+		if name == "" {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: obj.GetNamespace()}}}
+	}
+}
+
+// onCreate only passes create events; new history is what pushes older objects out of the limit
+var onCreate = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return true },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.Project{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&openchoreov1alpha1.ComponentRelease{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOwnerProject(func(obj client.Object) string {
+				return obj.(*openchoreov1alpha1.ComponentRelease).Spec.Owner.ProjectName
+			})),
+			builder.WithPredicates(onCreate)).
+		Watches(&openchoreov1alpha1.ComponentWorkflowRun{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOwnerProject(func(obj client.Object) string {
+				return obj.(*openchoreov1alpha1.ComponentWorkflowRun).Spec.Owner.ProjectName
+			})),
+			builder.WithPredicates(onCreate)).
+		Watches(&openchoreov1alpha1.WorkflowRun{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOwnerProject(func(obj client.Object) string {
+				return obj.GetLabels()[labels.LabelKeyProjectName]
+			})),
+			builder.WithPredicates(onCreate)).
+		// A binding moving to another release can make the previous one prunable
+		Watches(&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(enqueueOwnerProject(func(obj client.Object) string {
+				return obj.(*openchoreov1alpha1.ReleaseBinding).Spec.Owner.ProjectName
+			})),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("retention").
+		Complete(r)
+}
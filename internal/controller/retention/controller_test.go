@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("Retention Controller", func() {
+	Context("When pruning component releases", func() {
+		const (
+			namespace     = "default"
+			projectName   = "retention-project"
+			componentName = "retention-component"
+		)
+
+		ctx := context.Background()
+
+		newRelease := func(name string) *openchoreov1alpha1.ComponentRelease {
+			return &openchoreov1alpha1.ComponentRelease{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: openchoreov1alpha1.ComponentReleaseSpec{
+					Owner: openchoreov1alpha1.ComponentReleaseOwner{
+						ProjectName:   projectName,
+						ComponentName: componentName,
+					},
+					ComponentType: openchoreov1alpha1.ComponentTypeSpec{
+						WorkloadType: "deployment",
+						Resources: []openchoreov1alpha1.ResourceTemplate{{
+							ID:       "deployment",
+							Template: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment"}`)},
+						}},
+					},
+					Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+						Containers: map[string]openchoreov1alpha1.Container{"app": {Image: "nginx:latest"}},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, &openchoreov1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: projectName, Namespace: namespace},
+				Spec: openchoreov1alpha1.ProjectSpec{
+					DeploymentPipelineRef: "default",
+					Retention: &openchoreov1alpha1.RetentionPolicy{
+						ComponentReleases: &openchoreov1alpha1.HistoryLimit{KeepLast: ptr.To(int32(1))},
+					},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &openchoreov1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: componentName, Namespace: namespace},
+				Spec: openchoreov1alpha1.ComponentSpec{
+					Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: projectName},
+					ComponentType: "deployment/service",
+				},
+			})).To(Succeed())
+			for _, name := range []string{"release-1", "release-2", "release-3"} {
+				Expect(k8sClient.Create(ctx, newRelease(name))).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &openchoreov1alpha1.ReleaseBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: componentName + "-production", Namespace: namespace},
+				Spec: openchoreov1alpha1.ReleaseBindingSpec{
+					Owner: openchoreov1alpha1.ReleaseBindingOwner{
+						ProjectName:   projectName,
+						ComponentName: componentName,
+					},
+					Environment: "production",
+					ReleaseName: "release-1",
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.DeleteAllOf(ctx, &openchoreov1alpha1.ComponentRelease{}, client.InNamespace(namespace))).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &openchoreov1alpha1.ReleaseBinding{}, client.InNamespace(namespace))).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &openchoreov1alpha1.Component{}, client.InNamespace(namespace))).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &openchoreov1alpha1.Project{}, client.InNamespace(namespace))).To(Succeed())
+		})
+
+		It("should keep the newest and the bound releases", func() {
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: projectName, Namespace: namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(ResyncInterval))
+
+			get := func(name string) error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &openchoreov1alpha1.ComponentRelease{})
+			}
+			Expect(get("release-1")).To(Succeed())
+			Expect(get("release-3")).To(Succeed())
+			Expect(errors.IsNotFound(get("release-2"))).To(BeTrue())
+		})
+	})
+})
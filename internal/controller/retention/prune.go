@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"sort"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// effectivePolicy merges the project and component type retention policies.
+// Each history limit set on the project replaces the component type's one.
+func effectivePolicy(project, componentType *openchoreov1alpha1.RetentionPolicy) openchoreov1alpha1.RetentionPolicy {
+	var policy openchoreov1alpha1.RetentionPolicy
+	if componentType != nil {
+		policy = *componentType
+	}
+	if project != nil {
+		if project.ComponentReleases != nil {
+			policy.ComponentReleases = project.ComponentReleases
+		}
+		if project.WorkflowRuns != nil {
+			policy.WorkflowRuns = project.WorkflowRuns
+		}
+	}
+	return policy
+}
+
+// isLimited reports whether the limit bounds the history at all
+func isLimited(limit *openchoreov1alpha1.HistoryLimit) bool {
+	return limit != nil && (limit.KeepLast != nil || limit.MaxAge != nil)
+}
+
+// selectPrunable returns the objects that fall outside the history limit.
+// Objects in use are never returned. They still count towards KeepLast, so a limit of N keeps
+// the N most recent objects plus any older ones that are still in use.
+func selectPrunable(objs []client.Object, limit *openchoreov1alpha1.HistoryLimit, now time.Time,
+	inUse func(client.Object) bool) []client.Object {
+	if !isLimited(limit) {
+		return nil
+	}
+
+	sorted := make([]client.Object, len(objs))
+	copy(sorted, objs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].GetCreationTimestamp(), sorted[j].GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return sorted[i].GetName() > sorted[j].GetName()
+	})
+
+	prunable := make([]client.Object, 0)
+	for i, obj := range sorted {
+		if !obj.GetDeletionTimestamp().IsZero() || inUse(obj) {
+			continue
+		}
+		beyondCount := limit.KeepLast != nil && i >= int(*limit.KeepLast)
+		beyondAge := limit.MaxAge != nil && now.Sub(obj.GetCreationTimestamp().Time) > limit.MaxAge.Duration
+		if beyondCount || beyondAge {
+			prunable = append(prunable, obj)
+		}
+	}
+	return prunable
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("Retention pruning", func() {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	release := func(name string, age time.Duration) client.Object {
+		return &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}}
+	}
+	names := func(objs []client.Object) []string {
+		out := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			out = append(out, obj.GetName())
+		}
+		return out
+	}
+	notInUse := func(client.Object) bool { return false }
+
+	history := []client.Object{
+		release("r1", 72*time.Hour),
+		release("r3", 1*time.Hour),
+		release("r2", 48*time.Hour),
+	}
+
+	It("should keep the most recent objects", func() {
+		limit := &openchoreov1alpha1.HistoryLimit{KeepLast: ptr.To(int32(2))}
+		Expect(names(selectPrunable(history, limit, now, notInUse))).To(Equal([]string{"r1"}))
+	})
+
+	It("should prune objects older than the max age", func() {
+		limit := &openchoreov1alpha1.HistoryLimit{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}}
+		Expect(names(selectPrunable(history, limit, now, notInUse))).To(ConsistOf("r1", "r2"))
+	})
+
+	It("should never prune objects in use", func() {
+		limit := &openchoreov1alpha1.HistoryLimit{KeepLast: ptr.To(int32(1))}
+		inUse := func(obj client.Object) bool { return obj.GetName() == "r1" }
+		Expect(names(selectPrunable(history, limit, now, inUse))).To(Equal([]string{"r2"}))
+	})
+
+	It("should not prune without a limit", func() {
+		Expect(selectPrunable(history, &openchoreov1alpha1.HistoryLimit{}, now, notInUse)).To(BeEmpty())
+		Expect(selectPrunable(history, nil, now, notInUse)).To(BeEmpty())
+	})
+
+	It("should let the project override the component type per history", func() {
+		projectLimit := &openchoreov1alpha1.HistoryLimit{KeepLast: ptr.To(int32(3))}
+		typeLimit := &openchoreov1alpha1.HistoryLimit{KeepLast: ptr.To(int32(10))}
+		policy := effectivePolicy(
+			&openchoreov1alpha1.RetentionPolicy{WorkflowRuns: projectLimit},
+			&openchoreov1alpha1.RetentionPolicy{ComponentReleases: typeLimit, WorkflowRuns: typeLimit},
+		)
+		Expect(policy.ComponentReleases).To(Equal(typeLimit))
+		Expect(policy.WorkflowRuns).To(Equal(projectLimit))
+	})
+})
@@ -5,46 +5,314 @@ package secretreference
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+const (
+	// pendingRequeueInterval is how soon a SecretReference is checked again while a data plane
+	// has not reported a validation or sync result yet
+	pendingRequeueInterval = 15 * time.Second
+
+	// defaultRefreshInterval matches the default of SecretReferenceSpec.RefreshInterval
+	defaultRefreshInterval = time.Hour
 )
 
 // Reconciler reconciles a SecretReference object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme       *runtime.Scheme
+	K8sClientMgr *kubernetesClient.KubeMultiClientManager
+	GatewayURL   string
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SecretReference object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile validates the remote references of a SecretReference against the secret store of every
+// environment's data plane, and reports the sync state and content hash of the ExternalSecrets that
+// the deployed Releases generated from it.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	secretRef := &openchoreodevv1alpha1.SecretReference{}
+	if err := r.Get(ctx, req.NamespacedName, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("SecretReference resource not found. Ignoring since it must be deleted.")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get SecretReference")
+		return ctrl.Result{}, err
+	}
+
+	if !secretRef.DeletionTimestamp.IsZero() {
+		logger.Info("Finalizing SecretReference")
+		return r.finalize(ctx, secretRef)
+	}
+
+	if finalizerAdded, err := r.ensureFinalizer(ctx, secretRef); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	environments := &openchoreodevv1alpha1.EnvironmentList{}
+	if err := r.List(ctx, environments, client.InNamespace(secretRef.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list environments: %w", err)
+	}
+	releases := &openchoreodevv1alpha1.ReleaseList{}
+	if err := r.List(ctx, releases, client.InNamespace(secretRef.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list releases: %w", err)
+	}
+
+	sort.Slice(environments.Items, func(i, j int) bool {
+		return environments.Items[i].Name < environments.Items[j].Name
+	})
+
+	old := secretRef.Status.DeepCopy()
+	now := metav1.Now()
+	pending := false
+	validations := make(map[string]validationResult)
+	statuses := make([]openchoreodevv1alpha1.SecretReferenceEnvironmentStatus, 0, len(environments.Items))
+	for i := range environments.Items {
+		environment := &environments.Items[i]
+		if environment.Spec.DataPlaneRef == "" {
+			continue
+		}
+		status, envPending := r.observeEnvironment(ctx, secretRef, environment, releases.Items, validations)
+		setRotationTime(&status, findEnvironmentStatus(secretRef.Status.Environments, environment.Name), now)
+		statuses = append(statuses, status)
+		pending = pending || envPending
+	}
+
+	secretRef.Status.Environments = statuses
+	secretRef.Status.SecretStores = secretStoresInUse(statuses)
+	secretRef.Status.ObservedGeneration = secretRef.Generation
+	setValidatedCondition(secretRef)
+	setSyncedCondition(secretRef)
+
+	if statusNeedsUpdate(old, &secretRef.Status, now, refreshInterval(secretRef)) {
+		secretRef.Status.LastRefreshTime = &now
+		if err := r.Status().Update(ctx, secretRef); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update SecretReference status: %w", err)
+		}
+	}
+
+	if pending {
+		return ctrl.Result{RequeueAfter: pendingRequeueInterval}, nil
+	}
+	// Poll at the refresh interval so that secret versions picked up by the ExternalSecrets are reported
+	return ctrl.Result{RequeueAfter: refreshInterval(secretRef)}, nil
+}
+
+// observeEnvironment validates the SecretReference against the data plane of an environment and collects
+// the sync state of the ExternalSecrets deployed there. The second return value reports whether
+// a result is still pending. Validation results are shared between environments of the same data plane.
+func (r *Reconciler) observeEnvironment(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference,
+	environment *openchoreodevv1alpha1.Environment, releases []openchoreodevv1alpha1.Release,
+	validations map[string]validationResult) (openchoreodevv1alpha1.SecretReferenceEnvironmentStatus, bool) {
+	logger := log.FromContext(ctx).WithValues("environment", environment.Name)
+	previous := findEnvironmentStatus(secretRef.Status.Environments, environment.Name)
+
+	status := openchoreodevv1alpha1.SecretReferenceEnvironmentStatus{
+		Environment: environment.Name,
+		DataPlane:   environment.Spec.DataPlaneRef,
+	}
+
+	dataPlane := &openchoreodevv1alpha1.DataPlane{}
+	if err := r.Get(ctx, types.NamespacedName{Name: environment.Spec.DataPlaneRef, Namespace: environment.Namespace}, dataPlane); err != nil {
+		status.Validation = openchoreodevv1alpha1.SecretValidationInvalid
+		status.ValidationMessage = fmt.Sprintf("failed to get DataPlane %q: %v", environment.Spec.DataPlaneRef, err)
+		return status, !apierrors.IsNotFound(err)
+	}
+	if dataPlane.Spec.SecretStoreRef == nil || dataPlane.Spec.SecretStoreRef.Name == "" {
+		status.Validation = openchoreodevv1alpha1.SecretValidationInvalid
+		status.ValidationMessage = fmt.Sprintf("DataPlane %q has no secret store configured", dataPlane.Name)
+		return status, false
+	}
+	status.SecretStore = dataPlane.Spec.SecretStoreRef.Name
+
+	dpClient, err := kubernetesClient.GetK8sClientFromDataPlane(r.K8sClientMgr, dataPlane, r.GatewayURL)
+	if err != nil {
+		logger.Error(err, "Failed to get dataplane client")
+		status.Validation = openchoreodevv1alpha1.SecretValidationPending
+		status.ValidationMessage = fmt.Sprintf("data plane %q is not reachable: %v", dataPlane.Name, err)
+		keepPreviousSync(&status, previous)
+		return status, true
+	}
+
+	result, ok := validations[dataPlane.Name]
+	if !ok {
+		result = r.validate(ctx, dpClient, secretRef, dataPlane.Spec.SecretStoreRef.Name)
+		validations[dataPlane.Name] = result
+	}
+	status.Validation = result.phase
+	status.ValidationMessage = result.message
 
-	// TODO(user): your logic here
+	externalSecrets, contentHash, err := r.collectSyncStatus(ctx, dpClient, secretRef, environment.Name, releases)
+	if err != nil {
+		logger.Error(err, "Failed to collect ExternalSecret sync status")
+		keepPreviousSync(&status, previous)
+		return status, true
+	}
+	status.ExternalSecrets = externalSecrets
+	status.ContentHash = contentHash
 
-	return ctrl.Result{}, nil
+	pending := result.phase == openchoreodevv1alpha1.SecretValidationPending
+	for _, es := range externalSecrets {
+		if !es.Synced && es.Reason == "" {
+			pending = true
+		}
+	}
+	return status, pending
+}
+
+// keepPreviousSync carries over the last known sync state when the data plane could not be read,
+// so that an outage does not look like a rotation to the consuming workloads
+func keepPreviousSync(status, previous *openchoreodevv1alpha1.SecretReferenceEnvironmentStatus) {
+	if previous == nil {
+		return
+	}
+	status.ExternalSecrets = previous.ExternalSecrets
+	status.ContentHash = previous.ContentHash
+	status.LastRotationTime = previous.LastRotationTime
+}
+
+// setRotationTime records when the content hash of an environment last changed
+func setRotationTime(status, previous *openchoreodevv1alpha1.SecretReferenceEnvironmentStatus, now metav1.Time) {
+	if previous == nil {
+		return
+	}
+	status.LastRotationTime = previous.LastRotationTime
+	if previous.ContentHash != "" && status.ContentHash != "" && previous.ContentHash != status.ContentHash {
+		status.LastRotationTime = &now
+	}
+}
+
+// findEnvironmentStatus returns the status of the named environment, or nil
+func findEnvironmentStatus(statuses []openchoreodevv1alpha1.SecretReferenceEnvironmentStatus,
+	environment string) *openchoreodevv1alpha1.SecretReferenceEnvironmentStatus {
+	for i := range statuses {
+		if statuses[i].Environment == environment {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// secretStoresInUse lists the secret stores and namespaces of the ExternalSecrets that read the reference
+func secretStoresInUse(statuses []openchoreodevv1alpha1.SecretReferenceEnvironmentStatus) []openchoreodevv1alpha1.SecretStoreReference {
+	var stores []openchoreodevv1alpha1.SecretStoreReference
+	seen := make(map[string]bool)
+	for _, status := range statuses {
+		for _, es := range status.ExternalSecrets {
+			key := status.SecretStore + "/" + es.Namespace
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			stores = append(stores, openchoreodevv1alpha1.SecretStoreReference{
+				Name:      status.SecretStore,
+				Namespace: es.Namespace,
+				Kind:      externalSecretKind,
+			})
+		}
+	}
+	return stores
+}
+
+// statusNeedsUpdate reports whether the observed status differs from the stored one. An unchanged status
+// is only written again to move the refresh time along once per refresh interval, so that the reconciles
+// triggered by Releases and pending data planes do not write the status every time.
+func statusNeedsUpdate(old, observed *openchoreodevv1alpha1.SecretReferenceStatus, now metav1.Time,
+	interval time.Duration) bool {
+	if old.LastRefreshTime == nil || now.Sub(old.LastRefreshTime.Time) >= interval {
+		return true
+	}
+	unchanged := observed.DeepCopy()
+	unchanged.LastRefreshTime = old.LastRefreshTime
+	return !apiequality.Semantic.DeepEqual(old, unchanged)
+}
+
+// externalSecretStatusChanged passes Release updates that change the spec or the reported status of an
+// ExternalSecret, so that a secret rotation picked up by the ExternalSecret is reported without waiting
+// for the refresh interval
+func externalSecretStatusChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRelease, ok := e.ObjectOld.(*openchoreodevv1alpha1.Release)
+			if !ok {
+				return false
+			}
+			newRelease, ok := e.ObjectNew.(*openchoreodevv1alpha1.Release)
+			if !ok {
+				return false
+			}
+			if oldRelease.Generation != newRelease.Generation {
+				return true
+			}
+			return !apiequality.Semantic.DeepEqual(externalSecretStatuses(oldRelease), externalSecretStatuses(newRelease))
+		},
+	}
+}
+
+// externalSecretStatuses returns the live status of the ExternalSecrets of a Release keyed by resource ID
+func externalSecretStatuses(release *openchoreodevv1alpha1.Release) map[string]string {
+	statuses := make(map[string]string)
+	for _, resource := range release.Status.Resources {
+		if !isExternalSecret(schema.GroupKind{Group: resource.Group, Kind: resource.Kind}) || resource.Status == nil {
+			continue
+		}
+		statuses[resource.ID] = string(resource.Status.Raw)
+	}
+	return statuses
+}
+
+// refreshInterval returns the refresh interval of the SecretReference
+func refreshInterval(secretRef *openchoreodevv1alpha1.SecretReference) time.Duration {
+	if secretRef.Spec.RefreshInterval == nil || secretRef.Spec.RefreshInterval.Duration <= 0 {
+		return defaultRefreshInterval
+	}
+	return secretRef.Spec.RefreshInterval.Duration
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.K8sClientMgr == nil {
+		r.K8sClientMgr = kubernetesClient.NewManager()
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&openchoreodevv1alpha1.SecretReference{}).
+		For(&openchoreodevv1alpha1.SecretReference{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Report the sync state as soon as a Release deploys an ExternalSecret that reads the reference,
+		// and again whenever the ExternalSecret reports a new sync
+		Watches(&openchoreodevv1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.findSecretReferencesForRelease),
+			builder.WithPredicates(externalSecretStatusChanged())).
 		Named("secretreference").
 		Complete(r)
 }
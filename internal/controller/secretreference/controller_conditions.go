@@ -0,0 +1,113 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"fmt"
+	"strings"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionValidated represents whether the remote references resolve in the secret stores of all environments
+	ConditionValidated controller.ConditionType = "Validated"
+
+	// ConditionSynced represents whether the ExternalSecrets generated from the reference are synced
+	ConditionSynced controller.ConditionType = "Synced"
+)
+
+// Constants for condition reasons
+
+const (
+	// Reasons for Validated condition type
+
+	// ReasonReferencesResolved every remote reference resolved in every environment
+	ReasonReferencesResolved controller.ConditionReason = "ReferencesResolved"
+	// ReasonReferencesUnresolved a secret store is unavailable or a remote reference did not resolve
+	ReasonReferencesUnresolved controller.ConditionReason = "ReferencesUnresolved"
+	// ReasonValidationPending a secret store has not reported a result yet
+	ReasonValidationPending controller.ConditionReason = "ValidationPending"
+	// ReasonNoEnvironments there is no environment with a data plane to validate against
+	ReasonNoEnvironments controller.ConditionReason = "NoEnvironments"
+
+	// Reasons for Synced condition type
+
+	// ReasonSecretsSynced every generated ExternalSecret synced
+	ReasonSecretsSynced controller.ConditionReason = "SecretsSynced"
+	// ReasonSyncFailed at least one generated ExternalSecret failed to sync
+	ReasonSyncFailed controller.ConditionReason = "SyncFailed"
+	// ReasonSyncPending at least one generated ExternalSecret has not synced yet
+	ReasonSyncPending controller.ConditionReason = "SyncPending"
+	// ReasonNotDeployed no deployed ExternalSecret reads the reference
+	ReasonNotDeployed controller.ConditionReason = "NotDeployed"
+)
+
+// setValidatedCondition summarizes the per-environment validation results
+func setValidatedCondition(secretRef *openchoreodevv1alpha1.SecretReference) {
+	if len(secretRef.Status.Environments) == 0 {
+		controller.MarkUnknownCondition(secretRef, ConditionValidated, ReasonNoEnvironments,
+			"No environment with a data plane to validate against")
+		return
+	}
+
+	var invalid, pending []string
+	for _, status := range secretRef.Status.Environments {
+		switch status.Validation {
+		case openchoreodevv1alpha1.SecretValidationInvalid:
+			invalid = append(invalid, fmt.Sprintf("%s: %s", status.Environment, status.ValidationMessage))
+		case openchoreodevv1alpha1.SecretValidationPending:
+			pending = append(pending, status.Environment)
+		}
+	}
+
+	switch {
+	case len(invalid) > 0:
+		controller.MarkFalseCondition(secretRef, ConditionValidated, ReasonReferencesUnresolved,
+			strings.Join(invalid, "; "))
+	case len(pending) > 0:
+		controller.MarkUnknownCondition(secretRef, ConditionValidated, ReasonValidationPending,
+			fmt.Sprintf("Waiting for validation in environments: %s", strings.Join(pending, ", ")))
+	default:
+		controller.MarkTrueCondition(secretRef, ConditionValidated, ReasonReferencesResolved,
+			"All remote references resolved")
+	}
+}
+
+// setSyncedCondition summarizes the sync state of the deployed ExternalSecrets
+func setSyncedCondition(secretRef *openchoreodevv1alpha1.SecretReference) {
+	var total int
+	var failed, pending []string
+	for _, status := range secretRef.Status.Environments {
+		for _, es := range status.ExternalSecrets {
+			total++
+			if es.Synced {
+				continue
+			}
+			name := fmt.Sprintf("%s/%s/%s", status.Environment, es.Namespace, es.Name)
+			if es.Reason == "" {
+				pending = append(pending, name)
+				continue
+			}
+			failed = append(failed, fmt.Sprintf("%s: %s", name, conditionMessage(es.Reason, es.Message)))
+		}
+	}
+
+	switch {
+	case total == 0:
+		controller.MarkUnknownCondition(secretRef, ConditionSynced, ReasonNotDeployed,
+			"No deployed ExternalSecret reads this reference")
+	case len(failed) > 0:
+		controller.MarkFalseCondition(secretRef, ConditionSynced, ReasonSyncFailed, strings.Join(failed, "; "))
+	case len(pending) > 0:
+		controller.MarkUnknownCondition(secretRef, ConditionSynced, ReasonSyncPending,
+			fmt.Sprintf("Waiting for ExternalSecrets to sync: %s", strings.Join(pending, ", ")))
+	default:
+		controller.MarkTrueCondition(secretRef, ConditionSynced, ReasonSecretsSynced,
+			fmt.Sprintf("%d ExternalSecrets synced", total))
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+const (
+	// SecretReferenceCleanupFinalizer removes the validation ExternalSecrets from the data planes
+	SecretReferenceCleanupFinalizer = "openchoreo.dev/secretreference-cleanup"
+)
+
+// ensureFinalizer ensures that the finalizer is added to the SecretReference.
+// The first return value indicates whether the finalizer was added.
+func (r *Reconciler) ensureFinalizer(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference) (bool, error) {
+	if !secretRef.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+
+	if controllerutil.AddFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		return true, r.Update(ctx, secretRef)
+	}
+
+	return false, nil
+}
+
+// finalize deletes the validation ExternalSecrets from the data planes the SecretReference was validated in
+func (r *Reconciler) finalize(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	if !controllerutil.ContainsFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	cleaned := make(map[string]bool)
+	for _, status := range secretRef.Status.Environments {
+		if status.SecretStore == "" || cleaned[status.DataPlane] {
+			continue
+		}
+		cleaned[status.DataPlane] = true
+
+		dataPlane := &openchoreodevv1alpha1.DataPlane{}
+		if err := r.Get(ctx, types.NamespacedName{Name: status.DataPlane, Namespace: secretRef.Namespace}, dataPlane); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to get DataPlane %q: %w", status.DataPlane, err)
+		}
+
+		dpClient, err := kubernetesClient.GetK8sClientFromDataPlane(r.K8sClientMgr, dataPlane, r.GatewayURL)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get dataplane client for %q: %w", dataPlane.Name, err)
+		}
+
+		es := makeValidationExternalSecret(secretRef, status.SecretStore)
+		if err := dpClient.Delete(ctx, es); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete validation ExternalSecret from DataPlane %q: %w", dataPlane.Name, err)
+		}
+		logger.Info("Deleted validation ExternalSecret", "dataPlane", dataPlane.Name, "name", es.GetName())
+	}
+
+	if controllerutil.RemoveFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		if err := r.Update(ctx, secretRef); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// deployedExternalSecret is an ExternalSecret in a Release that reads at least one remote reference
+// of a SecretReference
+type deployedExternalSecret struct {
+	release string
+	object  *unstructured.Unstructured
+	status  map[string]any
+	// secretKeys maps the keys of the target Secret to the remote reference they are read from
+	secretKeys map[string]string
+}
+
+// collectSyncStatus reports the sync state of the ExternalSecrets that the Releases of an environment
+// generated from the SecretReference, and hashes the secret data they synced
+func (r *Reconciler) collectSyncStatus(ctx context.Context, dpClient client.Client,
+	secretRef *openchoreodevv1alpha1.SecretReference, environment string,
+	releases []openchoreodevv1alpha1.Release) ([]openchoreodevv1alpha1.ExternalSecretSyncStatus, string, error) {
+	deployed := findDeployedExternalSecrets(secretRef, environment, releases)
+
+	statuses := make([]openchoreodevv1alpha1.ExternalSecretSyncStatus, 0, len(deployed))
+	values := make(map[string]string)
+	for _, es := range deployed {
+		status := externalSecretSyncStatus(es)
+		statuses = append(statuses, status)
+		if !status.Synced {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: es.object.GetNamespace(), Name: externalSecretTarget(es.object)}
+		if err := dpClient.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, "", fmt.Errorf("failed to get secret %s: %w", key, err)
+		}
+		for secretKey, remoteRef := range es.secretKeys {
+			if value, ok := secret.Data[secretKey]; ok {
+				values[remoteRef] = hashValue(value)
+			}
+		}
+	}
+
+	return statuses, contentHash(values), nil
+}
+
+// findDeployedExternalSecrets returns the ExternalSecrets in the data plane Releases of an environment
+// that read any of the remote references of the SecretReference
+func findDeployedExternalSecrets(secretRef *openchoreodevv1alpha1.SecretReference, environment string,
+	releases []openchoreodevv1alpha1.Release) []deployedExternalSecret {
+	remoteRefs := make(map[string]bool, len(secretRef.Spec.Data))
+	for _, source := range secretRef.Spec.Data {
+		remoteRefs[remoteRefKey(source.RemoteRef.Key, source.RemoteRef.Property)] = true
+	}
+
+	var deployed []deployedExternalSecret
+	for i := range releases {
+		release := &releases[i]
+		if release.Spec.EnvironmentName != environment || !isDataPlaneRelease(release) {
+			continue
+		}
+		for _, resource := range release.Spec.Resources {
+			if resource.Object == nil {
+				continue
+			}
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(resource.Object.Raw); err != nil {
+				continue
+			}
+			if !isExternalSecret(obj.GroupVersionKind().GroupKind()) {
+				continue
+			}
+			secretKeys := matchRemoteRefs(obj, remoteRefs)
+			if len(secretKeys) == 0 {
+				continue
+			}
+			deployed = append(deployed, deployedExternalSecret{
+				release:    release.Name,
+				object:     obj,
+				status:     releaseResourceStatus(release, resource.ID),
+				secretKeys: secretKeys,
+			})
+		}
+	}
+
+	sort.Slice(deployed, func(i, j int) bool {
+		if deployed[i].object.GetNamespace() != deployed[j].object.GetNamespace() {
+			return deployed[i].object.GetNamespace() < deployed[j].object.GetNamespace()
+		}
+		return deployed[i].object.GetName() < deployed[j].object.GetName()
+	})
+	return deployed
+}
+
+// matchRemoteRefs returns the keys of the target Secret that an ExternalSecret reads from the given remote references
+func matchRemoteRefs(es *unstructured.Unstructured, remoteRefs map[string]bool) map[string]string {
+	data, _, _ := unstructured.NestedSlice(es.Object, "spec", "data")
+	secretKeys := make(map[string]string)
+	for _, entry := range data {
+		item, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		secretKey, _, _ := unstructured.NestedString(item, "secretKey")
+		key, _, _ := unstructured.NestedString(item, "remoteRef", "key")
+		property, _, _ := unstructured.NestedString(item, "remoteRef", "property")
+		ref := remoteRefKey(key, property)
+		if remoteRefs[ref] {
+			secretKeys[secretKey] = ref
+		}
+	}
+	return secretKeys
+}
+
+// externalSecretSyncStatus reads the sync state of a deployed ExternalSecret from the status the Release observed
+func externalSecretSyncStatus(es deployedExternalSecret) openchoreodevv1alpha1.ExternalSecretSyncStatus {
+	status := openchoreodevv1alpha1.ExternalSecretSyncStatus{
+		Name:      es.object.GetName(),
+		Namespace: es.object.GetNamespace(),
+		Release:   es.release,
+	}
+	if es.status == nil {
+		return status
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(es.status, "conditions")
+	ready, reason, message := readyConditionOf(conditions)
+	status.Synced = ready == "True"
+	status.Reason = reason
+	status.Message = message
+	if refreshTime, _, _ := unstructured.NestedString(es.status, "refreshTime"); refreshTime != "" {
+		if t, err := time.Parse(time.RFC3339, refreshTime); err == nil {
+			refreshed := metav1.NewTime(t)
+			status.RefreshTime = &refreshed
+		}
+	}
+	return status
+}
+
+// releaseResourceStatus returns the .status of a resource as last observed by the Release controller
+func releaseResourceStatus(release *openchoreodevv1alpha1.Release, id string) map[string]any {
+	for _, resource := range release.Status.Resources {
+		if resource.ID != id || resource.Status == nil {
+			continue
+		}
+		var status map[string]any
+		if err := json.Unmarshal(resource.Status.Raw, &status); err != nil {
+			return nil
+		}
+		return status
+	}
+	return nil
+}
+
+// externalSecretTarget returns the name of the Secret an ExternalSecret creates
+func externalSecretTarget(es *unstructured.Unstructured) string {
+	if name, _, _ := unstructured.NestedString(es.Object, "spec", "target", "name"); name != "" {
+		return name
+	}
+	return es.GetName()
+}
+
+// isDataPlaneRelease reports whether a Release targets the data plane
+func isDataPlaneRelease(release *openchoreodevv1alpha1.Release) bool {
+	return release.Spec.TargetPlane == "" || release.Spec.TargetPlane == openchoreodevv1alpha1.TargetPlaneDataPlane
+}
+
+// remoteRefKey identifies a remote reference by its key and property
+func remoteRefKey(key, property string) string {
+	if property == "" {
+		return key
+	}
+	return key + "#" + property
+}
+
+// hashValue returns the SHA-256 of a secret value, so that the values themselves are never kept
+func hashValue(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:])
+}
+
+// contentHash combines the hashed values of the remote references into a single hash.
+// It is empty when no value has been synced.
+func contentHash(values map[string]string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	refs := make([]string, 0, len(values))
+	for ref := range values {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	h := sha256.New()
+	for _, ref := range refs {
+		fmt.Fprintf(h, "%s=%s\n", ref, values[ref])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// findSecretReferencesForRelease returns reconcile requests for the SecretReferences read by the
+// ExternalSecrets of a Release
+func (r *Reconciler) findSecretReferencesForRelease(ctx context.Context, obj client.Object) []reconcile.Request {
+	release := obj.(*openchoreodevv1alpha1.Release)
+	if !isDataPlaneRelease(release) {
+		return nil
+	}
+
+	secretRefs := &openchoreodevv1alpha1.SecretReferenceList{}
+	if err := r.List(ctx, secretRefs, client.InNamespace(release.Namespace)); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list SecretReferences for Release", "release", release.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range secretRefs.Items {
+		secretRef := &secretRefs.Items[i]
+		releases := []openchoreodevv1alpha1.Release{*release}
+		if len(findDeployedExternalSecrets(secretRef, release.Spec.EnvironmentName, releases)) == 0 {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace},
+		})
+	}
+	return requests
+}
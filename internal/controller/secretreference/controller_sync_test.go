@@ -0,0 +1,312 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+var _ = Describe("SecretReference validation and sync", func() {
+	const (
+		namespace = "acme"
+		storeName = "vault"
+	)
+
+	var (
+		reconciler *Reconciler
+		cpClient   client.Client
+		dpClient   client.Client
+		testCtx    context.Context
+		key        client.ObjectKey
+	)
+
+	rawJSON := func(obj any) *runtime.RawExtension {
+		data, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		return &runtime.RawExtension{Raw: data}
+	}
+
+	withReady := func(status, reason string) map[string]any {
+		return map[string]any{"conditions": []any{
+			map[string]any{"type": "Ready", "status": status, "reason": reason},
+		}}
+	}
+
+	reconcileAndGet := func() *openchoreodevv1alpha1.SecretReference {
+		_, err := reconciler.Reconcile(testCtx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		secretRef := &openchoreodevv1alpha1.SecretReference{}
+		Expect(cpClient.Get(testCtx, key, secretRef)).To(Succeed())
+		return secretRef
+	}
+
+	setProbeStatus := func(status map[string]any) {
+		probe := &unstructured.Unstructured{}
+		probe.SetGroupVersionKind(externalSecretGVK)
+		Expect(dpClient.Get(testCtx, client.ObjectKey{
+			Namespace: dpkubernetes.SystemNamespace,
+			Name:      dpkubernetes.GenerateK8sName(validationNamePrefix, namespace, "db-credentials"),
+		}, probe)).To(Succeed())
+		probe.Object["status"] = status
+		Expect(dpClient.Update(testCtx, probe)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		testCtx = context.Background()
+		key = client.ObjectKey{Namespace: namespace, Name: "db-credentials"}
+
+		scheme := runtime.NewScheme()
+		Expect(openchoreodevv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		secretRef := &openchoreodevv1alpha1.SecretReference{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace},
+			Spec: openchoreodevv1alpha1.SecretReferenceSpec{
+				Data: []openchoreodevv1alpha1.SecretDataSource{{
+					SecretKey: "password",
+					RemoteRef: openchoreodevv1alpha1.RemoteReference{Key: "secret/data/db", Property: "password"},
+				}},
+			},
+		}
+		controllerutil.AddFinalizer(secretRef, SecretReferenceCleanupFinalizer)
+
+		release := &openchoreodevv1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-dev", Namespace: namespace},
+			Spec: openchoreodevv1alpha1.ReleaseSpec{
+				EnvironmentName: "dev",
+				Resources: []openchoreodevv1alpha1.Resource{
+					{ID: "api-env", Object: rawJSON(map[string]any{
+						"apiVersion": "external-secrets.io/v1",
+						"kind":       "ExternalSecret",
+						"metadata":   map[string]any{"name": "api-env", "namespace": "dp-acme-dev"},
+						"spec": map[string]any{"data": []any{
+							map[string]any{"secretKey": "DB_PASSWORD",
+								"remoteRef": map[string]any{"key": "secret/data/db", "property": "password"}},
+							map[string]any{"secretKey": "API_TOKEN",
+								"remoteRef": map[string]any{"key": "secret/data/api", "property": "token"}},
+						}},
+					})},
+					{ID: "other", Object: rawJSON(map[string]any{
+						"apiVersion": "external-secrets.io/v1",
+						"kind":       "ExternalSecret",
+						"metadata":   map[string]any{"name": "other", "namespace": "dp-acme-dev"},
+						"spec": map[string]any{"data": []any{
+							map[string]any{"secretKey": "TOKEN", "remoteRef": map[string]any{"key": "secret/data/api"}},
+						}},
+					})},
+				},
+			},
+			Status: openchoreodevv1alpha1.ReleaseStatus{
+				Resources: []openchoreodevv1alpha1.ResourceStatus{{
+					ID: "api-env", Version: "v1", Kind: "ExternalSecret", Name: "api-env",
+					Status: rawJSON(withReady("True", "SecretSynced")),
+				}},
+			},
+		}
+
+		cpClient = fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&openchoreodevv1alpha1.SecretReference{}).
+			WithObjects(
+				secretRef,
+				release,
+				&openchoreodevv1alpha1.Environment{
+					ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: namespace},
+					Spec:       openchoreodevv1alpha1.EnvironmentSpec{DataPlaneRef: "default"},
+				},
+				&openchoreodevv1alpha1.Environment{
+					ObjectMeta: metav1.ObjectMeta{Name: "sandbox", Namespace: namespace},
+					Spec:       openchoreodevv1alpha1.EnvironmentSpec{DataPlaneRef: "no-store"},
+				},
+				&openchoreodevv1alpha1.DataPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+					Spec: openchoreodevv1alpha1.DataPlaneSpec{
+						SecretStoreRef: &openchoreodevv1alpha1.SecretStoreRef{Name: storeName},
+					},
+				},
+				&openchoreodevv1alpha1.DataPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "no-store", Namespace: namespace},
+				},
+			).Build()
+
+		store := &unstructured.Unstructured{}
+		store.SetGroupVersionKind(clusterSecretStoreGVK)
+		store.SetName(storeName)
+		store.Object["status"] = withReady("True", "Valid")
+		dpClient = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			store,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "api-env", Namespace: "dp-acme-dev"},
+				Data:       map[string][]byte{"DB_PASSWORD": []byte("s3cret"), "API_TOKEN": []byte("token")},
+			},
+		).Build()
+
+		// Serve the fake data plane client through the client manager cache
+		clientMgr := kubernetesClient.NewManager()
+		_, err := clientMgr.GetOrAddClient(fmt.Sprintf("v2/dataplane/default/%s/default", namespace),
+			func() (client.Client, error) { return dpClient, nil })
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &Reconciler{
+			Client:       cpClient,
+			Scheme:       scheme,
+			K8sClientMgr: clientMgr,
+			GatewayURL:   "https://gateway.test",
+		}
+	})
+
+	It("should validate against the secret store and report the sync status per environment", func() {
+		secretRef := reconcileAndGet()
+		Expect(secretRef.Status.Environments).To(HaveLen(2))
+
+		dev := secretRef.Status.Environments[0]
+		Expect(dev.Environment).To(Equal("dev"))
+		Expect(dev.SecretStore).To(Equal(storeName))
+		Expect(dev.Validation).To(Equal(openchoreodevv1alpha1.SecretValidationPending))
+		Expect(dev.ExternalSecrets).To(HaveLen(1))
+		Expect(dev.ExternalSecrets[0].Name).To(Equal("api-env"))
+		Expect(dev.ExternalSecrets[0].Synced).To(BeTrue())
+		Expect(dev.ContentHash).To(HaveLen(16))
+
+		sandbox := secretRef.Status.Environments[1]
+		Expect(sandbox.Validation).To(Equal(openchoreodevv1alpha1.SecretValidationInvalid))
+		Expect(sandbox.ValidationMessage).To(ContainSubstring("no secret store configured"))
+
+		Expect(secretRef.Status.SecretStores).To(ConsistOf(openchoreodevv1alpha1.SecretStoreReference{
+			Name: storeName, Namespace: "dp-acme-dev", Kind: "ExternalSecret",
+		}))
+		Expect(conditionReason(secretRef, ConditionValidated)).To(Equal(string(ReasonReferencesUnresolved)))
+		Expect(conditionReason(secretRef, ConditionSynced)).To(Equal(string(ReasonSecretsSynced)))
+
+		By("creating a validation ExternalSecret that does not create a Secret")
+		probe := &unstructured.Unstructured{}
+		probe.SetGroupVersionKind(externalSecretGVK)
+		Expect(dpClient.Get(testCtx, client.ObjectKey{
+			Namespace: dpkubernetes.SystemNamespace,
+			Name:      dpkubernetes.GenerateK8sName(validationNamePrefix, namespace, key.Name),
+		}, probe)).To(Succeed())
+		policy, _, _ := unstructured.NestedString(probe.Object, "spec", "target", "creationPolicy")
+		Expect(policy).To(Equal("None"))
+		data, _, _ := unstructured.NestedSlice(probe.Object, "spec", "data")
+		Expect(data).To(ConsistOf(map[string]any{
+			"secretKey": "password",
+			"remoteRef": map[string]any{"key": "secret/data/db", "property": "password"},
+		}))
+
+		By("reporting the result of the secret store")
+		setProbeStatus(map[string]any{
+			"syncedResourceVersion": fmt.Sprintf("%d-abc", probe.GetGeneration()),
+			"conditions":            []any{map[string]any{"type": "Ready", "status": "True", "reason": "SecretSynced"}},
+		})
+		secretRef = reconcileAndGet()
+		Expect(secretRef.Status.Environments[0].Validation).To(Equal(openchoreodevv1alpha1.SecretValidationValid))
+
+		setProbeStatus(map[string]any{"conditions": []any{map[string]any{
+			"type": "Ready", "status": "False", "reason": "SecretSyncedError",
+			"message": "could not get secret data from provider",
+		}}})
+		secretRef = reconcileAndGet()
+		Expect(secretRef.Status.Environments[0].Validation).To(Equal(openchoreodevv1alpha1.SecretValidationInvalid))
+		Expect(secretRef.Status.Environments[0].ValidationMessage).To(ContainSubstring("could not get secret data from provider"))
+	})
+
+	It("should change the content hash only when the referenced value changes", func() {
+		secretRef := reconcileAndGet()
+		hash := secretRef.Status.Environments[0].ContentHash
+		Expect(secretRef.Status.Environments[0].LastRotationTime).To(BeNil())
+
+		secret := &corev1.Secret{}
+		Expect(dpClient.Get(testCtx, client.ObjectKey{Namespace: "dp-acme-dev", Name: "api-env"}, secret)).To(Succeed())
+		secret.Data["API_TOKEN"] = []byte("rotated")
+		Expect(dpClient.Update(testCtx, secret)).To(Succeed())
+		secretRef = reconcileAndGet()
+		Expect(secretRef.Status.Environments[0].ContentHash).To(Equal(hash))
+
+		secret.Data["DB_PASSWORD"] = []byte("rotated")
+		Expect(dpClient.Update(testCtx, secret)).To(Succeed())
+		secretRef = reconcileAndGet()
+		Expect(secretRef.Status.Environments[0].ContentHash).NotTo(Equal(hash))
+		Expect(secretRef.Status.Environments[0].LastRotationTime).NotTo(BeNil())
+	})
+
+	It("should not write the status again when nothing changed", func() {
+		secretRef := reconcileAndGet()
+		resourceVersion := secretRef.ResourceVersion
+
+		secretRef = reconcileAndGet()
+		Expect(secretRef.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should reconcile when a Release reports a new ExternalSecret sync", func() {
+		release := func(generation int64, status string) *openchoreodevv1alpha1.Release {
+			return &openchoreodevv1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{Name: "api-dev", Namespace: namespace, Generation: generation},
+				Status: openchoreodevv1alpha1.ReleaseStatus{Resources: []openchoreodevv1alpha1.ResourceStatus{{
+					ID: "api-env", Group: "external-secrets.io", Version: "v1", Kind: "ExternalSecret", Name: "api-env",
+					Status: &runtime.RawExtension{Raw: []byte(status)},
+				}}},
+			}
+		}
+		updated := func(old, new *openchoreodevv1alpha1.Release) bool {
+			return externalSecretStatusChanged().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: new})
+		}
+
+		Expect(updated(release(1, `{"syncedResourceVersion":"1"}`), release(1, `{"syncedResourceVersion":"1"}`))).To(BeFalse())
+		Expect(updated(release(1, `{"syncedResourceVersion":"1"}`), release(1, `{"syncedResourceVersion":"2"}`))).To(BeTrue())
+		Expect(updated(release(1, `{"syncedResourceVersion":"1"}`), release(2, `{"syncedResourceVersion":"1"}`))).To(BeTrue())
+	})
+
+	It("should report a missing secret store as invalid", func() {
+		store := &unstructured.Unstructured{}
+		store.SetGroupVersionKind(clusterSecretStoreGVK)
+		store.SetName(storeName)
+		Expect(dpClient.Delete(testCtx, store)).To(Succeed())
+
+		secretRef := reconcileAndGet()
+		Expect(secretRef.Status.Environments[0].Validation).To(Equal(openchoreodevv1alpha1.SecretValidationInvalid))
+		Expect(secretRef.Status.Environments[0].ValidationMessage).To(ContainSubstring("not found"))
+	})
+
+	It("should remove the validation ExternalSecrets when deleted", func() {
+		reconcileAndGet()
+		secretRef := &openchoreodevv1alpha1.SecretReference{}
+		Expect(cpClient.Get(testCtx, key, secretRef)).To(Succeed())
+		Expect(cpClient.Delete(testCtx, secretRef)).To(Succeed())
+
+		_, err := reconciler.Reconcile(testCtx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		probes := &unstructured.UnstructuredList{}
+		probes.SetGroupVersionKind(externalSecretGVK.GroupVersion().WithKind("ExternalSecretList"))
+		Expect(dpClient.List(testCtx, probes, client.InNamespace(dpkubernetes.SystemNamespace))).To(Succeed())
+		Expect(probes.Items).To(BeEmpty())
+		Expect(cpClient.Get(testCtx, key, secretRef)).NotTo(Succeed())
+	})
+})
+
+func conditionReason(secretRef *openchoreodevv1alpha1.SecretReference, conditionType interface{ String() string }) string {
+	for _, c := range secretRef.Status.Conditions {
+		if c.Type == conditionType.String() {
+			return c.Reason
+		}
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	externalSecretKind     = "ExternalSecret"
+	clusterSecretStoreKind = "ClusterSecretStore"
+
+	// validationNamePrefix prefixes the names of the validation ExternalSecrets in the data plane
+	validationNamePrefix = "secretref"
+)
+
+var (
+	externalSecretGVK     = esv1.SchemeGroupVersion.WithKind(externalSecretKind)
+	clusterSecretStoreGVK = esv1.SchemeGroupVersion.WithKind(clusterSecretStoreKind)
+)
+
+// validationResult is the outcome of validating a SecretReference in one data plane
+type validationResult struct {
+	phase   openchoreodevv1alpha1.SecretValidationPhase
+	message string
+}
+
+// validate checks that the ClusterSecretStore is ready and that every remote reference resolves in it.
+// The remote references are resolved by a validation ExternalSecret in the data plane system namespace
+// that reads the same keys as the SecretReference but does not create a Secret.
+func (r *Reconciler) validate(ctx context.Context, dpClient client.Client,
+	secretRef *openchoreodevv1alpha1.SecretReference, storeName string) validationResult {
+	store := &unstructured.Unstructured{}
+	store.SetGroupVersionKind(clusterSecretStoreGVK)
+	if err := dpClient.Get(ctx, client.ObjectKey{Name: storeName}, store); err != nil {
+		if apierrors.IsNotFound(err) {
+			return invalid("ClusterSecretStore %q not found in the data plane", storeName)
+		}
+		return pending("failed to get ClusterSecretStore %q: %v", storeName, err)
+	}
+	if ready, reason, message := readyCondition(store); ready == "False" {
+		return invalid("ClusterSecretStore %q is not ready: %s", storeName, conditionMessage(reason, message))
+	}
+
+	desired := makeValidationExternalSecret(secretRef, storeName)
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(externalSecretGVK)
+	err := dpClient.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if apierrors.IsNotFound(err) {
+		if err := dpClient.Create(ctx, desired); err != nil {
+			return pending("failed to create validation ExternalSecret: %v", err)
+		}
+		return pending("waiting for the secret store to resolve the remote references")
+	}
+	if err != nil {
+		return pending("failed to get validation ExternalSecret: %v", err)
+	}
+
+	if !equality.Semantic.DeepEqual(current.Object["spec"], desired.Object["spec"]) {
+		current.Object["spec"] = desired.Object["spec"]
+		if err := dpClient.Update(ctx, current); err != nil {
+			return pending("failed to update validation ExternalSecret: %v", err)
+		}
+		return pending("waiting for the secret store to resolve the remote references")
+	}
+
+	return validationResultFromExternalSecret(current)
+}
+
+// validationResultFromExternalSecret reads the result of a validation ExternalSecret from its Ready condition
+func validationResultFromExternalSecret(es *unstructured.Unstructured) validationResult {
+	ready, reason, message := readyCondition(es)
+	switch ready {
+	case "True":
+		// A Ready condition of an earlier spec is not a result for the current remote references
+		synced, _, _ := unstructured.NestedString(es.Object, "status", "syncedResourceVersion")
+		if !strings.HasPrefix(synced, fmt.Sprintf("%d-", es.GetGeneration())) {
+			return pending("waiting for the secret store to resolve the remote references")
+		}
+		return validationResult{phase: openchoreodevv1alpha1.SecretValidationValid}
+	case "False":
+		return invalid("remote references did not resolve: %s", conditionMessage(reason, message))
+	}
+	return pending("waiting for the secret store to resolve the remote references")
+}
+
+// makeValidationExternalSecret builds the validation ExternalSecret of a SecretReference
+func makeValidationExternalSecret(secretRef *openchoreodevv1alpha1.SecretReference, storeName string) *unstructured.Unstructured {
+	data := make([]any, 0, len(secretRef.Spec.Data))
+	for _, source := range secretRef.Spec.Data {
+		remoteRef := map[string]any{"key": source.RemoteRef.Key}
+		if source.RemoteRef.Property != "" {
+			remoteRef["property"] = source.RemoteRef.Property
+		}
+		if source.RemoteRef.Version != "" {
+			remoteRef["version"] = source.RemoteRef.Version
+		}
+		data = append(data, map[string]any{
+			"secretKey": source.SecretKey,
+			"remoteRef": remoteRef,
+		})
+	}
+
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(externalSecretGVK)
+	es.SetName(validationName(secretRef))
+	es.SetNamespace(dpkubernetes.SystemNamespace)
+	es.SetLabels(map[string]string{
+		labels.LabelKeyOrganizationName:    secretRef.Namespace,
+		labels.LabelKeySecretReferenceName: secretRef.Name,
+		labels.LabelKeyManagedBy:           labels.LabelValueManagedBy,
+	})
+	es.Object["spec"] = map[string]any{
+		"refreshInterval": refreshInterval(secretRef).String(),
+		"secretStoreRef": map[string]any{
+			"name": storeName,
+			"kind": clusterSecretStoreKind,
+		},
+		"target": map[string]any{
+			"creationPolicy": string(esv1.CreatePolicyNone),
+		},
+		"data": data,
+	}
+	return es
+}
+
+// validationName returns the name of the validation ExternalSecret of a SecretReference
+func validationName(secretRef *openchoreodevv1alpha1.SecretReference) string {
+	return dpkubernetes.GenerateK8sName(validationNamePrefix, secretRef.Namespace, secretRef.Name)
+}
+
+// readyCondition returns the status, reason and message of the Ready condition of an External Secrets Operator resource
+func readyCondition(obj *unstructured.Unstructured) (string, string, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	return readyConditionOf(conditions)
+}
+
+// readyConditionOf finds the Ready condition in a list of conditions
+func readyConditionOf(conditions []any) (string, string, string) {
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != string(esv1.ExternalSecretReady) {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		return status, reason, message
+	}
+	return "", "", ""
+}
+
+// conditionMessage joins the reason and message of a condition
+func conditionMessage(reason, message string) string {
+	switch {
+	case message == "":
+		return reason
+	case reason == "":
+		return message
+	}
+	return reason + ": " + message
+}
+
+func invalid(format string, args ...any) validationResult {
+	return validationResult{phase: openchoreodevv1alpha1.SecretValidationInvalid, message: fmt.Sprintf(format, args...)}
+}
+
+func pending(format string, args ...any) validationResult {
+	return validationResult{phase: openchoreodevv1alpha1.SecretValidationPending, message: fmt.Sprintf(format, args...)}
+}
+
+// isExternalSecret reports whether a GroupKind is an External Secrets Operator ExternalSecret
+func isExternalSecret(gk schema.GroupKind) bool {
+	return gk.Group == esv1.SchemeGroupVersion.Group && gk.Kind == externalSecretKind
+}
@@ -45,5 +45,29 @@ const (
 	// created by the observabilityalertsnotificationchannel controller.
 	LabelKeyNotificationChannelName = "openchoreo.dev/notification-channel-name"
 
+	// LabelKeyPreviewEnvironment marks resources created for a pull request PreviewEnvironment.
+	// ComponentWorkflowRuns carrying it do not update the component's Workload.
+	LabelKeyPreviewEnvironment = "openchoreo.dev/preview-environment"
+
+	// LabelKeyRolloutTrack separates the stable and canary pods of a component during a progressive rollout.
+	LabelKeyRolloutTrack = "openchoreo.dev/rollout-track"
+
+	// LabelKeySecretReferenceName identifies the validation ExternalSecret of a SecretReference in a data plane.
+	LabelKeySecretReferenceName = "openchoreo.dev/secret-reference"
+
 	LabelValueManagedBy = "openchoreo-control-plane"
+
+	// AnnotationKeyNotificationDeliveryFailing is set by the observer on the ConfigMap of a notification channel.
+	// It is "true" once a notification exhausts its delivery attempts, and "false" after the next delivery
+	// succeeds. The controller surfaces it as a condition on the ObservabilityAlertsNotificationChannel.
+	AnnotationKeyNotificationDeliveryFailing = "openchoreo.dev/notification-delivery-failing"
+
+	// AnnotationKeyNotificationDeliveryMessage describes the delivery that set the failing annotation.
+	AnnotationKeyNotificationDeliveryMessage = "openchoreo.dev/notification-delivery-message"
+
+	// AnnotationKeyNotificationDeliveryTime is when the observer last changed the failing annotation.
+	AnnotationKeyNotificationDeliveryTime = "openchoreo.dev/notification-delivery-time"
+
+	LabelValueRolloutTrackStable = "stable"
+	LabelValueRolloutTrackCanary = "canary"
 )
@@ -0,0 +1,539 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultGroupWait is how long a new group waits for more alerts before its notification is sent
+	DefaultGroupWait = 30 * time.Second
+	// DefaultRepeatInterval is how long a firing alert waits before it is notified again
+	DefaultRepeatInterval = 4 * time.Hour
+	// DefaultRetention is how long resolved alerts and ended silences are kept
+	DefaultRetention = 24 * time.Hour
+
+	defaultNotifyTimeout = 30 * time.Second
+	// expiryInterval is how often firing alerts are checked for a passed resolve timeout
+	expiryInterval = time.Minute
+)
+
+// Notifier sends the notification of an alert group to its channel
+type Notifier interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
+
+// Store persists alert states and silences so that they survive restarts
+type Store interface {
+	SaveState(ctx context.Context, state *State) error
+	SaveSilence(ctx context.Context, silence *Silence) error
+	// Load returns the firing alerts, and the resolved alerts and silences that ended after since
+	Load(ctx context.Context, since time.Time) ([]*State, []*Silence, error)
+}
+
+// Config holds the timing of the alert lifecycle
+type Config struct {
+	GroupWait      time.Duration
+	RepeatInterval time.Duration
+	Retention      time.Duration
+	NotifyTimeout  time.Duration
+}
+
+// Manager tracks the state of alerts across evaluations. Evaluations of an alert that is already firing
+// are deduplicated until the repeat interval elapses, alerts of the same scope and channel are grouped into
+// one notification, resolves are notified for alerts that were notified as firing, and silences suppress
+// notifications altogether.
+type Manager struct {
+	config   Config
+	store    Store
+	notifier Notifier
+	logger   *slog.Logger
+
+	now       func() time.Time
+	afterFunc func(d time.Duration, f func())
+
+	mu       sync.Mutex
+	states   map[string]*State
+	silences map[string]*Silence
+	groups   map[string]*pendingGroup
+}
+
+// pendingGroup holds the fingerprints of the alerts waiting for the notification of a group
+type pendingGroup struct {
+	channel  string
+	firing   map[string]struct{}
+	resolved map[string]struct{}
+}
+
+// NewManager creates a manager. The store may be nil, in which case state is kept in memory only.
+func NewManager(config Config, store Store, notifier Notifier, logger *slog.Logger) *Manager {
+	if config.GroupWait < 0 {
+		config.GroupWait = 0
+	}
+	if config.RepeatInterval <= 0 {
+		config.RepeatInterval = DefaultRepeatInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+	if config.NotifyTimeout <= 0 {
+		config.NotifyTimeout = defaultNotifyTimeout
+	}
+	return &Manager{
+		config:    config,
+		store:     store,
+		notifier:  notifier,
+		logger:    logger,
+		now:       time.Now,
+		afterFunc: func(d time.Duration, f func()) { time.AfterFunc(d, f) },
+		states:    map[string]*State{},
+		silences:  map[string]*Silence{},
+		groups:    map[string]*pendingGroup{},
+	}
+}
+
+// Load restores the persisted alert states and silences
+func (m *Manager) Load(ctx context.Context) error {
+	if m.store == nil {
+		return nil
+	}
+	states, silences, err := m.store.Load(ctx, m.now().Add(-m.config.Retention))
+	if err != nil {
+		return fmt.Errorf("failed to load alert state: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, state := range states {
+		m.states[state.Fingerprint] = state
+	}
+	for _, silence := range silences {
+		m.silences[silence.ID] = silence
+	}
+	m.logger.Info("Loaded alert state", "alerts", len(states), "silences", len(silences))
+	return nil
+}
+
+// IsFiring reports whether the alert is already firing, that is whether an evaluation would not start it
+func (m *Manager) IsFiring(alert *Alert) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[alert.Fingerprint()]
+	return ok && state.Status == StatusFiring
+}
+
+// Receive records an evaluation of an alert and queues its notification when one is due. It returns a
+// copy of the alert state, which is nil when a resolve is received for an unknown alert.
+func (m *Manager) Receive(ctx context.Context, alert *Alert) (Result, *State) {
+	now := m.now()
+
+	m.mu.Lock()
+	m.prune(now)
+	var state *State
+	var result Result
+	if alert.Status == StatusResolved {
+		state, result = m.receiveResolved(alert, now)
+	} else {
+		state, result = m.receiveFiring(alert, now)
+	}
+	snapshot := copyState(state)
+	m.mu.Unlock()
+
+	if snapshot != nil {
+		m.saveStates(ctx, snapshot)
+	}
+	return result, snapshot
+}
+
+func (m *Manager) receiveFiring(alert *Alert, now time.Time) (*State, Result) {
+	fingerprint := alert.Fingerprint()
+	state, ok := m.states[fingerprint]
+	started := !ok || state.Status == StatusResolved
+	if started {
+		state = &State{
+			Fingerprint:   fingerprint,
+			RuleName:      alert.RuleName,
+			RuleNamespace: alert.RuleNamespace,
+			Project:       alert.Project,
+			Component:     alert.Component,
+			Environment:   alert.Environment,
+			AlertID:       alert.AlertID,
+			StartsAt:      now,
+		}
+		m.states[fingerprint] = state
+	} else if state.GroupKey != alert.GroupKey() {
+		// The rule now notifies another channel
+		m.dequeue(state)
+	}
+
+	state.Status = StatusFiring
+	state.GroupKey = alert.GroupKey()
+	state.Channel = alert.Channel
+	state.Severity = alert.Severity
+	state.Details = alert.Details
+	state.LastReceivedAt = now
+	state.Occurrences++
+	state.ExpiresAt = nil
+	if alert.ResolveTimeout > 0 {
+		expiresAt := now.Add(alert.ResolveTimeout)
+		state.ExpiresAt = &expiresAt
+	}
+
+	if silence := m.matchingSilence(state, now); silence != nil {
+		state.SilencedBy = silence.ID
+		m.dequeue(state)
+		return state, ResultSilenced
+	}
+	state.SilencedBy = ""
+
+	if m.queued(state) {
+		return state, ResultDeduplicated
+	}
+	if state.LastNotifiedAt != nil && now.Sub(*state.LastNotifiedAt) < m.config.RepeatInterval {
+		return state, ResultDeduplicated
+	}
+	m.enqueue(state)
+	return state, ResultQueued
+}
+
+func (m *Manager) receiveResolved(alert *Alert, now time.Time) (*State, Result) {
+	state, ok := m.states[alert.Fingerprint()]
+	if !ok {
+		return nil, ResultIgnored
+	}
+	if state.Status == StatusResolved {
+		return state, ResultDeduplicated
+	}
+	return state, m.resolve(state, now)
+}
+
+// resolve marks a firing alert resolved and queues the resolve notification when the alert was notified
+func (m *Manager) resolve(state *State, now time.Time) Result {
+	// An alert that is resolved before its group is flushed is never notified
+	m.dequeue(state)
+	state.Status = StatusResolved
+	state.ResolvedAt = &now
+	state.ExpiresAt = nil
+	state.LastReceivedAt = now
+
+	if silence := m.matchingSilence(state, now); silence != nil {
+		state.SilencedBy = silence.ID
+		return ResultSilenced
+	}
+	if state.LastNotifiedAt == nil {
+		return ResultIgnored
+	}
+	m.enqueue(state)
+	return ResultQueued
+}
+
+// Run resolves the alerts whose resolve timeout passed until the context is done
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(expiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.resolveExpired(ctx)
+		}
+	}
+}
+
+func (m *Manager) resolveExpired(ctx context.Context) {
+	now := m.now()
+	var resolved []*State
+
+	m.mu.Lock()
+	for _, state := range m.states {
+		if state.Status == StatusFiring && state.ExpiresAt != nil && !now.Before(*state.ExpiresAt) {
+			m.resolve(state, now)
+			resolved = append(resolved, copyState(state))
+		}
+	}
+	m.mu.Unlock()
+
+	for _, state := range resolved {
+		m.logger.Debug("Resolved alert after its resolve timeout", "fingerprint", state.Fingerprint, "rule", state.RuleName)
+	}
+	m.saveStates(ctx, resolved...)
+}
+
+// enqueue adds the alert to its pending group, starting the group wait when the group is new
+func (m *Manager) enqueue(state *State) {
+	group, ok := m.groups[state.GroupKey]
+	if !ok {
+		group = &pendingGroup{
+			channel:  state.Channel,
+			firing:   map[string]struct{}{},
+			resolved: map[string]struct{}{},
+		}
+		m.groups[state.GroupKey] = group
+		groupKey := state.GroupKey
+		m.afterFunc(m.config.GroupWait, func() { m.flush(groupKey) })
+	}
+	if state.Status == StatusResolved {
+		group.resolved[state.Fingerprint] = struct{}{}
+	} else {
+		group.firing[state.Fingerprint] = struct{}{}
+	}
+}
+
+func (m *Manager) dequeue(state *State) {
+	if group, ok := m.groups[state.GroupKey]; ok {
+		delete(group.firing, state.Fingerprint)
+		delete(group.resolved, state.Fingerprint)
+	}
+}
+
+func (m *Manager) queued(state *State) bool {
+	group, ok := m.groups[state.GroupKey]
+	if !ok {
+		return false
+	}
+	_, ok = group.firing[state.Fingerprint]
+	return ok
+}
+
+// flush sends the notification of a pending group. Alerts that changed status or were silenced while the
+// group waited are left out.
+func (m *Manager) flush(groupKey string) {
+	m.mu.Lock()
+	group, ok := m.groups[groupKey]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+	delete(m.groups, groupKey)
+
+	now := m.now()
+	notification := &Notification{Channel: group.channel, GroupKey: groupKey}
+	var silenced []*State
+	for fingerprint := range group.firing {
+		state, ok := m.states[fingerprint]
+		if !ok || state.Status != StatusFiring {
+			continue
+		}
+		if silence := m.matchingSilence(state, now); silence != nil {
+			state.SilencedBy = silence.ID
+			silenced = append(silenced, copyState(state))
+			continue
+		}
+		state.LastNotifiedAt = &now
+		notification.Firing = append(notification.Firing, copyState(state))
+	}
+	for fingerprint := range group.resolved {
+		state, ok := m.states[fingerprint]
+		if !ok || state.Status != StatusResolved {
+			continue
+		}
+		state.LastNotifiedAt = &now
+		notification.Resolved = append(notification.Resolved, copyState(state))
+	}
+	m.mu.Unlock()
+
+	m.saveStates(context.Background(), silenced...)
+	if len(notification.Firing) == 0 && len(notification.Resolved) == 0 {
+		return
+	}
+	sortStates(notification.Firing)
+	sortStates(notification.Resolved)
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.config.NotifyTimeout)
+	defer cancel()
+	if err := m.notifier.Notify(ctx, notification); err != nil {
+		m.logger.Error("Failed to send alert notification",
+			"channel", notification.Channel, "group", groupKey, "error", err)
+		// Clearing the notification time lets the next evaluation queue the firing alerts again
+		m.mu.Lock()
+		for _, sent := range notification.Firing {
+			if state, ok := m.states[sent.Fingerprint]; ok && state.LastNotifiedAt != nil && state.LastNotifiedAt.Equal(now) {
+				state.LastNotifiedAt = nil
+				sent.LastNotifiedAt = nil
+			}
+		}
+		m.mu.Unlock()
+	}
+	m.saveStates(ctx, append(notification.Firing, notification.Resolved...)...)
+}
+
+// matchingSilence returns an active silence that covers the alert
+func (m *Manager) matchingSilence(state *State, now time.Time) *Silence {
+	for _, silence := range m.silences {
+		if silence.Active(now) && silence.Matches(state) {
+			return silence
+		}
+	}
+	return nil
+}
+
+// prune drops resolved alerts and ended silences that are past the retention
+func (m *Manager) prune(now time.Time) {
+	cutoff := now.Add(-m.config.Retention)
+	for fingerprint, state := range m.states {
+		if state.Status == StatusResolved && state.ResolvedAt != nil && state.ResolvedAt.Before(cutoff) {
+			m.dequeue(state)
+			delete(m.states, fingerprint)
+		}
+	}
+	for id, silence := range m.silences {
+		if silence.EndsAt.Before(cutoff) {
+			delete(m.silences, id)
+		}
+	}
+}
+
+// ListAlerts returns the alerts that match the filter, most recently started first
+func (m *Manager) ListAlerts(filter AlertFilter) []*State {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prune(m.now())
+
+	states := []*State{}
+	for _, state := range m.states {
+		if filter.matches(state) {
+			states = append(states, copyState(state))
+		}
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if !states[i].StartsAt.Equal(states[j].StartsAt) {
+			return states[i].StartsAt.After(states[j].StartsAt)
+		}
+		return states[i].Fingerprint < states[j].Fingerprint
+	})
+	return states
+}
+
+// CreateSilence validates and stores a silence. The start defaults to now.
+func (m *Manager) CreateSilence(ctx context.Context, silence *Silence) (*Silence, error) {
+	now := m.now()
+	created := *silence
+	if created.StartsAt.IsZero() {
+		created.StartsAt = now
+	}
+	if err := created.Validate(); err != nil {
+		return nil, err
+	}
+	if !created.EndsAt.After(now) {
+		return nil, fmt.Errorf("%w: endsAt must be in the future", ErrInvalidSilence)
+	}
+	created.ID = uuid.NewString()
+	created.CreatedAt = now
+
+	m.mu.Lock()
+	stored := created
+	m.silences[created.ID] = &stored
+	m.mu.Unlock()
+
+	m.saveSilence(ctx, &created)
+	return &created, nil
+}
+
+// ExpireSilence ends a silence now. Silences that already ended are returned unchanged.
+func (m *Manager) ExpireSilence(ctx context.Context, id string) (*Silence, error) {
+	now := m.now()
+
+	m.mu.Lock()
+	silence, ok := m.silences[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil, ErrSilenceNotFound
+	}
+	changed := silence.EndsAt.After(now)
+	if changed {
+		silence.EndsAt = now
+		if silence.StartsAt.After(now) {
+			silence.StartsAt = now
+		}
+	}
+	expired := *silence
+	m.mu.Unlock()
+
+	if changed {
+		m.saveSilence(ctx, &expired)
+	}
+	return &expired, nil
+}
+
+// GetSilence returns a silence by ID
+func (m *Manager) GetSilence(id string) (*Silence, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	silence, ok := m.silences[id]
+	if !ok {
+		return nil, ErrSilenceNotFound
+	}
+	copied := *silence
+	return &copied, nil
+}
+
+// ListSilences returns the silences that match the filter, newest first
+func (m *Manager) ListSilences(filter SilenceFilter) []*Silence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	m.prune(now)
+
+	silences := []*Silence{}
+	for _, silence := range m.silences {
+		if filter.matches(silence, now) {
+			copied := *silence
+			silences = append(silences, &copied)
+		}
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		if !silences[i].CreatedAt.Equal(silences[j].CreatedAt) {
+			return silences[i].CreatedAt.After(silences[j].CreatedAt)
+		}
+		return silences[i].ID < silences[j].ID
+	})
+	return silences
+}
+
+// saveStates persists states, logging failures since the in-memory state stays authoritative
+func (m *Manager) saveStates(ctx context.Context, states ...*State) {
+	if m.store == nil {
+		return
+	}
+	for _, state := range states {
+		if err := m.store.SaveState(ctx, state); err != nil {
+			m.logger.Warn("Failed to persist alert state", "fingerprint", state.Fingerprint, "error", err)
+		}
+	}
+}
+
+func (m *Manager) saveSilence(ctx context.Context, silence *Silence) {
+	if m.store == nil {
+		return
+	}
+	if err := m.store.SaveSilence(ctx, silence); err != nil {
+		m.logger.Warn("Failed to persist silence", "silence_id", silence.ID, "error", err)
+	}
+}
+
+func copyState(state *State) *State {
+	if state == nil {
+		return nil
+	}
+	copied := *state
+	return &copied
+}
+
+func sortStates(states []*State) {
+	sort.Slice(states, func(i, j int) bool {
+		if !states[i].StartsAt.Equal(states[j].StartsAt) {
+			return states[i].StartsAt.Before(states[j].StartsAt)
+		}
+		return states[i].RuleName < states[j].RuleName
+	})
+}
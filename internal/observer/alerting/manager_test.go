@@ -0,0 +1,322 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type recordingNotifier struct {
+	notifications []*Notification
+	err           error
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, notification *Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return n.err
+}
+
+// testManager returns a manager with a controllable clock whose group waits elapse only when flushed
+type testManager struct {
+	*Manager
+	clock    time.Time
+	notifier *recordingNotifier
+	pending  []func()
+}
+
+func newTestManager() *testManager {
+	tm := &testManager{
+		clock:    time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		notifier: &recordingNotifier{},
+	}
+	tm.Manager = NewManager(Config{GroupWait: 30 * time.Second, RepeatInterval: time.Hour}, nil, tm.notifier,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	tm.now = func() time.Time { return tm.clock }
+	tm.afterFunc = func(_ time.Duration, f func()) { tm.pending = append(tm.pending, f) }
+	return tm
+}
+
+func (tm *testManager) advance(d time.Duration) {
+	tm.clock = tm.clock.Add(d)
+}
+
+func (tm *testManager) flushAll() {
+	pending := tm.pending
+	tm.pending = nil
+	for _, f := range pending {
+		f()
+	}
+}
+
+func testAlert(name string, status Status) *Alert {
+	return &Alert{
+		RuleName:      name,
+		RuleNamespace: "default",
+		Channel:       "on-call",
+		Project:       "shop",
+		Component:     "api",
+		Environment:   "production",
+		Severity:      "critical",
+		Status:        status,
+	}
+}
+
+func TestReceiveDeduplicatesUntilRepeatInterval(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	if result, state := tm.Receive(ctx, testAlert("high-latency", StatusFiring)); result != ResultQueued || state.Occurrences != 1 {
+		t.Fatalf("first evaluation: got %s with %d occurrences", result, state.Occurrences)
+	}
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusFiring)); result != ResultDeduplicated {
+		t.Fatalf("evaluation while queued: got %s, want %s", result, ResultDeduplicated)
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 1 || len(tm.notifier.notifications[0].Firing) != 1 {
+		t.Fatalf("expected one notification with one firing alert, got %+v", tm.notifier.notifications)
+	}
+
+	tm.advance(30 * time.Minute)
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusFiring)); result != ResultDeduplicated {
+		t.Fatalf("evaluation within repeat interval: got %s, want %s", result, ResultDeduplicated)
+	}
+
+	tm.advance(31 * time.Minute)
+	result, state := tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	if result != ResultQueued {
+		t.Fatalf("evaluation after repeat interval: got %s, want %s", result, ResultQueued)
+	}
+	if state.Occurrences != 4 {
+		t.Errorf("occurrences = %d, want 4", state.Occurrences)
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 2 {
+		t.Errorf("expected the alert to be notified again, got %d notifications", len(tm.notifier.notifications))
+	}
+}
+
+func TestReceiveGroupsAlertsOfTheSameScope(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	tm.Receive(ctx, testAlert("high-error-rate", StatusFiring))
+	other := testAlert("high-latency", StatusFiring)
+	other.Environment = "staging"
+	tm.Receive(ctx, other)
+
+	if len(tm.pending) != 2 {
+		t.Fatalf("expected two pending groups, got %d", len(tm.pending))
+	}
+	tm.flushAll()
+
+	sizes := map[string]int{}
+	for _, notification := range tm.notifier.notifications {
+		sizes[notification.GroupKey] = len(notification.Firing)
+	}
+	if sizes["on-call/default/shop/api/production"] != 2 || sizes["on-call/default/shop/api/staging"] != 1 {
+		t.Errorf("unexpected groups: %v", sizes)
+	}
+}
+
+func TestReceiveResolved(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	tm.flushAll()
+
+	tm.advance(5 * time.Minute)
+	result, state := tm.Receive(ctx, testAlert("high-latency", StatusResolved))
+	if result != ResultQueued || state.Status != StatusResolved || state.ResolvedAt == nil {
+		t.Fatalf("resolve: got %s with state %+v", result, state)
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 2 || len(tm.notifier.notifications[1].Resolved) != 1 {
+		t.Fatalf("expected a resolve notification, got %+v", tm.notifier.notifications)
+	}
+
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusResolved)); result != ResultDeduplicated {
+		t.Errorf("repeated resolve: got %s, want %s", result, ResultDeduplicated)
+	}
+
+	// The next evaluation starts a new alert that is notified right away
+	result, state = tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	if result != ResultQueued || state.Occurrences != 1 {
+		t.Errorf("refiring: got %s with %d occurrences", result, state.Occurrences)
+	}
+}
+
+func TestReceiveResolvedBeforeNotified(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusResolved)); result != ResultIgnored {
+		t.Fatalf("resolve before the group wait: got %s, want %s", result, ResultIgnored)
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 0 {
+		t.Errorf("expected no notifications, got %d", len(tm.notifier.notifications))
+	}
+
+	if result, state := tm.Receive(ctx, testAlert("unknown", StatusResolved)); result != ResultIgnored || state != nil {
+		t.Errorf("resolve of an unknown alert: got %s with state %+v", result, state)
+	}
+}
+
+func TestSilences(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	silence, err := tm.CreateSilence(ctx, &Silence{
+		Namespace:   "default",
+		Project:     "shop",
+		Environment: "production",
+		EndsAt:      tm.clock.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateSilence() error = %v", err)
+	}
+
+	result, state := tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	if result != ResultSilenced || state.SilencedBy != silence.ID {
+		t.Fatalf("silenced evaluation: got %s silenced by %q", result, state.SilencedBy)
+	}
+
+	other := testAlert("high-latency", StatusFiring)
+	other.Environment = "staging"
+	if result, _ := tm.Receive(ctx, other); result != ResultQueued {
+		t.Errorf("evaluation outside the silence scope: got %s, want %s", result, ResultQueued)
+	}
+
+	if _, err := tm.ExpireSilence(ctx, silence.ID); err != nil {
+		t.Fatalf("ExpireSilence() error = %v", err)
+	}
+	if active := tm.ListSilences(SilenceFilter{Namespace: "default", ActiveOnly: true}); len(active) != 0 {
+		t.Errorf("expected no active silences, got %d", len(active))
+	}
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusFiring)); result != ResultQueued {
+		t.Errorf("evaluation after the silence expired: got %s, want %s", result, ResultQueued)
+	}
+
+	if _, err := tm.ExpireSilence(ctx, "missing"); !errors.Is(err, ErrSilenceNotFound) {
+		t.Errorf("ExpireSilence() of a missing silence error = %v", err)
+	}
+}
+
+func TestSilenceCreatedWhileQueued(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	if _, err := tm.CreateSilence(ctx, &Silence{
+		Namespace: "default",
+		Project:   "shop",
+		Component: "api",
+		EndsAt:    tm.clock.Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("CreateSilence() error = %v", err)
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 0 {
+		t.Errorf("expected the queued alert to be suppressed, got %d notifications", len(tm.notifier.notifications))
+	}
+}
+
+func TestCreateSilenceValidation(t *testing.T) {
+	tm := newTestManager()
+	end := tm.clock.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		silence Silence
+	}{
+		{"missing namespace", Silence{Project: "shop", EndsAt: end}},
+		{"missing scope", Silence{Namespace: "default", EndsAt: end}},
+		{"component without project", Silence{Namespace: "default", Component: "api", EndsAt: end}},
+		{"missing end", Silence{Namespace: "default", Project: "shop"}},
+		{"end before start", Silence{Namespace: "default", Project: "shop", StartsAt: end, EndsAt: end.Add(-time.Minute)}},
+		{"end in the past", Silence{Namespace: "default", Project: "shop", StartsAt: end.Add(-2 * time.Hour), EndsAt: end.Add(-90 * time.Minute)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tm.CreateSilence(context.Background(), &tt.silence); !errors.Is(err, ErrInvalidSilence) {
+				t.Errorf("CreateSilence() error = %v, want %v", err, ErrInvalidSilence)
+			}
+		})
+	}
+}
+
+func TestFailedNotificationIsRetriedOnNextEvaluation(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+	tm.notifier.err = errors.New("channel unavailable")
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	tm.flushAll()
+
+	tm.notifier.err = nil
+	if result, _ := tm.Receive(ctx, testAlert("high-latency", StatusFiring)); result != ResultQueued {
+		t.Errorf("evaluation after a failed notification: got %s, want %s", result, ResultQueued)
+	}
+}
+
+func TestListAlerts(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	tm.Receive(ctx, testAlert("high-latency", StatusFiring))
+	tm.advance(time.Minute)
+	tm.Receive(ctx, testAlert("high-error-rate", StatusFiring))
+	tm.Receive(ctx, testAlert("high-latency", StatusResolved))
+
+	firing := tm.ListAlerts(AlertFilter{Status: StatusFiring})
+	if len(firing) != 1 || firing[0].RuleName != "high-error-rate" {
+		t.Errorf("unexpected firing alerts: %+v", firing)
+	}
+	all := tm.ListAlerts(AlertFilter{Namespace: "default", Project: "shop"})
+	if len(all) != 2 || all[0].RuleName != "high-error-rate" {
+		t.Errorf("expected both alerts, newest first, got %+v", all)
+	}
+
+	// Resolved alerts are dropped once they are past the retention
+	tm.advance(DefaultRetention + time.Minute)
+	if remaining := tm.ListAlerts(AlertFilter{}); len(remaining) != 1 {
+		t.Errorf("expected only the firing alert to remain, got %d", len(remaining))
+	}
+}
+
+func TestResolveTimeout(t *testing.T) {
+	tm := newTestManager()
+	ctx := context.Background()
+
+	alert := testAlert("error-logs", StatusFiring)
+	alert.ResolveTimeout = 10 * time.Minute
+	tm.Receive(ctx, alert)
+	tm.flushAll()
+
+	tm.advance(8 * time.Minute)
+	tm.Receive(ctx, alert)
+	tm.advance(8 * time.Minute)
+	tm.resolveExpired(ctx)
+	if firing := tm.ListAlerts(AlertFilter{Status: StatusFiring}); len(firing) != 1 {
+		t.Fatalf("expected the alert to still fire within its resolve timeout, got %d firing", len(firing))
+	}
+
+	tm.advance(3 * time.Minute)
+	tm.resolveExpired(ctx)
+	if resolved := tm.ListAlerts(AlertFilter{Status: StatusResolved}); len(resolved) != 1 {
+		t.Fatalf("expected the alert to resolve after its resolve timeout, got %d resolved", len(resolved))
+	}
+	tm.flushAll()
+	if len(tm.notifier.notifications) != 2 || len(tm.notifier.notifications[1].Resolved) != 1 {
+		t.Errorf("expected a resolve notification, got %+v", tm.notifier.notifications)
+	}
+}
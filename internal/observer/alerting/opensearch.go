@@ -0,0 +1,161 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+const (
+	stateIndexName   = "openchoreo-alert-state"
+	silenceIndexName = "openchoreo-alert-silences"
+
+	// loadLimit bounds the documents read back at startup
+	loadLimit = 10000
+)
+
+var stateIndexBody = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"properties": map[string]interface{}{
+			"fingerprint":    map[string]interface{}{"type": "keyword"},
+			"groupKey":       map[string]interface{}{"type": "keyword"},
+			"ruleName":       map[string]interface{}{"type": "keyword"},
+			"ruleNamespace":  map[string]interface{}{"type": "keyword"},
+			"channel":        map[string]interface{}{"type": "keyword"},
+			"project":        map[string]interface{}{"type": "keyword"},
+			"component":      map[string]interface{}{"type": "keyword"},
+			"environment":    map[string]interface{}{"type": "keyword"},
+			"severity":       map[string]interface{}{"type": "keyword"},
+			"status":         map[string]interface{}{"type": "keyword"},
+			"alertId":        map[string]interface{}{"type": "keyword"},
+			"startsAt":       map[string]interface{}{"type": "date"},
+			"lastReceivedAt": map[string]interface{}{"type": "date"},
+			"lastNotifiedAt": map[string]interface{}{"type": "date"},
+			"resolvedAt":     map[string]interface{}{"type": "date"},
+			"occurrences":    map[string]interface{}{"type": "integer"},
+			"silencedBy":     map[string]interface{}{"type": "keyword"},
+			"details":        map[string]interface{}{"type": "object", "enabled": false},
+		},
+	},
+}
+
+var silenceIndexBody = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"properties": map[string]interface{}{
+			"id":          map[string]interface{}{"type": "keyword"},
+			"namespace":   map[string]interface{}{"type": "keyword"},
+			"project":     map[string]interface{}{"type": "keyword"},
+			"component":   map[string]interface{}{"type": "keyword"},
+			"environment": map[string]interface{}{"type": "keyword"},
+			"startsAt":    map[string]interface{}{"type": "date"},
+			"endsAt":      map[string]interface{}{"type": "date"},
+			"comment":     map[string]interface{}{"type": "text"},
+			"createdBy":   map[string]interface{}{"type": "keyword"},
+			"createdAt":   map[string]interface{}{"type": "date"},
+		},
+	},
+}
+
+// documentClient is the part of the OpenSearch client the store uses
+type documentClient interface {
+	EnsureIndex(ctx context.Context, index string, body map[string]interface{}) error
+	WriteDocument(ctx context.Context, index, id string, document interface{}) error
+	Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error)
+}
+
+// OpenSearchStore keeps alert states and silences in OpenSearch, keyed by fingerprint and silence ID
+type OpenSearchStore struct {
+	client documentClient
+}
+
+var _ Store = (*OpenSearchStore)(nil)
+
+// NewOpenSearchStore creates the alert state and silence indices when they are missing
+func NewOpenSearchStore(ctx context.Context, client documentClient) (*OpenSearchStore, error) {
+	if client == nil {
+		return nil, errors.New("OpenSearch client is required")
+	}
+	if err := client.EnsureIndex(ctx, stateIndexName, stateIndexBody); err != nil {
+		return nil, fmt.Errorf("failed to prepare alert state index: %w", err)
+	}
+	if err := client.EnsureIndex(ctx, silenceIndexName, silenceIndexBody); err != nil {
+		return nil, fmt.Errorf("failed to prepare silence index: %w", err)
+	}
+	return &OpenSearchStore{client: client}, nil
+}
+
+// SaveState implements Store
+func (s *OpenSearchStore) SaveState(ctx context.Context, state *State) error {
+	return s.client.WriteDocument(ctx, stateIndexName, state.Fingerprint, state)
+}
+
+// SaveSilence implements Store
+func (s *OpenSearchStore) SaveSilence(ctx context.Context, silence *Silence) error {
+	return s.client.WriteDocument(ctx, silenceIndexName, silence.ID, silence)
+}
+
+// Load implements Store
+func (s *OpenSearchStore) Load(ctx context.Context, since time.Time) ([]*State, []*Silence, error) {
+	cutoff := since.UTC().Format(time.RFC3339Nano)
+
+	stateResponse, err := s.client.Search(ctx, []string{stateIndexName}, map[string]interface{}{
+		"size": loadLimit,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"term": map[string]interface{}{"status": string(StatusFiring)}},
+					{"range": map[string]interface{}{"resolvedAt": map[string]interface{}{"gte": cutoff}}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search alert states: %w", err)
+	}
+	states := make([]*State, 0, len(stateResponse.Hits.Hits))
+	for _, hit := range stateResponse.Hits.Hits {
+		state := &State{}
+		if err := decodeHit(hit, state); err != nil {
+			return nil, nil, err
+		}
+		states = append(states, state)
+	}
+
+	silenceResponse, err := s.client.Search(ctx, []string{silenceIndexName}, map[string]interface{}{
+		"size": loadLimit,
+		"query": map[string]interface{}{
+			"range": map[string]interface{}{"endsAt": map[string]interface{}{"gte": cutoff}},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search silences: %w", err)
+	}
+	silences := make([]*Silence, 0, len(silenceResponse.Hits.Hits))
+	for _, hit := range silenceResponse.Hits.Hits {
+		silence := &Silence{}
+		if err := decodeHit(hit, silence); err != nil {
+			return nil, nil, err
+		}
+		silences = append(silences, silence)
+	}
+	return states, silences, nil
+}
+
+func decodeHit(hit opensearch.Hit, out interface{}) error {
+	data, err := json.Marshal(hit.Source)
+	if err != nil {
+		return fmt.Errorf("failed to read document %s: %w", hit.ID, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to read document %s: %w", hit.ID, err)
+	}
+	return nil
+}
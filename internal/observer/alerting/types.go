@@ -0,0 +1,194 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Status is the lifecycle status of an alert
+type Status string
+
+const (
+	StatusFiring   Status = "firing"
+	StatusResolved Status = "resolved"
+)
+
+// Result tells what the manager did with a received alert
+type Result string
+
+const (
+	// ResultQueued means a notification is queued and sent once the group wait elapses
+	ResultQueued Result = "queued"
+	// ResultDeduplicated means the alert was already notified within the repeat interval, or is already queued
+	ResultDeduplicated Result = "deduplicated"
+	// ResultSilenced means a silence matches the alert, so no notification is sent
+	ResultSilenced Result = "silenced"
+	// ResultIgnored means the alert needs no notification, such as a resolve for an alert that was never notified
+	ResultIgnored Result = "ignored"
+)
+
+var (
+	// ErrSilenceNotFound is returned when a silence does not exist
+	ErrSilenceNotFound = errors.New("silence not found")
+	// ErrInvalidSilence is returned when a silence fails validation
+	ErrInvalidSilence = errors.New("invalid silence")
+)
+
+// Alert is a single evaluation of an alert rule as reported by OpenSearch or Prometheus
+type Alert struct {
+	RuleName      string
+	RuleNamespace string
+	Channel       string
+	Project       string
+	Component     string
+	Environment   string
+	Severity      string
+	Status        Status
+	// AlertID is the ID of the stored alert entry, set only when the evaluation starts the alert
+	AlertID string
+	// ResolveTimeout resolves the alert when no evaluation arrives within it. It is set for sources
+	// that only report firing alerts.
+	ResolveTimeout time.Duration
+	// Details are the enriched alert details that notifications are built from
+	Details map[string]interface{}
+}
+
+// Fingerprint identifies an alert across evaluations. Rule names are unique within a namespace, and the
+// scope is included so that a rule that moves to another component starts a new alert.
+func (a *Alert) Fingerprint() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		a.RuleNamespace, a.RuleName, a.Project, a.Component, a.Environment,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// GroupKey identifies the notification group of an alert. Alerts of the same component and environment
+// that go to the same channel are sent together.
+func (a *Alert) GroupKey() string {
+	return strings.Join([]string{a.Channel, a.RuleNamespace, a.Project, a.Component, a.Environment}, "/")
+}
+
+// State is the tracked lifecycle of an alert
+type State struct {
+	Fingerprint   string `json:"fingerprint"`
+	GroupKey      string `json:"groupKey"`
+	RuleName      string `json:"ruleName"`
+	RuleNamespace string `json:"ruleNamespace"`
+	Channel       string `json:"channel"`
+	Project       string `json:"project,omitempty"`
+	Component     string `json:"component,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+	Severity      string `json:"severity,omitempty"`
+	Status        Status `json:"status"`
+	// AlertID is the ID of the alert entry stored when the alert started firing
+	AlertID        string     `json:"alertId,omitempty"`
+	StartsAt       time.Time  `json:"startsAt"`
+	LastReceivedAt time.Time  `json:"lastReceivedAt"`
+	LastNotifiedAt *time.Time `json:"lastNotifiedAt,omitempty"`
+	ResolvedAt     *time.Time `json:"resolvedAt,omitempty"`
+	// ExpiresAt is when the alert resolves unless another evaluation arrives, for sources without resolves
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Occurrences counts the evaluations received since the alert started firing
+	Occurrences int `json:"occurrences"`
+	// SilencedBy is the ID of the silence that suppressed the latest evaluation
+	SilencedBy string                 `json:"silencedBy,omitempty"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+}
+
+// AlertFilter selects alert states. Empty fields match everything.
+type AlertFilter struct {
+	Status      Status
+	Namespace   string
+	Project     string
+	Component   string
+	Environment string
+}
+
+func (f *AlertFilter) matches(state *State) bool {
+	return (f.Status == "" || f.Status == state.Status) &&
+		(f.Namespace == "" || f.Namespace == state.RuleNamespace) &&
+		(f.Project == "" || f.Project == state.Project) &&
+		(f.Component == "" || f.Component == state.Component) &&
+		(f.Environment == "" || f.Environment == state.Environment)
+}
+
+// SilenceFilter selects silences. Empty fields match everything.
+type SilenceFilter struct {
+	Namespace   string
+	Project     string
+	Component   string
+	Environment string
+	// ActiveOnly leaves out silences that have not started or have ended
+	ActiveOnly bool
+}
+
+func (f *SilenceFilter) matches(silence *Silence, now time.Time) bool {
+	return (f.Namespace == "" || f.Namespace == silence.Namespace) &&
+		(f.Project == "" || f.Project == silence.Project) &&
+		(f.Component == "" || f.Component == silence.Component) &&
+		(f.Environment == "" || f.Environment == silence.Environment) &&
+		(!f.ActiveOnly || silence.Active(now))
+}
+
+// Silence suppresses notifications of the alerts in a namespace that match its project, component and
+// environment. Empty scope fields match any value, but at least one of them must be set.
+type Silence struct {
+	ID          string    `json:"id"`
+	Namespace   string    `json:"namespace"`
+	Project     string    `json:"project,omitempty"`
+	Component   string    `json:"component,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+	StartsAt    time.Time `json:"startsAt"`
+	EndsAt      time.Time `json:"endsAt"`
+	Comment     string    `json:"comment,omitempty"`
+	CreatedBy   string    `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Validate checks the scope and the time range of the silence
+func (s *Silence) Validate() error {
+	if s.Namespace == "" {
+		return fmt.Errorf("%w: namespace is required", ErrInvalidSilence)
+	}
+	if s.Project == "" && s.Component == "" && s.Environment == "" {
+		return fmt.Errorf("%w: at least one of project, component or environment is required", ErrInvalidSilence)
+	}
+	if s.Component != "" && s.Project == "" {
+		return fmt.Errorf("%w: component requires project", ErrInvalidSilence)
+	}
+	if s.EndsAt.IsZero() {
+		return fmt.Errorf("%w: endsAt is required", ErrInvalidSilence)
+	}
+	if !s.EndsAt.After(s.StartsAt) {
+		return fmt.Errorf("%w: endsAt must be after startsAt", ErrInvalidSilence)
+	}
+	return nil
+}
+
+// Active reports whether the silence is in effect at the given time
+func (s *Silence) Active(now time.Time) bool {
+	return !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// Matches reports whether the silence covers the alert, regardless of its time range
+func (s *Silence) Matches(state *State) bool {
+	return s.Namespace == state.RuleNamespace &&
+		(s.Project == "" || s.Project == state.Project) &&
+		(s.Component == "" || s.Component == state.Component) &&
+		(s.Environment == "" || s.Environment == state.Environment)
+}
+
+// Notification is a group of alerts that are sent to a channel together
+type Notification struct {
+	Channel  string
+	GroupKey string
+	Firing   []*State
+	Resolved []*State
+}
@@ -73,3 +73,17 @@ func CheckAuthorization(
 
 	return nil
 }
+
+// AlertScopeResource returns the resource that operations on the alerts of a scope are authorized against:
+// the component when one is given, otherwise the project, otherwise the namespace
+func AlertScopeResource(namespace, project, component string) (ResourceType, string, authzcore.ResourceHierarchy) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: namespace, Project: project, Component: component}
+	switch {
+	case component != "":
+		return ResourceTypeComponent, component, hierarchy
+	case project != "":
+		return ResourceTypeProject, project, hierarchy
+	default:
+		return ResourceTypeOrg, namespace, hierarchy
+	}
+}
@@ -82,6 +82,28 @@ type AlertingConfig struct {
 	// RCAServiceURL is the base URL for the AI RCA (Root Cause Analysis) service.
 	// Used for health checks and triggering RCA analysis.
 	RCAServiceURL string `koanf:"rca.service.url"`
+	// RCAReportURL is the base URL that root cause analysis links in notifications point to.
+	// The alert ID is appended to it. No links are added when empty.
+	RCAReportURL string `koanf:"rca.report.url"`
+	// GroupWait is how long the first alert of a component and environment waits for other alerts to be
+	// sent in the same notification.
+	GroupWait time.Duration `koanf:"group.wait"`
+	// RepeatInterval is how long an alert that keeps firing waits before it is notified again.
+	RepeatInterval time.Duration `koanf:"repeat.interval"`
+	// DeliveryWorkers is how many notifications are delivered at the same time.
+	DeliveryWorkers int `koanf:"delivery.workers"`
+	// DeliveryQueueSize is how many notifications may wait for delivery.
+	DeliveryQueueSize int `koanf:"delivery.queue.size"`
+	// DeliveryMaxAttempts is how many times a notification is attempted before its delivery is recorded as failed.
+	DeliveryMaxAttempts int `koanf:"delivery.max.attempts"`
+	// DeliveryInitialBackoff is the wait before the first retry. It doubles for every retry up to DeliveryMaxBackoff.
+	DeliveryInitialBackoff time.Duration `koanf:"delivery.initial.backoff"`
+	DeliveryMaxBackoff     time.Duration `koanf:"delivery.max.backoff"`
+	// DeliveryAttemptTimeout bounds a single delivery attempt.
+	DeliveryAttemptTimeout time.Duration `koanf:"delivery.attempt.timeout"`
+	// DeliveryRatePerMinute and DeliveryRateBurst limit the notifications delivered to each channel.
+	DeliveryRatePerMinute int `koanf:"delivery.rate.per.minute"`
+	DeliveryRateBurst     int `koanf:"delivery.rate.burst"`
 	// ObservabilityNamespace is the Kubernetes namespace where openchoreo-observability-plane is deployed.
 	// Used for creating/listing PrometheusRule CRs for metric-based alerting.
 	ObservabilityNamespace string `koanf:"observability.namespace"`
@@ -147,6 +169,17 @@ func Load() (*Config, error) {
 		"LOGGING_DEFAULT_BUILD_LOG_LIMIT": "logging.default.build.log.limit",
 		"LOGGING_MAX_LOG_LINES_PER_FILE":  "logging.max.log.lines.per.file",
 		"RCA_SERVICE_URL":                 "alerting.rca.service.url",
+		"RCA_REPORT_URL":                  "alerting.rca.report.url",
+		"ALERT_GROUP_WAIT":                "alerting.group.wait",
+		"ALERT_REPEAT_INTERVAL":           "alerting.repeat.interval",
+		"NOTIFICATION_WORKERS":            "alerting.delivery.workers",
+		"NOTIFICATION_QUEUE_SIZE":         "alerting.delivery.queue.size",
+		"NOTIFICATION_MAX_ATTEMPTS":       "alerting.delivery.max.attempts",
+		"NOTIFICATION_INITIAL_BACKOFF":    "alerting.delivery.initial.backoff",
+		"NOTIFICATION_MAX_BACKOFF":        "alerting.delivery.max.backoff",
+		"NOTIFICATION_ATTEMPT_TIMEOUT":    "alerting.delivery.attempt.timeout",
+		"NOTIFICATION_RATE_PER_MINUTE":    "alerting.delivery.rate.per.minute",
+		"NOTIFICATION_RATE_BURST":         "alerting.delivery.rate.burst",
 		"OBSERVABILITY_NAMESPACE":         "alerting.observability.namespace",
 		"LOG_LEVEL":                       "loglevel",
 		"PORT":                            "server.port",           // Common alias
@@ -254,8 +287,18 @@ func getDefaults() map[string]interface{} {
 			"max.log.lines.per.file":  600000,
 		},
 		"alerting": map[string]interface{}{
-			"rca.service.url":         "http://ai-rca-agent:8080",
-			"observability.namespace": "openchoreo-observability-plane",
+			"rca.service.url":          "http://ai-rca-agent:8080",
+			"observability.namespace":  "openchoreo-observability-plane",
+			"group.wait":               "30s",
+			"repeat.interval":          "4h",
+			"delivery.workers":         4,
+			"delivery.queue.size":      1000,
+			"delivery.max.attempts":    6,
+			"delivery.initial.backoff": "10s",
+			"delivery.max.backoff":     "5m",
+			"delivery.attempt.timeout": "30s",
+			"delivery.rate.per.minute": 30,
+			"delivery.rate.burst":      10,
 		},
 		"loglevel": "info",
 	}
@@ -286,6 +329,23 @@ func (c *Config) validate() error {
 		return fmt.Errorf("max log limit must be positive")
 	}
 
+	if c.Alerting.GroupWait < 0 {
+		return fmt.Errorf("alert group wait must not be negative")
+	}
+
+	if c.Alerting.RepeatInterval < 0 {
+		return fmt.Errorf("alert repeat interval must not be negative")
+	}
+
+	alerting := c.Alerting
+	if alerting.DeliveryWorkers < 0 || alerting.DeliveryQueueSize < 0 || alerting.DeliveryMaxAttempts < 0 ||
+		alerting.DeliveryRatePerMinute < 0 || alerting.DeliveryRateBurst < 0 {
+		return fmt.Errorf("notification delivery settings must not be negative")
+	}
+	if alerting.DeliveryInitialBackoff < 0 || alerting.DeliveryMaxBackoff < 0 || alerting.DeliveryAttemptTimeout < 0 {
+		return fmt.Errorf("notification delivery durations must not be negative")
+	}
+
 	if c.Authz.Enabled {
 		if c.Authz.ServiceURL == "" {
 			return fmt.Errorf("authz service URL is required when authz is enabled")
@@ -0,0 +1,97 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+const (
+	// defaultListLimit bounds the records returned when the filter sets no limit
+	defaultListLimit = 100
+	// maxListLimit bounds the records returned by a single query
+	maxListLimit = 1000
+	// defaultMemoryLogSize is how many records the in-memory log keeps
+	defaultMemoryLogSize = 1000
+)
+
+// Log persists the delivery records so that they can be queried per alert
+type Log interface {
+	Save(ctx context.Context, record *Record) error
+	List(ctx context.Context, filter Filter) ([]*Record, error)
+}
+
+// MemoryLog keeps the most recent delivery records in memory. It is used when no persistent log is available.
+type MemoryLog struct {
+	size int
+
+	mu      sync.Mutex
+	records map[string]*Record
+	order   []string
+}
+
+var _ Log = (*MemoryLog)(nil)
+
+// NewMemoryLog creates a log that keeps up to size records, dropping the oldest first
+func NewMemoryLog(size int) *MemoryLog {
+	if size <= 0 {
+		size = defaultMemoryLogSize
+	}
+	return &MemoryLog{size: size, records: map[string]*Record{}}
+}
+
+// Save implements Log
+func (l *MemoryLog) Save(_ context.Context, record *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.records[record.ID]; !ok {
+		l.order = append(l.order, record.ID)
+		if len(l.order) > l.size {
+			delete(l.records, l.order[0])
+			l.order = l.order[1:]
+		}
+	}
+	l.records[record.ID] = copyRecord(record)
+	return nil
+}
+
+// List implements Log
+func (l *MemoryLog) List(_ context.Context, filter Filter) ([]*Record, error) {
+	l.mu.Lock()
+	records := make([]*Record, 0)
+	for _, record := range l.records {
+		if filter.matches(record) {
+			records = append(records, copyRecord(record))
+		}
+	}
+	l.mu.Unlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].CreatedAt.Equal(records[j].CreatedAt) {
+			return records[i].CreatedAt.After(records[j].CreatedAt)
+		}
+		return records[i].ID < records[j].ID
+	})
+	if limit := listLimit(filter.Limit); len(records) > limit {
+		records = records[:limit]
+	}
+	return records, nil
+}
+
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return defaultListLimit
+	}
+	if limit > maxListLimit {
+		return maxListLimit
+	}
+	return limit
+}
+
+func copyRecord(record *Record) *Record {
+	c := *record
+	return &c
+}
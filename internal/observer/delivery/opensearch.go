@@ -0,0 +1,129 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+const deliveryIndexName = "openchoreo-notification-deliveries"
+
+var deliveryIndexBody = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"properties": map[string]interface{}{
+			"id":            map[string]interface{}{"type": "keyword"},
+			"channel":       map[string]interface{}{"type": "keyword"},
+			"ruleName":      map[string]interface{}{"type": "keyword"},
+			"ruleNamespace": map[string]interface{}{"type": "keyword"},
+			"project":       map[string]interface{}{"type": "keyword"},
+			"component":     map[string]interface{}{"type": "keyword"},
+			"environment":   map[string]interface{}{"type": "keyword"},
+			"alertId":       map[string]interface{}{"type": "keyword"},
+			"fingerprint":   map[string]interface{}{"type": "keyword"},
+			"alertStatus":   map[string]interface{}{"type": "keyword"},
+			"status":        map[string]interface{}{"type": "keyword"},
+			"attempts":      map[string]interface{}{"type": "integer"},
+			"maxAttempts":   map[string]interface{}{"type": "integer"},
+			"lastError":     map[string]interface{}{"type": "text"},
+			"createdAt":     map[string]interface{}{"type": "date"},
+			"updatedAt":     map[string]interface{}{"type": "date"},
+			"nextAttemptAt": map[string]interface{}{"type": "date"},
+			"deliveredAt":   map[string]interface{}{"type": "date"},
+			"payload":       map[string]interface{}{"type": "object", "enabled": false},
+		},
+	},
+}
+
+// documentClient is the part of the OpenSearch client the log uses
+type documentClient interface {
+	EnsureIndex(ctx context.Context, index string, body map[string]interface{}) error
+	WriteDocument(ctx context.Context, index, id string, document interface{}) error
+	Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error)
+}
+
+// OpenSearchLog keeps delivery records in OpenSearch, keyed by delivery ID
+type OpenSearchLog struct {
+	client documentClient
+}
+
+var _ Log = (*OpenSearchLog)(nil)
+
+// NewOpenSearchLog creates the delivery index when it is missing
+func NewOpenSearchLog(ctx context.Context, client documentClient) (*OpenSearchLog, error) {
+	if client == nil {
+		return nil, errors.New("OpenSearch client is required")
+	}
+	if err := client.EnsureIndex(ctx, deliveryIndexName, deliveryIndexBody); err != nil {
+		return nil, fmt.Errorf("failed to prepare notification delivery index: %w", err)
+	}
+	return &OpenSearchLog{client: client}, nil
+}
+
+// Save implements Log
+func (l *OpenSearchLog) Save(ctx context.Context, record *Record) error {
+	return l.client.WriteDocument(ctx, deliveryIndexName, record.ID, record)
+}
+
+// List implements Log
+func (l *OpenSearchLog) List(ctx context.Context, filter Filter) ([]*Record, error) {
+	response, err := l.client.Search(ctx, []string{deliveryIndexName}, buildListQuery(filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notification deliveries: %w", err)
+	}
+
+	records := make([]*Record, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		data, err := json.Marshal(hit.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read delivery %s: %w", hit.ID, err)
+		}
+		record := &Record{}
+		if err := json.Unmarshal(data, record); err != nil {
+			return nil, fmt.Errorf("failed to read delivery %s: %w", hit.ID, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// buildListQuery builds the search for the records that match the filter, newest first
+func buildListQuery(filter Filter) map[string]interface{} {
+	terms := []struct {
+		field string
+		value string
+	}{
+		{"alertId", filter.AlertID},
+		{"fingerprint", filter.Fingerprint},
+		{"channel", filter.Channel},
+		{"ruleNamespace", filter.Namespace},
+		{"project", filter.Project},
+		{"component", filter.Component},
+		{"environment", filter.Environment},
+		{"status", string(filter.Status)},
+	}
+
+	clauses := []map[string]interface{}{}
+	for _, term := range terms {
+		if term.value != "" {
+			clauses = append(clauses, map[string]interface{}{
+				"term": map[string]interface{}{term.field: term.value},
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"size": listLimit(filter.Limit),
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{"filter": clauses},
+		},
+		"sort": []map[string]interface{}{
+			{"createdAt": map[string]interface{}{"order": "desc"}},
+		},
+	}
+}
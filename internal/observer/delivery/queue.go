@@ -0,0 +1,368 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultWorkers is how many deliveries are attempted at the same time
+	DefaultWorkers = 4
+	// DefaultQueueSize is how many deliveries may wait for a worker
+	DefaultQueueSize = 1000
+	// DefaultMaxAttempts is how many times a delivery is attempted before it is recorded as failed
+	DefaultMaxAttempts = 6
+	// DefaultInitialBackoff is the wait before the second attempt, doubled for every attempt after it
+	DefaultInitialBackoff = 10 * time.Second
+	// DefaultMaxBackoff caps the wait between attempts
+	DefaultMaxBackoff = 5 * time.Minute
+	// DefaultAttemptTimeout bounds a single attempt
+	DefaultAttemptTimeout = 30 * time.Second
+	// DefaultRatePerMinute is how many deliveries a channel receives per minute once its burst is used up
+	DefaultRatePerMinute = 30
+	// DefaultRateBurst is how many deliveries a channel receives at once
+	DefaultRateBurst = 10
+
+	healthReportTimeout = 10 * time.Second
+)
+
+// Config holds the concurrency, retry and rate limit settings of the queue
+type Config struct {
+	Workers        int
+	QueueSize      int
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	AttemptTimeout time.Duration
+	RatePerMinute  int
+	RateBurst      int
+}
+
+// Queue delivers notifications in the background. Failed attempts are retried with exponential backoff,
+// every channel is rate limited on its own, and each delivery is recorded in the log after every attempt.
+type Queue struct {
+	config   Config
+	log      Log
+	reporter HealthReporter
+	logger   *slog.Logger
+
+	now       func() time.Time
+	afterFunc func(d time.Duration, f func())
+	// createdAt tells the deliveries of earlier runs apart from those enqueued while resuming them
+	createdAt time.Time
+
+	jobs chan *job
+	done chan struct{}
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	health   map[string]*ChannelHealth
+}
+
+// job is a queued delivery. Only the worker that holds a job touches its record.
+type job struct {
+	record *Record
+	send   SendFunc
+}
+
+// NewQueue creates a queue. Zero config values fall back to the defaults, and the reporter may be nil.
+func NewQueue(config Config, log Log, reporter HealthReporter, logger *slog.Logger) *Queue {
+	if config.Workers <= 0 {
+		config.Workers = DefaultWorkers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultQueueSize
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultMaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DefaultInitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultMaxBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	if config.AttemptTimeout <= 0 {
+		config.AttemptTimeout = DefaultAttemptTimeout
+	}
+	if config.RatePerMinute <= 0 {
+		config.RatePerMinute = DefaultRatePerMinute
+	}
+	if config.RateBurst <= 0 {
+		config.RateBurst = DefaultRateBurst
+	}
+	if log == nil {
+		log = NewMemoryLog(0)
+	}
+	return &Queue{
+		config:    config,
+		log:       log,
+		reporter:  reporter,
+		logger:    logger,
+		now:       time.Now,
+		createdAt: time.Now(),
+		afterFunc: func(d time.Duration, f func()) { time.AfterFunc(d, f) },
+		jobs:      make(chan *job, config.QueueSize),
+		done:      make(chan struct{}),
+		limiters:  map[string]*rate.Limiter{},
+		health:    map[string]*ChannelHealth{},
+	}
+}
+
+// Run attempts the queued deliveries until the context is done. Deliveries still waiting for an attempt
+// at that point keep their pending or retrying record.
+func (q *Queue) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < q.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-q.jobs:
+					q.attempt(ctx, j)
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+	close(q.done)
+	wg.Wait()
+}
+
+// Enqueue records a delivery and queues its first attempt. The record is filled in with its ID and
+// initial status. A delivery that does not fit in the queue is recorded as failed and ErrQueueFull is
+// returned.
+func (q *Queue) Enqueue(ctx context.Context, record *Record, send SendFunc) error {
+	now := q.now()
+	record.ID = uuid.NewString()
+	record.Status = StatusPending
+	record.MaxAttempts = q.config.MaxAttempts
+	record.CreatedAt = now
+	record.UpdatedAt = now
+
+	// The record is saved before it is queued, as a worker owns it from then on
+	q.save(ctx, record)
+	j := &job{record: record, send: send}
+	select {
+	case q.jobs <- j:
+		return nil
+	default:
+		record.Status = StatusFailed
+		record.LastError = ErrQueueFull.Error()
+		q.save(ctx, record)
+		return ErrQueueFull
+	}
+}
+
+// Resume queues the deliveries the log holds as pending or retrying, which an earlier run of the observer
+// stopped before they finished. A retrying delivery keeps its attempts and waits until its next attempt is
+// due. Deliveries that cannot be resolved or do not fit in the queue are recorded as failed. Resume must be
+// called before Run.
+func (q *Queue) Resume(ctx context.Context, resolve ResolveFunc) error {
+	var unfinished []*Record
+	for _, status := range []Status{StatusPending, StatusRetrying} {
+		records, err := q.log.List(ctx, Filter{Status: status, Limit: maxListLimit})
+		if err != nil {
+			return fmt.Errorf("failed to list %s notification deliveries: %w", status, err)
+		}
+		for _, record := range records {
+			if record.CreatedAt.Before(q.createdAt) {
+				unfinished = append(unfinished, record)
+			}
+		}
+	}
+	// Oldest first, so that the notifications of an alert keep their order
+	sort.Slice(unfinished, func(i, j int) bool {
+		return unfinished[i].CreatedAt.Before(unfinished[j].CreatedAt)
+	})
+
+	now := q.now()
+	resumed := 0
+	for _, record := range unfinished {
+		send, err := resolve(record)
+		if err != nil {
+			q.fail(ctx, record, err)
+			continue
+		}
+		j := &job{record: record, send: send}
+		if record.NextAttemptAt != nil && record.NextAttemptAt.After(now) {
+			q.retryAfter(record.NextAttemptAt.Sub(now), j)
+			resumed++
+			continue
+		}
+		select {
+		case q.jobs <- j:
+			resumed++
+		default:
+			q.fail(ctx, record, ErrQueueFull)
+		}
+	}
+	if len(unfinished) > 0 {
+		q.logger.Info("Resumed notification deliveries", "resumed", resumed, "failed", len(unfinished)-resumed)
+	}
+	return nil
+}
+
+// fail records a delivery that is not attempted again
+func (q *Queue) fail(ctx context.Context, record *Record, err error) {
+	record.Status = StatusFailed
+	record.LastError = err.Error()
+	record.NextAttemptAt = nil
+	record.UpdatedAt = q.now()
+	q.save(ctx, record)
+	q.logger.Warn("Notification delivery not resumed", "deliveryId", record.ID, "channel", record.Channel,
+		"ruleName", record.RuleName, "error", err)
+}
+
+// List returns the delivery records that match the filter, newest first
+func (q *Queue) List(ctx context.Context, filter Filter) ([]*Record, error) {
+	return q.log.List(ctx, filter)
+}
+
+// attempt sends a delivery once. A delivery over the rate limit of its channel is put back without using
+// up an attempt, and a failed attempt is retried after the backoff until the attempts run out.
+func (q *Queue) attempt(ctx context.Context, j *job) {
+	record := j.record
+	if delay := q.reserve(record.Channel); delay > 0 {
+		q.retryAfter(delay, j)
+		return
+	}
+
+	record.Attempts++
+	attemptCtx, cancel := context.WithTimeout(ctx, q.config.AttemptTimeout)
+	err := j.send(attemptCtx)
+	cancel()
+
+	now := q.now()
+	record.UpdatedAt = now
+	record.NextAttemptAt = nil
+	switch {
+	case err == nil:
+		record.Status = StatusDelivered
+		record.LastError = ""
+		record.DeliveredAt = &now
+		q.save(ctx, record)
+		q.logger.Debug("Notification delivered",
+			"deliveryId", record.ID, "channel", record.Channel, "ruleName", record.RuleName, "attempts", record.Attempts)
+		q.updateHealth(ctx, record, nil)
+
+	case record.Attempts >= record.MaxAttempts:
+		record.Status = StatusFailed
+		record.LastError = err.Error()
+		q.save(ctx, record)
+		q.logger.Error("Notification delivery failed, giving up",
+			"deliveryId", record.ID, "channel", record.Channel, "ruleName", record.RuleName,
+			"attempts", record.Attempts, "error", err)
+		q.updateHealth(ctx, record, err)
+
+	default:
+		backoff := q.backoff(record.Attempts)
+		next := now.Add(backoff)
+		record.Status = StatusRetrying
+		record.LastError = err.Error()
+		record.NextAttemptAt = &next
+		q.save(ctx, record)
+		q.logger.Warn("Notification delivery attempt failed, retrying",
+			"deliveryId", record.ID, "channel", record.Channel, "ruleName", record.RuleName,
+			"attempts", record.Attempts, "retryIn", backoff, "error", err)
+		q.retryAfter(backoff, j)
+	}
+}
+
+// backoff is the wait after the given number of failed attempts
+func (q *Queue) backoff(attempts int) time.Duration {
+	backoff := q.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= q.config.MaxBackoff {
+			return q.config.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// retryAfter puts a delivery back in the queue once the delay passes, unless the queue stopped by then
+func (q *Queue) retryAfter(delay time.Duration, j *job) {
+	q.afterFunc(delay, func() {
+		select {
+		case q.jobs <- j:
+		case <-q.done:
+		}
+	})
+}
+
+// reserve takes a delivery from the rate limit of the channel. It returns how long to wait when the
+// channel is over its limit, in which case nothing is taken.
+func (q *Queue) reserve(channel string) time.Duration {
+	q.mu.Lock()
+	limiter, ok := q.limiters[channel]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(float64(q.config.RatePerMinute)/60), q.config.RateBurst)
+		q.limiters[channel] = limiter
+	}
+	q.mu.Unlock()
+
+	now := q.now()
+	reservation := limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// updateHealth records the outcome of a delivery for its channel and reports the health of the channel
+// when it starts or stops failing
+func (q *Queue) updateHealth(ctx context.Context, record *Record, err error) {
+	q.mu.Lock()
+	health, ok := q.health[record.Channel]
+	if !ok {
+		health = &ChannelHealth{Channel: record.Channel}
+		q.health[record.Channel] = health
+	}
+	wasFailing := health.Failing
+	if err != nil {
+		health.Failing = true
+		health.ConsecutiveFailures++
+		health.Message = fmt.Sprintf("Delivery of alert %s failed after %d attempts: %v", record.RuleName, record.Attempts, err)
+	} else {
+		health.Failing = false
+		health.ConsecutiveFailures = 0
+		health.Message = fmt.Sprintf("Delivery of alert %s succeeded", record.RuleName)
+	}
+	health.UpdatedAt = q.now()
+	report := *health
+	q.mu.Unlock()
+
+	// The first outcome is reported as well, so that a restarted observer corrects a stale status
+	if q.reporter == nil || (ok && wasFailing == report.Failing) {
+		return
+	}
+	reportCtx, cancel := context.WithTimeout(ctx, healthReportTimeout)
+	defer cancel()
+	if err := q.reporter.ReportChannelHealth(reportCtx, report); err != nil {
+		q.logger.Warn("Failed to report notification channel health", "channel", record.Channel, "error", err)
+	}
+}
+
+func (q *Queue) save(ctx context.Context, record *Record) {
+	if err := q.log.Save(ctx, record); err != nil {
+		q.logger.Warn("Failed to record notification delivery", "deliveryId", record.ID, "error", err)
+	}
+}
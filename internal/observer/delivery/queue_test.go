@@ -0,0 +1,312 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type recordingReporter struct {
+	reports []ChannelHealth
+}
+
+func (r *recordingReporter) ReportChannelHealth(_ context.Context, health ChannelHealth) error {
+	r.reports = append(r.reports, health)
+	return nil
+}
+
+// testQueue returns a queue with a controllable clock. Retries are collected instead of scheduled, and
+// queued deliveries are attempted only when the test takes them.
+type testQueue struct {
+	*Queue
+	clock    time.Time
+	reporter *recordingReporter
+	delays   []time.Duration
+	pending  []func()
+}
+
+func newTestQueue(config Config) *testQueue {
+	tq := &testQueue{
+		clock:    time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		reporter: &recordingReporter{},
+	}
+	tq.Queue = NewQueue(config, NewMemoryLog(0), tq.reporter, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	tq.now = func() time.Time { return tq.clock }
+	tq.afterFunc = func(d time.Duration, f func()) {
+		tq.delays = append(tq.delays, d)
+		tq.pending = append(tq.pending, f)
+	}
+	return tq
+}
+
+// next attempts the next queued delivery
+func (tq *testQueue) next(t *testing.T) {
+	t.Helper()
+	select {
+	case j := <-tq.jobs:
+		tq.attempt(context.Background(), j)
+	default:
+		t.Fatal("expected a queued delivery")
+	}
+}
+
+// retry advances the clock past the latest scheduled retry and queues it
+func (tq *testQueue) retry(t *testing.T) {
+	t.Helper()
+	if len(tq.pending) == 0 {
+		t.Fatal("expected a scheduled retry")
+	}
+	tq.clock = tq.clock.Add(tq.delays[len(tq.delays)-1])
+	f := tq.pending[len(tq.pending)-1]
+	tq.pending = tq.pending[:len(tq.pending)-1]
+	f()
+}
+
+func (tq *testQueue) record(t *testing.T, id string) *Record {
+	t.Helper()
+	records, err := tq.List(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	for _, record := range records {
+		if record.ID == id {
+			return record
+		}
+	}
+	t.Fatalf("delivery %s not found in the log", id)
+	return nil
+}
+
+func testRecord(channel string) *Record {
+	return &Record{Channel: channel, RuleName: "high-latency", RuleNamespace: "default", AlertID: "alert-1"}
+}
+
+// flakySender fails the first failures attempts
+func flakySender(failures int) (SendFunc, *int) {
+	calls := 0
+	return func(context.Context) error {
+		calls++
+		if calls <= failures {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, &calls
+}
+
+func TestQueueRetriesWithBackoffUntilDelivered(t *testing.T) {
+	tq := newTestQueue(Config{MaxAttempts: 5, InitialBackoff: 10 * time.Second, MaxBackoff: 30 * time.Second})
+	send, calls := flakySender(3)
+	record := testRecord("email")
+	if err := tq.Enqueue(context.Background(), record, send); err != nil {
+		t.Fatalf("Enqueue() error: %v", err)
+	}
+	if got := tq.record(t, record.ID).Status; got != StatusPending {
+		t.Fatalf("status after enqueue = %s, want %s", got, StatusPending)
+	}
+
+	tq.next(t)
+	logged := tq.record(t, record.ID)
+	if logged.Status != StatusRetrying || logged.Attempts != 1 || logged.LastError != "connection refused" {
+		t.Fatalf("after first attempt: %+v", logged)
+	}
+	if logged.NextAttemptAt == nil || !logged.NextAttemptAt.Equal(tq.clock.Add(10*time.Second)) {
+		t.Fatalf("next attempt at %v, want 10s from now", logged.NextAttemptAt)
+	}
+
+	for i := 0; i < 3; i++ {
+		tq.retry(t)
+		tq.next(t)
+	}
+	if *calls != 4 {
+		t.Fatalf("send called %d times, want 4", *calls)
+	}
+	wantDelays := []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second}
+	for i, want := range wantDelays {
+		if tq.delays[i] != want {
+			t.Errorf("backoff %d = %v, want %v", i+1, tq.delays[i], want)
+		}
+	}
+
+	logged = tq.record(t, record.ID)
+	if logged.Status != StatusDelivered || logged.Attempts != 4 || logged.LastError != "" || logged.DeliveredAt == nil {
+		t.Fatalf("after delivery: %+v", logged)
+	}
+	if len(tq.reporter.reports) != 1 || tq.reporter.reports[0].Failing {
+		t.Fatalf("expected one healthy report, got %+v", tq.reporter.reports)
+	}
+}
+
+func TestQueueRecordsFailureAfterMaxAttempts(t *testing.T) {
+	tq := newTestQueue(Config{MaxAttempts: 2, InitialBackoff: time.Second})
+	send, _ := flakySender(10)
+	record := testRecord("email")
+	if err := tq.Enqueue(context.Background(), record, send); err != nil {
+		t.Fatalf("Enqueue() error: %v", err)
+	}
+
+	tq.next(t)
+	tq.retry(t)
+	tq.next(t)
+	if len(tq.pending) != 0 {
+		t.Fatalf("expected no retry after the last attempt, got %d", len(tq.pending))
+	}
+
+	failed, err := tq.List(context.Background(), Filter{AlertID: "alert-1", Status: StatusFailed})
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(failed) != 1 || failed[0].Attempts != 2 || failed[0].LastError != "connection refused" {
+		t.Fatalf("expected one failed delivery after 2 attempts, got %+v", failed)
+	}
+	if len(tq.reporter.reports) != 1 || !tq.reporter.reports[0].Failing || tq.reporter.reports[0].ConsecutiveFailures != 1 {
+		t.Fatalf("expected one failing report, got %+v", tq.reporter.reports)
+	}
+
+	// A later success recovers the channel, and repeated outcomes are not reported again
+	for i := 0; i < 2; i++ {
+		if err := tq.Enqueue(context.Background(), testRecord("email"), func(context.Context) error { return nil }); err != nil {
+			t.Fatalf("Enqueue() error: %v", err)
+		}
+		tq.next(t)
+	}
+	if len(tq.reporter.reports) != 2 || tq.reporter.reports[1].Failing {
+		t.Fatalf("expected a recovery report, got %+v", tq.reporter.reports)
+	}
+}
+
+func TestQueueResumesUnfinishedDeliveries(t *testing.T) {
+	before := newTestQueue(Config{InitialBackoff: 10 * time.Second})
+	pending := testRecord("email")
+	pending.Payload = map[string]interface{}{"ruleName": "high-latency"}
+	retrying := testRecord("slack")
+	retrying.Payload = map[string]interface{}{"ruleName": "high-latency"}
+	unresolvable := testRecord("teams")
+	failing, _ := flakySender(10)
+	for _, record := range []*Record{pending, retrying, unresolvable} {
+		if err := before.Enqueue(context.Background(), record, failing); err != nil {
+			t.Fatalf("Enqueue() error: %v", err)
+		}
+	}
+	// The slack delivery fails once, and the observer restarts before the others are attempted
+	<-before.jobs
+	before.next(t)
+	before.clock = before.clock.Add(4 * time.Second)
+
+	after := newTestQueue(Config{InitialBackoff: 10 * time.Second})
+	after.log = before.log
+	after.clock = before.clock
+	sent := map[string]int{}
+	err := after.Resume(context.Background(), func(record *Record) (SendFunc, error) {
+		if record.Payload == nil {
+			return nil, errors.New("no payload")
+		}
+		return func(context.Context) error {
+			sent[record.Channel]++
+			return nil
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("Resume() error: %v", err)
+	}
+
+	if got := after.record(t, unresolvable.ID); got.Status != StatusFailed || got.LastError != "no payload" {
+		t.Errorf("unresolvable delivery = %+v, want failed", got)
+	}
+	after.next(t)
+	if got := after.record(t, pending.ID); got.Status != StatusDelivered || sent["email"] != 1 {
+		t.Errorf("pending delivery = %+v, want delivered", got)
+	}
+	// The retrying delivery waits for the rest of its backoff and keeps its attempts
+	if len(after.delays) != 1 || after.delays[0] != 6*time.Second {
+		t.Fatalf("retry delays = %v, want [6s]", after.delays)
+	}
+	after.retry(t)
+	after.next(t)
+	if got := after.record(t, retrying.ID); got.Status != StatusDelivered || got.Attempts != 2 || sent["slack"] != 1 {
+		t.Errorf("retrying delivery = %+v, want delivered on the second attempt", got)
+	}
+}
+
+func TestQueueRateLimitsPerChannel(t *testing.T) {
+	tq := newTestQueue(Config{RatePerMinute: 6, RateBurst: 1})
+	sent := map[string]int{}
+	sender := func(channel string) SendFunc {
+		return func(context.Context) error {
+			sent[channel]++
+			return nil
+		}
+	}
+
+	for _, channel := range []string{"slack", "slack", "email"} {
+		if err := tq.Enqueue(context.Background(), testRecord(channel), sender(channel)); err != nil {
+			t.Fatalf("Enqueue() error: %v", err)
+		}
+	}
+	tq.next(t)
+	tq.next(t)
+	tq.next(t)
+	if sent["slack"] != 1 || sent["email"] != 1 {
+		t.Fatalf("sent = %v, want one delivery per channel", sent)
+	}
+	if len(tq.delays) != 1 || tq.delays[0] != 10*time.Second {
+		t.Fatalf("expected the second slack delivery to wait 10s, got %v", tq.delays)
+	}
+
+	tq.retry(t)
+	tq.next(t)
+	if sent["slack"] != 2 {
+		t.Fatalf("slack deliveries = %d, want 2", sent["slack"])
+	}
+	records, _ := tq.List(context.Background(), Filter{Channel: "slack", Status: StatusDelivered})
+	for _, record := range records {
+		if record.Attempts != 1 {
+			t.Errorf("rate limited delivery used %d attempts, want 1", record.Attempts)
+		}
+	}
+}
+
+func TestQueueRecordsFailureWhenFull(t *testing.T) {
+	tq := newTestQueue(Config{QueueSize: 1})
+	noop := func(context.Context) error { return nil }
+	if err := tq.Enqueue(context.Background(), testRecord("email"), noop); err != nil {
+		t.Fatalf("Enqueue() error: %v", err)
+	}
+	record := testRecord("email")
+	if err := tq.Enqueue(context.Background(), record, noop); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("Enqueue() error = %v, want %v", err, ErrQueueFull)
+	}
+	if logged := tq.record(t, record.ID); logged.Status != StatusFailed || logged.Attempts != 0 {
+		t.Fatalf("expected a failed record without attempts, got %+v", logged)
+	}
+}
+
+func TestMemoryLogListFiltersAndLimits(t *testing.T) {
+	log := NewMemoryLog(3)
+	base := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	for i, alertID := range []string{"a", "b", "a", "a"} {
+		record := &Record{ID: string(rune('1' + i)), AlertID: alertID, Status: StatusDelivered, CreatedAt: base.Add(time.Duration(i) * time.Minute)}
+		if err := log.Save(context.Background(), record); err != nil {
+			t.Fatalf("Save() error: %v", err)
+		}
+	}
+
+	records, err := log.List(context.Background(), Filter{AlertID: "a"})
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	// The oldest record was dropped, and the others are returned newest first
+	if len(records) != 2 || records[0].ID != "4" || records[1].ID != "3" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+
+	records, _ = log.List(context.Background(), Filter{Limit: 1})
+	if len(records) != 1 || records[0].ID != "4" {
+		t.Fatalf("expected the newest record only, got %+v", records)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Status is the status of a notification delivery
+type Status string
+
+const (
+	// StatusPending means the delivery is queued and has not been attempted yet
+	StatusPending Status = "pending"
+	// StatusRetrying means an attempt failed and another attempt is scheduled
+	StatusRetrying Status = "retrying"
+	// StatusDelivered means the channel accepted the notification
+	StatusDelivered Status = "delivered"
+	// StatusFailed means every attempt failed. The record is kept as the dead letter of the notification.
+	StatusFailed Status = "failed"
+)
+
+// ErrQueueFull is returned when a delivery cannot be queued because the queue is at capacity
+var ErrQueueFull = errors.New("notification delivery queue is full")
+
+// SendFunc sends a notification to its channel. It is called once per attempt.
+type SendFunc func(ctx context.Context) error
+
+// ResolveFunc rebuilds the send of a delivery recorded by an earlier run of the observer
+type ResolveFunc func(record *Record) (SendFunc, error)
+
+// Record is the delivery log entry of a notification, updated after every attempt
+type Record struct {
+	ID            string `json:"id"`
+	Channel       string `json:"channel"`
+	RuleName      string `json:"ruleName"`
+	RuleNamespace string `json:"ruleNamespace"`
+	Project       string `json:"project,omitempty"`
+	Component     string `json:"component,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+	// AlertID is the ID of the stored alert entry, and Fingerprint identifies the alert across evaluations
+	AlertID     string `json:"alertId,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// AlertStatus tells whether the notification reports the alert as firing or resolved
+	AlertStatus   string     `json:"alertStatus,omitempty"`
+	Status        Status     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	MaxAttempts   int        `json:"maxAttempts"`
+	LastError     string     `json:"lastError,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	DeliveredAt   *time.Time `json:"deliveredAt,omitempty"`
+	// Payload is the notification, kept so that a delivery interrupted by a restart can be resumed
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// Filter selects delivery records. Empty fields match everything.
+type Filter struct {
+	AlertID     string
+	Fingerprint string
+	Channel     string
+	Namespace   string
+	Project     string
+	Component   string
+	Environment string
+	Status      Status
+	// Limit bounds the number of records returned, newest first
+	Limit int
+}
+
+func (f *Filter) matches(record *Record) bool {
+	return (f.AlertID == "" || f.AlertID == record.AlertID) &&
+		(f.Fingerprint == "" || f.Fingerprint == record.Fingerprint) &&
+		(f.Channel == "" || f.Channel == record.Channel) &&
+		(f.Namespace == "" || f.Namespace == record.RuleNamespace) &&
+		(f.Project == "" || f.Project == record.Project) &&
+		(f.Component == "" || f.Component == record.Component) &&
+		(f.Environment == "" || f.Environment == record.Environment) &&
+		(f.Status == "" || f.Status == record.Status)
+}
+
+// ChannelHealth summarizes the recent deliveries to a notification channel. A channel is failing once a
+// delivery exhausts its attempts, and recovers with the next delivery that succeeds.
+type ChannelHealth struct {
+	Channel             string
+	Failing             bool
+	ConsecutiveFailures int
+	// Message describes the latest failure, or the latest success once the channel recovers
+	Message   string
+	UpdatedAt time.Time
+}
+
+// HealthReporter publishes the health of a notification channel when it changes
+type HealthReporter interface {
+	ReportChannelHealth(ctx context.Context, health ChannelHealth) error
+}
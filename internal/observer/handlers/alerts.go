@@ -0,0 +1,278 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/delivery"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	ErrorTypeNotFound = "notFound"
+
+	ErrorCodeNotFound = "OBS-L-14"
+
+	ErrorMsgNamespaceRequired  = "Namespace is required"
+	ErrorMsgInvalidAlertStatus = "Status must be firing or resolved"
+	ErrorMsgSilenceIDRequired  = "Silence ID is required"
+	ErrorMsgSilenceNotFound    = "Silence not found"
+	ErrorMsgInvalidDelivery    = "Status must be pending, retrying, delivered or failed"
+)
+
+// CreateSilenceRequest represents the request body for POST /api/alerting/silences. The silence ends at
+// endsAt, or after duration when endsAt is not set.
+type CreateSilenceRequest struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project,omitempty"`
+	Component   string `json:"component,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	StartsAt    string `json:"startsAt,omitempty"`
+	EndsAt      string `json:"endsAt,omitempty"`
+	Duration    string `json:"duration,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// ListAlerts handles GET /api/alerting/alerts
+func (h *Handler) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := alerting.AlertFilter{
+		Status:      alerting.Status(query.Get("status")),
+		Namespace:   query.Get("namespace"),
+		Project:     query.Get("project"),
+		Component:   query.Get("component"),
+		Environment: query.Get("environment"),
+	}
+	if filter.Status != "" && filter.Status != alerting.StatusFiring && filter.Status != alerting.StatusResolved {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidAlertStatus)
+		return
+	}
+	if !h.authorizeAlertScope(w, r, observerAuthz.ActionViewAlerts, filter.Namespace, filter.Project, filter.Component) {
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"alerts": h.service.ListAlerts(filter),
+	})
+}
+
+// ListDeliveries handles GET /api/alerting/deliveries. The deliveries of an alert are selected by its
+// alertId or fingerprint.
+func (h *Handler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := delivery.Filter{
+		AlertID:     query.Get("alertId"),
+		Fingerprint: query.Get("fingerprint"),
+		Channel:     query.Get("channel"),
+		Namespace:   query.Get("namespace"),
+		Project:     query.Get("project"),
+		Component:   query.Get("component"),
+		Environment: query.Get("environment"),
+		Status:      delivery.Status(query.Get("status")),
+	}
+	switch filter.Status {
+	case "", delivery.StatusPending, delivery.StatusRetrying, delivery.StatusDelivered, delivery.StatusFailed:
+	default:
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidDelivery)
+		return
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed <= 0 {
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, "limit must be a positive number")
+			return
+		}
+		filter.Limit = parsed
+	}
+	if !h.authorizeAlertScope(w, r, observerAuthz.ActionViewAlerts, filter.Namespace, filter.Project, filter.Component) {
+		return
+	}
+
+	deliveries, err := h.service.ListDeliveries(r.Context(), filter)
+	if err != nil {
+		h.logger.Error("Failed to list notification deliveries", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, "Failed to list notification deliveries")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"deliveries": deliveries,
+	})
+}
+
+// ListSilences handles GET /api/alerting/silences
+func (h *Handler) ListSilences(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := alerting.SilenceFilter{
+		Namespace:   query.Get("namespace"),
+		Project:     query.Get("project"),
+		Component:   query.Get("component"),
+		Environment: query.Get("environment"),
+	}
+	if active := query.Get("active"); active != "" {
+		activeOnly, err := strconv.ParseBool(active)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, "active must be true or false")
+			return
+		}
+		filter.ActiveOnly = activeOnly
+	}
+	if !h.authorizeAlertScope(w, r, observerAuthz.ActionViewAlerts, filter.Namespace, filter.Project, filter.Component) {
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"silences": h.service.ListSilences(filter),
+	})
+}
+
+// CreateSilence handles POST /api/alerting/silences
+func (h *Handler) CreateSilence(w http.ResponseWriter, r *http.Request) {
+	var req CreateSilenceRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+	if req.Namespace == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgNamespaceRequired)
+		return
+	}
+	if !h.authorizeAlertScope(w, r, observerAuthz.ActionSilenceAlerts, req.Namespace, req.Project, req.Component) {
+		return
+	}
+
+	silence, err := silenceFromRequest(&req, time.Now())
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, err.Error())
+		return
+	}
+	if subject, ok := auth.GetSubjectContextFromContext(r.Context()); ok && subject != nil {
+		silence.CreatedBy = subject.ID
+	}
+
+	created, err := h.service.CreateSilence(r.Context(), silence)
+	if err != nil {
+		if errors.Is(err, alerting.ErrInvalidSilence) {
+			h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, err.Error())
+			return
+		}
+		h.logger.Error("Failed to create silence", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, "Failed to create silence")
+		return
+	}
+
+	h.logger.Info("Silence created",
+		"silence_id", created.ID,
+		"namespace", created.Namespace,
+		"project", created.Project,
+		"component", created.Component,
+		"environment", created.Environment,
+		"ends_at", created.EndsAt)
+	h.writeJSON(w, http.StatusCreated, created)
+}
+
+// ExpireSilence handles DELETE /api/alerting/silences/{silenceId}. The silence is ended rather than removed,
+// so that it stays listed until the retention passes.
+func (h *Handler) ExpireSilence(w http.ResponseWriter, r *http.Request) {
+	silenceID := httputil.GetPathParam(r, "silenceId")
+	if silenceID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgSilenceIDRequired)
+		return
+	}
+
+	silence, err := h.service.GetSilence(silenceID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusNotFound, ErrorTypeNotFound, ErrorCodeNotFound, ErrorMsgSilenceNotFound)
+		return
+	}
+	if !h.authorizeAlertScope(w, r, observerAuthz.ActionSilenceAlerts, silence.Namespace, silence.Project, silence.Component) {
+		return
+	}
+
+	expired, err := h.service.ExpireSilence(r.Context(), silenceID)
+	if err != nil {
+		if errors.Is(err, alerting.ErrSilenceNotFound) {
+			h.writeErrorResponse(w, http.StatusNotFound, ErrorTypeNotFound, ErrorCodeNotFound, ErrorMsgSilenceNotFound)
+			return
+		}
+		h.logger.Error("Failed to expire silence", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, "Failed to expire silence")
+		return
+	}
+
+	h.logger.Info("Silence expired", "silence_id", expired.ID)
+	h.writeJSON(w, http.StatusOK, expired)
+}
+
+// silenceFromRequest parses the time range of a silence request
+func silenceFromRequest(req *CreateSilenceRequest, now time.Time) (*alerting.Silence, error) {
+	silence := &alerting.Silence{
+		Namespace:   req.Namespace,
+		Project:     req.Project,
+		Component:   req.Component,
+		Environment: req.Environment,
+		Comment:     req.Comment,
+		StartsAt:    now,
+	}
+	if req.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return nil, errors.New("startsAt must be an RFC3339 time")
+		}
+		silence.StartsAt = startsAt
+	}
+
+	switch {
+	case req.EndsAt != "" && req.Duration != "":
+		return nil, errors.New("only one of endsAt and duration can be set")
+	case req.EndsAt != "":
+		endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return nil, errors.New("endsAt must be an RFC3339 time")
+		}
+		silence.EndsAt = endsAt
+	case req.Duration != "":
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil || duration <= 0 {
+			return nil, errors.New("duration must be a positive duration such as 2h")
+		}
+		silence.EndsAt = silence.StartsAt.Add(duration)
+	default:
+		return nil, errors.New("one of endsAt and duration is required")
+	}
+	return silence, nil
+}
+
+// authorizeAlertScope checks the action on the alerts of a scope and writes the error response when it is
+// not allowed. The namespace is required for authorization but optional when authz is disabled.
+func (h *Handler) authorizeAlertScope(w http.ResponseWriter, r *http.Request, action observerAuthz.Action, namespace, project, component string) bool {
+	if h.authzPDP != nil && namespace == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgMissingAuthHierarchy)
+		return false
+	}
+
+	resourceType, resourceID, hierarchy := observerAuthz.AlertScopeResource(namespace, project, component)
+	err := observerAuthz.CheckAuthorization(r.Context(), h.logger, h.authzPDP, action, resourceType, resourceID, hierarchy)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+		h.writeErrorResponse(w, http.StatusForbidden, ErrorTypeForbidden, ErrorCodeAuthForbidden, ErrorMsgAccessDenied)
+	case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrorTypeUnauthorized, ErrorCodeAuthUnauthorized, ErrorMsgUnauthorized)
+	case errors.Is(err, observerAuthz.ErrAuthzServiceUnavailable):
+		h.logger.Error(LogMsgAuthServiceUnavailableError, "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, ErrorMsgFailedToAuthorize)
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, ErrorMsgFailedToAuthorize)
+	}
+	return false
+}
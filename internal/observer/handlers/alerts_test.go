@@ -0,0 +1,86 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSilenceFromRequest(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		req          CreateSilenceRequest
+		wantErr      bool
+		wantStartsAt time.Time
+		wantEndsAt   time.Time
+	}{
+		{
+			name:         "Duration starts now",
+			req:          CreateSilenceRequest{Namespace: "default", Project: "shop", Duration: "2h"},
+			wantStartsAt: now,
+			wantEndsAt:   now.Add(2 * time.Hour),
+		},
+		{
+			name: "Duration from a later start",
+			req: CreateSilenceRequest{
+				Namespace: "default", Environment: "production", StartsAt: "2025-06-01T12:00:00Z", Duration: "30m",
+			},
+			wantStartsAt: now.Add(2 * time.Hour),
+			wantEndsAt:   now.Add(150 * time.Minute),
+		},
+		{
+			name:         "Explicit end",
+			req:          CreateSilenceRequest{Namespace: "default", Project: "shop", EndsAt: "2025-06-02T10:00:00Z"},
+			wantStartsAt: now,
+			wantEndsAt:   now.Add(24 * time.Hour),
+		},
+		{
+			name:    "Both end and duration",
+			req:     CreateSilenceRequest{Namespace: "default", Project: "shop", EndsAt: "2025-06-02T10:00:00Z", Duration: "1h"},
+			wantErr: true,
+		},
+		{
+			name:    "Neither end nor duration",
+			req:     CreateSilenceRequest{Namespace: "default", Project: "shop"},
+			wantErr: true,
+		},
+		{
+			name:    "Negative duration",
+			req:     CreateSilenceRequest{Namespace: "default", Project: "shop", Duration: "-1h"},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid start",
+			req:     CreateSilenceRequest{Namespace: "default", Project: "shop", StartsAt: "tomorrow", Duration: "1h"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence, err := silenceFromRequest(&tt.req, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("silenceFromRequest() expected error, got %+v", silence)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("silenceFromRequest() unexpected error: %v", err)
+			}
+			if !silence.StartsAt.Equal(tt.wantStartsAt) {
+				t.Errorf("StartsAt = %v, want %v", silence.StartsAt, tt.wantStartsAt)
+			}
+			if !silence.EndsAt.Equal(tt.wantEndsAt) {
+				t.Errorf("EndsAt = %v, want %v", silence.EndsAt, tt.wantEndsAt)
+			}
+			if silence.Namespace != tt.req.Namespace || silence.Project != tt.req.Project {
+				t.Errorf("scope = %s/%s, want %s/%s", silence.Namespace, silence.Project, tt.req.Namespace, tt.req.Project)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
 	"github.com/openchoreo/openchoreo/internal/observer/httputil"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
@@ -188,6 +189,8 @@ type MetricsRequest struct {
 	StartTime       string `json:"startTime,omitempty"`
 	ProjectName     string `json:"projectName,omitempty"`
 	ProjectID       string `json:"projectId" validate:"required"`
+	// RolloutTrack restricts HTTP metrics to the stable or canary pods of a progressive rollout
+	RolloutTrack string `json:"rolloutTrack,omitempty"`
 }
 
 // ProjectRCAReportsRequest represents the request body for getting RCA reports by project
@@ -865,7 +868,7 @@ func (h *Handler) GetComponentHTTPMetrics(w http.ResponseWriter, r *http.Request
 
 	// Execute query
 	ctx := r.Context()
-	result, err := h.service.GetComponentHTTPMetrics(ctx, req.ComponentID, req.EnvironmentID, req.ProjectID, startTime, endTime)
+	result, err := h.service.GetComponentHTTPMetrics(ctx, req.ComponentID, req.EnvironmentID, req.ProjectID, req.RolloutTrack, startTime, endTime)
 	if err != nil {
 		h.logger.Error("Failed to get component HTTP metrics", "error", err)
 		h.writeJSON(w, http.StatusInternalServerError, map[string]string{
@@ -1041,6 +1044,10 @@ func (h *Handler) DeleteAlertingRule(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) AlertingWebhook(w http.ResponseWriter, r *http.Request) {
 	// Parse the webhook payload according to the alerting vendor and retrieve alert details
 	ruleName, ruleNamespace, alertValue, timestamp, err := h.parseWebhookPayload(w, r)
+	if errors.Is(err, service.ErrAlertResolved) {
+		h.resolveAlert(w, r, ruleName, ruleNamespace)
+		return
+	}
 	if err != nil {
 		// Check if alert is not in firing state (ignore 'resolved' alerts from Prometheus)
 		if err.Error() == "alert is not in firing state" {
@@ -1071,31 +1078,61 @@ func (h *Handler) AlertingWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Send Notification
-	err = h.service.SendAlertNotification(r.Context(), alertDetails, alertRule.Spec.Name)
-	if err != nil {
-		h.logger.Warn("Failed to send alert notification", "error", err)
+	// Only the evaluation that starts an alert is stored and analysed, later evaluations continue it. The entry
+	// is stored before the evaluation is received, so that notifications can link to its RCA report.
+	started := !h.service.IsAlertFiring(alertRule)
+	var alertID string
+	var storeErr error
+	if started {
+		alertID, storeErr = h.service.StoreAlertEntry(r.Context(), alertDetails, alertRule.Spec.Name)
 	}
 
-	// Store alert entry in logs backend
-	alertID, err := h.service.StoreAlertEntry(r.Context(), alertDetails, alertRule.Spec.Name)
+	result, state, err := h.service.ReceiveAlert(r.Context(), alertRule, alertDetails, alertID, alerting.StatusFiring)
 	if err != nil {
-		h.logger.Error("Failed to store alert entry", "error", err)
+		h.logger.Warn("Failed to process alert evaluation", "error", err)
+	}
+
+	if storeErr != nil {
+		h.logger.Error("Failed to store alert entry", "error", storeErr)
 		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, "Failed to store alert entry")
 		return
 	}
 
 	// Trigger AI RCA analysis if enabled
-	if enableRCA, ok := alertDetails["enableAiRootCauseAnalysis"].(bool); ok && enableRCA {
+	if enableRCA, ok := alertDetails["enableAiRootCauseAnalysis"].(bool); ok && enableRCA && started {
 		if isAIRCAEnabled() {
 			h.service.TriggerRCAAnalysis(r.Context(), h.rcaServiceURL, alertID, alertDetails, alertRule)
 		}
 	}
 
-	// Return success response
-	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+	response := map[string]interface{}{
 		"message": "Alert processed successfully",
-		"alertID": alertID,
+		"result":  result,
+	}
+	if state != nil {
+		response["alertID"] = state.AlertID
+		response["fingerprint"] = state.Fingerprint
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// resolveAlert records that the alert of a rule has cleared, which notifies its channel when the alert was notified
+func (h *Handler) resolveAlert(w http.ResponseWriter, r *http.Request, ruleName, ruleNamespace string) {
+	alertRule, err := h.service.GetObservabilityAlertRuleByName(r.Context(), ruleName, ruleNamespace)
+	if err != nil {
+		h.logger.Error("Failed to get ObservabilityAlertRule", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, "Failed to get ObservabilityAlertRule")
+		return
+	}
+
+	result, _, err := h.service.ReceiveAlert(r.Context(), alertRule, nil, "", alerting.StatusResolved)
+	if err != nil {
+		h.logger.Warn("Failed to process resolved alert", "error", err)
+	}
+
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"message": "Alert resolved",
+		"result":  result,
 	})
 }
 
@@ -21,6 +21,9 @@ const (
 	// ProjectID identifies the project that groups multiple components
 	ProjectID = "openchoreo.dev/project-uid"
 
+	// RolloutTrack separates the stable and canary pods of a component during a progressive rollout
+	RolloutTrack = "openchoreo.dev/rollout-track"
+
 	// Version is the human-readable version string (e.g., "v1.2.3")
 	Version = "version"
 
@@ -6,14 +6,22 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 )
 
 type MCPHandler struct {
 	Service *service.LoggingService
+	// AuthzPDP authorizes the alert and silence tools, which are not checked when it is nil
+	AuthzPDP authzcore.PDP
+	Logger   *slog.Logger
 }
 
 // GetComponentLogs retrieves logs for a specific component
@@ -70,7 +78,7 @@ func (h *MCPHandler) GetComponentHTTPMetrics(ctx context.Context, componentID, e
 		return nil, err
 	}
 
-	return h.Service.GetComponentHTTPMetrics(ctx, componentID, environmentID, projectID, startTimeObj, endTimeObj)
+	return h.Service.GetComponentHTTPMetrics(ctx, componentID, environmentID, projectID, "", startTimeObj, endTimeObj)
 }
 
 func parseRFC3339Time(timeStr string) (time.Time, error) {
@@ -80,3 +88,61 @@ func parseRFC3339Time(timeStr string) (time.Time, error) {
 	}
 	return t, nil
 }
+
+// ListAlerts lists the tracked alerts of a scope
+func (h *MCPHandler) ListAlerts(ctx context.Context, filter alerting.AlertFilter) (any, error) {
+	if err := h.authorizeAlertScope(ctx, observerAuthz.ActionViewAlerts, filter.Namespace, filter.Project, filter.Component); err != nil {
+		return nil, err
+	}
+	return map[string]any{"alerts": h.Service.ListAlerts(filter)}, nil
+}
+
+// ListSilences lists the silences of a scope
+func (h *MCPHandler) ListSilences(ctx context.Context, filter alerting.SilenceFilter) (any, error) {
+	if err := h.authorizeAlertScope(ctx, observerAuthz.ActionViewAlerts, filter.Namespace, filter.Project, filter.Component); err != nil {
+		return nil, err
+	}
+	return map[string]any{"silences": h.Service.ListSilences(filter)}, nil
+}
+
+// CreateSilence silences the alerts of a scope for the given duration, starting now
+func (h *MCPHandler) CreateSilence(ctx context.Context, silence *alerting.Silence, duration string) (any, error) {
+	if err := h.authorizeAlertScope(ctx, observerAuthz.ActionSilenceAlerts, silence.Namespace, silence.Project, silence.Component); err != nil {
+		return nil, err
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil || d <= 0 {
+		return nil, fmt.Errorf("invalid duration %q: expected a positive duration such as 2h", duration)
+	}
+	now := time.Now()
+	silence.StartsAt = now
+	silence.EndsAt = now.Add(d)
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		silence.CreatedBy = subject.ID
+	}
+	return h.Service.CreateSilence(ctx, silence)
+}
+
+// ExpireSilence ends a silence now
+func (h *MCPHandler) ExpireSilence(ctx context.Context, silenceID string) (any, error) {
+	silence, err := h.Service.GetSilence(silenceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.authorizeAlertScope(ctx, observerAuthz.ActionSilenceAlerts, silence.Namespace, silence.Project, silence.Component); err != nil {
+		return nil, err
+	}
+	return h.Service.ExpireSilence(ctx, silenceID)
+}
+
+func (h *MCPHandler) authorizeAlertScope(ctx context.Context, action observerAuthz.Action, namespace, project, component string) error {
+	if h.AuthzPDP != nil && namespace == "" {
+		return fmt.Errorf("namespace is required for authorization")
+	}
+	logger := h.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	resourceType, resourceID, hierarchy := observerAuthz.AlertScopeResource(namespace, project, component)
+	return observerAuthz.CheckAuthorization(ctx, logger, h.AuthzPDP, action, resourceType, resourceID, hierarchy)
+}
@@ -10,6 +10,7 @@ import (
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 )
 
@@ -21,6 +22,10 @@ type Handler interface {
 	GetTraces(ctx context.Context, params opensearch.TracesRequestParams) (any, error)
 	GetComponentResourceMetrics(ctx context.Context, componentID, environmentID, projectID, startTime, endTime string) (any, error)
 	GetComponentHTTPMetrics(ctx context.Context, componentID, environmentID, projectID, startTime, endTime string) (any, error)
+	ListAlerts(ctx context.Context, filter alerting.AlertFilter) (any, error)
+	ListSilences(ctx context.Context, filter alerting.SilenceFilter) (any, error)
+	CreateSilence(ctx context.Context, silence *alerting.Silence, duration string) (any, error)
+	ExpireSilence(ctx context.Context, silenceID string) (any, error)
 }
 
 // NewHTTPServer creates a new MCP HTTP server for the observer API
@@ -377,6 +382,113 @@ func registerTools(s *mcpsdk.Server, handler Handler) {
 		result, err := handler.GetComponentHTTPMetrics(ctx, args.ComponentID, args.EnvironmentID, args.ProjectID, args.StartTime, args.EndTime)
 		return handleToolResult(result, err)
 	})
+
+	// List Alerts
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "list_alerts",
+		Description: "List the alerts tracked by the OpenChoreo observer, with their lifecycle: when each alert started firing, how many evaluations were received, when it was last notified, whether it has resolved, and the silence that suppresses it, if any. Repeated evaluations of an alert are deduplicated into one entry. Useful for checking what is currently firing for a project, component or environment.",
+		InputSchema: createSchema(map[string]any{
+			"namespace":   stringProperty("Namespace (organization) of the alert rules"),
+			"project":     stringProperty("Optional: Project name to filter"),
+			"component":   stringProperty("Optional: Component name to filter"),
+			"environment": stringProperty("Optional: Environment name to filter"),
+			"status": map[string]any{
+				"type":        "string",
+				"description": "Optional: 'firing' or 'resolved'. Default: both",
+				"enum":        []string{"firing", "resolved"},
+			},
+		}, []string{"namespace"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace   string `json:"namespace"`
+		Project     string `json:"project"`
+		Component   string `json:"component"`
+		Environment string `json:"environment"`
+		Status      string `json:"status"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		result, err := handler.ListAlerts(ctx, alerting.AlertFilter{
+			Status:      alerting.Status(args.Status),
+			Namespace:   args.Namespace,
+			Project:     args.Project,
+			Component:   args.Component,
+			Environment: args.Environment,
+		})
+		return handleToolResult(result, err)
+	})
+
+	// List Silences
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "list_silences",
+		Description: "List the silences that suppress alert notifications in a namespace of OpenChoreo. A silence covers the alerts of a project, component or environment for a time range.",
+		InputSchema: createSchema(map[string]any{
+			"namespace":   stringProperty("Namespace (organization) of the silences"),
+			"project":     stringProperty("Optional: Project name to filter"),
+			"component":   stringProperty("Optional: Component name to filter"),
+			"environment": stringProperty("Optional: Environment name to filter"),
+			"active_only": map[string]any{
+				"type":        "boolean",
+				"description": "Optional: Only list silences that are in effect now. Default: false",
+			},
+		}, []string{"namespace"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace   string `json:"namespace"`
+		Project     string `json:"project"`
+		Component   string `json:"component"`
+		Environment string `json:"environment"`
+		ActiveOnly  bool   `json:"active_only"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		result, err := handler.ListSilences(ctx, alerting.SilenceFilter{
+			Namespace:   args.Namespace,
+			Project:     args.Project,
+			Component:   args.Component,
+			Environment: args.Environment,
+			ActiveOnly:  args.ActiveOnly,
+		})
+		return handleToolResult(result, err)
+	})
+
+	// Create Silence
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "create_silence",
+		Description: "Silence alert notifications for a project, component or environment in OpenChoreo, starting now. Alerts in the scope are still tracked but their channels are not notified until the silence ends. At least one of project, component or environment must be given, and a component requires its project.",
+		InputSchema: createSchema(map[string]any{
+			"namespace":   stringProperty("Namespace (organization) of the alert rules"),
+			"project":     stringProperty("Optional: Project to silence"),
+			"component":   stringProperty("Optional: Component to silence, requires project"),
+			"environment": stringProperty("Optional: Environment to silence"),
+			"duration":    stringProperty("How long the silence lasts, as a Go duration (e.g., 30m, 2h)"),
+			"comment":     stringProperty("Optional: Why the alerts are silenced"),
+		}, []string{"namespace", "duration"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace   string `json:"namespace"`
+		Project     string `json:"project"`
+		Component   string `json:"component"`
+		Environment string `json:"environment"`
+		Duration    string `json:"duration"`
+		Comment     string `json:"comment"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		result, err := handler.CreateSilence(ctx, &alerting.Silence{
+			Namespace:   args.Namespace,
+			Project:     args.Project,
+			Component:   args.Component,
+			Environment: args.Environment,
+			Comment:     args.Comment,
+		}, args.Duration)
+		return handleToolResult(result, err)
+	})
+
+	// Expire Silence
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "expire_silence",
+		Description: "End an alert silence in OpenChoreo now, so that notifications for the alerts it covered resume.",
+		InputSchema: createSchema(map[string]any{
+			"silence_id": stringProperty("ID of the silence to end"),
+		}, []string{"silence_id"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		SilenceID string `json:"silence_id"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		result, err := handler.ExpireSilence(ctx, args.SilenceID)
+		return handleToolResult(result, err)
+	})
 }
 
 // Helper functions for schema creation
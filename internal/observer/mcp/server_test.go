@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 )
 
@@ -142,6 +143,26 @@ func (m *MockHandler) GetComponentHTTPMetrics(ctx context.Context, componentID,
 	return metricsData, nil
 }
 
+func (m *MockHandler) ListAlerts(ctx context.Context, filter alerting.AlertFilter) (any, error) {
+	m.recordCall("ListAlerts", filter)
+	return map[string]any{"alerts": []any{}}, nil
+}
+
+func (m *MockHandler) ListSilences(ctx context.Context, filter alerting.SilenceFilter) (any, error) {
+	m.recordCall("ListSilences", filter)
+	return map[string]any{"silences": []any{}}, nil
+}
+
+func (m *MockHandler) CreateSilence(ctx context.Context, silence *alerting.Silence, duration string) (any, error) {
+	m.recordCall("CreateSilence", silence, duration)
+	return silence, nil
+}
+
+func (m *MockHandler) ExpireSilence(ctx context.Context, silenceID string) (any, error) {
+	m.recordCall("ExpireSilence", silenceID)
+	return map[string]any{"id": silenceID}, nil
+}
+
 // setupTestServer creates a test MCP server with mock handler
 func setupTestServer(t *testing.T) (*mcp.ClientSession, *MockHandler) {
 	t.Helper()
@@ -624,6 +645,108 @@ var allToolSpecs = []toolTestSpec{
 			}
 		},
 	},
+	{
+		name:                "list_alerts",
+		descriptionKeywords: []string{"alerts", "firing"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace"},
+		optionalParams:      []string{"project", "component", "environment", "status"},
+		testArgs: map[string]any{
+			"namespace":   testNamespace,
+			"project":     testProjectID,
+			"component":   testComponentID,
+			"environment": testEnvironmentID,
+			"status":      "firing",
+		},
+		expectedMethod: "ListAlerts",
+		validateCall: func(t *testing.T, args []interface{}) {
+			filter, ok := args[0].(alerting.AlertFilter)
+			if !ok {
+				t.Fatalf("Expected AlertFilter, got %T", args[0])
+			}
+			want := alerting.AlertFilter{
+				Status:      alerting.StatusFiring,
+				Namespace:   testNamespace,
+				Project:     testProjectID,
+				Component:   testComponentID,
+				Environment: testEnvironmentID,
+			}
+			if diff := cmp.Diff(want, filter); diff != "" {
+				t.Errorf("filter mismatch (-want +got):\n%s", diff)
+			}
+		},
+	},
+	{
+		name:                "list_silences",
+		descriptionKeywords: []string{"silences", "notifications"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace"},
+		optionalParams:      []string{"project", "component", "environment", "active_only"},
+		testArgs: map[string]any{
+			"namespace":   testNamespace,
+			"project":     testProjectID,
+			"active_only": true,
+		},
+		expectedMethod: "ListSilences",
+		validateCall: func(t *testing.T, args []interface{}) {
+			filter, ok := args[0].(alerting.SilenceFilter)
+			if !ok {
+				t.Fatalf("Expected SilenceFilter, got %T", args[0])
+			}
+			want := alerting.SilenceFilter{Namespace: testNamespace, Project: testProjectID, ActiveOnly: true}
+			if diff := cmp.Diff(want, filter); diff != "" {
+				t.Errorf("filter mismatch (-want +got):\n%s", diff)
+			}
+		},
+	},
+	{
+		name:                "create_silence",
+		descriptionKeywords: []string{"silence", "notifications"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace", "duration"},
+		optionalParams:      []string{"project", "component", "environment", "comment"},
+		testArgs: map[string]any{
+			"namespace":   testNamespace,
+			"project":     testProjectID,
+			"environment": testEnvironmentID,
+			"duration":    "2h",
+			"comment":     "planned maintenance",
+		},
+		expectedMethod: "CreateSilence",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if len(args) < 2 {
+				t.Fatalf("Expected 2 arguments, got %d", len(args))
+			}
+			silence, ok := args[0].(*alerting.Silence)
+			if !ok {
+				t.Fatalf("Expected *Silence, got %T", args[0])
+			}
+			if silence.Namespace != testNamespace || silence.Project != testProjectID || silence.Environment != testEnvironmentID {
+				t.Errorf("unexpected silence scope: %+v", silence)
+			}
+			if silence.Comment != "planned maintenance" {
+				t.Errorf("Expected comment 'planned maintenance', got %q", silence.Comment)
+			}
+			if duration, _ := args[1].(string); duration != "2h" {
+				t.Errorf("Expected duration '2h', got %v", args[1])
+			}
+		},
+	},
+	{
+		name:                "expire_silence",
+		descriptionKeywords: []string{"silence"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"silence_id"},
+		testArgs: map[string]any{
+			"silence_id": "silence-123",
+		},
+		expectedMethod: "ExpireSilence",
+		validateCall: func(t *testing.T, args []interface{}) {
+			if silenceID, _ := args[0].(string); silenceID != "silence-123" {
+				t.Errorf("Expected silence_id 'silence-123', got %v", args[0])
+			}
+		},
+	},
 }
 
 // TestToolRegistration verifies that all expected tools are registered
@@ -0,0 +1,160 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Alert is the alert that the built-in message formats of the chat and paging channels describe
+type Alert struct {
+	// Key identifies the alert rule that fired, so that a later resolve refers to the same incident
+	Key         string
+	Name        string
+	Description string
+	Severity    string
+	Value       string
+	Threshold   string
+	Timestamp   string
+	Component   string
+	Project     string
+	Environment string
+
+	// RCAEnabled is set when AI root cause analysis runs for the alert
+	RCAEnabled bool
+	// RCALink points to the root cause analysis report, when one is available
+	RCALink string
+
+	// Resolved is set when the notification tells that the alert has cleared
+	Resolved   bool
+	ResolvedAt string
+	// Related names the other alerts of the same component and environment sent in the same notification
+	Related []string
+}
+
+// title is the headline used by the built-in message formats
+func (a *Alert) title() string {
+	if a.Resolved {
+		return fmt.Sprintf("[RESOLVED] Alert resolved: %s", a.Name)
+	}
+	if a.Severity == "" {
+		return fmt.Sprintf("Alert triggered: %s", a.Name)
+	}
+	return fmt.Sprintf("[%s] Alert triggered: %s", strings.ToUpper(a.Severity), a.Name)
+}
+
+// location describes where the alert fired, e.g. "component api in project shop (production)"
+func (a *Alert) location() string {
+	var parts []string
+	if a.Component != "" {
+		parts = append(parts, "component "+a.Component)
+	}
+	if a.Project != "" {
+		parts = append(parts, "project "+a.Project)
+	}
+	location := strings.Join(parts, " in ")
+	if a.Environment != "" {
+		if location == "" {
+			return a.Environment
+		}
+		location += " (" + a.Environment + ")"
+	}
+	return location
+}
+
+// facts are the labelled details listed in the built-in message formats, skipping empty ones
+func (a *Alert) facts() [][2]string {
+	var facts [][2]string
+	for _, fact := range [][2]string{
+		{"Severity", a.Severity},
+		{"Component", a.Component},
+		{"Project", a.Project},
+		{"Environment", a.Environment},
+		{"Value", a.Value},
+		{"Threshold", a.Threshold},
+		{"Triggered at", a.Timestamp},
+		{"Resolved at", a.ResolvedAt},
+	} {
+		if fact[1] != "" {
+			facts = append(facts, fact)
+		}
+	}
+	return facts
+}
+
+// summary is the sentence placing the alert, e.g. "Fired for component api in project shop (production)."
+func (a *Alert) summary() string {
+	location := a.location()
+	if location == "" {
+		return ""
+	}
+	if a.Resolved {
+		return "Resolved for " + location + "."
+	}
+	return "Fired for " + location + "."
+}
+
+// relatedNote lists the other alerts of the notification, empty when the alert was sent on its own
+func (a *Alert) relatedNote() string {
+	if len(a.Related) == 0 {
+		return ""
+	}
+	if a.Resolved {
+		return "Also resolved: " + strings.Join(a.Related, ", ")
+	}
+	return "Also firing: " + strings.Join(a.Related, ", ")
+}
+
+// rcaNote describes the root cause analysis state, empty when analysis is not enabled
+func (a *Alert) rcaNote() string {
+	if !a.RCAEnabled {
+		return ""
+	}
+	if a.RCALink == "" {
+		return "AI root cause analysis has been started for this alert."
+	}
+	return "AI root cause analysis has been started for this alert: " + a.RCALink
+}
+
+// postJSON sends the payload as a JSON POST request and fails on non-2xx responses
+func postJSON(ctx context.Context, url string, headers map[string]string, payload interface{}) error {
+	jsonBody, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload to JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// Read response body for better error messages
+		var responseBody bytes.Buffer
+		if _, readErr := responseBody.ReadFrom(resp.Body); readErr == nil && responseBody.Len() > 0 {
+			return fmt.Errorf("request failed with status code: %d, response: %s", resp.StatusCode, responseBody.String())
+		}
+		return fmt.Errorf("request failed with status code: %d", resp.StatusCode)
+	}
+	return nil
+}
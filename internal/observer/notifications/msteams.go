@@ -0,0 +1,113 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MSTeamsConfig holds Microsoft Teams incoming webhook configuration
+type MSTeamsConfig struct {
+	WebhookURL string
+}
+
+// msTeamsSeverityColors are the Adaptive Card text colors of each alert severity
+var msTeamsSeverityColors = map[string]string{
+	"critical": "attention",
+	"warning":  "warning",
+	"info":     "accent",
+}
+
+// SendMSTeamsWithConfig posts the alert to Microsoft Teams as an Adaptive Card
+func SendMSTeamsWithConfig(ctx context.Context, config *MSTeamsConfig, alert *Alert) error {
+	if config.WebhookURL == "" {
+		return fmt.Errorf("microsoft teams webhook URL is required")
+	}
+	if err := postJSON(ctx, config.WebhookURL, nil, buildMSTeamsMessage(alert)); err != nil {
+		return fmt.Errorf("failed to send microsoft teams message: %w", err)
+	}
+	return nil
+}
+
+// buildMSTeamsMessage formats the alert as an Adaptive Card message, which both incoming webhooks
+// and workflow webhooks accept
+func buildMSTeamsMessage(alert *Alert) map[string]interface{} {
+	color := msTeamsSeverityColors[strings.ToLower(alert.Severity)]
+	if alert.Resolved {
+		color = "good"
+	} else if color == "" {
+		color = "default"
+	}
+
+	body := []interface{}{
+		map[string]interface{}{
+			"type":   "TextBlock",
+			"text":   alert.title(),
+			"size":   "large",
+			"weight": "bolder",
+			"color":  color,
+			"wrap":   true,
+		},
+	}
+	if alert.Description != "" {
+		body = append(body, map[string]interface{}{
+			"type": "TextBlock",
+			"text": alert.Description,
+			"wrap": true,
+		})
+	}
+	if note := alert.relatedNote(); note != "" {
+		body = append(body, map[string]interface{}{
+			"type": "TextBlock",
+			"text": note,
+			"wrap": true,
+		})
+	}
+
+	var facts []interface{}
+	for _, fact := range alert.facts() {
+		facts = append(facts, map[string]interface{}{"title": fact[0], "value": fact[1]})
+	}
+	if len(facts) > 0 {
+		body = append(body, map[string]interface{}{"type": "FactSet", "facts": facts})
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if alert.RCAEnabled && !alert.Resolved {
+		if alert.RCALink != "" {
+			card["actions"] = []interface{}{
+				map[string]interface{}{
+					"type":  "Action.OpenUrl",
+					"title": "View root cause analysis",
+					"url":   alert.RCALink,
+				},
+			}
+		} else {
+			body = append(body, map[string]interface{}{
+				"type":     "TextBlock",
+				"text":     alert.rcaNote(),
+				"isSubtle": true,
+				"wrap":     true,
+			})
+			card["body"] = body
+		}
+	}
+
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []interface{}{
+			map[string]interface{}{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBuildMSTeamsMessage(t *testing.T) {
+	alert := &Alert{
+		Name:        "high-error-rate",
+		Description: "Error rate above threshold",
+		Severity:    "critical",
+		Component:   "api",
+		RCAEnabled:  true,
+		RCALink:     "https://console.example.com/rca/alert-1",
+	}
+
+	message := buildMSTeamsMessage(alert)
+	if message["type"] != "message" {
+		t.Errorf("type = %v, want message", message["type"])
+	}
+	attachments, _ := message["attachments"].([]interface{})
+	if len(attachments) != 1 {
+		t.Fatalf("attachments = %v, want a single Adaptive Card", message["attachments"])
+	}
+	attachment, _ := attachments[0].(map[string]interface{})
+	if attachment["contentType"] != "application/vnd.microsoft.card.adaptive" {
+		t.Errorf("contentType = %v", attachment["contentType"])
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	for _, want := range []string{`"color":"attention"`, `"type":"Action.OpenUrl"`, alert.RCALink, "Error rate above threshold"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("message does not contain %q: %s", want, data)
+		}
+	}
+}
+
+func TestBuildMSTeamsMessage_RCAWithoutLink(t *testing.T) {
+	data, err := json.Marshal(buildMSTeamsMessage(&Alert{Name: "rule", RCAEnabled: true}))
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	if !strings.Contains(string(data), "AI root cause analysis has been started") {
+		t.Errorf("message should mention the root cause analysis: %s", data)
+	}
+	if strings.Contains(string(data), "Action.OpenUrl") {
+		t.Errorf("message should not have a link action: %s", data)
+	}
+}
+
+func TestBuildMSTeamsMessage_ResolvedGroup(t *testing.T) {
+	alert := &Alert{
+		Name:        "high-error-rate",
+		Severity:    "critical",
+		Component:   "api",
+		Environment: "production",
+		RCAEnabled:  true,
+		RCALink:     "https://console.example.com/rca/alert-1",
+		Resolved:    true,
+		Related:     []string{"high-latency"},
+	}
+
+	data, err := json.Marshal(buildMSTeamsMessage(alert))
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	for _, want := range []string{`"color":"good"`, "Also resolved: high-latency"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("message does not contain %q: %s", want, data)
+		}
+	}
+	if strings.Contains(string(data), "Action.OpenUrl") {
+		t.Errorf("resolved message should not link the root cause analysis: %s", data)
+	}
+}
+
+func TestSendMSTeamsWithConfig(t *testing.T) {
+	var messages []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+			t.Errorf("failed to decode message: %v", err)
+		}
+		messages = append(messages, message)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The webhook URL is resolved from the channel's secret reference before it reaches the config
+	config := &MSTeamsConfig{WebhookURL: server.URL}
+	if err := SendMSTeamsWithConfig(context.Background(), config, &Alert{Name: "rule", Severity: "warning"}); err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if len(messages) != 1 || messages[0]["type"] != "message" {
+		t.Errorf("messages = %v, want a single Adaptive Card message", messages)
+	}
+}
+
+func TestSendMSTeamsWithConfig_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Webhook Bad Request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	alert := &Alert{Name: "rule"}
+	if err := SendMSTeamsWithConfig(context.Background(), &MSTeamsConfig{}, alert); err == nil {
+		t.Error("expected an error without a webhook URL")
+	}
+	if err := SendMSTeamsWithConfig(context.Background(), &MSTeamsConfig{WebhookURL: server.URL}, alert); err == nil {
+		t.Error("expected an error when Microsoft Teams rejects the message")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultPagerDutyEventsURL is the PagerDuty Events API v2 endpoint
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// maxPagerDutySummaryLength is the longest summary the Events API accepts
+const maxPagerDutySummaryLength = 1024
+
+// PagerDuty event actions
+const (
+	PagerDutyActionTrigger = "trigger"
+	PagerDutyActionResolve = "resolve"
+)
+
+// PagerDutyConfig holds PagerDuty Events API v2 configuration
+type PagerDutyConfig struct {
+	RoutingKey string
+	// SeverityMapping maps alert severities to PagerDuty severities
+	SeverityMapping map[string]string
+	// Source overrides the affected system reported to PagerDuty
+	Source string
+	// EventsURL overrides the Events API endpoint, defaults to DefaultPagerDutyEventsURL
+	EventsURL string
+}
+
+// pagerDutySeverities are the severities accepted by the Events API
+var pagerDutySeverities = map[string]bool{
+	"critical": true,
+	"error":    true,
+	"warning":  true,
+	"info":     true,
+}
+
+// SendPagerDutyEvent sends a trigger or resolve event for the alert. Both use the alert key as the
+// deduplication key, so that a resolve closes the incident opened by the trigger.
+func SendPagerDutyEvent(ctx context.Context, config *PagerDutyConfig, alert *Alert, action string) error {
+	if config.RoutingKey == "" {
+		return fmt.Errorf("pagerduty routing key is required")
+	}
+	if alert.Key == "" {
+		return fmt.Errorf("alert key is required for pagerduty events")
+	}
+
+	var event map[string]interface{}
+	switch action {
+	case PagerDutyActionTrigger:
+		event = buildPagerDutyTrigger(config, alert)
+	case PagerDutyActionResolve:
+		event = map[string]interface{}{
+			"routing_key":  config.RoutingKey,
+			"event_action": PagerDutyActionResolve,
+			"dedup_key":    alert.Key,
+		}
+	default:
+		return fmt.Errorf("unsupported pagerduty event action: %s", action)
+	}
+
+	url := config.EventsURL
+	if url == "" {
+		url = DefaultPagerDutyEventsURL
+	}
+	if err := postJSON(ctx, url, nil, event); err != nil {
+		return fmt.Errorf("failed to send pagerduty %s event: %w", action, err)
+	}
+	return nil
+}
+
+func buildPagerDutyTrigger(config *PagerDutyConfig, alert *Alert) map[string]interface{} {
+	summary := alert.Name
+	if alert.Description != "" {
+		summary += ": " + alert.Description
+	}
+	if location := alert.location(); location != "" {
+		summary += " - " + location
+	}
+	// The Events API rejects summaries longer than 1024 characters, the cut stays on a rune boundary
+	if len(summary) > maxPagerDutySummaryLength {
+		cut := maxPagerDutySummaryLength - len("...")
+		for cut > 0 && !utf8.RuneStart(summary[cut]) {
+			cut--
+		}
+		summary = summary[:cut] + "..."
+	}
+
+	source := config.Source
+	if source == "" {
+		source = alert.location()
+	}
+	if source == "" {
+		source = "openchoreo"
+	}
+
+	details := map[string]interface{}{}
+	for _, fact := range alert.facts() {
+		details[fact[0]] = fact[1]
+	}
+	if note := alert.rcaNote(); note != "" {
+		details["Root cause analysis"] = note
+	}
+
+	payload := map[string]interface{}{
+		"summary":        summary,
+		"source":         source,
+		"severity":       pagerDutySeverity(config.SeverityMapping, alert.Severity),
+		"custom_details": details,
+	}
+	if timestamp, err := time.Parse(time.RFC3339, alert.Timestamp); err == nil {
+		payload["timestamp"] = timestamp.UTC().Format(time.RFC3339)
+	}
+	if alert.Component != "" {
+		payload["component"] = alert.Component
+	}
+	if alert.Project != "" {
+		payload["group"] = alert.Project
+	}
+
+	event := map[string]interface{}{
+		"routing_key":  config.RoutingKey,
+		"event_action": PagerDutyActionTrigger,
+		"dedup_key":    alert.Key,
+		"payload":      payload,
+	}
+	if alert.RCALink != "" {
+		event["links"] = []interface{}{
+			map[string]interface{}{"href": alert.RCALink, "text": "Root cause analysis"},
+		}
+	}
+	return event
+}
+
+// pagerDutySeverity maps an alert severity to a PagerDuty severity. Unmapped severities that PagerDuty
+// does not know are sent as "error", which PagerDuty treats as high urgency.
+func pagerDutySeverity(mapping map[string]string, severity string) string {
+	severity = strings.ToLower(severity)
+	if mapped, ok := mapping[severity]; ok && pagerDutySeverities[mapped] {
+		return mapped
+	}
+	if pagerDutySeverities[severity] {
+		return severity
+	}
+	return "error"
+}
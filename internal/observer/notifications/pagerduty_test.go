@@ -0,0 +1,132 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSendPagerDutyEvent(t *testing.T) {
+	var events []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	config := &PagerDutyConfig{
+		RoutingKey:      "routing-key",
+		SeverityMapping: map[string]string{"warning": "error"},
+		EventsURL:       server.URL,
+	}
+	alert := &Alert{
+		Key:         "openchoreo/default/high-error-rate",
+		Name:        "high-error-rate",
+		Description: "Error rate above threshold",
+		Severity:    "warning",
+		Timestamp:   "2025-01-01T10:00:00Z",
+		Component:   "api",
+		Project:     "shop",
+		Environment: "production",
+		RCAEnabled:  true,
+		RCALink:     "https://console.example.com/rca/alert-1",
+	}
+
+	if err := SendPagerDutyEvent(context.Background(), config, alert, PagerDutyActionTrigger); err != nil {
+		t.Fatalf("trigger failed: %v", err)
+	}
+	if err := SendPagerDutyEvent(context.Background(), config, alert, PagerDutyActionResolve); err != nil {
+		t.Fatalf("resolve failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+
+	trigger := events[0]
+	if trigger["event_action"] != "trigger" || trigger["dedup_key"] != alert.Key || trigger["routing_key"] != "routing-key" {
+		t.Errorf("unexpected trigger event: %v", trigger)
+	}
+	payload, _ := trigger["payload"].(map[string]interface{})
+	if payload["severity"] != "error" {
+		t.Errorf("severity = %v, want the mapped severity error", payload["severity"])
+	}
+	if payload["source"] != "component api in project shop (production)" {
+		t.Errorf("source = %v", payload["source"])
+	}
+	if links, _ := trigger["links"].([]interface{}); len(links) != 1 {
+		t.Errorf("links = %v, want the RCA link", trigger["links"])
+	}
+
+	resolve := events[1]
+	if resolve["event_action"] != "resolve" || resolve["dedup_key"] != alert.Key {
+		t.Errorf("unexpected resolve event: %v", resolve)
+	}
+	if _, ok := resolve["payload"]; ok {
+		t.Error("resolve event should not carry a payload")
+	}
+}
+
+func TestSendPagerDutyEvent_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":"invalid event"}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	alert := &Alert{Key: "openchoreo/default/rule", Name: "rule"}
+	if err := SendPagerDutyEvent(context.Background(), &PagerDutyConfig{EventsURL: server.URL}, alert, PagerDutyActionTrigger); err == nil {
+		t.Error("expected an error without a routing key")
+	}
+	config := &PagerDutyConfig{RoutingKey: "key", EventsURL: server.URL}
+	if err := SendPagerDutyEvent(context.Background(), config, alert, "acknowledge"); err == nil {
+		t.Error("expected an error for an unsupported action")
+	}
+	if err := SendPagerDutyEvent(context.Background(), config, alert, PagerDutyActionTrigger); err == nil {
+		t.Error("expected an error when PagerDuty rejects the event")
+	}
+}
+
+func TestPagerDutySeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapping  map[string]string
+		severity string
+		want     string
+	}{
+		{name: "mapped", mapping: map[string]string{"info": "warning"}, severity: "info", want: "warning"},
+		{name: "same name", severity: "critical", want: "critical"},
+		{name: "case insensitive", severity: "Warning", want: "warning"},
+		{name: "unknown", severity: "major", want: "error"},
+		{name: "invalid mapping is ignored", mapping: map[string]string{"info": "page"}, severity: "info", want: "info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pagerDutySeverity(tt.mapping, tt.severity); got != tt.want {
+				t.Errorf("pagerDutySeverity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPagerDutyTrigger_TruncatesSummaryOnRuneBoundary(t *testing.T) {
+	alert := &Alert{Name: "rule", Description: strings.Repeat("é", 1024)}
+
+	payload, _ := buildPagerDutyTrigger(&PagerDutyConfig{}, alert)["payload"].(map[string]interface{})
+	summary, _ := payload["summary"].(string)
+	if len(summary) > maxPagerDutySummaryLength {
+		t.Errorf("summary is %d bytes, want at most %d", len(summary), maxPagerDutySummaryLength)
+	}
+	if !utf8.ValidString(summary) || !strings.HasSuffix(summary, "é...") {
+		t.Errorf("summary = %q, want a valid string cut after a whole rune", summary)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SlackConfig holds Slack incoming webhook configuration
+type SlackConfig struct {
+	WebhookURL string
+	Channel    string // Optional channel override
+	Username   string // Optional username override
+}
+
+// slackSeverityColors are the attachment colors of each alert severity
+var slackSeverityColors = map[string]string{
+	"critical": "#d32f2f",
+	"warning":  "#f9a825",
+	"info":     "#1976d2",
+}
+
+const slackResolvedColor = "#2e7d32"
+
+// SendSlackWithConfig posts the alert to Slack as a message with a color-coded attachment
+func SendSlackWithConfig(ctx context.Context, config *SlackConfig, alert *Alert) error {
+	if config.WebhookURL == "" {
+		return fmt.Errorf("slack webhook URL is required")
+	}
+	if err := postJSON(ctx, config.WebhookURL, nil, buildSlackMessage(config, alert)); err != nil {
+		return fmt.Errorf("failed to send slack message: %w", err)
+	}
+	return nil
+}
+
+// buildSlackMessage formats the alert as Slack blocks. The text field is the notification fallback.
+func buildSlackMessage(config *SlackConfig, alert *Alert) map[string]interface{} {
+	blocks := []interface{}{
+		map[string]interface{}{
+			"type": "header",
+			"text": map[string]interface{}{"type": "plain_text", "text": alert.title()},
+		},
+	}
+
+	var summary []string
+	if alert.Description != "" {
+		summary = append(summary, alert.Description)
+	}
+	if sentence := alert.summary(); sentence != "" {
+		summary = append(summary, sentence)
+	}
+	if note := alert.relatedNote(); note != "" {
+		summary = append(summary, note)
+	}
+	if len(summary) > 0 {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": strings.Join(summary, "\n")},
+		})
+	}
+
+	var fields []interface{}
+	for _, fact := range alert.facts() {
+		fields = append(fields, map[string]interface{}{
+			"type": "mrkdwn",
+			"text": fmt.Sprintf("*%s*\n%s", fact[0], fact[1]),
+		})
+	}
+	if len(fields) > 0 {
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields})
+	}
+
+	if alert.RCAEnabled && !alert.Resolved {
+		if alert.RCALink != "" {
+			blocks = append(blocks, map[string]interface{}{
+				"type": "actions",
+				"elements": []interface{}{
+					map[string]interface{}{
+						"type": "button",
+						"text": map[string]interface{}{"type": "plain_text", "text": "View root cause analysis"},
+						"url":  alert.RCALink,
+					},
+				},
+			})
+		} else {
+			blocks = append(blocks, map[string]interface{}{
+				"type": "context",
+				"elements": []interface{}{
+					map[string]interface{}{"type": "mrkdwn", "text": alert.rcaNote()},
+				},
+			})
+		}
+	}
+
+	message := map[string]interface{}{
+		"text": alert.title(),
+		"attachments": []interface{}{
+			map[string]interface{}{
+				"color":  slackColor(alert),
+				"blocks": blocks,
+			},
+		},
+	}
+	if config.Channel != "" {
+		message["channel"] = config.Channel
+	}
+	if config.Username != "" {
+		message["username"] = config.Username
+	}
+	return message
+}
+
+func slackColor(alert *Alert) string {
+	if alert.Resolved {
+		return slackResolvedColor
+	}
+	if color, ok := slackSeverityColors[strings.ToLower(alert.Severity)]; ok {
+		return color
+	}
+	return "#757575"
+}
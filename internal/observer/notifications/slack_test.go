@@ -0,0 +1,85 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBuildSlackMessage(t *testing.T) {
+	alert := &Alert{
+		Name:        "high-error-rate",
+		Description: "Error rate above threshold",
+		Severity:    "critical",
+		Component:   "api",
+		RCAEnabled:  true,
+		RCALink:     "https://console.example.com/rca/alert-1",
+	}
+
+	message := buildSlackMessage(&SlackConfig{Channel: "#alerts"}, alert)
+	if message["channel"] != "#alerts" {
+		t.Errorf("channel = %v, want #alerts", message["channel"])
+	}
+	if _, ok := message["username"]; ok {
+		t.Error("username should not be set without an override")
+	}
+	if message["text"] != "[CRITICAL] Alert triggered: high-error-rate" {
+		t.Errorf("text = %v", message["text"])
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	for _, want := range []string{`"color":"#d32f2f"`, "View root cause analysis", alert.RCALink, "Error rate above threshold"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("message does not contain %q: %s", want, data)
+		}
+	}
+}
+
+func TestBuildSlackMessage_RCAWithoutLink(t *testing.T) {
+	message := buildSlackMessage(&SlackConfig{}, &Alert{Name: "rule", RCAEnabled: true})
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	if !strings.Contains(string(data), "AI root cause analysis has been started") {
+		t.Errorf("message should mention the root cause analysis: %s", data)
+	}
+	if strings.Contains(string(data), `"type":"button"`) {
+		t.Errorf("message should not have a link button: %s", data)
+	}
+}
+
+func TestBuildSlackMessage_ResolvedGroup(t *testing.T) {
+	alert := &Alert{
+		Name:        "high-error-rate",
+		Severity:    "critical",
+		Component:   "api",
+		Environment: "production",
+		RCAEnabled:  true,
+		Resolved:    true,
+		Related:     []string{"high-latency"},
+	}
+
+	message := buildSlackMessage(&SlackConfig{}, alert)
+	if message["text"] != "[RESOLVED] Alert resolved: high-error-rate" {
+		t.Errorf("text = %v", message["text"])
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	for _, want := range []string{`"color":"#2e7d32"`, "Resolved for component api (production).", "Also resolved: high-latency"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("message does not contain %q: %s", want, data)
+		}
+	}
+	if strings.Contains(string(data), "root cause analysis") {
+		t.Errorf("resolved message should not mention the root cause analysis: %s", data)
+	}
+}
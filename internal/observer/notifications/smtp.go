@@ -5,11 +5,17 @@ package notifications
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/smtp"
 	"strings"
+	"time"
 )
 
+// defaultSMTPTimeout bounds an SMTP exchange when the context has no deadline
+const defaultSMTPTimeout = 30 * time.Second
+
 // SMTPConfig holds SMTP configuration for sending emails
 type SMTPConfig struct {
 	Host     string
@@ -27,15 +33,19 @@ type EmailConfig struct {
 	BodyTemplate    string
 }
 
-// NotificationChannelConfig combines email and webhook configuration
+// NotificationChannelConfig combines the configuration of all channel types
 type NotificationChannelConfig struct {
-	Type    string // "email" or "webhook"
-	Email   EmailConfig
-	Webhook WebhookConfig
+	Type      string // "email", "webhook", "slack", "msteams" or "pagerduty"
+	Email     EmailConfig
+	Webhook   WebhookConfig
+	Slack     SlackConfig
+	MSTeams   MSTeamsConfig
+	PagerDuty PagerDutyConfig
 }
 
-// SendEmailWithConfig sends an alert email using the provided configuration.
-func SendEmailWithConfig(_ context.Context, config *NotificationChannelConfig, subject, body string) error {
+// SendEmailWithConfig sends an alert email using the provided configuration. The SMTP exchange is bound by
+// the deadline of the context, so that an unresponsive relay fails the delivery instead of blocking it.
+func SendEmailWithConfig(ctx context.Context, config *NotificationChannelConfig, subject, body string) error {
 	to := config.Email.To
 	if len(to) == 0 {
 		return fmt.Errorf("no recipients specified")
@@ -59,5 +69,65 @@ func SendEmailWithConfig(_ context.Context, config *NotificationChannelConfig, s
 		body,
 	)
 
-	return smtp.SendMail(addr, auth, config.Email.SMTP.From, to, []byte(message))
+	return sendMail(ctx, addr, config.Email.SMTP.Host, auth, config.Email.SMTP.From, to, []byte(message))
+}
+
+// sendMail does what smtp.SendMail does over a connection that is closed once the context is done
+func sendMail(ctx context.Context, addr, host string, auth smtp.Auth, from string, to []string, message []byte) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultSMTPTimeout)
+	}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server does not support authentication")
+		}
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
 }
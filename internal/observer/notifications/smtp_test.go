@@ -0,0 +1,49 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSendEmailWithConfig_UnresponsiveServerTimesOut(t *testing.T) {
+	// The listener accepts connections but never sends the SMTP greeting
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		<-t.Context().Done()
+		conn.Close()
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	config := &NotificationChannelConfig{
+		Type: "email",
+		Email: EmailConfig{
+			SMTP: SMTPConfig{Host: "127.0.0.1", Port: addr.Port, From: "alerts@example.com"},
+			To:   []string{"oncall@example.com"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = SendEmailWithConfig(ctx, config, "subject", "body")
+	if err == nil {
+		t.Fatalf("expected an error from %s", net.JoinHostPort("127.0.0.1", strconv.Itoa(addr.Port)))
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("send returned after %v, expected it to stop at the context deadline", elapsed)
+	}
+}
@@ -381,3 +381,63 @@ func (c *Client) WriteAlertEntry(ctx context.Context, entry map[string]interface
 	c.logger.Debug("Alert entry written", "alert_id", parsed.ID)
 	return parsed.ID, nil
 }
+
+// EnsureIndex creates an index with the given settings and mappings unless it already exists
+func (c *Client) EnsureIndex(ctx context.Context, index string, body map[string]interface{}) error {
+	existsRes, err := opensearchapi.IndicesExistsRequest{Index: []string{index}}.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("index exists request failed: %w", err)
+	}
+	existsRes.Body.Close()
+	if existsRes.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal index body: %w", err)
+	}
+	res, err := opensearchapi.IndicesCreateRequest{Index: index, Body: bytes.NewReader(payload)}.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("index create request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		bodyBytes, _ := io.ReadAll(res.Body)
+		// Another replica may have created the index in the meantime
+		if strings.Contains(string(bodyBytes), "resource_already_exists_exception") {
+			return nil
+		}
+		return fmt.Errorf("index create request failed with status: %s, response: %s", res.Status(), string(bodyBytes))
+	}
+
+	c.logger.Info("Created index", "index", index)
+	return nil
+}
+
+// WriteDocument indexes a document under the given ID, replacing any document with the same ID
+func (c *Client) WriteDocument(ctx context.Context, index, id string, document interface{}) error {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	req := opensearchapi.IndexRequest{
+		Index:      index,
+		DocumentID: id,
+		Body:       bytes.NewReader(body),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("index request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("index request failed with status: %s, response: %s", res.Status(), string(bodyBytes))
+	}
+	return nil
+}
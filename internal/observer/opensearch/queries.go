@@ -734,11 +734,9 @@ func (qb *QueryBuilder) BuildLogAlertingRuleMonitorBody(params types.AlertingRul
 								Source: buildWebhookMessageTemplate(params),
 								Lang:   "mustache",
 							},
-							ThrottleEnabled: true,
-							Throttle: MonitorTriggerActionThrottle{
-								Value: 60, // TODO: Make throttle value configurable in future
-								Unit:  "MINUTES",
-							},
+							// Every evaluation is sent to the observer, which deduplicates them and resolves the
+							// alert once evaluations stop
+							ThrottleEnabled: false,
 							SubjectTemplate: MonitorMessageTemplate{
 								Source: "TheSubject", // TODO: Add appropriate subject template
 								Lang:   "mustache",
@@ -330,7 +330,7 @@ type MonitorTriggerAction struct {
 	DestinationID         string                              `json:"destination_id"`
 	MessageTemplate       MonitorMessageTemplate              `json:"message_template"`
 	ThrottleEnabled       bool                                `json:"throttle_enabled"`
-	Throttle              MonitorTriggerActionThrottle        `json:"throttle"`
+	Throttle              *MonitorTriggerActionThrottle       `json:"throttle,omitempty"`
 	SubjectTemplate       MonitorMessageTemplate              `json:"subject_template"`
 	ActionExecutionPolicy MonitorTriggerActionExecutionPolicy `json:"action_execution_policy"`
 }
@@ -70,6 +70,15 @@ func BuildLabelFilter(componentID, projectID, environmentID string) string {
 		componentLabel, componentID, projectLabel, projectID, environmentLabel, environmentID)
 }
 
+// WithRolloutTrack narrows a component label filter to the pods of a single rollout track,
+// e.g. the canary pods of a progressive rollout. An empty track leaves the filter unchanged.
+func WithRolloutTrack(labelFilter, track string) string {
+	if track == "" {
+		return labelFilter
+	}
+	return fmt.Sprintf(`%s,%s=%q`, labelFilter, prometheusLabelName(labels.RolloutTrack), track)
+}
+
 // BuildCPUUsageQuery builds a PromQL query for CPU usage rate
 func BuildCPUUsageQuery(labelFilter string) string {
 	query := fmt.Sprintf(`sum by (label_openchoreo_dev_component_uid, label_openchoreo_dev_project_uid, label_openchoreo_dev_environment_uid, container) (
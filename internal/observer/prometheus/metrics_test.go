@@ -603,3 +603,14 @@ func TestBuild99thPercentileHTTPRequestLatencyQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestWithRolloutTrack(t *testing.T) {
+	filter := BuildLabelFilter("c1", "p1", "e1")
+	if got := WithRolloutTrack(filter, ""); got != filter {
+		t.Errorf("WithRolloutTrack() without a track = %q, want %q", got, filter)
+	}
+	want := filter + `,label_openchoreo_dev_rollout_track="canary"`
+	if got := WithRolloutTrack(filter, "canary"); got != want {
+		t.Errorf("WithRolloutTrack() = %q, want %q", got, want)
+	}
+}
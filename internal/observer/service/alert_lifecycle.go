@@ -0,0 +1,186 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	choreoapis "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
+)
+
+// missedEvaluations is how many evaluations of a log alert rule may pass without the alert firing before
+// the alert is resolved. OpenSearch monitors only report firing alerts.
+const missedEvaluations = 2
+
+var _ alerting.Notifier = (*LoggingService)(nil)
+
+func (s *LoggingService) newAlertManager(store alerting.Store) *alerting.Manager {
+	return alerting.NewManager(alerting.Config{
+		GroupWait:      s.config.Alerting.GroupWait,
+		RepeatInterval: s.config.Alerting.RepeatInterval,
+	}, store, s, s.logger.With("component", "alert-manager"))
+}
+
+// UseAlertStore persists the alert lifecycle in the store and restores the alerts and silences kept in it
+func (s *LoggingService) UseAlertStore(ctx context.Context, store alerting.Store) error {
+	manager := s.newAlertManager(store)
+	if err := manager.Load(ctx); err != nil {
+		return err
+	}
+	s.alertManager = manager
+	return nil
+}
+
+// RunAlertLifecycle resolves alerts whose evaluations stopped until the context is done
+func (s *LoggingService) RunAlertLifecycle(ctx context.Context) {
+	s.alertManager.Run(ctx)
+}
+
+// lifecycleAlert describes an evaluation of an alert rule to the alert manager
+func lifecycleAlert(alertRule *choreoapis.ObservabilityAlertRule, details map[string]interface{}, status alerting.Status) *alerting.Alert {
+	alert := &alerting.Alert{
+		RuleName:      alertRule.Spec.Name,
+		RuleNamespace: alertRule.Namespace,
+		Channel:       alertRule.Spec.NotificationChannel,
+		Project:       alertRule.Labels["openchoreo.dev/project"],
+		Component:     alertRule.Labels["openchoreo.dev/component"],
+		Environment:   alertRule.Labels["openchoreo.dev/environment"],
+		Severity:      string(alertRule.Spec.Severity),
+		Status:        status,
+		Details:       details,
+	}
+	if alertRule.Spec.Source.Type == choreoapis.ObservabilityAlertSourceTypeLog && alertRule.Spec.Condition.Interval.Duration > 0 {
+		alert.ResolveTimeout = missedEvaluations*alertRule.Spec.Condition.Interval.Duration + time.Minute
+	}
+	return alert
+}
+
+// IsAlertFiring reports whether the alert of the rule is already firing, in which case an evaluation
+// continues the alert instead of starting a new one
+func (s *LoggingService) IsAlertFiring(alertRule *choreoapis.ObservabilityAlertRule) bool {
+	if s.alertManager == nil {
+		return false
+	}
+	return s.alertManager.IsFiring(lifecycleAlert(alertRule, nil, alerting.StatusFiring))
+}
+
+// ReceiveAlert records an evaluation of the alert rule. The notification channel is notified once the group
+// wait elapses, unless the alert was already notified within the repeat interval or a silence matches it.
+// The alert ID is only recorded when the evaluation starts the alert.
+func (s *LoggingService) ReceiveAlert(ctx context.Context, alertRule *choreoapis.ObservabilityAlertRule, details map[string]interface{}, alertID string, status alerting.Status) (alerting.Result, *alerting.State, error) {
+	if s.alertManager == nil {
+		return "", nil, errors.New("alert lifecycle is not initialized")
+	}
+	alert := lifecycleAlert(alertRule, details, status)
+	alert.AlertID = alertID
+	result, state := s.alertManager.Receive(ctx, alert)
+	s.logger.Debug("Alert evaluation received",
+		"ruleName", alert.RuleName,
+		"ruleNamespace", alert.RuleNamespace,
+		"status", status,
+		"result", result)
+	return result, state, nil
+}
+
+// ListAlerts returns the tracked alerts that match the filter
+func (s *LoggingService) ListAlerts(filter alerting.AlertFilter) []*alerting.State {
+	return s.alertManager.ListAlerts(filter)
+}
+
+// ListSilences returns the silences that match the filter
+func (s *LoggingService) ListSilences(filter alerting.SilenceFilter) []*alerting.Silence {
+	return s.alertManager.ListSilences(filter)
+}
+
+// GetSilence returns a silence by ID
+func (s *LoggingService) GetSilence(silenceID string) (*alerting.Silence, error) {
+	return s.alertManager.GetSilence(silenceID)
+}
+
+// CreateSilence suppresses the notifications of the alerts in the scope of the silence
+func (s *LoggingService) CreateSilence(ctx context.Context, silence *alerting.Silence) (*alerting.Silence, error) {
+	return s.alertManager.CreateSilence(ctx, silence)
+}
+
+// ExpireSilence ends a silence now
+func (s *LoggingService) ExpireSilence(ctx context.Context, silenceID string) (*alerting.Silence, error) {
+	return s.alertManager.ExpireSilence(ctx, silenceID)
+}
+
+// Notify implements alerting.Notifier by queueing the deliveries of the notification. PagerDuty tracks every
+// alert as an incident of its own, so each alert of the group is sent as a separate event. The other
+// channels get one message per status that describes the first alert and names the others. An error means
+// that a delivery could not be queued.
+func (s *LoggingService) Notify(ctx context.Context, notification *alerting.Notification) error {
+	channelConfig, err := s.getNotificationChannelConfig(ctx, notification.Channel)
+	if err != nil {
+		return fmt.Errorf("failed to get notification channel config: %w", err)
+	}
+
+	var errs []error
+	for _, states := range [][]*alerting.State{notification.Firing, notification.Resolved} {
+		if len(states) == 0 {
+			continue
+		}
+		if channelConfig.Type == "pagerduty" {
+			for _, state := range states {
+				errs = append(errs, s.enqueueNotification(ctx, state, notificationDetails(state, nil)))
+			}
+			continue
+		}
+		errs = append(errs, s.enqueueNotification(ctx, states[0], notificationDetails(states[0], states[1:])))
+	}
+	return errors.Join(errs...)
+}
+
+// notificationDetails adds the lifecycle of the alert, and the other alerts of its group, to the alert details
+func notificationDetails(state *alerting.State, related []*alerting.State) map[string]interface{} {
+	details := make(map[string]interface{}, len(state.Details)+8)
+	for key, value := range state.Details {
+		details[key] = value
+	}
+	details["notificationChannel"] = state.Channel
+	details["alertStatus"] = string(state.Status)
+	details["fingerprint"] = state.Fingerprint
+	details["startsAt"] = state.StartsAt.UTC().Format(time.RFC3339)
+	details["occurrences"] = state.Occurrences
+	if state.AlertID != "" {
+		details["alertId"] = state.AlertID
+	}
+	if state.ResolvedAt != nil {
+		details["resolvedAt"] = state.ResolvedAt.UTC().Format(time.RFC3339)
+	}
+
+	grouped := make([]interface{}, 0, len(related))
+	for _, other := range related {
+		grouped = append(grouped, map[string]interface{}{
+			"ruleName":    other.RuleName,
+			"severity":    other.Severity,
+			"fingerprint": other.Fingerprint,
+			"value":       other.Details["value"],
+		})
+	}
+	details["groupedAlerts"] = grouped
+	return details
+}
+
+// alertStatus is the status detail of an alert, which is only set by the alert lifecycle
+func alertStatus(details map[string]interface{}) string {
+	if status, ok := details["alertStatus"].(string); ok && status != "" {
+		return status
+	}
+	return string(alerting.StatusFiring)
+}
+
+// groupedAlerts are the other alerts sent in the same notification, empty when the alert was sent alone
+func groupedAlerts(details map[string]interface{}) []interface{} {
+	if grouped, ok := details["groupedAlerts"].([]interface{}); ok {
+		return grouped
+	}
+	return []interface{}{}
+}
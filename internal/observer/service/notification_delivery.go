@@ -0,0 +1,128 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
+	"github.com/openchoreo/openchoreo/internal/observer/delivery"
+)
+
+// maxDeliveryMessageLength bounds the delivery message annotation, which carries the error of the channel
+const maxDeliveryMessageLength = 1024
+
+var _ delivery.HealthReporter = (*LoggingService)(nil)
+
+func (s *LoggingService) newDeliveryQueue(log delivery.Log) *delivery.Queue {
+	alertingConfig := s.config.Alerting
+	return delivery.NewQueue(delivery.Config{
+		Workers:        alertingConfig.DeliveryWorkers,
+		QueueSize:      alertingConfig.DeliveryQueueSize,
+		MaxAttempts:    alertingConfig.DeliveryMaxAttempts,
+		InitialBackoff: alertingConfig.DeliveryInitialBackoff,
+		MaxBackoff:     alertingConfig.DeliveryMaxBackoff,
+		AttemptTimeout: alertingConfig.DeliveryAttemptTimeout,
+		RatePerMinute:  alertingConfig.DeliveryRatePerMinute,
+		RateBurst:      alertingConfig.DeliveryRateBurst,
+	}, log, s, s.logger.With("component", "notification-delivery"))
+}
+
+// UseDeliveryLog records notification deliveries in the log. It must be called before
+// RunNotificationDelivery.
+func (s *LoggingService) UseDeliveryLog(log delivery.Log) {
+	s.deliveryQueue = s.newDeliveryQueue(log)
+}
+
+// RunNotificationDelivery delivers the queued notifications until the context is done. The deliveries a
+// previous run left unfinished in the delivery log are resumed first.
+func (s *LoggingService) RunNotificationDelivery(ctx context.Context) {
+	if err := s.deliveryQueue.Resume(ctx, s.resumeNotification); err != nil {
+		s.logger.Error("Failed to resume notification deliveries", "error", err)
+	}
+	s.deliveryQueue.Run(ctx)
+}
+
+// ListDeliveries returns the notification deliveries that match the filter, newest first
+func (s *LoggingService) ListDeliveries(ctx context.Context, filter delivery.Filter) ([]*delivery.Record, error) {
+	return s.deliveryQueue.List(ctx, filter)
+}
+
+// enqueueNotification queues the delivery of a notification about the alert. The details are rendered
+// into a message again on every attempt, so that a retry picks up a fixed channel configuration.
+func (s *LoggingService) enqueueNotification(ctx context.Context, state *alerting.State, details map[string]interface{}) error {
+	record := &delivery.Record{
+		Channel:       state.Channel,
+		RuleName:      state.RuleName,
+		RuleNamespace: state.RuleNamespace,
+		Project:       state.Project,
+		Component:     state.Component,
+		Environment:   state.Environment,
+		AlertID:       state.AlertID,
+		Fingerprint:   state.Fingerprint,
+		AlertStatus:   string(state.Status),
+		Payload:       details,
+	}
+	return s.deliveryQueue.Enqueue(ctx, record, func(ctx context.Context) error {
+		return s.SendAlertNotification(ctx, details, state.RuleName)
+	})
+}
+
+// resumeNotification rebuilds the send of a notification from its delivery record
+func (s *LoggingService) resumeNotification(record *delivery.Record) (delivery.SendFunc, error) {
+	if len(record.Payload) == 0 {
+		return nil, errors.New("the delivery was recorded without its notification")
+	}
+	return func(ctx context.Context) error {
+		return s.SendAlertNotification(ctx, record.Payload, record.RuleName)
+	}, nil
+}
+
+// ReportChannelHealth implements delivery.HealthReporter. The health is recorded as annotations on the
+// ConfigMap of the channel, from where the controller copies it to the status of the channel resource.
+func (s *LoggingService) ReportChannelHealth(ctx context.Context, health delivery.ChannelHealth) error {
+	if s.k8sClient == nil {
+		return nil
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := s.k8sClient.List(ctx, configMaps, client.MatchingLabels{
+		labels.LabelKeyNotificationChannelName: health.Channel,
+	}); err != nil {
+		return fmt.Errorf("failed to list ConfigMaps of notification channel %s: %w", health.Channel, err)
+	}
+	if len(configMaps.Items) == 0 {
+		return fmt.Errorf("failed to find ConfigMap of notification channel %s", health.Channel)
+	}
+
+	message := health.Message
+	if len(message) > maxDeliveryMessageLength {
+		message = message[:maxDeliveryMessageLength]
+	}
+	configMap := &configMaps.Items[0]
+	original := configMap.DeepCopy()
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Annotations[labels.AnnotationKeyNotificationDeliveryFailing] = strconv.FormatBool(health.Failing)
+	configMap.Annotations[labels.AnnotationKeyNotificationDeliveryMessage] = message
+	configMap.Annotations[labels.AnnotationKeyNotificationDeliveryTime] = health.UpdatedAt.UTC().Format(time.RFC3339)
+	if err := s.k8sClient.Patch(ctx, configMap, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to record delivery health of notification channel %s: %w", health.Channel, err)
+	}
+
+	s.logger.Info("Notification channel delivery health changed",
+		"channel", health.Channel,
+		"failing", health.Failing,
+		"consecutiveFailures", health.ConsecutiveFailures)
+	return nil
+}
@@ -7,9 +7,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
@@ -24,7 +26,9 @@ import (
 
 	choreoapis "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/delivery"
 	observerlabels "github.com/openchoreo/openchoreo/internal/observer/labels"
 	"github.com/openchoreo/openchoreo/internal/observer/notifications"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
@@ -61,6 +65,8 @@ type LoggingService struct {
 	k8sClient      client.Client
 	config         *config.Config
 	logger         *slog.Logger
+	alertManager   *alerting.Manager
+	deliveryQueue  *delivery.Queue
 }
 
 // LogResponse represents the response structure for log queries
@@ -83,8 +89,9 @@ type HTTPMetricsTimeSeries struct {
 }
 
 // NewLoggingService creates a new logging service instance
+// The alert lifecycle and the delivery log are kept in memory until UseAlertStore and UseDeliveryLog are called.
 func NewLoggingService(osClient OpenSearchClient, metricsService *prometheus.MetricsService, k8sClient client.Client, cfg *config.Config, logger *slog.Logger) *LoggingService {
-	return &LoggingService{
+	s := &LoggingService{
 		osClient:       osClient,
 		queryBuilder:   opensearch.NewQueryBuilder(cfg.OpenSearch.IndexPrefix),
 		metricsService: metricsService,
@@ -92,6 +99,9 @@ func NewLoggingService(osClient OpenSearchClient, metricsService *prometheus.Met
 		config:         cfg,
 		logger:         logger,
 	}
+	s.alertManager = s.newAlertManager(nil)
+	s.deliveryQueue = s.newDeliveryQueue(nil)
+	return s
 }
 
 // GetBuildLogs retrieves logs for a specific build using V2 wildcard search
@@ -740,8 +750,9 @@ func (s *LoggingService) DeleteMetricAlertRule(ctx context.Context, ruleName str
 	}, nil
 }
 
-// GetComponentHTTPMetrics retrieves HTTP metrics for a component
-func (s *LoggingService) GetComponentHTTPMetrics(ctx context.Context, componentID, environmentID, projectID string, startTime, endTime time.Time) (*HTTPMetricsTimeSeries, error) {
+// GetComponentHTTPMetrics retrieves HTTP metrics for a component.
+// A non-empty rolloutTrack restricts the metrics to the pods of that rollout track.
+func (s *LoggingService) GetComponentHTTPMetrics(ctx context.Context, componentID, environmentID, projectID, rolloutTrack string, startTime, endTime time.Time) (*HTTPMetricsTimeSeries, error) {
 	s.logger.Debug("Getting resource metrics",
 		"project", projectID,
 		"component", componentID,
@@ -753,7 +764,7 @@ func (s *LoggingService) GetComponentHTTPMetrics(ctx context.Context, componentI
 	metrics := &HTTPMetricsTimeSeries{}
 
 	// Build component label filter using query builder
-	labelFilter := prometheus.BuildLabelFilter(componentID, projectID, environmentID)
+	labelFilter := prometheus.WithRolloutTrack(prometheus.BuildLabelFilter(componentID, projectID, environmentID), rolloutTrack)
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -1137,7 +1148,8 @@ func (s *LoggingService) GetRCAReportByAlert(ctx context.Context, params opensea
 	return result, nil
 }
 
-// SendAlertNotification sends an alert notification via the configured notification channel
+// SendAlertNotification sends an alert notification via the configured notification channel. Alerts whose
+// alertStatus detail is resolved are sent as resolve notifications.
 func (s *LoggingService) SendAlertNotification(ctx context.Context, requestBody map[string]interface{}, ruleName string) error {
 	notificationChannelName := ""
 	if channel, ok := requestBody["notificationChannel"].(string); ok && channel != "" {
@@ -1161,6 +1173,8 @@ func (s *LoggingService) SendAlertNotification(ctx context.Context, requestBody
 		return fmt.Errorf("failed to get notification channel config: %w", err)
 	}
 
+	resolved := requestBody["alertStatus"] == string(alerting.StatusResolved)
+
 	// Send notification based on channel type
 	switch channelConfig.Type {
 	case "webhook":
@@ -1211,11 +1225,16 @@ func (s *LoggingService) SendAlertNotification(ctx context.Context, requestBody
 
 		// Build subject and body using templates if available, otherwise use defaults
 		subject := fmt.Sprintf("OpenChoreo alert triggered: %s", ruleName)
+		emailEvent := "triggered"
+		if resolved {
+			subject = fmt.Sprintf("OpenChoreo alert resolved: %s", ruleName)
+			emailEvent = "resolved"
+		}
 		if channelConfig.Email.SubjectTemplate != "" {
 			subject = s.renderTemplate(channelConfig.Email.SubjectTemplate, requestBody)
 		}
 
-		emailBody := fmt.Sprintf("An alert was triggered at %s UTC.\n\nPayload:\n%s\n", time.Now().UTC().Format(time.RFC3339), string(payload))
+		emailBody := fmt.Sprintf("An alert was %s at %s UTC.\n\nPayload:\n%s\n", emailEvent, time.Now().UTC().Format(time.RFC3339), string(payload))
 		if channelConfig.Email.BodyTemplate != "" {
 			emailBody = s.renderTemplate(channelConfig.Email.BodyTemplate, requestBody)
 		}
@@ -1235,11 +1254,97 @@ func (s *LoggingService) SendAlertNotification(ctx context.Context, requestBody
 			"recipients count", len(channelConfig.Email.To))
 		return nil
 
+	case "slack":
+		if err := notifications.SendSlackWithConfig(ctx, &channelConfig.Slack, s.alertFromDetails(requestBody)); err != nil {
+			s.logger.Error("Failed to send alert notification to Slack",
+				"error", err,
+				"channelName", notificationChannelName)
+			return fmt.Errorf("failed to send alert notification to slack: %w", err)
+		}
+		s.logger.Debug("Alert notification sent successfully via Slack",
+			"ruleName", ruleName,
+			"channelName", notificationChannelName)
+		return nil
+
+	case "msteams":
+		if err := notifications.SendMSTeamsWithConfig(ctx, &channelConfig.MSTeams, s.alertFromDetails(requestBody)); err != nil {
+			s.logger.Error("Failed to send alert notification to Microsoft Teams",
+				"error", err,
+				"channelName", notificationChannelName)
+			return fmt.Errorf("failed to send alert notification to microsoft teams: %w", err)
+		}
+		s.logger.Debug("Alert notification sent successfully via Microsoft Teams",
+			"ruleName", ruleName,
+			"channelName", notificationChannelName)
+		return nil
+
+	case "pagerduty":
+		alert := s.alertFromDetails(requestBody)
+		action := notifications.PagerDutyActionTrigger
+		if resolved {
+			action = notifications.PagerDutyActionResolve
+		}
+		if err := notifications.SendPagerDutyEvent(ctx, &channelConfig.PagerDuty, alert, action); err != nil {
+			s.logger.Error("Failed to send alert notification to PagerDuty",
+				"error", err,
+				"channelName", notificationChannelName)
+			return fmt.Errorf("failed to send alert notification to pagerduty: %w", err)
+		}
+		s.logger.Debug("Alert notification sent successfully via PagerDuty",
+			"ruleName", ruleName,
+			"channelName", notificationChannelName,
+			"action", action,
+			"dedupKey", alert.Key)
+		return nil
+
 	default:
 		return fmt.Errorf("unsupported notification channel type: %s", channelConfig.Type)
 	}
 }
 
+// alertFromDetails converts the enriched alert details into the alert described by the built-in message
+// formats. The RCA link is only set once the alert has been stored and has an ID.
+func (s *LoggingService) alertFromDetails(details map[string]interface{}) *notifications.Alert {
+	str := func(key string) string {
+		if value, ok := details[key]; ok && value != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return ""
+	}
+
+	alert := &notifications.Alert{
+		Key:         alertKey(str("ruleNamespace"), str("ruleName")),
+		Name:        str("ruleName"),
+		Description: str("description"),
+		Severity:    str("severity"),
+		Value:       str("value"),
+		Threshold:   str("threshold"),
+		Timestamp:   str("timestamp"),
+		Component:   str("component"),
+		Project:     str("project"),
+		Environment: str("environment"),
+	}
+	alert.RCAEnabled, _ = details["enableAiRootCauseAnalysis"].(bool)
+	alert.Resolved = details["alertStatus"] == string(alerting.StatusResolved)
+	alert.ResolvedAt = str("resolvedAt")
+	if grouped, ok := details["groupedAlerts"].([]interface{}); ok {
+		for _, item := range grouped {
+			if related, ok := item.(map[string]interface{}); ok && related["ruleName"] != nil {
+				alert.Related = append(alert.Related, fmt.Sprintf("%v", related["ruleName"]))
+			}
+		}
+	}
+	if alertID := str("alertId"); alert.RCAEnabled && alertID != "" && s.config.Alerting.RCAReportURL != "" {
+		alert.RCALink = strings.TrimSuffix(s.config.Alerting.RCAReportURL, "/") + "/" + url.PathEscape(alertID)
+	}
+	return alert
+}
+
+// alertKey identifies the alert rule across the firing and resolved notifications of an alert
+func alertKey(ruleNamespace, ruleName string) string {
+	return fmt.Sprintf("openchoreo/%s/%s", ruleNamespace, ruleName)
+}
+
 // getNotificationChannelConfig fetches the notification channel configuration from Kubernetes
 // It reads the ConfigMap and Secret for the notification channel and resolves SMTP credentials
 func (s *LoggingService) getNotificationChannelConfig(ctx context.Context, channelName string) (*notifications.NotificationChannelConfig, error) {
@@ -1388,6 +1493,43 @@ func (s *LoggingService) getNotificationChannelConfig(ctx context.Context, chann
 			"headerCount", len(config.Webhook.Headers),
 			"hasPayloadTemplate", payloadTemplate != "")
 
+	case "slack":
+		webhookURL := secretValue(secret, "slack.webhookUrl")
+		if webhookURL == "" {
+			return nil, fmt.Errorf("slack webhook URL not found in Secret")
+		}
+		config.Slack = notifications.SlackConfig{
+			WebhookURL: webhookURL,
+			Channel:    configMap.Data["slack.channel"],
+			Username:   configMap.Data["slack.username"],
+		}
+
+	case "msteams":
+		webhookURL := secretValue(secret, "msteams.webhookUrl")
+		if webhookURL == "" {
+			return nil, fmt.Errorf("microsoft teams webhook URL not found in Secret")
+		}
+		config.MSTeams = notifications.MSTeamsConfig{
+			WebhookURL: webhookURL,
+		}
+
+	case "pagerduty":
+		routingKey := secretValue(secret, "pagerduty.routingKey")
+		if routingKey == "" {
+			return nil, fmt.Errorf("pagerduty routing key not found in Secret")
+		}
+		severityMapping := make(map[string]string)
+		for key, value := range configMap.Data {
+			if alertSeverity, ok := strings.CutPrefix(key, "pagerduty.severity."); ok {
+				severityMapping[alertSeverity] = value
+			}
+		}
+		config.PagerDuty = notifications.PagerDutyConfig{
+			RoutingKey:      routingKey,
+			SeverityMapping: severityMapping,
+			Source:          configMap.Data["pagerduty.source"],
+		}
+
 	default:
 		return nil, fmt.Errorf("unsupported notification channel type: %s", channelType)
 	}
@@ -1395,6 +1537,14 @@ func (s *LoggingService) getNotificationChannelConfig(ctx context.Context, chann
 	return config, nil
 }
 
+// secretValue returns the value of a key of the notification channel Secret, or "" when it is missing
+func secretValue(secret *corev1.Secret, key string) string {
+	if secret == nil || secret.Data == nil {
+		return ""
+	}
+	return string(secret.Data[key])
+}
+
 // parseRecipientsList parses a string representation of recipients list
 // The format is "[email1@example.com email2@example.com]" as stored by the controller
 func parseRecipientsList(s string) []string {
@@ -1431,6 +1581,8 @@ func (s *LoggingService) renderTemplate(templateStr string, data map[string]inte
 		"projectId":                       data["projectUid"],
 		"environmentId":                   data["environmentUid"],
 		"alertAIRootCauseAnalysisEnabled": data["enableAiRootCauseAnalysis"],
+		"alertStatus":                     alertStatus(data),
+		"groupedAlerts":                   groupedAlerts(data),
 	}
 
 	s.logger.Debug("CEL template rendering inputs", "alertData", celInputs)
@@ -1618,6 +1770,9 @@ func (s *LoggingService) ParseOpenSearchAlertPayload(requestBody map[string]inte
 	return ruleName, ruleNamespace, alertValue, timestamp, nil
 }
 
+// ErrAlertResolved is returned by ParsePrometheusAlertPayload, together with the rule, when the alert has cleared
+var ErrAlertResolved = errors.New("alert is resolved")
+
 // ParsePrometheusAlertPayload parses the Prometheus Alertmanager webhook payload
 // Returns: ruleName, ruleNamespace, alertValue, timestamp, error
 func (s *LoggingService) ParsePrometheusAlertPayload(requestBody map[string]interface{}) (string, string, string, string, error) {
@@ -1632,18 +1787,22 @@ func (s *LoggingService) ParsePrometheusAlertPayload(requestBody map[string]inte
 	if !ok {
 		return "", "", "", "", fmt.Errorf("invalid alert format in Prometheus payload")
 	}
-	// Ignore alert if not in "firing" state
-	status, _ := alert["status"].(string)
-	if status != "firing" {
-		return "", "", "", "", fmt.Errorf("alert is not in firing state")
-	}
-
 	// Extract from annotations (where we put rule_name, rule_namespace, alert_value)
 	annotations, _ := alert["annotations"].(map[string]interface{})
 	ruleName, _ := annotations["rule_name"].(string)
 	ruleNamespace, _ := annotations["rule_namespace"].(string)
 	alertValue, _ := annotations["alert_value"].(string)
 
+	// Resolved alerts are returned with the rule so that channels tracking incidents can close them,
+	// alerts in any other state are ignored
+	status, _ := alert["status"].(string)
+	if status == "resolved" && ruleName != "" && ruleNamespace != "" {
+		return ruleName, ruleNamespace, "", "", ErrAlertResolved
+	}
+	if status != "firing" {
+		return "", "", "", "", fmt.Errorf("alert is not in firing state")
+	}
+
 	// Extract timestamp from startsAt
 	timestamp, _ := alert["startsAt"].(string)
 
@@ -1673,6 +1832,7 @@ func (s *LoggingService) EnrichAlertDetails(alertRule *choreoapis.ObservabilityA
 		"component":                 alertRule.Labels["openchoreo.dev/component"],
 		"project":                   alertRule.Labels["openchoreo.dev/project"],
 		"environment":               alertRule.Labels["openchoreo.dev/environment"],
+		"ruleNamespace":             alertRule.Namespace,
 		"notificationChannel":       alertRule.Spec.NotificationChannel,
 		"enableAiRootCauseAnalysis": alertRule.Spec.EnableAiRootCauseAnalysis,
 	}, nil
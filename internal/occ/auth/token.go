@@ -10,11 +10,13 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
 )
 
-// IsTokenExpired checks if the JWT token is expired or will expire soon (within 1 minute)
+// IsTokenExpired checks if the JWT token is expired or will expire soon (within 1 minute).
+// API tokens are opaque, their expiry is only known to the server.
 func IsTokenExpired(token string) bool {
-	if token == "" {
+	if token == "" || apikey.IsAPIToken(token) {
 		return false
 	}
 
@@ -69,6 +71,10 @@ func RefreshToken() (string, error) {
 		return "", fmt.Errorf("failed to fetch OIDC config: %w", err)
 	}
 
+	if credential.AuthMethod == "api_token" {
+		return "", fmt.Errorf("API tokens cannot be refreshed, create a new token and login again")
+	}
+
 	// Check auth method and use appropriate refresh strategy
 	if credential.AuthMethod == "authorization_code" && credential.RefreshToken != "" {
 		// Use PKCE refresh token grant
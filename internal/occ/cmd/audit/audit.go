@@ -0,0 +1,150 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// Output formats of the audit command
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// AuditImpl implements AuditAPI
+type AuditImpl struct{}
+
+// NewAuditImpl creates a new AuditImpl
+func NewAuditImpl() *AuditImpl {
+	return &AuditImpl{}
+}
+
+// ListAuditEvents implements the audit command
+func (a *AuditImpl) ListAuditEvents(params api.ListAuditEventsParams) error {
+	if params.OutputFormat != "" && params.OutputFormat != outputJSON && params.OutputFormat != outputYAML {
+		return fmt.Errorf("unsupported output format %q, use json or yaml", params.OutputFormat)
+	}
+	if params.Limit < 0 {
+		return fmt.Errorf("limit must not be negative")
+	}
+
+	now := time.Now()
+	since, err := resolveTime(params.Since, now)
+	if err != nil {
+		return fmt.Errorf("invalid --since: %w", err)
+	}
+	until, err := resolveTime(params.Until, now)
+	if err != nil {
+		return fmt.Errorf("invalid --until: %w", err)
+	}
+
+	query := url.Values{}
+	for key, value := range map[string]string{
+		"actor":        params.Actor,
+		"action":       params.Action,
+		"resourceType": params.ResourceType,
+		"resourceName": params.ResourceName,
+		"result":       params.Result,
+		"since":        since,
+		"until":        until,
+		"continue":     params.Continue,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	events, err := apiClient.ListAuditEvents(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	switch params.OutputFormat {
+	case outputJSON:
+		data, err := json.MarshalIndent(events, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format audit events: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case outputYAML:
+		data, err := yaml.Marshal(events)
+		if err != nil {
+			return fmt.Errorf("failed to format audit events: %w", err)
+		}
+		fmt.Print(string(data))
+		return nil
+	}
+	return printEvents(os.Stdout, events)
+}
+
+// resolveTime accepts an RFC3339 time, or a duration that is counted back from now
+func resolveTime(value string, now time.Time) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither an RFC3339 time nor a duration", value)
+	}
+	return now.Add(-d).UTC().Format(time.RFC3339), nil
+}
+
+// printEvents writes the events as a table, followed by the continue token when more events match
+func printEvents(out io.Writer, events *client.AuditEventList) error {
+	if len(events.Items) == 0 {
+		fmt.Fprintln(out, "No audit events found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tACTOR\tACTION\tRESOURCE\tRESULT")
+	for _, event := range events.Items {
+		resource := "-"
+		if event.Resource != nil {
+			name := event.Resource.Name
+			if name == "" {
+				name = event.Resource.ID
+			}
+			resource = event.Resource.Type + "/" + name
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", event.Timestamp, event.Actor.ID, event.Action, resource, event.Result)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if events.Metadata.Continue != "" {
+		fmt.Fprintf(out, "\nMore events match, fetch the next page with --continue %s\n", events.Metadata.Continue)
+	}
+	return nil
+}
@@ -12,13 +12,10 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
-	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
 	occonfig "github.com/openchoreo/openchoreo/internal/occ/fsmode/config"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/generator"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
-	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
-	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
 )
 
 const releaseConfigFileName = "release-config.yaml"
@@ -33,73 +30,31 @@ func NewComponentReleaseImpl() *ComponentReleaseImpl {
 
 // GenerateComponentRelease implements the component-release generate command
 func (c *ComponentReleaseImpl) GenerateComponentRelease(params api.GenerateComponentReleaseParams) error {
-	// 1. Load context and validate file-system mode
-	cfg, err := config.LoadStoredConfig()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	if cfg.CurrentContext == "" {
-		return fmt.Errorf("no current context set")
-	}
-
-	// Find current context
-	var ctx *configContext.Context
-	for _, c := range cfg.Contexts {
-		if c.Name == cfg.CurrentContext {
-			ctxCopy := c
-			ctx = &ctxCopy
-			break
-		}
-	}
-
-	if ctx == nil {
-		return fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
-	}
-
-	if ctx.Mode != configContext.ModeFileSystem {
-		// TODO: support API server mode and update this properly
-		return fmt.Errorf("component-release generate only supports file-system mode currently; current mode is %q", ctx.Mode)
-	}
-
-	repoPath := ctx.RootDirectoryPath
-	if repoPath == "" {
-		repoPath, _ = os.Getwd()
-	}
-
-	// 2. Load or build index
+	// 1. Load the index of the repository of the current context
 	fmt.Println("Loading index...")
-	persistentIndex, err := cache.LoadOrBuild(repoPath)
+	repo, err := config.LoadRepository("component-release generate")
 	if err != nil {
-		return fmt.Errorf("failed to build index: %w", err)
+		return err
 	}
+	ocIndex, namespace, repoPath := repo.Index, repo.Namespace, repo.Path
 
-	// Wrap generic index with OpenChoreo-specific functionality
-	ocIndex := fsmode.WrapIndex(persistentIndex.Index)
-
-	// 3. Load release config (required for bulk operations, not for single component)
+	// 2. Load release config (required for bulk operations, not for single component)
 	requireReleaseConfig := params.All || (params.ProjectName != "" && params.ComponentName == "")
 	releaseConfig, err := c.loadReleaseConfig(repoPath, requireReleaseConfig)
 	if err != nil {
 		return err
 	}
 
-	// 4. Create generator
+	// 3. Create generator
 	gen := generator.NewReleaseGenerator(ocIndex)
 
-	// 5. Determine base directory and custom output path
+	// 4. Determine base directory and custom output path
 	// baseDir is where the writer will use for default path resolution
 	// customOutputPath is only set when user explicitly provides --output-path
 	baseDir := repoPath
 	customOutputPath := params.OutputPath
 
-	// 6. Get namespace from context (same as organization)
-	namespace := ctx.Organization
-	if namespace == "" {
-		return fmt.Errorf("organization is required in context")
-	}
-
-	// 7. Generate releases based on scope
+	// 5. Generate releases based on scope
 	if params.All {
 		return c.generateAll(gen, namespace, baseDir, customOutputPath, params.DryRun, releaseConfig)
 	}
@@ -0,0 +1,76 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
+)
+
+// Repository is the file-system mode repository of the current context
+type Repository struct {
+	// Index holds the resources of the repository
+	Index *fsmode.Index
+	// Namespace is the organization of the context
+	Namespace string
+	// Path is the root directory of the repository
+	Path string
+}
+
+// LoadRepository loads the index of the repository of the current context, which must be in
+// file-system mode. The command names the caller in the error about an unsupported mode.
+func LoadRepository(command string) (*Repository, error) {
+	cfg, err := LoadStoredConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if cfg.CurrentContext == "" {
+		return nil, fmt.Errorf("no current context set")
+	}
+
+	var ctx *configContext.Context
+	for _, c := range cfg.Contexts {
+		if c.Name == cfg.CurrentContext {
+			ctxCopy := c
+			ctx = &ctxCopy
+			break
+		}
+	}
+
+	if ctx == nil {
+		return nil, fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
+	}
+
+	if ctx.Mode != configContext.ModeFileSystem {
+		// TODO: support API server mode and update this properly
+		return nil, fmt.Errorf("%s only supports file-system mode currently; current mode is %q", command, ctx.Mode)
+	}
+
+	namespace := ctx.Organization
+	if namespace == "" {
+		return nil, fmt.Errorf("organization is required in context")
+	}
+
+	repoPath := ctx.RootDirectoryPath
+	if repoPath == "" {
+		repoPath, _ = os.Getwd()
+	}
+
+	persistentIndex, err := cache.LoadOrBuild(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build index: %w", err)
+	}
+
+	// Wrap generic index with OpenChoreo-specific functionality
+	return &Repository{
+		Index:     fsmode.WrapIndex(persistentIndex.Index),
+		Namespace: namespace,
+		Path:      repoPath,
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
 	"github.com/openchoreo/openchoreo/internal/occ/browser"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
@@ -23,6 +24,9 @@ func NewAuthImpl() *AuthImpl {
 }
 
 func (i *AuthImpl) Login(params api.LoginParams) error {
+	if params.Token != "" || os.Getenv("OCC_TOKEN") != "" {
+		return i.loginWithAPIToken(params)
+	}
 	if params.ClientCredentials {
 		return i.loginWithClientCredentials(params)
 	}
@@ -139,6 +143,82 @@ func (i *AuthImpl) loginWithClientCredentials(params api.LoginParams) error {
 	return nil
 }
 
+// loginWithAPIToken saves a personal access token or service account key as the credential. The token is
+// sent as is, there is nothing to exchange or refresh.
+func (i *AuthImpl) loginWithAPIToken(params api.LoginParams) error {
+	token := params.Token
+	if token == "" {
+		token = os.Getenv("OCC_TOKEN")
+	}
+	if !apikey.IsAPIToken(token) {
+		return fmt.Errorf("not an API token, expected a token created with 'occ token create'")
+	}
+
+	currentContext, err := config.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("failed to get current context: %w", err)
+	}
+	// Use existing credential name if none specified
+	credentialName := params.CredentialName
+	if credentialName == "" {
+		credentialName = currentContext.Credentials
+	}
+	if credentialName == "" {
+		return fmt.Errorf("credential name must be specified when no existing credential is associated with the current context")
+	}
+
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Update control plane URL if specified in params
+	if params.URL != "" {
+		controlPlane, err := config.GetCurrentControlPlane()
+		if err != nil {
+			return fmt.Errorf("failed to get control plane: %w", err)
+		}
+		fmt.Printf("Updating control plane URL to: %s\n", params.URL)
+		for idx := range cfg.ControlPlanes {
+			if cfg.ControlPlanes[idx].Name == controlPlane.Name {
+				cfg.ControlPlanes[idx].URL = params.URL
+				break
+			}
+		}
+	}
+
+	credentialExists := false
+	for idx := range cfg.Credentials {
+		if cfg.Credentials[idx].Name == credentialName {
+			cfg.Credentials[idx].Token = token
+			cfg.Credentials[idx].RefreshToken = ""
+			cfg.Credentials[idx].ClientID = ""
+			cfg.Credentials[idx].ClientSecret = ""
+			cfg.Credentials[idx].AuthMethod = "api_token"
+			credentialExists = true
+			break
+		}
+	}
+
+	if !credentialExists {
+		cfg.Credentials = append(cfg.Credentials, configContext.Credential{
+			Name:       credentialName,
+			Token:      token,
+			AuthMethod: "api_token",
+		})
+	}
+	currentContext.Credentials = credentialName
+
+	if err := config.SaveStoredConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("✓ API token saved\n")
+	fmt.Printf("Credential '%s' saved and associated with context '%s'\n", credentialName, cfg.CurrentContext)
+
+	return nil
+}
+
 func (i *AuthImpl) loginWithPKCE(params api.LoginParams) error {
 	currentContext, err := config.GetCurrentContext()
 	if err != nil {
@@ -308,5 +388,8 @@ func (i *AuthImpl) GetLoginPrompt() string {
    export OCC_CLIENT_SECRET=<client-secret>
    occ login --client-credentials
 
+   API token (personal access token or service account key):
+   occ login --token <token>
+
 For more information, run: occ login --help`
 }
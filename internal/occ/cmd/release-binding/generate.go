@@ -12,14 +12,11 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
-	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
 	occonfig "github.com/openchoreo/openchoreo/internal/occ/fsmode/config"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/generator"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/pipeline"
-	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
-	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
 )
 
 const releaseConfigFileName = "release-config.yaml"
@@ -34,56 +31,22 @@ func NewReleaseBindingImpl() *ReleaseBindingImpl {
 
 // GenerateReleaseBinding implements the release-binding generate command
 func (r *ReleaseBindingImpl) GenerateReleaseBinding(params api.GenerateReleaseBindingParams) error {
-	// 1. Load context and validate file-system mode
-	cfg, err := config.LoadStoredConfig()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	if cfg.CurrentContext == "" {
-		return fmt.Errorf("no current context set")
-	}
-
-	// Find current context
-	var ctx *configContext.Context
-	for _, c := range cfg.Contexts {
-		if c.Name == cfg.CurrentContext {
-			ctxCopy := c
-			ctx = &ctxCopy
-			break
-		}
-	}
-
-	if ctx == nil {
-		return fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
-	}
-
-	if ctx.Mode != configContext.ModeFileSystem {
-		return fmt.Errorf("release-binding generate only supports file-system mode currently; current mode is %q", ctx.Mode)
-	}
-
-	repoPath := ctx.RootDirectoryPath
-	if repoPath == "" {
-		repoPath, _ = os.Getwd()
-	}
-
-	// 2. Load or build index
+	// 1. Load the index of the repository of the current context
 	fmt.Println("Loading index...")
-	persistentIndex, err := cache.LoadOrBuild(repoPath)
+	repo, err := config.LoadRepository("release-binding generate")
 	if err != nil {
-		return fmt.Errorf("failed to build index: %w", err)
+		return err
 	}
+	ocIndex, namespace, repoPath := repo.Index, repo.Namespace, repo.Path
 
-	ocIndex := fsmode.WrapIndex(persistentIndex.Index)
-
-	// 3. Load release config (required for bulk operations)
+	// 2. Load release config (required for bulk operations)
 	requireConfig := params.All || (params.ProjectName != "" && params.ComponentName == "")
 	releaseConfig, err := r.loadReleaseConfig(repoPath, requireConfig)
 	if err != nil {
 		return err
 	}
 
-	// 4. Get and validate deployment pipeline
+	// 3. Get and validate deployment pipeline
 	pipelineEntry, ok := ocIndex.GetDeploymentPipeline(params.UsePipeline)
 	if !ok {
 		return fmt.Errorf("deployment pipeline %q not found", params.UsePipeline)
@@ -94,22 +57,16 @@ func (r *ReleaseBindingImpl) GenerateReleaseBinding(params api.GenerateReleaseBi
 		return fmt.Errorf("failed to parse deployment pipeline: %w", err)
 	}
 
-	// 5. Validate target environment exists in pipeline
+	// 4. Validate target environment exists in pipeline
 	if err := pipelineInfo.ValidateEnvironment(params.TargetEnv); err != nil {
 		return fmt.Errorf("invalid target environment: %w", err)
 	}
 
-	// 6. Get namespace from context
-	namespace := ctx.Organization
-	if namespace == "" {
-		return fmt.Errorf("organization is required in context")
-	}
-
-	// 7. Create generator
+	// 5. Create generator
 	gen := generator.NewBindingGenerator(ocIndex)
 	baseDir := repoPath
 
-	// 8. Generate bindings based on scope
+	// 6. Generate bindings based on scope
 	if params.All {
 		return r.generateAll(gen, namespace, params.TargetEnv, pipelineInfo, baseDir, params.OutputPath, params.DryRun, releaseConfig)
 	}
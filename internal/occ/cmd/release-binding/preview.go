@@ -0,0 +1,152 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// Output formats of the release-binding preview command
+const (
+	previewOutputJSON = "json"
+	previewOutputYAML = "yaml"
+)
+
+// PreviewReleaseBinding implements the release-binding preview command
+func (r *ReleaseBindingImpl) PreviewReleaseBinding(params api.PreviewReleaseBindingParams) error {
+	if params.Organization == "" {
+		return fmt.Errorf("organization is required (--organization or set via context)")
+	}
+	if params.ProjectName == "" {
+		return fmt.Errorf("project is required (--project or set via context)")
+	}
+	if params.ComponentName == "" {
+		return fmt.Errorf("component is required (--component or set via context)")
+	}
+	if params.OutputFormat != "" && params.OutputFormat != previewOutputJSON && params.OutputFormat != previewOutputYAML {
+		return fmt.Errorf("unsupported output format %q, use json or yaml", params.OutputFormat)
+	}
+
+	body, err := loadPreviewRequest(params.OverridesFile)
+	if err != nil {
+		return err
+	}
+	if params.ReleaseName != "" {
+		body["releaseName"] = params.ReleaseName
+	}
+	if params.Environment != "" {
+		body["environment"] = params.Environment
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	preview, err := apiClient.PreviewReleaseBinding(ctx, params.Organization, params.ProjectName,
+		params.ComponentName, params.BindingName, body)
+	if err != nil {
+		return err
+	}
+
+	switch params.OutputFormat {
+	case previewOutputJSON:
+		data, err := json.MarshalIndent(preview, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format preview: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case previewOutputYAML:
+		return printPreviewResources(os.Stdout, preview)
+	}
+	printPreviewDiff(os.Stdout, preview)
+	return nil
+}
+
+// loadPreviewRequest reads the proposed parameters and overrides from a YAML or JSON file
+func loadPreviewRequest(path string) (map[string]any, error) {
+	body := map[string]any{}
+	if path == "" {
+		return body, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overrides file: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse overrides file %s: %w", path, err)
+	}
+	if body == nil {
+		body = map[string]any{}
+	}
+	return body, nil
+}
+
+// printPreviewResources writes the rendered resources as a multi-document YAML stream
+func printPreviewResources(w io.Writer, preview *client.ReleaseBindingPreviewResponse) error {
+	for i, resource := range preview.Resources {
+		data, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return fmt.Errorf("failed to format resource %s: %w", resource.ID, err)
+		}
+		if i > 0 {
+			fmt.Fprintln(w, "---")
+		}
+		fmt.Fprintf(w, "# %s (%s)\n%s", resource.ID, resource.TargetPlane, data)
+	}
+	return nil
+}
+
+// printPreviewDiff writes a summary of the preview followed by the changed resources and fields
+func printPreviewDiff(w io.Writer, preview *client.ReleaseBindingPreviewResponse) {
+	summary := preview.Summary
+	fmt.Fprintf(w, "Release binding %s in environment %s with release %s: %d added, %d changed, %d removed, %d unchanged\n",
+		preview.BindingName, preview.Environment, preview.ReleaseName,
+		summary.Added, summary.Changed, summary.Removed, summary.Unchanged)
+
+	for _, resource := range preview.Diff {
+		marker := "~"
+		switch resource.Action {
+		case "added":
+			marker = "+"
+		case "removed":
+			marker = "-"
+		}
+		fmt.Fprintf(w, "\n%s %s %s (%s)\n", marker, resource.Kind, resource.Name, resource.TargetPlane)
+		for _, change := range resource.Changes {
+			switch {
+			case change.Current == nil:
+				fmt.Fprintf(w, "    + %s: %s\n", change.Path, formatPreviewValue(change.Proposed))
+			case change.Proposed == nil:
+				fmt.Fprintf(w, "    - %s: %s\n", change.Path, formatPreviewValue(change.Current))
+			default:
+				fmt.Fprintf(w, "    ~ %s: %s -> %s\n", change.Path,
+					formatPreviewValue(change.Current), formatPreviewValue(change.Proposed))
+			}
+		}
+	}
+}
+
+// formatPreviewValue renders a field value on a single line
+func formatPreviewValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
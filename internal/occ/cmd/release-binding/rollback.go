@@ -0,0 +1,44 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// RollbackReleaseBinding implements the release-binding rollback command
+func (r *ReleaseBindingImpl) RollbackReleaseBinding(params api.RollbackReleaseBindingParams) error {
+	if params.Organization == "" {
+		return fmt.Errorf("organization is required (--organization or set via context)")
+	}
+	if params.ProjectName == "" {
+		return fmt.Errorf("project is required (--project or set via context)")
+	}
+	if params.ComponentName == "" {
+		return fmt.Errorf("component is required (--component or set via context)")
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	binding, err := apiClient.RollbackReleaseBinding(ctx, params.Organization, params.ProjectName,
+		params.ComponentName, params.BindingName, params.ReleaseName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Release binding %s in environment %s rolled back to release %s\n",
+		binding.Name, binding.Environment, binding.ReleaseName)
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	fsrender "github.com/openchoreo/openchoreo/internal/occ/fsmode/render"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// Output formats of the render command
+const (
+	renderOutputYAML = "yaml"
+	renderOutputJSON = "json"
+)
+
+// RenderImpl implements RenderAPI
+type RenderImpl struct{}
+
+// NewRenderImpl creates a new RenderImpl
+func NewRenderImpl() *RenderImpl {
+	return &RenderImpl{}
+}
+
+// Render implements the render command
+func (r *RenderImpl) Render(params api.RenderParams) error {
+	if params.ComponentName == "" {
+		return fmt.Errorf("component is required (--component)")
+	}
+	if params.Environment == "" {
+		return fmt.Errorf("environment is required (--env)")
+	}
+	format := params.OutputFormat
+	if format == "" {
+		format = renderOutputYAML
+	}
+	if format != renderOutputYAML && format != renderOutputJSON {
+		return fmt.Errorf("unsupported output format %q, use yaml or json", params.OutputFormat)
+	}
+
+	// 1. Load the index of the repository of the current context
+	repo, err := config.LoadRepository("render")
+	if err != nil {
+		return err
+	}
+
+	// 2. Render the component
+	output, err := fsrender.Render(componentpipeline.NewPipeline(), repo.Index, fsrender.Options{
+		Namespace:     repo.Namespace,
+		ProjectName:   params.ProjectName,
+		ComponentName: params.ComponentName,
+		Environment:   params.Environment,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Warnings go to stderr, so that the manifests on stdout can be piped to other tools
+	for _, warning := range output.Metadata.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+	for _, violation := range output.Metadata.PolicyViolations {
+		if violation.Mode == v1alpha1.RenderPolicyModeAudit {
+			fmt.Fprintf(os.Stderr, "Audit: %s\n", violation)
+		}
+	}
+
+	// 3. Write the rendered resources
+	if params.OutputPath != "" {
+		paths, err := writeResourceFiles(params.OutputPath, format, output.Resources)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Printf("Wrote %s\n", path)
+		}
+		return nil
+	}
+	if format == renderOutputJSON {
+		return printResourceList(os.Stdout, output.Resources)
+	}
+	return printResources(os.Stdout, output.Resources)
+}
+
+// printResources writes the rendered resources as a multi-document YAML stream
+func printResources(w io.Writer, resources []renderer.RenderedResource) error {
+	for i, resource := range resources {
+		data, err := yaml.Marshal(resource.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to format resource %s: %w", resourceRef(resource), err)
+		}
+		if i > 0 {
+			fmt.Fprintln(w, "---")
+		}
+		fmt.Fprintf(w, "# %s (%s)\n%s", resourceRef(resource), resource.TargetPlane, data)
+	}
+	return nil
+}
+
+// printResourceList writes the rendered resources as a JSON v1 List, which kubectl and linters accept
+func printResourceList(w io.Writer, resources []renderer.RenderedResource) error {
+	items := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		items = append(items, resource.Resource)
+	}
+	data, err := json.MarshalIndent(map[string]any{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format resources: %w", err)
+	}
+	fmt.Fprintln(w, string(data))
+	return nil
+}
+
+// writeResourceFiles writes every rendered resource to its own file, in a directory per target plane.
+// It returns the paths of the written files.
+func writeResourceFiles(dir, format string, resources []renderer.RenderedResource) ([]string, error) {
+	paths := make([]string, 0, len(resources))
+	written := make(map[string]bool, len(resources))
+	for _, resource := range resources {
+		path := filepath.Join(dir, resource.TargetPlane, resourceFileName(resource, format))
+		if written[path] {
+			return nil, fmt.Errorf("more than one rendered resource would be written to %s", path)
+		}
+		written[path] = true
+
+		var data []byte
+		var err error
+		if format == renderOutputJSON {
+			data, err = json.MarshalIndent(resource.Resource, "", "  ")
+		} else {
+			data, err = yaml.Marshal(resource.Resource)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to format resource %s: %w", resourceRef(resource), err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// resourceFileName names the file of a rendered resource after its kind and name, e.g. deployment-greeter.yaml
+func resourceFileName(resource renderer.RenderedResource, format string) string {
+	kind, name := renderer.KindAndName(resource.Resource)
+	return fmt.Sprintf("%s-%s.%s", strings.ToLower(kind), name, format)
+}
+
+// resourceRef identifies a rendered resource by its kind and name
+func resourceRef(resource renderer.RenderedResource) string {
+	kind, name := renderer.KindAndName(resource.Resource)
+	return kind + "/" + name
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/rendertest"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// RunRenderTests implements the test command
+func (r *RenderImpl) RunRenderTests(params api.RunRenderTestsParams) error {
+	// 1. Load the index of the repository of the current context
+	repo, err := config.LoadRepository("test")
+	if err != nil {
+		return err
+	}
+
+	// 2. Find the test cases, in the whole repository unless paths are given
+	paths := params.Paths
+	if len(paths) == 0 {
+		paths = []string{repo.Path}
+	}
+	files, err := rendertest.Discover(paths...)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fmt.Printf("No test cases (*%s) found\n", rendertest.TestFileSuffix)
+		return nil
+	}
+
+	// 3. Run the test cases
+	runner := rendertest.NewRunner(repo.Index, repo.Namespace)
+	results := make([]*rendertest.Result, 0, len(files))
+	for _, file := range files {
+		tc, err := rendertest.LoadTestCase(file)
+		if err != nil {
+			results = append(results, &rendertest.Result{Name: file, Path: file, Err: err})
+			continue
+		}
+		results = append(results, runner.Run(tc, params.Update))
+	}
+
+	return printTestResults(os.Stdout, results)
+}
+
+// printTestResults reports every test case with its failures and returns an error if any failed
+func printTestResults(w io.Writer, results []*rendertest.Result) error {
+	passed, failed := 0, 0
+	for _, result := range results {
+		switch {
+		case !result.Passed():
+			failed++
+			fmt.Fprintf(w, "FAIL     %s (%s)\n", result.Name, result.Path)
+			if result.Err != nil {
+				fmt.Fprintf(w, "    %v\n", result.Err)
+			}
+			for _, failure := range result.Failures {
+				fmt.Fprintf(w, "    %s\n", failure)
+			}
+		case result.Updated:
+			passed++
+			fmt.Fprintf(w, "UPDATED  %s (%s)\n", result.Name, result.Path)
+		default:
+			passed++
+			fmt.Fprintf(w, "PASS     %s (%s)\n", result.Name, result.Path)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d passed, %d failed\n", passed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d render tests failed", failed, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// Output formats of the token commands
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// kindService is the kind of service account keys
+const kindService = "service"
+
+// TokenImpl implements TokenAPI
+type TokenImpl struct{}
+
+// NewTokenImpl creates a new TokenImpl
+func NewTokenImpl() *TokenImpl {
+	return &TokenImpl{}
+}
+
+// CreateToken implements the token create command
+func (t *TokenImpl) CreateToken(params api.CreateTokenParams) error {
+	if err := validateOutputFormat(params.OutputFormat); err != nil {
+		return err
+	}
+	if params.ExpiresIn != "" {
+		if _, err := time.ParseDuration(params.ExpiresIn); err != nil {
+			return fmt.Errorf("invalid --expires-in: %w", err)
+		}
+	}
+
+	req := client.CreateAPITokenRequest{
+		Name:         params.Name,
+		Description:  params.Description,
+		Entitlements: params.Entitlements,
+		Scopes:       params.Scopes,
+		ExpiresIn:    params.ExpiresIn,
+	}
+	if params.ServiceAccount != "" {
+		req.Kind = kindService
+		req.ServiceAccount = params.ServiceAccount
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	token, err := apiClient.CreateAPIToken(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if params.OutputFormat != "" {
+		return printFormatted(token, params.OutputFormat)
+	}
+	fmt.Printf("Token '%s' created with ID %s, expires at %s\n\n", token.Name, token.ID, token.ExpiresAt)
+	fmt.Println(token.Token)
+	fmt.Println("\nCopy the token now, it cannot be shown again.")
+	return nil
+}
+
+// ListTokens implements the token list command
+func (t *TokenImpl) ListTokens(params api.ListTokensParams) error {
+	if err := validateOutputFormat(params.OutputFormat); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	kind := ""
+	if params.Service {
+		kind = kindService
+	}
+	tokens, err := apiClient.ListAPITokens(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	if params.OutputFormat != "" {
+		return printFormatted(tokens, params.OutputFormat)
+	}
+	return printTokens(os.Stdout, tokens)
+}
+
+// RevokeToken implements the token revoke command
+func (t *TokenImpl) RevokeToken(params api.RevokeTokenParams) error {
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := apiClient.RevokeAPIToken(ctx, params.TokenID); err != nil {
+		return err
+	}
+	fmt.Printf("Token '%s' revoked\n", params.TokenID)
+	return nil
+}
+
+func validateOutputFormat(format string) error {
+	if format != "" && format != outputJSON && format != outputYAML {
+		return fmt.Errorf("unsupported output format %q, use json or yaml", format)
+	}
+	return nil
+}
+
+func printFormatted(v interface{}, format string) error {
+	if format == outputJSON {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format tokens: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to format tokens: %w", err)
+	}
+	fmt.Print(string(data))
+	return nil
+}
+
+// printTokens writes the tokens as a table
+func printTokens(out io.Writer, tokens []client.APIToken) error {
+	if len(tokens) == 0 {
+		fmt.Fprintln(out, "No tokens found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tKIND\tSUBJECT\tSTATUS\tEXPIRES\tLAST USED")
+	for _, token := range tokens {
+		lastUsed := token.LastUsedAt
+		if lastUsed == "" {
+			lastUsed = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			token.ID, token.Name, token.Kind, token.Subject, token.Status, token.ExpiresAt, lastUsed)
+	}
+	return w.Flush()
+}
@@ -9,8 +9,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
 	typed2 "github.com/openchoreo/openchoreo/internal/occ/fsmode/typed"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 )
 
 // ReleaseGenerator generates ComponentRelease resources
@@ -61,9 +63,13 @@ func (g *ReleaseGenerator) GenerateRelease(opts ReleaseOptions) (*unstructured.U
 		return nil, err
 	}
 
-	// 4. Fetch Traits referenced by Component
-	traitRefs := comp.GetTraitRefs()
-	traitsMap, profileTraits, err := g.buildTraitsData(traitRefs)
+	// 4. Fetch Traits referenced by Component and attached by the ComponentType.
+	// The traits attached by environments are merged in when the component is rendered for them.
+	traitInstances, err := componentpipeline.EffectiveTraits(comp.Component, ct.ComponentType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid traits of component %q: %w", opts.ComponentName, err)
+	}
+	traitsMap, profileTraits, err := g.buildTraitsData(traitInstances, comp.GetTraitRefs())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch traits: %w", err)
 	}
@@ -88,20 +94,21 @@ func (g *ReleaseGenerator) GenerateRelease(opts ReleaseOptions) (*unstructured.U
 	return release, nil
 }
 
-// buildTraitsData fetches traits and builds both the traits map and profile traits
-func (g *ReleaseGenerator) buildTraitsData(traitRefs []typed2.TraitRef) (
+// buildTraitsData fetches the traits of the trait instances and builds both the traits map and
+// the profile traits from the trait references of the component
+func (g *ReleaseGenerator) buildTraitsData(traitInstances []v1alpha1.ComponentTrait, traitRefs []typed2.TraitRef) (
 	map[string]interface{}, // traitsMap: traitName -> full TraitSpec
 	[]interface{}, // profileTraits: trait references for componentProfile
 	error,
 ) {
-	if len(traitRefs) == 0 {
+	if len(traitInstances) == 0 && len(traitRefs) == 0 {
 		return nil, nil, nil
 	}
 
 	// Collect unique trait names
 	traitNames := make(map[string]bool)
-	for _, ref := range traitRefs {
-		traitNames[ref.Name] = true
+	for _, instance := range traitInstances {
+		traitNames[instance.Name] = true
 	}
 
 	// Fetch trait resources
@@ -158,10 +165,23 @@ func (g *ReleaseGenerator) buildRelease(
 	}
 
 	// Add traits to componentProfile if present
+	componentProfile := spec["componentProfile"].(map[string]interface{})
 	if len(profileTraits) > 0 {
-		componentProfile := spec["componentProfile"].(map[string]interface{})
 		componentProfile["traits"] = profileTraits
 	}
+	if len(comp.Spec.DisabledTraits) > 0 {
+		disabledTraits := make([]interface{}, len(comp.Spec.DisabledTraits))
+		for i, instanceName := range comp.Spec.DisabledTraits {
+			disabledTraits[i] = instanceName
+		}
+		componentProfile["disabledTraits"] = disabledTraits
+	}
+
+	// Add the traits attached by the ComponentType if present
+	if ctTraits := ct.GetTraits(); len(ctTraits) > 0 {
+		componentType := spec["componentType"].(map[string]interface{})
+		componentType["traits"] = ctTraits
+	}
 
 	// Add traits map if present
 	if len(traitsMap) > 0 {
@@ -0,0 +1,102 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/testutils"
+)
+
+// platformTraits attach a required and a default trait to the worker ComponentType
+const platformTraits = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: worker
+  namespace: default
+spec:
+  workloadType: deployment
+  resources: []
+  traits:
+    - name: config
+      instanceName: platform
+      required: true
+      parameters:
+        key: platform
+    - name: sidecar
+      instanceName: proxy
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: sidecar
+  namespace: default
+spec:
+  creates: []
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: indexer
+  namespace: default
+spec:
+  owner:
+    projectName: demo
+  componentType: deployment/worker
+  disabledTraits: [proxy]
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Workload
+metadata:
+  name: indexer
+  namespace: default
+spec:
+  owner:
+    projectName: demo
+    componentName: indexer
+  containers:
+    app:
+      image: indexer:v1
+`
+
+func TestGenerateReleaseSnapshotsPlatformTraits(t *testing.T) {
+	idx := testutils.NewIndex(t, testutils.PlatformResources, platformTraits)
+
+	release, err := NewReleaseGenerator(idx).GenerateRelease(ReleaseOptions{
+		ComponentName: "indexer",
+		Namespace:     "default",
+		ReleaseName:   "indexer-1",
+	})
+	if err != nil {
+		t.Fatalf("GenerateRelease() error = %v", err)
+	}
+
+	ctTraits, _, _ := unstructured.NestedSlice(release.Object, "spec", "componentType", "traits")
+	if len(ctTraits) != 2 {
+		t.Fatalf("componentType.traits = %v, want the 2 traits of the ComponentType", ctTraits)
+	}
+	if required, _, _ := unstructured.NestedBool(ctTraits[0].(map[string]any), "required"); !required {
+		t.Errorf("componentType.traits[0] = %v, want a required trait", ctTraits[0])
+	}
+
+	// The definition of the required trait is snapshotted, the disabled default trait is not applied
+	traits, _, _ := unstructured.NestedMap(release.Object, "spec", "traits")
+	if _, ok := traits["config"]; !ok {
+		t.Errorf("traits = %v, want the config trait", traits)
+	}
+	if _, ok := traits["sidecar"]; ok {
+		t.Errorf("traits = %v, want no sidecar trait", traits)
+	}
+
+	disabled, _, _ := unstructured.NestedStringSlice(release.Object, "spec", "componentProfile", "disabledTraits")
+	if len(disabled) != 1 || disabled[0] != "proxy" {
+		t.Errorf("componentProfile.disabledTraits = %v, want [proxy]", disabled)
+	}
+
+	// DeepCopy panics on values that are not JSON compatible
+	_ = release.DeepCopy()
+}
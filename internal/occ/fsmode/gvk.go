@@ -7,14 +7,21 @@ import "k8s.io/apimachinery/pkg/runtime/schema"
 
 // OpenChoreo resource GroupVersionKinds
 var (
-	ComponentGVK          = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Component"}
-	ComponentTypeGVK      = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ComponentType"}
-	WorkloadGVK           = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Workload"}
-	TraitGVK              = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Trait"}
-	ComponentReleaseGVK   = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ComponentRelease"}
-	ReleaseBindingGVK     = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ReleaseBinding"}
-	DeploymentPipelineGVK = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "DeploymentPipeline"}
-	ProjectGVK            = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Project"}
-	EnvironmentGVK        = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Environment"}
-	DataPlaneGVK          = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "DataPlane"}
+	ComponentGVK           = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Component"}
+	ComponentTypeGVK       = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ComponentType"}
+	WorkloadGVK            = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Workload"}
+	TraitGVK               = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Trait"}
+	ComponentReleaseGVK    = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ComponentRelease"}
+	ReleaseBindingGVK      = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ReleaseBinding"}
+	DeploymentPipelineGVK  = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "DeploymentPipeline"}
+	ProjectGVK             = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Project"}
+	EnvironmentGVK         = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Environment"}
+	DataPlaneGVK           = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "DataPlane"}
+	SecretReferenceGVK     = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "SecretReference"}
+	RenderPolicyGVK        = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "RenderPolicy"}
+	ClusterRenderPolicyGVK = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ClusterRenderPolicy"}
+
+	ObservabilityAlertsNotificationChannelGVK = schema.GroupVersionKind{
+		Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ObservabilityAlertsNotificationChannel",
+	}
 )
@@ -123,7 +123,7 @@ func ParsePipeline(pipeline *unstructured.Unstructured) (*PipelineInfo, error) {
 }
 
 // FindRootEnvironment finds the root environment (never appears as a target)
-// This mirrors the logic in internal/controller/component/controller.go:findRootEnvironment
+// This mirrors the logic in internal/controller/component/controller.go:FindRootEnvironment
 func FindRootEnvironment(pipeline *unstructured.Unstructured) (string, error) {
 	if pipeline == nil {
 		return "", fmt.Errorf("pipeline is nil")
@@ -0,0 +1,326 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package render renders the resources of a component in an environment from the resources of a
+// file-system mode repository, without a cluster.
+package render
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/typed"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// Options selects the component and environment to render
+type Options struct {
+	Namespace     string
+	ProjectName   string
+	ComponentName string
+	Environment   string
+}
+
+// Render renders the resources of a component in an environment with the given pipeline. The steps a
+// reconcile of the ReleaseBinding takes around the pipeline render apply too, such as the injected connection
+// variables and the NetworkPolicy, so that the output is what will ship.
+func Render(pipeline *componentpipeline.Pipeline, idx *fsmode.Index, opts Options) (*componentpipeline.RenderOutput, error) {
+	input, err := BuildInput(idx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to build scheme: %w", err)
+	}
+	repoClient, err := repositoryClient(idx, scheme)
+	if err != nil {
+		return nil, err
+	}
+	project := &v1alpha1.Project{}
+	if entry, ok := idx.Index.Get(fsmode.ProjectGVK, opts.Namespace, input.Component.Spec.Owner.ProjectName); ok {
+		if project, err = typed.FromEntry[v1alpha1.Project](entry); err != nil {
+			return nil, fmt.Errorf("failed to convert project %q: %w", entry.Name(), err)
+		}
+	}
+
+	reconciler := &releasebinding.Reconciler{Client: repoClient, Scheme: scheme, Pipeline: pipeline}
+	output, err := reconciler.Render(context.Background(), input, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render component %q in environment %q: %w", opts.ComponentName, opts.Environment, err)
+	}
+	return output, nil
+}
+
+// repositoryClient returns a client that serves the resources of the repository that a render of a
+// ReleaseBinding looks up: the bindings and releases of the connected components and the notification
+// channels. Components get the UIDs their rendered selectors are computed from.
+func repositoryClient(idx *fsmode.Index, scheme *runtime.Scheme) (client.Client, error) {
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, gvk := range []schema.GroupVersionKind{
+		fsmode.ReleaseBindingGVK,
+		fsmode.ComponentReleaseGVK,
+		fsmode.ComponentGVK,
+		fsmode.ObservabilityAlertsNotificationChannelGVK,
+	} {
+		for _, entry := range idx.Index.List(gvk) {
+			obj, err := scheme.New(gvk)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create %s: %w", gvk.Kind, err)
+			}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(entry.Resource.Object, obj); err != nil {
+				return nil, fmt.Errorf("failed to convert %s %q: %w", gvk.Kind, entry.Name(), err)
+			}
+			resource, ok := obj.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a client object", gvk.Kind)
+			}
+			if gvk == fsmode.ComponentGVK {
+				resource.SetUID(types.UID(resourceUID(gvk, resource.GetNamespace(), resource.GetName(), resource.GetUID())))
+			}
+			builder = builder.WithObjects(resource)
+		}
+	}
+	return builder.Build(), nil
+}
+
+// BuildInput assembles the render input of a component in an environment from the indexed resources.
+// The ReleaseBinding of the environment is optional, and its overrides apply when the repository has one.
+// Resources are rendered from the current Component, Workload, ComponentType and Traits rather than a
+// ComponentRelease, so that changes show up before a release is generated.
+func BuildInput(idx *fsmode.Index, opts Options) (*componentpipeline.RenderInput, error) {
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if opts.ComponentName == "" {
+		return nil, fmt.Errorf("component is required")
+	}
+	if opts.Environment == "" {
+		return nil, fmt.Errorf("environment is required")
+	}
+
+	comp, err := idx.GetTypedComponent(opts.Namespace, opts.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ProjectName != "" && comp.ProjectName() != opts.ProjectName {
+		return nil, fmt.Errorf("component %q belongs to project %q, not %q",
+			opts.ComponentName, comp.ProjectName(), opts.ProjectName)
+	}
+
+	wl, err := idx.GetTypedWorkloadForComponent(comp.ProjectName(), comp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var binding *v1alpha1.ReleaseBinding
+	if entry, ok := idx.GetReleaseBindingForEnv(comp.ProjectName(), comp.Name, opts.Environment); ok {
+		binding, err = typed.FromEntry[v1alpha1.ReleaseBinding](entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert release binding %q: %w", entry.Name(), err)
+		}
+	}
+
+	return BuildInputFor(idx, opts.Namespace, comp.Component, wl.Workload, opts.Environment, binding)
+}
+
+// BuildInputFor assembles the render input of the given component, workload and optional ReleaseBinding
+// in an environment. The ComponentType, Traits, Environment, DataPlane and SecretReferences are loaded
+// from the index.
+func BuildInputFor(idx *fsmode.Index, namespace string, comp *v1alpha1.Component, workload *v1alpha1.Workload,
+	environmentName string, binding *v1alpha1.ReleaseBinding) (*componentpipeline.RenderInput, error) {
+	typeName := (&typed.Component{Component: comp}).ComponentTypeName()
+	ct, err := idx.GetTypedComponentType(typeName)
+	if err != nil {
+		return nil, fmt.Errorf("component type %q not found (referenced by component %q): %w",
+			typeName, comp.Name, err)
+	}
+
+	environment, err := getResource[v1alpha1.Environment](idx, fsmode.EnvironmentGVK, namespace, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The traits attached by the ComponentType and the Environment need their definitions too
+	instances, err := componentpipeline.EffectiveTraits(comp, ct.ComponentType, environment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid traits of component %q: %w", comp.Name, err)
+	}
+	traits, err := loadTraits(idx, comp.Name, instances)
+	if err != nil {
+		return nil, err
+	}
+	if environment.Spec.DataPlaneRef == "" {
+		return nil, fmt.Errorf("environment %q has no dataPlaneRef configured", environment.Name)
+	}
+	dataPlane, err := getResource[v1alpha1.DataPlane](idx, fsmode.DataPlaneGVK, namespace, environment.Spec.DataPlaneRef)
+	if err != nil {
+		return nil, err
+	}
+
+	secretReferences, err := collectSecretReferences(idx, workload, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	renderPolicies, err := listResources[v1alpha1.RenderPolicy](idx, fsmode.RenderPolicyGVK, namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterRenderPolicies, err := listResources[v1alpha1.ClusterRenderPolicy](idx, fsmode.ClusterRenderPolicyGVK, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &componentpipeline.RenderInput{
+		ComponentType:         ct.ComponentType,
+		Component:             comp,
+		Traits:                traits,
+		Workload:              workload,
+		Environment:           environment,
+		ReleaseBinding:        binding,
+		DataPlane:             dataPlane,
+		SecretReferences:      secretReferences,
+		Metadata:              buildMetadataContext(namespace, comp, dataPlane, environment),
+		RenderPolicies:        renderPolicies,
+		ClusterRenderPolicies: clusterRenderPolicies,
+	}, nil
+}
+
+// loadTraits returns the definitions of the traits of the given instances, once per trait
+func loadTraits(idx *fsmode.Index, componentName string, instances []v1alpha1.ComponentTrait) ([]v1alpha1.Trait, error) {
+	var traits []v1alpha1.Trait
+	seen := make(map[string]bool)
+	for _, instance := range instances {
+		if seen[instance.Name] {
+			continue
+		}
+		seen[instance.Name] = true
+
+		t, err := idx.GetTypedTrait(instance.Name)
+		if err != nil {
+			return nil, fmt.Errorf("trait %q not found (referenced by component %q): %w", instance.Name, componentName, err)
+		}
+		traits = append(traits, *t.Trait)
+	}
+	return traits, nil
+}
+
+// collectSecretReferences returns the SecretReferences the workload and the binding overrides refer to
+func collectSecretReferences(idx *fsmode.Index, workload *v1alpha1.Workload,
+	binding *v1alpha1.ReleaseBinding) (map[string]*v1alpha1.SecretReference, error) {
+	secretRefs := make(map[string]*v1alpha1.SecretReference)
+	collect := func(namespace string, env []v1alpha1.EnvVar, files []v1alpha1.FileVar) error {
+		var names []string
+		for _, e := range env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretRef != nil {
+				names = append(names, e.ValueFrom.SecretRef.Name)
+			}
+		}
+		for _, f := range files {
+			if f.ValueFrom != nil && f.ValueFrom.SecretRef != nil {
+				names = append(names, f.ValueFrom.SecretRef.Name)
+			}
+		}
+		for _, name := range names {
+			if name == "" || secretRefs[name] != nil {
+				continue
+			}
+			secretRef, err := getResource[v1alpha1.SecretReference](idx, fsmode.SecretReferenceGVK, namespace, name)
+			if err != nil {
+				return err
+			}
+			secretRefs[name] = secretRef
+		}
+		return nil
+	}
+
+	for _, container := range workload.Spec.Containers {
+		if err := collect(workload.Namespace, container.Env, container.Files); err != nil {
+			return nil, err
+		}
+	}
+	if binding != nil && binding.Spec.WorkloadOverrides != nil {
+		for _, container := range binding.Spec.WorkloadOverrides.Containers {
+			if err := collect(binding.Namespace, container.Env, container.Files); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return secretRefs, nil
+}
+
+// buildMetadataContext computes the names, labels and selectors of the rendered resources, with the UIDs
+// derived for the resources that have none
+func buildMetadataContext(namespace string, comp *v1alpha1.Component, dataPlane *v1alpha1.DataPlane,
+	environment *v1alpha1.Environment) pipelinecontext.MetadataContext {
+	projectName := comp.Spec.Owner.ProjectName
+	return pipelinecontext.BuildMetadataContext(&pipelinecontext.MetadataContextInput{
+		Namespace:       namespace,
+		ComponentName:   comp.Name,
+		ComponentUID:    resourceUID(fsmode.ComponentGVK, namespace, comp.Name, comp.UID),
+		ProjectName:     projectName,
+		ProjectUID:      resourceUID(fsmode.ProjectGVK, namespace, projectName, ""),
+		EnvironmentName: environment.Name,
+		EnvironmentUID:  resourceUID(fsmode.EnvironmentGVK, namespace, environment.Name, environment.UID),
+		DataPlaneName:   dataPlane.Name,
+		DataPlaneUID:    resourceUID(fsmode.DataPlaneGVK, namespace, dataPlane.Name, dataPlane.UID),
+	})
+}
+
+// resourceUID returns the UID of a resource. Resources in a repository have none, so a UID derived from
+// the kind and name stands in for it, which keeps the rendered selectors the same across runs.
+func resourceUID(gvk schema.GroupVersionKind, namespace, name string, uid types.UID) string {
+	if uid != "" {
+		return string(uid)
+	}
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("openchoreo.dev/%s/%s/%s", gvk.Kind, namespace, name))).String()
+}
+
+// getResource looks up a namespaced resource in the index and converts it to its typed form
+func getResource[T any](idx *fsmode.Index, gvk schema.GroupVersionKind, namespace, name string) (*T, error) {
+	entry, ok := idx.Index.Get(gvk, namespace, name)
+	if !ok {
+		return nil, fmt.Errorf("%s %q not found in namespace %q", gvk.Kind, name, namespace)
+	}
+	obj, err := typed.FromEntry[T](entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s %q: %w", gvk.Kind, name, err)
+	}
+	return obj, nil
+}
+
+// listResources returns the resources of a kind in a namespace, or all of them if the namespace is empty,
+// in their typed form and ordered by name
+func listResources[T any](idx *fsmode.Index, gvk schema.GroupVersionKind, namespace string) ([]T, error) {
+	entries := idx.Index.List(gvk)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	var resources []T
+	for _, entry := range entries {
+		if namespace != "" && entry.Namespace() != namespace {
+			continue
+		}
+		obj, err := typed.FromEntry[T](entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s %q: %w", gvk.Kind, entry.Name(), err)
+		}
+		resources = append(resources, *obj)
+	}
+	return resources, nil
+}
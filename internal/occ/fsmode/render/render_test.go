@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/testutils"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+func TestRender(t *testing.T) {
+	idx := testutils.NewIndex(t, testutils.PlatformResources, testutils.ComponentResources)
+
+	tests := []struct {
+		name        string
+		environment string
+		wantArgs    string
+	}{
+		{name: "binding overrides apply", environment: "production", wantArgs: "--log-level=warn"},
+		{name: "defaults without a binding", environment: "staging", wantArgs: "--log-level=info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := Render(componentpipeline.NewPipeline(), idx, Options{
+				Namespace:     "default",
+				ProjectName:   "demo",
+				ComponentName: "greeter",
+				Environment:   tt.environment,
+			})
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			rendered := map[string]*unstructured.Unstructured{}
+			for _, resource := range output.Resources {
+				obj := &unstructured.Unstructured{Object: resource.Resource}
+				rendered[obj.GetKind()] = obj
+			}
+			deployment, configMap := rendered["Deployment"], rendered["ConfigMap"]
+			if len(output.Resources) != 2 || deployment == nil || configMap == nil {
+				t.Fatalf("rendered %v, want a Deployment and a ConfigMap", rendered)
+			}
+			if !strings.HasPrefix(deployment.GetName(), "greeter-"+tt.environment+"-") {
+				t.Errorf("deployment name = %q, want it to start with greeter-%s-", deployment.GetName(), tt.environment)
+			}
+			containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+			container, _ := containers[0].(map[string]any)
+			args, _ := container["args"].([]any)
+			if container["image"] != "greeter:v1" || len(args) != 1 || args[0] != tt.wantArgs {
+				t.Errorf("container = %v, want image greeter:v1 with args [%s]", container, tt.wantArgs)
+			}
+			if replicas, _, _ := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas"); fmt.Sprint(replicas) != "2" {
+				t.Errorf("replicas = %v, want 2", replicas)
+			}
+			if value, _, _ := unstructured.NestedString(configMap.Object, "data", "key"); value != "value" {
+				t.Errorf("config map data key = %q, want value", value)
+			}
+		})
+	}
+}
+
+func TestRenderIsolatedEnvironment(t *testing.T) {
+	idx := testutils.NewIndex(t, testutils.PlatformResources, testutils.ComponentResources, `
+apiVersion: openchoreo.dev/v1alpha1
+kind: Environment
+metadata:
+  name: isolated
+  namespace: default
+spec:
+  dataPlaneRef: default
+  networkIsolation:
+    enabled: true
+`)
+
+	output, err := Render(componentpipeline.NewPipeline(), idx, Options{
+		Namespace:     "default",
+		ComponentName: "greeter",
+		Environment:   "isolated",
+	})
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	var kinds []string
+	for _, resource := range output.Resources {
+		kinds = append(kinds, (&unstructured.Unstructured{Object: resource.Resource}).GetKind())
+	}
+	if len(kinds) != 3 || kinds[2] != "NetworkPolicy" {
+		t.Errorf("rendered kinds = %v, want the component's resources followed by a NetworkPolicy", kinds)
+	}
+}
+
+func TestBuildInputErrors(t *testing.T) {
+	idx := testutils.NewIndex(t, testutils.PlatformResources, testutils.ComponentResources)
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr string
+	}{
+		{
+			name:    "unknown component",
+			opts:    Options{Namespace: "default", ComponentName: "missing", Environment: "production"},
+			wantErr: `component "missing" not found`,
+		},
+		{
+			name:    "component of another project",
+			opts:    Options{Namespace: "default", ProjectName: "other", ComponentName: "greeter", Environment: "production"},
+			wantErr: `belongs to project "demo"`,
+		},
+		{
+			name:    "unknown environment",
+			opts:    Options{Namespace: "default", ComponentName: "greeter", Environment: "qa"},
+			wantErr: `Environment "qa" not found`,
+		},
+		{
+			name:    "missing environment",
+			opts:    Options{Namespace: "default", ComponentName: "greeter"},
+			wantErr: "environment is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildInput(idx, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("BuildInput() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
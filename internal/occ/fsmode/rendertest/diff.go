@@ -0,0 +1,189 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rendertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// Compare compares the expected resources with the rendered ones, matched by apiVersion, kind, namespace
+// and name. It returns a line per missing or unexpected resource and per differing field, e.g.
+// "Deployment/greeter: spec.replicas is 3, expected 2". Both sides must be in their JSON form.
+func Compare(expected, actual []map[string]any) []string {
+	expectedByKey := keyResources(expected)
+	actualByKey := keyResources(actual)
+
+	keys := make([]string, 0, len(expectedByKey)+len(actualByKey))
+	for key := range expectedByKey {
+		keys = append(keys, key)
+	}
+	for key := range actualByKey {
+		if _, found := expectedByKey[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var failures []string
+	for _, key := range keys {
+		expectedObj, isExpected := expectedByKey[key]
+		actualObj, isRendered := actualByKey[key]
+		kind, name := renderer.KindAndName(expectedObj)
+		if !isExpected {
+			kind, name = renderer.KindAndName(actualObj)
+		}
+		ref := kind + "/" + name
+
+		switch {
+		case !isRendered:
+			failures = append(failures, fmt.Sprintf("%s: expected but not rendered", ref))
+		case !isExpected:
+			failures = append(failures, fmt.Sprintf("%s: rendered but not expected", ref))
+		default:
+			var diffs []string
+			diffValues(expectedObj, actualObj, "", &diffs)
+			for _, diff := range diffs {
+				failures = append(failures, ref+": "+diff)
+			}
+		}
+	}
+	return failures
+}
+
+// keyResources keys resources by their apiVersion, kind, namespace and name
+func keyResources(resources []map[string]any) map[string]map[string]any {
+	byKey := make(map[string]map[string]any, len(resources))
+	for _, resource := range resources {
+		apiVersion, _ := resource["apiVersion"].(string)
+		kind, name := renderer.KindAndName(resource)
+		namespace := ""
+		if metadata, ok := resource["metadata"].(map[string]any); ok {
+			namespace, _ = metadata["namespace"].(string)
+		}
+		byKey[fmt.Sprintf("%s/%s/%s/%s", apiVersion, kind, namespace, name)] = resource
+	}
+	return byKey
+}
+
+// diffValues records the differing fields between the expected and the rendered value. Lists whose
+// entries all have a name are compared entry by entry by name, other lists by index.
+func diffValues(expected, actual any, path string, diffs *[]string) {
+	expectedMap, expectedIsMap := expected.(map[string]any)
+	actualMap, actualIsMap := actual.(map[string]any)
+	if expectedIsMap && actualIsMap {
+		keys := make([]string, 0, len(expectedMap)+len(actualMap))
+		for key := range expectedMap {
+			keys = append(keys, key)
+		}
+		for key := range actualMap {
+			if _, found := expectedMap[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffChild(expectedMap, actualMap, key, joinFieldPath(path, key), diffs)
+		}
+		return
+	}
+
+	expectedList, expectedIsList := expected.([]any)
+	actualList, actualIsList := actual.([]any)
+	if expectedIsList && actualIsList {
+		expectedByName, expectedNamed := listEntriesByName(expectedList)
+		actualByName, actualNamed := listEntriesByName(actualList)
+		if expectedNamed && actualNamed {
+			names := make([]string, 0, len(expectedByName)+len(actualByName))
+			for _, item := range expectedList {
+				names = append(names, item.(map[string]any)["name"].(string))
+			}
+			for _, item := range actualList {
+				name := item.(map[string]any)["name"].(string)
+				if _, found := expectedByName[name]; !found {
+					names = append(names, name)
+				}
+			}
+			for _, name := range names {
+				diffChild(expectedByName, actualByName, name, fmt.Sprintf("%s[%s]", path, name), diffs)
+			}
+			return
+		}
+
+		for i := 0; i < len(expectedList) || i < len(actualList); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(expectedList):
+				*diffs = append(*diffs, fmt.Sprintf("%s is %s, expected it to be unset", childPath, formatValue(actualList[i])))
+			case i >= len(actualList):
+				*diffs = append(*diffs, fmt.Sprintf("%s is not set, expected %s", childPath, formatValue(expectedList[i])))
+			default:
+				diffValues(expectedList[i], actualList[i], childPath, diffs)
+			}
+		}
+		return
+	}
+
+	if !valuesEqual(expected, actual) {
+		*diffs = append(*diffs, fmt.Sprintf("%s is %s, expected %s", path, formatValue(actual), formatValue(expected)))
+	}
+}
+
+// diffChild compares the entries of two maps under the same key
+func diffChild(expected, actual map[string]any, key, path string, diffs *[]string) {
+	expectedValue, inExpected := expected[key]
+	actualValue, inActual := actual[key]
+	switch {
+	case !inActual:
+		*diffs = append(*diffs, fmt.Sprintf("%s is not set, expected %s", path, formatValue(expectedValue)))
+	case !inExpected:
+		*diffs = append(*diffs, fmt.Sprintf("%s is %s, expected it to be unset", path, formatValue(actualValue)))
+	default:
+		diffValues(expectedValue, actualValue, path, diffs)
+	}
+}
+
+// listEntriesByName keys the entries of a list by name, if every entry is an object with a unique name
+func listEntriesByName(list []any) (map[string]any, bool) {
+	byName := make(map[string]any, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		if _, duplicate := byName[name]; duplicate {
+			return nil, false
+		}
+		byName[name] = obj
+	}
+	return byName, len(list) > 0
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func valuesEqual(expected, actual any) bool {
+	return reflect.DeepEqual(expected, actual)
+}
+
+// formatValue renders a value on a single line
+func formatValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rendertest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/testutils"
+)
+
+const testCaseFile = `
+name: web-service in production
+environment: production
+component:
+  metadata:
+    name: greeter
+  spec:
+    owner:
+      projectName: demo
+    componentType: deployment/web-service
+    parameters:
+      replicas: 2
+workload:
+  spec:
+    containers:
+      app:
+        image: greeter:v1
+releaseBinding:
+  componentTypeEnvOverrides:
+    logLevel: warn
+golden: greeter.golden.yaml
+assertions:
+  - kind: Deployment
+    path: spec.template.spec.containers.0.args.0
+    equals: --log-level=warn
+expectations:
+  - resources.all(r, r.kind != 'Deployment' || r.spec.replicas >= 2)
+`
+
+func newTestRunner(t *testing.T) *Runner {
+	t.Helper()
+	return NewRunner(testutils.NewIndex(t, testutils.PlatformResources), "default")
+}
+
+// writeTestCase writes a test case file to a new directory and loads it
+func writeTestCase(t *testing.T, content string) *TestCase {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "greeter"+TestFileSuffix)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write test case: %v", err)
+	}
+	tc, err := LoadTestCase(path)
+	if err != nil {
+		t.Fatalf("LoadTestCase() error: %v", err)
+	}
+	return tc
+}
+
+func TestRunUpdatesAndComparesGoldenFile(t *testing.T) {
+	runner := newTestRunner(t)
+	tc := writeTestCase(t, testCaseFile)
+
+	result := runner.Run(tc, false)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "--update") {
+		t.Fatalf("expected a missing golden file error, got %v", result.Err)
+	}
+
+	result = runner.Run(tc, true)
+	if !result.Passed() || !result.Updated {
+		t.Fatalf("expected the golden file to be written, got %+v", result)
+	}
+	result = runner.Run(tc, false)
+	if !result.Passed() {
+		t.Fatalf("expected the test to pass against its golden file, got %+v", result)
+	}
+
+	// A changed golden file is reported field by field
+	golden, err := os.ReadFile(tc.GoldenPath())
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	changed := strings.Replace(string(golden), "replicas: 2", "replicas: 3", 1)
+	if err := os.WriteFile(tc.GoldenPath(), []byte(changed), 0600); err != nil {
+		t.Fatalf("failed to write golden file: %v", err)
+	}
+	result = runner.Run(tc, false)
+	if len(result.Failures) != 1 || !strings.HasSuffix(result.Failures[0], "spec.replicas is 2, expected 3") {
+		t.Fatalf("expected a replicas diff, got %+v", result)
+	}
+}
+
+func TestRunReportsFailedChecks(t *testing.T) {
+	runner := newTestRunner(t)
+	content := strings.NewReplacer(
+		"golden: greeter.golden.yaml\n", "",
+		"equals: --log-level=warn", "equals: --log-level=debug",
+		"r.spec.replicas >= 2", "r.spec.replicas >= 3",
+		"expectations:", "  - kind: Service\n    path: spec.type\n    equals: ClusterIP\nexpectations:",
+	).Replace(testCaseFile)
+	tc := writeTestCase(t, content)
+
+	result := runner.Run(tc, false)
+	if result.Err != nil {
+		t.Fatalf("Run() error: %v", result.Err)
+	}
+	want := []string{
+		`spec.template.spec.containers.0.args.0 is "--log-level=warn", expected "--log-level=debug"`,
+		"assertion on spec.type: no rendered Service",
+		"expectation \"resources.all(r, r.kind != 'Deployment' || r.spec.replicas >= 3)\" is false",
+	}
+	if len(result.Failures) != len(want) {
+		t.Fatalf("failures = %q, want %d", result.Failures, len(want))
+	}
+	for i, w := range want {
+		if !strings.Contains(result.Failures[i], w) {
+			t.Errorf("failure %d = %q, want it to contain %q", i, result.Failures[i], w)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	deployment := func(replicas float64, containers ...any) map[string]any {
+		return map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "app"},
+			"spec":       map[string]any{"replicas": replicas, "containers": containers},
+		}
+	}
+	configMap := map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "config"}}
+
+	expected := []map[string]any{
+		deployment(2, map[string]any{"name": "app", "image": "app:v1"}, map[string]any{"name": "proxy", "image": "proxy:v1"}),
+		configMap,
+	}
+	actual := []map[string]any{
+		deployment(3, map[string]any{"name": "proxy", "image": "proxy:v1"}, map[string]any{"name": "app", "image": "app:v2"}),
+	}
+
+	got := Compare(expected, actual)
+	want := []string{
+		"Deployment/app: spec.containers[app].image is \"app:v2\", expected \"app:v1\"",
+		"Deployment/app: spec.replicas is 3, expected 2",
+		"ConfigMap/config: expected but not rendered",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Compare() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b/web" + TestFileSuffix, "a" + TestFileSuffix, "a.golden.yaml", ".hidden/c" + TestFileSuffix} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover() error: %v", err)
+	}
+	want := []string{filepath.Join(dir, "a"+TestFileSuffix), filepath.Join(dir, "b/web"+TestFileSuffix)}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Fatalf("Discover() = %v, want %v", files, want)
+	}
+}
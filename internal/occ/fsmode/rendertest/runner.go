@@ -0,0 +1,333 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rendertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	fsrender "github.com/openchoreo/openchoreo/internal/occ/fsmode/render"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// Result is the outcome of a test case
+type Result struct {
+	Name string
+	Path string
+
+	// Failures lists the golden file differences and the failed assertions and expectations
+	Failures []string
+
+	// Updated is set when the golden file was written instead of compared
+	Updated bool
+
+	// Err is set when the test case could not be rendered or checked
+	Err error
+}
+
+// Passed reports whether the test case rendered and all its checks passed
+func (r *Result) Passed() bool {
+	return r.Err == nil && len(r.Failures) == 0
+}
+
+// Runner runs test cases against the resources of a repository
+type Runner struct {
+	index     *fsmode.Index
+	namespace string
+	pipeline  *componentpipeline.Pipeline
+	engine    *template.Engine
+}
+
+// NewRunner creates a runner that resolves the resources of test cases in the given namespace of the index
+func NewRunner(idx *fsmode.Index, namespace string) *Runner {
+	return &Runner{
+		index:     idx,
+		namespace: namespace,
+		pipeline:  componentpipeline.NewPipeline(),
+		engine:    template.NewEngineWithOptions(template.WithCELExtensions(pipelinecontext.CELExtensions()...)),
+	}
+}
+
+// Run renders a test case and checks the result. With update set, the golden file of the test case is
+// written with the rendered resources instead of being compared with them.
+func (r *Runner) Run(tc *TestCase, update bool) *Result {
+	result := &Result{Name: tc.Name, Path: tc.Path}
+
+	rendered, err := r.render(tc)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	resources, err := normalizeAll(rendered)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	if golden := tc.GoldenPath(); golden != "" {
+		if update {
+			if err := writeGolden(golden, resources); err != nil {
+				result.Err = err
+				return result
+			}
+			result.Updated = true
+		} else {
+			expected, err := readGolden(golden)
+			if err != nil {
+				result.Err = err
+				return result
+			}
+			result.Failures = append(result.Failures, Compare(expected, resources)...)
+		}
+	}
+
+	for _, assertion := range tc.Assertions {
+		result.Failures = append(result.Failures, checkAssertion(assertion, resources)...)
+	}
+	// Expectations see the rendered values, as CEL does not compare the floats of the JSON form with ints
+	for _, expectation := range tc.Expectations {
+		if failure := r.checkExpectation(expectation, rendered); failure != "" {
+			result.Failures = append(result.Failures, failure)
+		}
+	}
+	return result
+}
+
+// render renders the component of a test case
+func (r *Runner) render(tc *TestCase) ([]map[string]any, error) {
+	comp := tc.Component.DeepCopy()
+	if comp.Name == "" {
+		comp.Name = "component"
+	}
+	if comp.Namespace == "" {
+		comp.Namespace = r.namespace
+	}
+
+	workload := &v1alpha1.Workload{}
+	if tc.Workload != nil {
+		workload = tc.Workload.DeepCopy()
+	}
+	if workload.Name == "" {
+		workload.Name = comp.Name
+	}
+	if workload.Namespace == "" {
+		workload.Namespace = comp.Namespace
+	}
+
+	var binding *v1alpha1.ReleaseBinding
+	if tc.ReleaseBinding != nil {
+		binding = &v1alpha1.ReleaseBinding{Spec: *tc.ReleaseBinding.DeepCopy()}
+		binding.Name = comp.Name + "-" + tc.Environment
+		binding.Namespace = comp.Namespace
+		binding.Spec.Owner = v1alpha1.ReleaseBindingOwner{ProjectName: comp.Spec.Owner.ProjectName, ComponentName: comp.Name}
+		binding.Spec.Environment = tc.Environment
+	}
+
+	input, err := fsrender.BuildInputFor(r.index, comp.Namespace, comp, workload, tc.Environment, binding)
+	if err != nil {
+		return nil, err
+	}
+	output, err := r.pipeline.Render(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render: %w", err)
+	}
+
+	resources := make([]map[string]any, 0, len(output.Resources))
+	for _, resource := range output.Resources {
+		resources = append(resources, resource.Resource)
+	}
+	return resources, nil
+}
+
+// checkAssertion checks a field of every rendered resource the assertion selects
+func checkAssertion(assertion Assertion, resources []map[string]any) []string {
+	expected, err := normalizeValue(assertion.Equals)
+	if err != nil {
+		return []string{fmt.Sprintf("assertion on %s: %v", assertion.Path, err)}
+	}
+
+	var failures []string
+	matched := false
+	for _, resource := range resources {
+		kind, name := renderer.KindAndName(resource)
+		if kind != assertion.Kind || (assertion.Name != "" && name != assertion.Name) {
+			continue
+		}
+		matched = true
+		actual, found := lookupPath(resource, assertion.Path)
+		switch {
+		case !found:
+			failures = append(failures, fmt.Sprintf("%s/%s: %s is not set, expected %s",
+				kind, name, assertion.Path, formatValue(expected)))
+		case !valuesEqual(expected, actual):
+			failures = append(failures, fmt.Sprintf("%s/%s: %s is %s, expected %s",
+				kind, name, assertion.Path, formatValue(actual), formatValue(expected)))
+		}
+	}
+	if !matched {
+		selector := assertion.Kind
+		if assertion.Name != "" {
+			selector += "/" + assertion.Name
+		}
+		failures = append(failures, fmt.Sprintf("assertion on %s: no rendered %s", assertion.Path, selector))
+	}
+	return failures
+}
+
+// checkExpectation evaluates a CEL expectation with the rendered resources. It returns a failure message,
+// or an empty string when the expectation holds.
+func (r *Runner) checkExpectation(expectation string, resources []map[string]any) string {
+	expression := strings.TrimSpace(expectation)
+	if !strings.HasPrefix(expression, "${") {
+		expression = "${" + expression + "}"
+	}
+
+	items := make([]any, 0, len(resources))
+	for _, resource := range resources {
+		items = append(items, resource)
+	}
+	value, err := r.engine.Render(expression, map[string]any{"resources": items})
+	if err != nil {
+		return fmt.Sprintf("expectation %q failed to evaluate: %v", expectation, err)
+	}
+	holds, ok := value.(bool)
+	if !ok {
+		return fmt.Sprintf("expectation %q evaluated to %v, expected a boolean", expectation, value)
+	}
+	if !holds {
+		return fmt.Sprintf("expectation %q is false", expectation)
+	}
+	return ""
+}
+
+// lookupPath returns the value at a dot-separated path, where numeric segments index into lists
+func lookupPath(obj any, path string) (any, bool) {
+	current := obj
+	for _, segment := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			next, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// readGolden reads the expected resources from a multi-document YAML file
+func readGolden(path string) ([]map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("golden file %s does not exist, run with --update to create it", path)
+		}
+		return nil, fmt.Errorf("failed to read golden file: %w", err)
+	}
+
+	var resources []map[string]any
+	for _, doc := range splitDocuments(data) {
+		obj := map[string]any{}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse golden file %s: %w", path, err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		normalized, err := normalize(obj)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, normalized)
+	}
+	return resources, nil
+}
+
+// writeGolden writes the rendered resources to a multi-document YAML file
+func writeGolden(path string, resources []map[string]any) error {
+	var buf bytes.Buffer
+	for i, resource := range resources {
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return fmt.Errorf("failed to format resource: %w", err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write golden file: %w", err)
+	}
+	return nil
+}
+
+// splitDocuments splits a YAML stream on its document separators
+func splitDocuments(data []byte) [][]byte {
+	var docs [][]byte
+	var current []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimRight(line, " \t\r") == "---" {
+			docs = append(docs, []byte(strings.Join(current, "\n")))
+			current = nil
+			continue
+		}
+		current = append(current, line)
+	}
+	return append(docs, []byte(strings.Join(current, "\n")))
+}
+
+func normalizeAll(resources []map[string]any) ([]map[string]any, error) {
+	normalized := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		obj, err := normalize(resource)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, obj)
+	}
+	return normalized, nil
+}
+
+// normalize converts a resource to its JSON form, so that rendered and parsed values compare equal
+func normalize(resource map[string]any) (map[string]any, error) {
+	value, err := normalizeValue(resource)
+	if err != nil {
+		return nil, err
+	}
+	normalized, _ := value.(map[string]any)
+	return normalized, nil
+}
+
+func normalizeValue(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize value: %w", err)
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to normalize value: %w", err)
+	}
+	return normalized, nil
+}
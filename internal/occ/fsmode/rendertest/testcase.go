@@ -0,0 +1,141 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rendertest runs golden-file tests of ComponentTypes and Traits. A test case renders a
+// Component and Workload in an environment with the ComponentTypes, Traits, Environments and DataPlanes
+// of a file-system mode repository, and checks the rendered resources against a golden file, field
+// assertions and CEL expectations.
+package rendertest
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// TestFileSuffix is the suffix of test case files
+const TestFileSuffix = ".test.yaml"
+
+// TestCase is a single render test, read from a file ending in TestFileSuffix.
+// The file has no apiVersion and kind, so that the repository index skips it.
+type TestCase struct {
+	// Name describes the test. Defaults to the file name.
+	Name string `json:"name,omitempty"`
+
+	// Environment is the name of the Environment in the repository to render for
+	Environment string `json:"environment"`
+
+	// Component is the component to render. Its ComponentType and Traits are loaded from the repository.
+	Component v1alpha1.Component `json:"component"`
+
+	// Workload of the component. Optional, defaults to a workload without containers.
+	Workload *v1alpha1.Workload `json:"workload,omitempty"`
+
+	// ReleaseBinding holds the environment overrides of the component. Optional.
+	// The owner and environment are filled in from the component and the environment of the test.
+	ReleaseBinding *v1alpha1.ReleaseBindingSpec `json:"releaseBinding,omitempty"`
+
+	// Golden is the file with the expected rendered resources, relative to the test file. Optional.
+	Golden string `json:"golden,omitempty"`
+
+	// Assertions check single fields of the rendered resources. Optional.
+	Assertions []Assertion `json:"assertions,omitempty"`
+
+	// Expectations are CEL expressions over the list of rendered resources, named resources,
+	// that must evaluate to true. Optional.
+	Expectations []string `json:"expectations,omitempty"`
+
+	// Path is the file the test case was loaded from
+	Path string `json:"-"`
+}
+
+// Assertion checks a field of the rendered resources of a kind, and optionally a name
+type Assertion struct {
+	Kind string `json:"kind"`
+	Name string `json:"name,omitempty"`
+
+	// Path is the dot-separated path of the field, with list indexes as numbers,
+	// e.g. spec.template.spec.containers.0.image
+	Path string `json:"path"`
+
+	// Equals is the expected value of the field
+	Equals any `json:"equals"`
+}
+
+// LoadTestCase reads a test case file
+func LoadTestCase(path string) (*TestCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test case: %w", err)
+	}
+	tc := &TestCase{}
+	if err := yaml.UnmarshalStrict(data, tc); err != nil {
+		return nil, fmt.Errorf("failed to parse test case %s: %w", path, err)
+	}
+	tc.Path = path
+	if tc.Name == "" {
+		tc.Name = strings.TrimSuffix(filepath.Base(path), TestFileSuffix)
+	}
+	if tc.Environment == "" {
+		return nil, fmt.Errorf("test case %s: environment is required", path)
+	}
+	if tc.Component.Spec.ComponentType == "" {
+		return nil, fmt.Errorf("test case %s: component.spec.componentType is required", path)
+	}
+	for i, assertion := range tc.Assertions {
+		if assertion.Kind == "" || assertion.Path == "" {
+			return nil, fmt.Errorf("test case %s: assertion %d needs a kind and a path", path, i+1)
+		}
+	}
+	return tc, nil
+}
+
+// GoldenPath returns the path of the golden file, or an empty string if the test has none
+func (tc *TestCase) GoldenPath() string {
+	if tc.Golden == "" {
+		return ""
+	}
+	if filepath.IsAbs(tc.Golden) {
+		return tc.Golden
+	}
+	return filepath.Join(filepath.Dir(tc.Path), tc.Golden)
+}
+
+// Discover returns the test case files under the given paths, sorted. A path may also be a test case file.
+func Discover(paths ...string) ([]string, error) {
+	var files []string
+	for _, root := range paths {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, root)
+			continue
+		}
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			if !d.IsDir() && strings.HasSuffix(d.Name(), TestFileSuffix) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find test cases in %s: %w", root, err)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testutils holds the fixtures of the tests that render components from a file-system mode repository.
+package testutils
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+)
+
+// PlatformResources are the resources a platform team provides: the web-service ComponentType, the config
+// Trait, the production and staging Environments and their DataPlane
+const PlatformResources = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: web-service
+  namespace: default
+spec:
+  workloadType: deployment
+  schema:
+    parameters:
+      replicas: "integer | default=1"
+    envOverrides:
+      logLevel: "string | default=info"
+  resources:
+    - id: deployment
+      template:
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata:
+          name: ${metadata.name}
+          namespace: ${metadata.namespace}
+        spec:
+          replicas: ${parameters.replicas}
+          template:
+            spec:
+              containers:
+                - name: app
+                  image: ${workload.containers["app"].image}
+                  args: ["--log-level=${envOverrides.logLevel}"]
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: config
+  namespace: default
+spec:
+  schema:
+    parameters:
+      key: "string"
+  creates:
+    - template:
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: ${metadata.name}-${trait.instanceName}
+          namespace: ${metadata.namespace}
+        data:
+          key: ${parameters.key}
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Environment
+metadata:
+  name: production
+  namespace: default
+spec:
+  dataPlaneRef: default
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Environment
+metadata:
+  name: staging
+  namespace: default
+spec:
+  dataPlaneRef: default
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: DataPlane
+metadata:
+  name: default
+  namespace: default
+spec: {}
+`
+
+// ComponentResources are the resources of the greeter component of the demo project, which uses the
+// web-service ComponentType and binds to production with an override
+const ComponentResources = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: greeter
+  namespace: default
+spec:
+  owner:
+    projectName: demo
+  componentType: deployment/web-service
+  parameters:
+    replicas: 2
+  traits:
+    - name: config
+      instanceName: settings
+      parameters:
+        key: value
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Workload
+metadata:
+  name: greeter
+  namespace: default
+spec:
+  owner:
+    projectName: demo
+    componentName: greeter
+  containers:
+    app:
+      image: greeter:v1
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: greeter-production
+  namespace: default
+spec:
+  owner:
+    projectName: demo
+    componentName: greeter
+  environment: production
+  componentTypeEnvOverrides:
+    logLevel: warn
+`
+
+// NewIndex indexes the resources of the given multi-document YAML streams
+func NewIndex(t *testing.T, resources ...string) *fsmode.Index {
+	t.Helper()
+	idx := index.New(t.TempDir())
+	for _, doc := range strings.Split(strings.Join(resources, "\n---\n"), "\n---\n") {
+		obj := map[string]any{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			t.Fatalf("failed to parse resource: %v", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		if err := idx.Add(&index.ResourceEntry{Resource: &unstructured.Unstructured{Object: obj}, FilePath: "resources.yaml"}); err != nil {
+			t.Fatalf("failed to index resource: %v", err)
+		}
+	}
+	return fsmode.WrapIndex(idx)
+}
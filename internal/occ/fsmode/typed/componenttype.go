@@ -6,6 +6,8 @@ package typed
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
 )
@@ -79,3 +81,21 @@ func (ct *ComponentType) GetResources() []interface{} {
 func (ct *ComponentType) WorkloadType() string {
 	return ct.Spec.WorkloadType
 }
+
+// GetTraits returns the traits attached by the ComponentType as a slice for template processing
+func (ct *ComponentType) GetTraits() []interface{} {
+	if len(ct.Spec.Traits) == 0 {
+		return nil
+	}
+
+	traits := make([]interface{}, 0, len(ct.Spec.Traits))
+	for _, trait := range ct.Spec.Traits {
+		traitMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&trait)
+		if err != nil {
+			continue
+		}
+		traits = append(traits, traitMap)
+	}
+
+	return traits
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/apply"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/audit"
 	componentrelease "github.com/openchoreo/openchoreo/internal/occ/cmd/component-release"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/create/component"
@@ -20,7 +21,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/logout"
 	releasebinding "github.com/openchoreo/openchoreo/internal/occ/cmd/release-binding"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/render"
 	scaffoldcomponent "github.com/openchoreo/openchoreo/internal/occ/cmd/scaffold/component"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/token"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
@@ -161,3 +164,45 @@ func (c *CommandImplementation) GenerateReleaseBinding(params api.GenerateReleas
 	bindingImpl := releasebinding.NewReleaseBindingImpl()
 	return bindingImpl.GenerateReleaseBinding(params)
 }
+
+func (c *CommandImplementation) RollbackReleaseBinding(params api.RollbackReleaseBindingParams) error {
+	bindingImpl := releasebinding.NewReleaseBindingImpl()
+	return bindingImpl.RollbackReleaseBinding(params)
+}
+
+func (c *CommandImplementation) PreviewReleaseBinding(params api.PreviewReleaseBindingParams) error {
+	bindingImpl := releasebinding.NewReleaseBindingImpl()
+	return bindingImpl.PreviewReleaseBinding(params)
+}
+
+// Render Operations (File-System Mode)
+
+func (c *CommandImplementation) Render(params api.RenderParams) error {
+	renderImpl := render.NewRenderImpl()
+	return renderImpl.Render(params)
+}
+
+func (c *CommandImplementation) RunRenderTests(params api.RunRenderTestsParams) error {
+	renderImpl := render.NewRenderImpl()
+	return renderImpl.RunRenderTests(params)
+}
+
+func (c *CommandImplementation) ListAuditEvents(params api.ListAuditEventsParams) error {
+	auditImpl := audit.NewAuditImpl()
+	return auditImpl.ListAuditEvents(params)
+}
+
+func (c *CommandImplementation) CreateToken(params api.CreateTokenParams) error {
+	tokenImpl := token.NewTokenImpl()
+	return tokenImpl.CreateToken(params)
+}
+
+func (c *CommandImplementation) ListTokens(params api.ListTokensParams) error {
+	tokenImpl := token.NewTokenImpl()
+	return tokenImpl.ListTokens(params)
+}
+
+func (c *CommandImplementation) RevokeToken(params api.RevokeTokenParams) error {
+	tokenImpl := token.NewTokenImpl()
+	return tokenImpl.RevokeToken(params)
+}
@@ -127,6 +127,122 @@ type ListComponentsResponse struct {
 	Code  string `json:"code,omitempty"`
 }
 
+// ReleaseBindingResponse represents a release binding from the API
+type ReleaseBindingResponse struct {
+	Name          string `json:"name"`
+	ComponentName string `json:"componentName"`
+	ProjectName   string `json:"projectName"`
+	OrgName       string `json:"orgName"`
+	Environment   string `json:"environment"`
+	ReleaseName   string `json:"releaseName,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+// ReleaseBindingPreviewResponse represents the rendered resources of a release binding preview and their diff
+type ReleaseBindingPreviewResponse struct {
+	BindingName string                    `json:"bindingName"`
+	Environment string                    `json:"environment"`
+	ReleaseName string                    `json:"releaseName"`
+	Resources   []PreviewResource         `json:"resources"`
+	Diff        []ResourceDiff            `json:"diff"`
+	Summary     ReleaseBindingDiffSummary `json:"summary"`
+}
+
+// PreviewResource represents a rendered resource and the plane it targets
+type PreviewResource struct {
+	TargetPlane string         `json:"targetPlane"`
+	ID          string         `json:"id"`
+	Object      map[string]any `json:"object"`
+}
+
+// ResourceDiff represents a resource that a preview would add, remove or change
+type ResourceDiff struct {
+	TargetPlane string        `json:"targetPlane"`
+	ID          string        `json:"id"`
+	Kind        string        `json:"kind"`
+	Name        string        `json:"name"`
+	Namespace   string        `json:"namespace,omitempty"`
+	Action      string        `json:"action"`
+	Changes     []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange represents a single changed field of a resource
+type FieldChange struct {
+	Path     string `json:"path"`
+	Current  any    `json:"current,omitempty"`
+	Proposed any    `json:"proposed,omitempty"`
+}
+
+// ReleaseBindingDiffSummary counts the resources of a preview by diff action
+type ReleaseBindingDiffSummary struct {
+	Added     int `json:"added"`
+	Changed   int `json:"changed"`
+	Removed   int `json:"removed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// AuditEvent represents an event of the audit trail
+type AuditEvent struct {
+	EventID   string `json:"event_id"`
+	Timestamp string `json:"timestamp"`
+	Actor     struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"actor"`
+	Action   string `json:"action"`
+	Category string `json:"category"`
+	Resource *struct {
+		Type string `json:"type"`
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"resource"`
+	Result    string         `json:"result"`
+	RequestID string         `json:"request_id"`
+	SourceIP  string         `json:"source_ip"`
+	Service   string         `json:"service"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// AuditEventList represents a page of audit events and the token of the next page
+type AuditEventList struct {
+	Items    []AuditEvent `json:"items"`
+	Metadata struct {
+		Continue string `json:"continue,omitempty"`
+		HasMore  bool   `json:"hasMore"`
+	} `json:"metadata"`
+}
+
+// APIToken represents a personal access token or service account key. Token is only set on creation.
+type APIToken struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description,omitempty"`
+	Kind              string   `json:"kind"`
+	Subject           string   `json:"subject"`
+	SubjectType       string   `json:"subjectType"`
+	EntitlementClaim  string   `json:"entitlementClaim"`
+	EntitlementValues []string `json:"entitlementValues,omitempty"`
+	Scopes            []string `json:"scopes,omitempty"`
+	CreatedBy         string   `json:"createdBy"`
+	CreatedAt         string   `json:"createdAt"`
+	ExpiresAt         string   `json:"expiresAt"`
+	LastUsedAt        string   `json:"lastUsedAt,omitempty"`
+	RevokedAt         string   `json:"revokedAt,omitempty"`
+	Status            string   `json:"status"`
+	Token             string   `json:"token,omitempty"`
+}
+
+// CreateAPITokenRequest represents the request to create an API token
+type CreateAPITokenRequest struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	Kind           string   `json:"kind,omitempty"`
+	ServiceAccount string   `json:"serviceAccount,omitempty"`
+	Entitlements   []string `json:"entitlements,omitempty"`
+	Scopes         []string `json:"scopes,omitempty"`
+	ExpiresIn      string   `json:"expiresIn,omitempty"`
+}
+
 // GetSuccess implements the listResponse interface
 func (r ListOrganizationsResponse) GetSuccess() bool {
 	return r.Success
@@ -461,6 +577,210 @@ func (c *APIClient) GetComponentWorkflowSchema(ctx context.Context, orgName, cwN
 	return c.getSchema(ctx, path)
 }
 
+// RollbackReleaseBinding points a release binding back at a release from its history.
+// An empty release name rolls back to the previous ready release.
+func (c *APIClient) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName,
+	releaseName string) (*ReleaseBindingResponse, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/release-bindings/%s/rollback",
+		orgName, projectName, componentName, bindingName)
+	body := map[string]string{}
+	if releaseName != "" {
+		body["releaseName"] = releaseName
+	}
+
+	resp, err := c.post(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make rollback request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var apiResponse struct {
+		Success bool                   `json:"success"`
+		Data    ReleaseBindingResponse `json:"data"`
+		Error   string                 `json:"error,omitempty"`
+		Code    string                 `json:"code,omitempty"`
+	}
+	if err := json.Unmarshal(respBody, &apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !apiResponse.Success {
+		if apiResponse.Code != "" {
+			return nil, fmt.Errorf("rollback failed: %s (error code: %s)", apiResponse.Error, apiResponse.Code)
+		}
+		return nil, fmt.Errorf("rollback failed: %s", apiResponse.Error)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// PreviewReleaseBinding renders a release binding with the proposed changes without applying them.
+// The request body carries the proposed release, environment, parameters and overrides.
+func (c *APIClient) PreviewReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string,
+	body map[string]any) (*ReleaseBindingPreviewResponse, error) {
+	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/release-bindings/%s/preview",
+		orgName, projectName, componentName, bindingName)
+
+	resp, err := c.post(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make preview request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var apiResponse struct {
+		Success bool                          `json:"success"`
+		Data    ReleaseBindingPreviewResponse `json:"data"`
+		Error   string                        `json:"error,omitempty"`
+		Code    string                        `json:"code,omitempty"`
+	}
+	if err := json.Unmarshal(respBody, &apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !apiResponse.Success {
+		if apiResponse.Code != "" {
+			return nil, fmt.Errorf("preview failed: %s (error code: %s)", apiResponse.Error, apiResponse.Code)
+		}
+		return nil, fmt.Errorf("preview failed: %s", apiResponse.Error)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// ListAuditEvents fetches a page of audit events matching the query parameters
+func (c *APIClient) ListAuditEvents(ctx context.Context, params url.Values) (*AuditEventList, error) {
+	resp, err := c.getWithParams(ctx, "/api/v1/audit/events", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make audit request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var apiResponse struct {
+		Success bool           `json:"success"`
+		Data    AuditEventList `json:"data"`
+		Error   string         `json:"error,omitempty"`
+		Code    string         `json:"code,omitempty"`
+	}
+	if err := json.Unmarshal(respBody, &apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !apiResponse.Success {
+		if apiResponse.Code != "" {
+			return nil, fmt.Errorf("failed to list audit events: %s (error code: %s)", apiResponse.Error, apiResponse.Code)
+		}
+		return nil, fmt.Errorf("failed to list audit events: %s", apiResponse.Error)
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// CreateAPIToken creates a personal access token or service account key
+func (c *APIClient) CreateAPIToken(ctx context.Context, req CreateAPITokenRequest) (*APIToken, error) {
+	resp, err := c.post(ctx, "/api/v1/tokens", req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token APIToken
+	if err := decodeAPIResponse(resp, &token); err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	return &token, nil
+}
+
+// ListAPITokens lists the personal access tokens of the caller, or the service account keys when kind is service
+func (c *APIClient) ListAPITokens(ctx context.Context, kind string) ([]APIToken, error) {
+	params := url.Values{}
+	if kind != "" {
+		params.Set("kind", kind)
+	}
+	resp, err := c.getWithParams(ctx, "/api/v1/tokens", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var list struct {
+		Items []APIToken `json:"items"`
+	}
+	if err := decodeAPIResponse(resp, &list); err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	return list.Items, nil
+}
+
+// RevokeAPIToken revokes an API token by its ID
+func (c *APIClient) RevokeAPIToken(ctx context.Context, tokenID string) error {
+	resp, err := c.delete(ctx, "/api/v1/tokens/"+url.PathEscape(tokenID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to make token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := decodeAPIResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// decodeAPIResponse decodes the data of a wrapped API response into out, or returns the error it carries
+func decodeAPIResponse(resp *http.Response, out interface{}) error {
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var apiResponse struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+		Error   string          `json:"error,omitempty"`
+		Message string          `json:"message,omitempty"`
+		Code    string          `json:"code,omitempty"`
+	}
+	if err := json.Unmarshal(respBody, &apiResponse); err != nil {
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if !apiResponse.Success {
+		// Authentication failures are written by the middleware as an error code and a message
+		if apiResponse.Message != "" {
+			return fmt.Errorf("%s (error code: %s)", apiResponse.Message, apiResponse.Error)
+		}
+		if apiResponse.Code != "" {
+			return fmt.Errorf("%s (error code: %s)", apiResponse.Error, apiResponse.Code)
+		}
+		return fmt.Errorf("%s", apiResponse.Error)
+	}
+
+	if out == nil || len(apiResponse.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(apiResponse.Data, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
 // getSchema is a helper to fetch schema from the API
 func (c *APIClient) getSchema(ctx context.Context, path string) (*json.RawMessage, error) {
 	resp, err := c.get(ctx, path)
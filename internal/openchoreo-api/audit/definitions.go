@@ -84,6 +84,36 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "promote_component",
 			Category: audit.CategoryResource,
 		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests",
+			Action:   "request_promotion",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/approve",
+			Action:   "approve_promotion",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/reject",
+			Action:   "reject_promotion",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PATCH",
+			Pattern:  "/api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}",
+			Action:   "patch_release_binding",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/rollback",
+			Action:   "rollback_release_binding",
+			Category: audit.CategoryResource,
+		},
 
 		// Trait operations
 		{
@@ -162,5 +192,57 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "delete_authz_role_mapping",
 			Category: audit.CategoryAuth,
 		},
+
+		// API token operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/tokens",
+			Action:   "create_api_token",
+			Category: audit.CategoryAuth,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/tokens/{tokenId}",
+			Action:   "revoke_api_token",
+			Category: audit.CategoryAuth,
+		},
+	}
+}
+
+// GetMCPToolActions returns the audit action of each MCP tool, keyed by tool name.
+// Like the HTTP routes, only tools that modify state are audited.
+func GetMCPToolActions() map[string]audit.ActionDefinition {
+	resource := func(action string) audit.ActionDefinition {
+		return audit.ActionDefinition{Action: action, Category: audit.CategoryResource}
+	}
+	return map[string]audit.ActionDefinition{
+		// Project, environment and data plane operations
+		"create_project":     resource("create_project"),
+		"create_environment": resource("create_environment"),
+		"create_dataplane":   resource("create_dataplane"),
+
+		// Apply/Delete operations
+		"apply_resource":  resource("apply_resource"),
+		"delete_resource": resource("delete_resource"),
+
+		// Component operations
+		"create_component":                 resource("create_component"),
+		"patch_component":                  resource("update_component"),
+		"update_component_traits":          resource("update_component_traits"),
+		"update_component_binding":         resource("update_component_binding"),
+		"update_component_workflow_schema": resource("update_workflow_parameters"),
+		"trigger_component_workflow":       resource("create_workflow_run"),
+		"trigger_build":                    resource("trigger_build"),
+		"create_workload":                  resource("create_workload"),
+
+		// Release and deployment operations
+		"create_component_release":  resource("create_component_release"),
+		"deploy_release":            resource("deploy_component"),
+		"promote_component":         resource("promote_component"),
+		"request_promotion":         resource("request_promotion"),
+		"approve_promotion_request": resource("approve_promotion"),
+		"reject_promotion_request":  resource("reject_promotion"),
+		"patch_release_binding":     resource("patch_release_binding"),
+		"rollback_release_binding":  resource("rollback_release_binding"),
 	}
 }
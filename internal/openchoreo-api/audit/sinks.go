@@ -0,0 +1,71 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	observerconfig "github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// NewSinks creates the audit sinks of the configuration. The store is the first sink that can be
+// queried, and is nil when none of the sinks can serve the audit query API.
+func NewSinks(ctx context.Context, cfg config.AuditConfig, logger *slog.Logger) ([]audit.Sink, audit.Store, error) {
+	sinks := make([]audit.Sink, 0, len(cfg.Sinks))
+	var store audit.Store
+	for i, sinkCfg := range cfg.Sinks {
+		sink, err := newSink(ctx, sinkCfg, logger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create audit sink %d (%s): %w", i, sinkCfg.Type, err)
+		}
+		sinks = append(sinks, sink)
+		if s, ok := sink.(audit.Store); ok && store == nil {
+			store = s
+		}
+		logger.Info("Enabled audit sink", slog.String("type", sinkCfg.Type))
+	}
+	return sinks, store, nil
+}
+
+func newSink(ctx context.Context, cfg config.AuditSinkConfig, logger *slog.Logger) (audit.Sink, error) {
+	switch cfg.Type {
+	case config.AuditSinkFile:
+		return audit.NewFileSink(audit.FileSinkConfig{
+			Path:         cfg.File.Path,
+			MaxSizeBytes: int64(cfg.File.MaxSizeMB) * 1024 * 1024,
+			MaxBackups:   cfg.File.MaxBackups,
+		})
+	case config.AuditSinkWebhook:
+		return audit.NewWebhookSink(audit.WebhookSinkConfig{
+			URL:     cfg.Webhook.URL,
+			Headers: cfg.Webhook.Headers,
+			Timeout: cfg.Webhook.Timeout,
+		})
+	case config.AuditSinkOpenSearch:
+		username := cfg.OpenSearch.Username
+		if username == "" {
+			username = os.Getenv(config.EnvOpenSearchUsername)
+		}
+		password := cfg.OpenSearch.Password
+		if password == "" {
+			password = os.Getenv(config.EnvOpenSearchPassword)
+		}
+		client, err := opensearch.NewClient(&observerconfig.OpenSearchConfig{
+			Address:  cfg.OpenSearch.Address,
+			Username: username,
+			Password: password,
+		}, logger.With("component", "audit-opensearch"))
+		if err != nil {
+			return nil, err
+		}
+		return audit.NewOpenSearchSink(ctx, client, cfg.OpenSearch.Index)
+	}
+	return nil, fmt.Errorf("unknown audit sink type %q", cfg.Type)
+}
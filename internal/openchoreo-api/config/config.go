@@ -6,6 +6,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -15,12 +16,14 @@ import (
 // Config represents the top-level configuration structure for openchoreo-api
 type Config struct {
 	Security SecurityConfig `yaml:"security"`
+	Audit    AuditConfig    `yaml:"audit"`
 }
 
 // SecurityConfig represents the authorization configuration section
 type SecurityConfig struct {
 	UserTypes       []subject.UserTypeConfig `yaml:"user_types"`
 	ExternalClients []ExternalClientConfig   `yaml:"external_clients"`
+	APITokens       APITokensConfig          `yaml:"api_tokens"`
 }
 
 // ExternalClientConfig represents an external client configuration
@@ -30,6 +33,124 @@ type ExternalClientConfig struct {
 	Scopes   []string `yaml:"scopes"`
 }
 
+// Default lifetimes of API tokens
+const (
+	DefaultAPITokenLifetime    = 30 * 24 * time.Hour
+	DefaultAPITokenMaxLifetime = 365 * 24 * time.Hour
+)
+
+// APIKeyAuthMechanism is the auth mechanism type that designates the user type service account keys
+// authenticate as. The entitlement claim of the mechanism carries the service account name.
+const APIKeyAuthMechanism = "api_key"
+
+// APITokensConfig represents the personal access token and service account key configuration.
+// API tokens are stored in the authorization database, so they are only available when authorization is enabled.
+type APITokensConfig struct {
+	DefaultLifetime time.Duration `yaml:"default_lifetime"`
+	MaxLifetime     time.Duration `yaml:"max_lifetime"`
+}
+
+// withDefaults fills in the lifetimes that are not configured
+func (c APITokensConfig) withDefaults() APITokensConfig {
+	if c.DefaultLifetime == 0 {
+		c.DefaultLifetime = DefaultAPITokenLifetime
+	}
+	if c.MaxLifetime == 0 {
+		c.MaxLifetime = DefaultAPITokenMaxLifetime
+	}
+	return c
+}
+
+// Validate checks that the lifetimes are positive and the default does not exceed the maximum
+func (c *APITokensConfig) Validate() error {
+	if c.DefaultLifetime < 0 || c.MaxLifetime < 0 {
+		return fmt.Errorf("lifetimes must not be negative")
+	}
+	if c.DefaultLifetime > c.MaxLifetime {
+		return fmt.Errorf("default_lifetime %s exceeds max_lifetime %s", c.DefaultLifetime, c.MaxLifetime)
+	}
+	return nil
+}
+
+// ServiceKeySubject returns the user type and entitlement claim that service account keys authenticate
+// with, taken from the user type that has an api_key auth mechanism
+func (c *SecurityConfig) ServiceKeySubject() (userType, claim string, ok bool) {
+	for _, ut := range c.UserTypes {
+		for _, am := range ut.AuthMechanisms {
+			if am.Type == APIKeyAuthMechanism {
+				return ut.Type, am.Entitlement.Claim, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// Audit sink types
+const (
+	AuditSinkFile       = "file"
+	AuditSinkWebhook    = "webhook"
+	AuditSinkOpenSearch = "opensearch"
+)
+
+// AuditConfig represents the durable audit trail configuration section.
+// Audit events are always written to the service log; the sinks persist them in addition.
+type AuditConfig struct {
+	Sinks []AuditSinkConfig `yaml:"sinks"`
+}
+
+// AuditSinkConfig represents a single audit sink, configured by the section matching its type
+type AuditSinkConfig struct {
+	Type       string                     `yaml:"type"`
+	File       *AuditFileSinkConfig       `yaml:"file,omitempty"`
+	Webhook    *AuditWebhookSinkConfig    `yaml:"webhook,omitempty"`
+	OpenSearch *AuditOpenSearchSinkConfig `yaml:"opensearch,omitempty"`
+}
+
+// AuditFileSinkConfig represents a file sink that rotates by size
+type AuditFileSinkConfig struct {
+	Path       string `yaml:"path"`
+	MaxSizeMB  int    `yaml:"max_size_mb"`
+	MaxBackups int    `yaml:"max_backups"`
+}
+
+// AuditWebhookSinkConfig represents a webhook sink
+type AuditWebhookSinkConfig struct {
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	Timeout time.Duration     `yaml:"timeout"`
+}
+
+// AuditOpenSearchSinkConfig represents an OpenSearch sink. The credentials may be left empty and
+// provided through the OPENSEARCH_USERNAME and OPENSEARCH_PASSWORD environment variables.
+type AuditOpenSearchSinkConfig struct {
+	Address  string `yaml:"address"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Index    string `yaml:"index"`
+}
+
+// Validate checks that every sink has a known type and the section for it
+func (c *AuditConfig) Validate() error {
+	for i, sink := range c.Sinks {
+		var configured bool
+		switch sink.Type {
+		case AuditSinkFile:
+			configured = sink.File != nil && sink.File.Path != ""
+		case AuditSinkWebhook:
+			configured = sink.Webhook != nil && sink.Webhook.URL != ""
+		case AuditSinkOpenSearch:
+			configured = sink.OpenSearch != nil && sink.OpenSearch.Address != ""
+		default:
+			return fmt.Errorf("sink %d: unknown type %q, expected %s, %s or %s",
+				i, sink.Type, AuditSinkFile, AuditSinkWebhook, AuditSinkOpenSearch)
+		}
+		if !configured {
+			return fmt.Errorf("sink %d: the %s section is required for %s sinks", i, sink.Type, sink.Type)
+		}
+	}
+	return nil
+}
+
 // Load loads and validates the configuration from the specified file path
 func Load(filePath string) (*Config, error) {
 	data, err := os.ReadFile(filePath)
@@ -46,6 +167,15 @@ func Load(filePath string) (*Config, error) {
 		return nil, fmt.Errorf("invalid user type config: %w", err)
 	}
 
+	if err := config.Audit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid audit config: %w", err)
+	}
+
+	config.Security.APITokens = config.Security.APITokens.withDefaults()
+	if err := config.Security.APITokens.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid API token config: %w", err)
+	}
+
 	subject.SortByPriority(config.Security.UserTypes)
 	return &config, nil
 }
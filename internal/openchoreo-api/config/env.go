@@ -37,6 +37,12 @@ const (
 
 	// EnvOIDCTokenURL is the OIDC token endpoint URL
 	EnvOIDCTokenURL = "OIDC_TOKEN_URL" // #nosec G101 -- This is an environment variable name, not a credential
+
+	// EnvOpenSearchUsername is the username of the OpenSearch audit sink when the config file does not set one
+	EnvOpenSearchUsername = "OPENSEARCH_USERNAME"
+
+	// EnvOpenSearchPassword is the password of the OpenSearch audit sink when the config file does not set one
+	EnvOpenSearchPassword = "OPENSEARCH_PASSWORD" // #nosec G101 -- This is an environment variable name, not a credential
 )
 
 // Default values for configuration
@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+)
+
+// ListAuditEvents handles GET /api/v1/audit/events
+func (h *Handler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	log.Debug("ListAuditEvents handler called")
+
+	query := r.URL.Query()
+	opts, err := extractListParams(query)
+	if err != nil {
+		log.Warn("Invalid list parameters", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	req := &models.ListAuditEventsRequest{
+		Actor:        query.Get("actor"),
+		Action:       query.Get("action"),
+		ResourceType: query.Get("resourceType"),
+		ResourceName: query.Get("resourceName"),
+		Result:       query.Get("result"),
+		Since:        query.Get("since"),
+		Until:        query.Get("until"),
+		Limit:        opts.Limit,
+		Continue:     opts.Continue,
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		log.Warn("Invalid audit event query", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	result, err := h.services.AuditService.ListEvents(ctx, req)
+	if err != nil {
+		if handlePaginationError(w, err, log) {
+			return
+		}
+		if errors.Is(err, services.ErrForbidden) {
+			log.Warn("Unauthorized to list audit events")
+			writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrAuditStoreNotConfigured) {
+			writeErrorResponse(w, http.StatusNotImplemented, err.Error(), services.CodeAuditStoreNotConfigured)
+			return
+		}
+		log.Error("Failed to list audit events", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	log.Debug("Listed audit events successfully", "count", len(result.Items), "hasMore", result.Metadata.HasMore)
+	writeListResponse(w, result.Items, result.Metadata.ResourceVersion, result.Metadata.Continue)
+}
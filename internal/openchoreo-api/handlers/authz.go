@@ -259,6 +259,10 @@ func (h *Handler) AddRoleMapping(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusConflict, authz.ErrRolePolicyMappingAlreadyExists.Error(), services.CodeConflict)
 			return
 		}
+		if errors.Is(err, authz.ErrInvalidRequest) {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+			return
+		}
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to add role mapping", services.CodeInternalError)
 		return
 	}
@@ -329,6 +333,10 @@ func (h *Handler) UpdateRoleMapping(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusConflict, "A mapping with these parameters already exists", services.CodeConflict)
 			return
 		}
+		if errors.Is(err, authz.ErrInvalidRequest) {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+			return
+		}
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to update role mapping", services.CodeInternalError)
 		return
 	}
@@ -6,6 +6,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strings"
 
@@ -61,6 +62,11 @@ func (h *Handler) CreateComponent(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusConflict, "Component already exists", services.CodeComponentExists)
 			return
 		}
+		if errors.Is(err, services.ErrProjectQuotaExhausted) {
+			logger.Warn("Project quota exhausted", "org", orgName, "project", projectName, "error", err)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeProjectQuotaExhausted)
+			return
+		}
 		logger.Error("Failed to create component", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -439,6 +445,11 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 
 	// Sanitize input
 	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), "INVALID_REQUEST")
+		return
+	}
 
 	setAuditResource(ctx, "component", componentName, componentName)
 	addAuditMetadataBatch(ctx, map[string]any{
@@ -447,6 +458,7 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 		"source_environment": req.SourceEnvironment,
 		"target_environment": req.TargetEnvironment,
 	})
+	addBreakGlassAuditMetadata(ctx, req.BreakGlass)
 
 	promoteReq := &services.PromoteComponentPayload{
 		PromoteComponentRequest: req,
@@ -487,6 +499,16 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Promotion requires approval", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusConflict, services.ErrPromotionApprovalRequired.Error(), services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, services.ErrChangeWindowClosed) {
+			logger.Warn("Promotion refused outside the change window", "target", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeChangeWindowClosed)
+			return
+		}
 		logger.Error("Failed to promote component", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -888,6 +910,52 @@ func (h *Handler) GetEnvironmentRelease(w http.ResponseWriter, r *http.Request)
 	writeSuccessResponse(w, http.StatusOK, release)
 }
 
+func (h *Handler) GetComponentDrift(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetComponentDrift handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	environmentName := r.PathValue("environmentName")
+	if orgName == "" || projectName == "" || componentName == "" || environmentName == "" {
+		logger.Warn("Organization name, project name, component name, and environment name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and environment name are required", services.CodeInvalidInput)
+		return
+	}
+
+	report, err := h.services.ComponentService.GetComponentDrift(ctx, orgName, projectName, componentName, environmentName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			logger.Warn("Unauthorized to view component drift", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Environment not found", "org", orgName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseNotFound) {
+			logger.Warn("Release not found", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
+			writeErrorResponse(w, http.StatusNotFound, "Release not found", services.CodeReleaseNotFound)
+			return
+		}
+		logger.Error("Failed to get component drift", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Retrieved component drift successfully", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName, "drifted", report.Drifted)
+	writeSuccessResponse(w, http.StatusOK, report)
+}
+
 func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.GetLogger(ctx)
@@ -912,6 +980,21 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.BreakGlass.Sanitize()
+	if err := req.BreakGlass.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), "INVALID_REQUEST")
+		return
+	}
+
+	setAuditResource(ctx, "release_binding", bindingName, bindingName)
+	addAuditMetadataBatch(ctx, map[string]any{
+		"organization": orgName,
+		"project":      projectName,
+		"component":    componentName,
+	})
+	addBreakGlassAuditMetadata(ctx, req.BreakGlass)
+
 	binding, err := h.services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
@@ -934,6 +1017,16 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Binding a release to the environment requires approval", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusConflict, services.ErrPromotionApprovalRequired.Error(), services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, services.ErrChangeWindowClosed) {
+			logger.Warn("Release binding change refused outside the change window", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeChangeWindowClosed)
+			return
+		}
 		logger.Error("Failed to patch release binding", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -943,6 +1036,143 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 	writeSuccessResponse(w, http.StatusOK, binding)
 }
 
+func (h *Handler) RollbackReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("RollbackReleaseBinding handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", services.CodeInvalidParams)
+		return
+	}
+
+	// The body is optional, an empty body rolls back to the previous ready release
+	defer r.Body.Close()
+	var req models.RollbackReleaseBindingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), "INVALID_REQUEST")
+		return
+	}
+	addBreakGlassAuditMetadata(ctx, req.BreakGlass)
+
+	binding, err := h.services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			logger.Warn("Unauthorized to roll back release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Release binding not found", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "binding", bindingName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrNoRollbackTarget) {
+			logger.Warn("No release to roll back to", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusConflict, services.ErrNoRollbackTarget.Error(), services.CodeNoRollbackTarget)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseNotInHistory) {
+			logger.Warn("Release is not in the binding history", "org", orgName, "binding", bindingName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusBadRequest, services.ErrReleaseNotInHistory.Error(), services.CodeReleaseNotInHistory)
+			return
+		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Rolling back in the environment requires approval", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, services.ErrChangeWindowClosed) {
+			logger.Warn("Rollback refused outside the change window", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeChangeWindowClosed)
+			return
+		}
+		logger.Error("Failed to roll back release binding", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Rolled back release binding successfully", "org", orgName, "binding", bindingName, "release", binding.ReleaseName)
+	writeSuccessResponse(w, http.StatusOK, binding)
+}
+
+func (h *Handler) PreviewReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("PreviewReleaseBinding handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", services.CodeInvalidParams)
+		return
+	}
+
+	// The body is optional, an empty body previews the binding as it is
+	defer r.Body.Close()
+	var req models.PreviewReleaseBindingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+
+	preview, err := h.services.ComponentService.PreviewReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			logger.Warn("Unauthorized to preview release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Release binding not found", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "binding", bindingName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingRenderFailed) {
+			logger.Warn("Failed to render release binding", "org", orgName, "binding", bindingName, "error", err)
+			writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error(), services.CodeReleaseBindingRenderFailed)
+			return
+		}
+		logger.Error("Failed to preview release binding", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Previewed release binding successfully", "org", orgName, "binding", bindingName, "changes", len(preview.Diff))
+	writeSuccessResponse(w, http.StatusOK, preview)
+}
+
 func (h *Handler) ListReleaseBindings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.GetLogger(ctx)
@@ -1020,6 +1250,14 @@ func (h *Handler) DeployRelease(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setAuditResource(ctx, "component", componentName, componentName)
+	addAuditMetadataBatch(ctx, map[string]any{
+		"organization": orgName,
+		"project":      projectName,
+		"release":      req.ReleaseName,
+	})
+	addBreakGlassAuditMetadata(ctx, req.BreakGlass)
+
 	binding, err := h.services.ComponentService.DeployRelease(ctx, orgName, projectName, componentName, &req)
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
@@ -1042,6 +1280,11 @@ func (h *Handler) DeployRelease(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrChangeWindowClosed) {
+			logger.Warn("Deployment refused outside the change window", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeChangeWindowClosed)
+			return
+		}
 		logger.Error("Failed to deploy release", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
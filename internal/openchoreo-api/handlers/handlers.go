@@ -17,6 +17,8 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/logger"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
@@ -27,17 +29,20 @@ import (
 
 // Handler holds the services and provides HTTP handlers
 type Handler struct {
-	services *services.Services
-	config   *config.Config
-	logger   *slog.Logger
+	services    *services.Services
+	config      *config.Config
+	auditLogger *audit.Logger
+	logger      *slog.Logger
 }
 
-// New creates a new Handler instance
-func New(services *services.Services, cfg *config.Config, logger *slog.Logger) *Handler {
+// New creates a new Handler instance. The audit logger persists the audit events of the protected routes
+// to the configured sinks; when it is nil the events are only written to the service log.
+func New(services *services.Services, cfg *config.Config, auditLogger *audit.Logger, logger *slog.Logger) *Handler {
 	return &Handler{
-		services: services,
-		config:   cfg,
-		logger:   logger,
+		services:    services,
+		config:      cfg,
+		auditLogger: auditLogger,
+		logger:      logger,
 	}
 }
 
@@ -70,14 +75,16 @@ func (h *Handler) Routes() http.Handler {
 	routes.HandleFunc("POST "+v1+"/webhooks/github", h.HandleGitHubWebhook)
 	routes.HandleFunc("POST "+v1+"/webhooks/gitlab", h.HandleGitLabWebhook)
 	routes.HandleFunc("POST "+v1+"/webhooks/bitbucket", h.HandleBitbucketWebhook)
+	routes.HandleFunc("POST "+v1+"/webhooks/gitea", h.HandleGiteaWebhook)
+	routes.HandleFunc("POST "+v1+"/webhooks/azuredevops", h.HandleAzureDevOpsWebhook)
 
-	// ===== Protected API Routes (JWT Authentication Required) =====
+	// ===== Protected API Routes (JWT or API Token Authentication Required) =====
 
-	// JWT authentication middleware - applies to protected routes only
-	jwtAuth := h.InitJWTMiddleware()
+	// Authentication middleware - applies to protected routes only
+	authn := h.InitAuthMiddleware()
 
 	// Audit logging middleware - applies to protected routes only
-	auditMiddleware := h.initAuditMiddleware()
+	auditMiddleware := h.InitAuditMiddleware()
 
 	// MCP endpoint
 	toolsets := getMCPServerToolsets(h)
@@ -85,13 +92,14 @@ func (h *Handler) Routes() http.Handler {
 	// MCP middleware
 	mcpMiddleware := h.initMCPMiddleware()
 
-	// MCP endpoint with chained middleware (logger -> auth401 -> jwt -> handler)
-	mcpRoutes := routes.Group(mcpMiddleware, jwtAuth)
-	mcpRoutes.Handle("/mcp", mcp.NewHTTPServer(toolsets))
+	// MCP endpoint with chained middleware (logger -> auth401 -> auth -> handler)
+	mcpRoutes := routes.Group(mcpMiddleware, authn)
+	mcpRoutes.Handle("/mcp", mcp.NewHTTPServer(toolsets,
+		mcpmiddleware.AuditTools(h.getAuditLogger(), apiaudit.GetMCPToolActions())))
 
-	// Create protected route group with JWT auth and audit logging
-	// Middleware order: logger -> jwt -> audit -> handler
-	api := routes.With(jwtAuth, auditMiddleware)
+	// Create protected route group with authentication and audit logging
+	// Middleware order: logger -> auth -> audit -> handler
+	api := routes.With(authn, auditMiddleware)
 
 	// Organization operations
 	api.HandleFunc("GET "+v1+"/orgs", h.ListOrganizations)
@@ -151,6 +159,7 @@ func (h *Handler) Routes() http.Handler {
 	api.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.PatchComponent)
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/schema", h.GetComponentSchema)
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/release", h.GetEnvironmentRelease)
+	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/drift", h.GetComponentDrift)
 
 	// Component trait management
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/traits", h.ListComponentTraits)
@@ -167,6 +176,8 @@ func (h *Handler) Routes() http.Handler {
 
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings", h.ListReleaseBindings)
 	api.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}", h.PatchReleaseBinding)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/rollback", h.RollbackReleaseBinding)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/preview", h.PreviewReleaseBinding)
 
 	// Deployment endpoint
 	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/deploy", h.DeployRelease)
@@ -174,6 +185,13 @@ func (h *Handler) Routes() http.Handler {
 	// Promotion endpoint
 	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promote", h.PromoteComponent)
 
+	// Promotion approval endpoints
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests", h.CreatePromotionRequest)
+	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests", h.ListPromotionRequests)
+	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}", h.GetPromotionRequest)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/approve", h.ApprovePromotionRequest)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/reject", h.RejectPromotionRequest)
+
 	// Observer URL endpoints
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url", h.GetComponentObserverURL)
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url", h.GetBuildObserverURL)
@@ -205,6 +223,14 @@ func (h *Handler) Routes() http.Handler {
 	// ObservabilityPlane management
 	api.HandleFunc("GET "+v1+"/orgs/{orgName}/observabilityplanes", h.ListObservabilityPlanes)
 
+	// Audit trail
+	api.HandleFunc("GET "+v1+"/audit/events", h.ListAuditEvents)
+
+	// API tokens (personal access tokens and service account keys)
+	api.HandleFunc("GET "+v1+"/tokens", h.ListAPITokens)
+	api.HandleFunc("POST "+v1+"/tokens", h.CreateAPIToken)
+	api.HandleFunc("DELETE "+v1+"/tokens/{tokenId}", h.RevokeAPIToken)
+
 	return mux
 }
 
@@ -252,6 +278,35 @@ func (h *Handler) InitJWTMiddleware() func(http.Handler) http.Handler {
 	return jwt.Middleware(jwtConfig)
 }
 
+// InitAuthMiddleware initializes the authentication middleware of the protected routes. Requests that carry
+// an API token are authenticated by the token service and every other request by the JWT middleware.
+func (h *Handler) InitAuthMiddleware() func(http.Handler) http.Handler {
+	jwtMiddleware := h.InitJWTMiddleware()
+	if h.services.TokenService == nil || !h.services.TokenService.Enabled() {
+		return apikey.Middleware(apikey.Config{Logger: h.logger}, jwtMiddleware)
+	}
+	return apikey.Middleware(apikey.Config{
+		Authenticator: h.services.TokenService,
+		Logger:        h.logger,
+	}, func(next http.Handler) http.Handler {
+		return jwtMiddleware(h.recordEntitlements(next))
+	})
+}
+
+// recordEntitlements records the entitlements of JWT authenticated subjects, which their personal access
+// tokens resolve their entitlements from
+func (h *Handler) recordEntitlements(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subject, ok := auth.GetSubjectContext(r); ok {
+			if err := h.services.TokenService.RecordEntitlements(r.Context(), subject); err != nil {
+				// Tokens keep resolving from the previously recorded entitlements
+				h.logger.Warn("Failed to record subject entitlements", "error", err, "subject", subject.ID)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (h *Handler) initMCPMiddleware() func(http.Handler) http.Handler {
 	// Get MCP configuration from environment variables
 	serverBaseURL := os.Getenv(config.EnvServerBaseURL)
@@ -263,15 +318,23 @@ func (h *Handler) initMCPMiddleware() func(http.Handler) http.Handler {
 	return mcpmiddleware.Auth401Interceptor(resourceMetadataURL)
 }
 
-// initAuditMiddleware initializes the audit logging middleware
-func (h *Handler) initAuditMiddleware() func(http.Handler) http.Handler {
-	auditLogger := audit.NewLogger(h.logger, "openchoreo-api")
+// InitAuditMiddleware initializes the audit logging middleware. It is shared with the OpenAPI handlers,
+// which serve the same routes.
+func (h *Handler) InitAuditMiddleware() func(http.Handler) http.Handler {
 	actionDefinitions := apiaudit.GetActionDefinitions()
 	resolver := audit.NewActionResolver(actionDefinitions)
-	auditMw := audit.NewMiddleware(auditLogger, resolver)
+	auditMw := audit.NewMiddleware(h.getAuditLogger(), resolver)
 	return auditMw.Handler
 }
 
+// getAuditLogger returns the audit logger, falling back to one that only writes to the service log
+func (h *Handler) getAuditLogger() *audit.Logger {
+	if h.auditLogger == nil {
+		h.auditLogger = audit.NewLogger(h.logger, "openchoreo-api")
+	}
+	return h.auditLogger
+}
+
 // Health handles health check requests
 func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -281,6 +344,11 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 // Ready handles readiness check requests
 func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
 	// Add readiness checks (K8s connections, etc.)
+	if err := h.getAuditLogger().Check(); err != nil {
+		h.logger.Warn("Reporting not ready", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte("Ready")) // Ignore write errors for health checks
 }
@@ -314,7 +382,8 @@ func getMCPServerToolsets(h *Handler) *tools.Toolsets {
 			string(tools.ToolsetDeployment) + "," +
 			string(tools.ToolsetInfrastructure) + "," +
 			string(tools.ToolsetSchema) + "," +
-			string(tools.ToolsetResource)
+			string(tools.ToolsetResource) + "," +
+			string(tools.ToolsetAudit)
 	}
 
 	// Parse toolsets
@@ -358,6 +427,9 @@ func getMCPServerToolsets(h *Handler) *tools.Toolsets {
 		case tools.ToolsetResource:
 			toolsets.ResourceToolset = handler
 			h.logger.Debug("Enabled MCP toolset", slog.String("toolset", "resource"))
+		case tools.ToolsetAudit:
+			toolsets.AuditToolset = handler
+			h.logger.Debug("Enabled MCP toolset", slog.String("toolset", "audit"))
 		default:
 			h.logger.Warn("Unknown toolset type", slog.String("toolset", string(toolsetType)))
 		}
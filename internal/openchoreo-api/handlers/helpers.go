@@ -109,3 +109,14 @@ func addAuditMetadata(ctx context.Context, key string, value any) {
 func addAuditMetadataBatch(ctx context.Context, metadata map[string]any) {
 	audit.AddMetadataBatch(ctx, metadata)
 }
+
+// addBreakGlassAuditMetadata records a break-glass override of an environment's change calendar in the audit log
+func addBreakGlassAuditMetadata(ctx context.Context, breakGlass *models.BreakGlassRequest) {
+	if breakGlass == nil {
+		return
+	}
+	addAuditMetadataBatch(ctx, map[string]any{
+		"break_glass":        true,
+		"break_glass_reason": breakGlass.Reason,
+	})
+}
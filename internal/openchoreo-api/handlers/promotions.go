@@ -0,0 +1,220 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+)
+
+// CreatePromotionRequest handles POST /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests
+func (h *Handler) CreatePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreatePromotionRequest handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", services.CodeInvalidParams)
+		return
+	}
+
+	var req models.CreatePromotionRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		logger.Warn("Invalid promotion request", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	setAuditResource(ctx, "component", componentName, componentName)
+	addAuditMetadataBatch(ctx, map[string]any{
+		"organization":       orgName,
+		"project":            projectName,
+		"source_environment": req.SourceEnvironment,
+		"target_environment": req.TargetEnvironment,
+	})
+
+	promotionRequest, err := h.services.PromotionService.RequestPromotion(ctx, orgName, projectName, componentName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidPromotionPath) {
+			logger.Warn("Invalid promotion path", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusBadRequest, "Invalid promotion path", services.CodeInvalidPromotionPath)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Source release binding not found", "org", orgName, "component", componentName, "environment", req.SourceEnvironment)
+			writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("No release bound to source environment", "org", orgName, "component", componentName, "environment", req.SourceEnvironment)
+			writeErrorResponse(w, http.StatusNotFound, "No release is bound to the source environment", services.CodeComponentReleaseNotFound)
+			return
+		}
+		writePromotionError(w, logger, err, "Failed to create promotion request")
+		return
+	}
+
+	setAuditResource(ctx, "promotion_request", promotionRequest.Name, promotionRequest.Name)
+	logger.Debug("Promotion request created", "org", orgName, "component", componentName, "request", promotionRequest.Name)
+	writeSuccessResponse(w, http.StatusCreated, promotionRequest)
+}
+
+// ListPromotionRequests handles GET /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests
+func (h *Handler) ListPromotionRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListPromotionRequests handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", services.CodeInvalidParams)
+		return
+	}
+
+	promotionRequests, err := h.services.PromotionService.ListPromotionRequests(ctx, orgName, projectName, componentName)
+	if err != nil {
+		writePromotionError(w, logger, err, "Failed to list promotion requests")
+		return
+	}
+
+	writeListResponse(w, promotionRequests, "", "")
+}
+
+// GetPromotionRequest handles GET /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}
+func (h *Handler) GetPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetPromotionRequest handler called")
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and request name are required", services.CodeInvalidParams)
+		return
+	}
+
+	promotionRequest, err := h.services.PromotionService.GetPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		writePromotionError(w, logger, err, "Failed to get promotion request")
+		return
+	}
+
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+// ApprovePromotionRequest handles POST .../promotion-requests/{requestName}/approve
+func (h *Handler) ApprovePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.decidePromotionRequest(w, r, true)
+}
+
+// RejectPromotionRequest handles POST .../promotion-requests/{requestName}/reject
+func (h *Handler) RejectPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.decidePromotionRequest(w, r, false)
+}
+
+func (h *Handler) decidePromotionRequest(w http.ResponseWriter, r *http.Request, approve bool) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("Promotion decision handler called", "approve", approve)
+
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and request name are required", services.CodeInvalidParams)
+		return
+	}
+
+	// The body is optional; it only carries a reviewer comment
+	var req models.PromotionDecisionRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			logger.Warn("Invalid JSON body", "error", err)
+			writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+			return
+		}
+	}
+	defer r.Body.Close()
+	req.Sanitize()
+
+	setAuditResource(ctx, "promotion_request", requestName, requestName)
+	addAuditMetadataBatch(ctx, map[string]any{
+		"organization": orgName,
+		"project":      projectName,
+		"component":    componentName,
+	})
+
+	var (
+		promotionRequest *models.PromotionRequestResponse
+		err              error
+	)
+	if approve {
+		promotionRequest, err = h.services.PromotionService.ApprovePromotionRequest(ctx, orgName, projectName, componentName, requestName, &req)
+	} else {
+		promotionRequest, err = h.services.PromotionService.RejectPromotionRequest(ctx, orgName, projectName, componentName, requestName, &req)
+	}
+	if err != nil {
+		writePromotionError(w, logger, err, "Failed to record promotion decision")
+		return
+	}
+
+	addAuditMetadataBatch(ctx, map[string]any{
+		"decision":           promotionRequest.Decision,
+		"decided_by":         promotionRequest.DecidedBy,
+		"release":            promotionRequest.ReleaseName,
+		"target_environment": promotionRequest.TargetEnvironment,
+	})
+
+	logger.Debug("Promotion decision recorded", "org", orgName, "request", requestName, "decision", promotionRequest.Decision)
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+// writePromotionError maps the errors shared by all promotion request operations to responses
+func writePromotionError(w http.ResponseWriter, logger *slog.Logger, err error, msg string) {
+	switch {
+	case errors.Is(err, services.ErrForbidden):
+		writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+	case errors.Is(err, services.ErrProjectNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrDeploymentPipelineNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Deployment pipeline not found", services.CodeDeploymentPipelineNotFound)
+	case errors.Is(err, services.ErrPromotionRequestNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Promotion request not found", services.CodePromotionRequestNotFound)
+	case errors.Is(err, services.ErrPromotionRequestExists):
+		writeErrorResponse(w, http.StatusConflict, services.ErrPromotionRequestExists.Error(), services.CodePromotionRequestExists)
+	case errors.Is(err, services.ErrPromotionAlreadyDecided):
+		writeErrorResponse(w, http.StatusConflict, services.ErrPromotionAlreadyDecided.Error(), services.CodePromotionAlreadyDecided)
+	case errors.Is(err, services.ErrSelfApprovalNotAllowed):
+		writeErrorResponse(w, http.StatusForbidden, services.ErrSelfApprovalNotAllowed.Error(), services.CodeSelfApprovalNotAllowed)
+	default:
+		logger.Error(msg, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+	}
+}
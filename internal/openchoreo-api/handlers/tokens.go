@@ -0,0 +1,124 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+)
+
+// CreateAPIToken handles POST /api/v1/tokens
+func (h *Handler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	log.Debug("CreateAPIToken handler called")
+
+	var req models.CreateAPITokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", services.CodeInvalidInput)
+		return
+	}
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	setAuditResource(ctx, "api_token", "", req.Name)
+
+	token, err := h.services.TokenService.CreateToken(ctx, &req)
+	if err != nil {
+		if handleAPITokenError(w, err) {
+			return
+		}
+		if errors.Is(err, services.ErrAPITokenCreatedWithToken) || errors.Is(err, services.ErrAPIKeyExceedsCaller) {
+			writeErrorResponse(w, http.StatusForbidden, err.Error(), services.CodeForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrAPITokenEntitlementNotHeld) || errors.Is(err, services.ErrAPITokenLifetimeExceeded) {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+			return
+		}
+		if errors.Is(err, services.ErrServiceKeysNotConfigured) {
+			writeErrorResponse(w, http.StatusNotImplemented, err.Error(), services.CodeAPITokensNotEnabled)
+			return
+		}
+		log.Error("Failed to create API token", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	setAuditResource(ctx, "api_token", token.ID, token.Name)
+	writeSuccessResponse(w, http.StatusCreated, token)
+}
+
+// ListAPITokens handles GET /api/v1/tokens
+func (h *Handler) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	log.Debug("ListAPITokens handler called")
+
+	items, err := h.services.TokenService.ListTokens(ctx, r.URL.Query().Get("kind"))
+	if err != nil {
+		if handleAPITokenError(w, err) {
+			return
+		}
+		if errors.Is(err, services.ErrInvalidAPITokenKind) {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+			return
+		}
+		log.Error("Failed to list API tokens", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeListResponse(w, items, "", "")
+}
+
+// RevokeAPIToken handles DELETE /api/v1/tokens/{tokenId}
+func (h *Handler) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	tokenID := r.PathValue("tokenId")
+	if tokenID == "" {
+		writeErrorResponse(w, http.StatusBadRequest, "Token ID is required", services.CodeInvalidInput)
+		return
+	}
+
+	setAuditResource(ctx, "api_token", tokenID, tokenID)
+
+	if err := h.services.TokenService.RevokeToken(ctx, tokenID); err != nil {
+		if handleAPITokenError(w, err) {
+			return
+		}
+		if errors.Is(err, services.ErrAPITokenNotFound) {
+			writeErrorResponse(w, http.StatusNotFound, err.Error(), services.CodeAPITokenNotFound)
+			return
+		}
+		log.Error("Failed to revoke API token", "error", err, "id", tokenID)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	writeSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// handleAPITokenError writes the responses of the errors shared by the API token endpoints
+func handleAPITokenError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, services.ErrForbidden):
+		writeErrorResponse(w, http.StatusForbidden, services.ErrForbidden.Error(), services.CodeForbidden)
+	case errors.Is(err, services.ErrAPITokensNotEnabled):
+		writeErrorResponse(w, http.StatusNotImplemented, err.Error(), services.CodeAPITokensNotEnabled)
+	default:
+		return false
+	}
+	return true
+}
@@ -34,9 +34,27 @@ func (h *Handler) HandleGitLabWebhook(w http.ResponseWriter, r *http.Request) {
 	h.handleWebhook(w, r, git.ProviderGitLab, "X-Gitlab-Token", "gitlab-secret")
 }
 
-// HandleBitbucketWebhook processes incoming Bitbucket webhook events
+// HandleBitbucketWebhook processes incoming Bitbucket webhook events.
+// Webhooks without a secret send no signature; they fall back to the legacy
+// X-Hook-UUID token check until a secret is configured in Bitbucket.
 func (h *Handler) HandleBitbucketWebhook(w http.ResponseWriter, r *http.Request) {
-	h.handleWebhook(w, r, git.ProviderBitbucket, "X-Hook-UUID", "bitbucket-secret")
+	signatureHeader := "X-Hub-Signature"
+	if r.Header.Get(signatureHeader) == "" && r.Header.Get("X-Hook-UUID") != "" {
+		h.logger.Warn("Bitbucket webhook is not signed, falling back to X-Hook-UUID token validation; " +
+			"configure a webhook secret in Bitbucket to enable HMAC signatures")
+		signatureHeader = "X-Hook-UUID"
+	}
+	h.handleWebhook(w, r, git.ProviderBitbucket, signatureHeader, "bitbucket-secret")
+}
+
+// HandleGiteaWebhook processes incoming Gitea and Forgejo webhook events
+func (h *Handler) HandleGiteaWebhook(w http.ResponseWriter, r *http.Request) {
+	h.handleWebhook(w, r, git.ProviderGitea, "X-Gitea-Signature", "gitea-secret")
+}
+
+// HandleAzureDevOpsWebhook processes incoming Azure DevOps service hook events
+func (h *Handler) HandleAzureDevOpsWebhook(w http.ResponseWriter, r *http.Request) {
+	h.handleWebhook(w, r, git.ProviderAzureDevOps, "Authorization", "azuredevops-secret")
 }
 
 // handleWebhook is the common handler for all git provider webhooks
@@ -0,0 +1,20 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// ListAuditEvents returns a single page of audit events. Unlike the list tools of other resources it does not
+// collect every page, since the trail grows without bound; the continue token is returned to the client instead.
+func (h *MCPHandler) ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (any, error) {
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return h.Services.AuditService.ListEvents(ctx, req)
+}
@@ -24,6 +24,10 @@ type ListReleaseBindingsResponse struct {
 	Bindings []*models.ReleaseBindingResponse `json:"bindings"`
 }
 
+type ListPromotionRequestsResponse struct {
+	PromotionRequests []*models.PromotionRequestResponse `json:"promotionRequests"`
+}
+
 type ListComponentWorkflowRunsResponse struct {
 	WorkflowRuns []*models.ComponentWorkflowResponse `json:"workflowRuns"`
 }
@@ -183,6 +187,15 @@ func (h *MCPHandler) PatchReleaseBinding(ctx context.Context, orgName, projectNa
 	return h.Services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req)
 }
 
+func (h *MCPHandler) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.RollbackReleaseBindingRequest) (any, error) {
+	req.Sanitize()
+	return h.Services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req)
+}
+
+func (h *MCPHandler) PreviewReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PreviewReleaseBindingRequest) (any, error) {
+	return h.Services.ComponentService.PreviewReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req)
+}
+
 func (h *MCPHandler) DeployRelease(ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest) (any, error) {
 	return h.Services.ComponentService.DeployRelease(ctx, orgName, projectName, componentName, req)
 }
@@ -197,6 +210,34 @@ func (h *MCPHandler) PromoteComponent(ctx context.Context, orgName, projectName,
 	return binding, err
 }
 
+func (h *MCPHandler) RequestPromotion(ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest) (any, error) {
+	req.Sanitize()
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return h.Services.PromotionService.RequestPromotion(ctx, orgName, projectName, componentName, req)
+}
+
+func (h *MCPHandler) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string) (any, error) {
+	promotionRequests, err := h.Services.PromotionService.ListPromotionRequests(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return ListPromotionRequestsResponse{}, err
+	}
+	return ListPromotionRequestsResponse{
+		PromotionRequests: promotionRequests,
+	}, nil
+}
+
+func (h *MCPHandler) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest) (any, error) {
+	req.Sanitize()
+	return h.Services.PromotionService.ApprovePromotionRequest(ctx, orgName, projectName, componentName, requestName, req)
+}
+
+func (h *MCPHandler) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest) (any, error) {
+	req.Sanitize()
+	return h.Services.PromotionService.RejectPromotionRequest(ctx, orgName, projectName, componentName, requestName, req)
+}
+
 func (h *MCPHandler) CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error) {
 	// Convert interface{} to WorkloadSpec
 	workloadSpecBytes, err := json.Marshal(workloadSpec)
@@ -232,6 +273,10 @@ func (h *MCPHandler) GetEnvironmentRelease(ctx context.Context, orgName, project
 	return h.Services.ComponentService.GetEnvironmentRelease(ctx, orgName, projectName, componentName, environmentName)
 }
 
+func (h *MCPHandler) GetComponentDrift(ctx context.Context, orgName, projectName, componentName, environmentName string) (any, error) {
+	return h.Services.ComponentService.GetComponentDrift(ctx, orgName, projectName, componentName, environmentName)
+}
+
 func (h *MCPHandler) PatchComponent(ctx context.Context, orgName, projectName, componentName string, req *models.PatchComponentRequest) (any, error) {
 	return h.Services.ComponentService.PatchComponent(ctx, orgName, projectName, componentName, req)
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -98,6 +99,32 @@ type PromoteComponentRequest struct {
 	SourceEnvironment string `json:"sourceEnv"`
 	TargetEnvironment string `json:"targetEnv"`
 	// TODO Support overrides for the target environment
+
+	// BreakGlass promotes even when the change calendar of the target environment is closed
+	// +optional
+	BreakGlass *BreakGlassRequest `json:"breakGlass,omitempty"`
+}
+
+// BreakGlassRequest overrides the change calendar of the target environment for one change.
+// The override and its reason are recorded in the audit log.
+type BreakGlassRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Sanitize sanitizes the BreakGlassRequest by trimming whitespace
+func (req *BreakGlassRequest) Sanitize() {
+	if req == nil {
+		return
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+}
+
+// Validate validates the BreakGlassRequest
+func (req *BreakGlassRequest) Validate() error {
+	if req != nil && req.Reason == "" {
+		return errors.New("breakGlass.reason is required")
+	}
+	return nil
 }
 
 // PatchComponentRequest represents the request to patch a Component
@@ -122,11 +149,16 @@ func (req *CreateComponentReleaseRequest) Sanitize() {
 // DeployReleaseRequest represents the request to deploy a release to the lowest environment
 type DeployReleaseRequest struct {
 	ReleaseName string `json:"releaseName"`
+
+	// BreakGlass deploys even when the change calendar of the environment is closed
+	// +optional
+	BreakGlass *BreakGlassRequest `json:"breakGlass,omitempty"`
 }
 
 // Sanitize sanitizes the DeployReleaseRequest by trimming whitespace
 func (req *DeployReleaseRequest) Sanitize() {
 	req.ReleaseName = strings.TrimSpace(req.ReleaseName)
+	req.BreakGlass.Sanitize()
 }
 
 // Validate validates the DeployReleaseRequest
@@ -134,9 +166,229 @@ func (req *DeployReleaseRequest) Validate() error {
 	if req.ReleaseName == "" {
 		return errors.New("releaseName is required")
 	}
+	return req.BreakGlass.Validate()
+}
+
+// RollbackReleaseBindingRequest represents the request to roll a release binding back to an earlier release.
+// When ReleaseName is empty the binding rolls back to the most recent earlier release that reached Ready.
+type RollbackReleaseBindingRequest struct {
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// BreakGlass rolls back even when the change calendar of the environment is closed
+	// +optional
+	BreakGlass *BreakGlassRequest `json:"breakGlass,omitempty"`
+}
+
+// Sanitize sanitizes the RollbackReleaseBindingRequest by trimming whitespace
+func (req *RollbackReleaseBindingRequest) Sanitize() {
+	req.ReleaseName = strings.TrimSpace(req.ReleaseName)
+	req.BreakGlass.Sanitize()
+}
+
+// Validate validates the RollbackReleaseBindingRequest
+func (req *RollbackReleaseBindingRequest) Validate() error {
+	return req.BreakGlass.Validate()
+}
+
+// CreatePromotionRequestRequest represents the request to raise a promotion that needs approval
+type CreatePromotionRequestRequest struct {
+	SourceEnvironment string `json:"sourceEnv"`
+	TargetEnvironment string `json:"targetEnv"`
+}
+
+// Sanitize sanitizes the CreatePromotionRequestRequest by trimming whitespace
+func (req *CreatePromotionRequestRequest) Sanitize() {
+	req.SourceEnvironment = strings.TrimSpace(req.SourceEnvironment)
+	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+}
+
+// Validate validates the CreatePromotionRequestRequest
+func (req *CreatePromotionRequestRequest) Validate() error {
+	if req.SourceEnvironment == "" {
+		return errors.New("sourceEnv is required")
+	}
+	if req.TargetEnvironment == "" {
+		return errors.New("targetEnv is required")
+	}
+	if req.SourceEnvironment == req.TargetEnvironment {
+		return errors.New("sourceEnv and targetEnv must differ")
+	}
+	return nil
+}
+
+// PromotionDecisionRequest represents the body of an approve or reject call on a promotion request
+type PromotionDecisionRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// Sanitize sanitizes the PromotionDecisionRequest by trimming whitespace
+func (req *PromotionDecisionRequest) Sanitize() {
+	req.Comment = strings.TrimSpace(req.Comment)
+}
+
+// ListAuditEventsRequest represents the filters of an audit event query. Empty filters match every event.
+type ListAuditEventsRequest struct {
+	Actor        string `json:"actor,omitempty"`
+	Action       string `json:"action,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Result       string `json:"result,omitempty"`
+	// Since and Until are RFC 3339 timestamps bounding the events, Until is exclusive
+	Since    string `json:"since,omitempty"`
+	Until    string `json:"until,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// Sanitize sanitizes the ListAuditEventsRequest by trimming whitespace
+func (req *ListAuditEventsRequest) Sanitize() {
+	req.Actor = strings.TrimSpace(req.Actor)
+	req.Action = strings.TrimSpace(req.Action)
+	req.ResourceType = strings.TrimSpace(req.ResourceType)
+	req.ResourceName = strings.TrimSpace(req.ResourceName)
+	req.Result = strings.TrimSpace(req.Result)
+	req.Since = strings.TrimSpace(req.Since)
+	req.Until = strings.TrimSpace(req.Until)
+}
+
+// Validate validates the ListAuditEventsRequest
+func (req *ListAuditEventsRequest) Validate() error {
+	switch req.Result {
+	case "", "success", "failure", "denied":
+	default:
+		return fmt.Errorf("result must be one of success, failure or denied")
+	}
+	if req.Limit < 0 {
+		return errors.New("limit must not be negative")
+	}
+	since, until, err := req.TimeRange()
+	if err != nil {
+		return err
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return errors.New("since must be before until")
+	}
 	return nil
 }
 
+// TimeRange parses the time bounds of the query, returning zero times for the bounds that are not set
+func (req *ListAuditEventsRequest) TimeRange() (time.Time, time.Time, error) {
+	var since, until time.Time
+	var err error
+	if req.Since != "" {
+		if since, err = time.Parse(time.RFC3339, req.Since); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("since must be an RFC 3339 timestamp: %w", err)
+		}
+	}
+	if req.Until != "" {
+		if until, err = time.Parse(time.RFC3339, req.Until); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("until must be an RFC 3339 timestamp: %w", err)
+		}
+	}
+	return since, until, nil
+}
+
+// API token kinds
+const (
+	APITokenKindPersonal = "personal"
+	APITokenKindService  = "service"
+)
+
+// CreateAPITokenRequest represents the request to create a personal access token or a service account key
+type CreateAPITokenRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Kind is personal (the default) or service
+	Kind string `json:"kind,omitempty"`
+	// ServiceAccount is the service account a service key authenticates as
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// Entitlements limits a personal access token to a subset of the entitlements of its creator. The token
+	// only carries the entitlements of the subset its creator still holds when it is used.
+	Entitlements []string `json:"entitlements,omitempty"`
+	// Scopes limits the token to matching actions, such as component:view or component:*
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresIn is the lifetime of the token as a duration, such as 720h
+	ExpiresIn string `json:"expiresIn,omitempty"`
+}
+
+// Sanitize sanitizes the CreateAPITokenRequest by trimming whitespace and defaulting the kind
+func (req *CreateAPITokenRequest) Sanitize() {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Description = strings.TrimSpace(req.Description)
+	req.Kind = strings.TrimSpace(req.Kind)
+	if req.Kind == "" {
+		req.Kind = APITokenKindPersonal
+	}
+	req.ServiceAccount = strings.TrimSpace(req.ServiceAccount)
+	req.ExpiresIn = strings.TrimSpace(req.ExpiresIn)
+	for i := range req.Entitlements {
+		req.Entitlements[i] = strings.TrimSpace(req.Entitlements[i])
+	}
+	for i := range req.Scopes {
+		req.Scopes[i] = strings.TrimSpace(req.Scopes[i])
+	}
+}
+
+// Validate validates the CreateAPITokenRequest
+func (req *CreateAPITokenRequest) Validate() error {
+	if req.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(req.Name) > 128 {
+		return errors.New("name must not exceed 128 characters")
+	}
+	switch req.Kind {
+	case APITokenKindPersonal:
+		if req.ServiceAccount != "" {
+			return errors.New("serviceAccount only applies to service keys")
+		}
+	case APITokenKindService:
+		if req.ServiceAccount == "" {
+			return errors.New("serviceAccount is required for service keys")
+		}
+		if len(req.Entitlements) > 0 {
+			return errors.New("entitlements only apply to personal access tokens")
+		}
+	default:
+		return fmt.Errorf("kind must be %s or %s", APITokenKindPersonal, APITokenKindService)
+	}
+	for _, entitlement := range req.Entitlements {
+		if entitlement == "" {
+			return errors.New("entitlements must not be empty")
+		}
+	}
+	for _, scope := range req.Scopes {
+		if !validScope(scope) {
+			return fmt.Errorf("invalid scope %q, expected an action such as component:view, component:* or *", scope)
+		}
+	}
+	if req.ExpiresIn != "" {
+		d, err := time.ParseDuration(req.ExpiresIn)
+		if err != nil {
+			return fmt.Errorf("expiresIn must be a duration such as 720h: %w", err)
+		}
+		if d <= 0 {
+			return errors.New("expiresIn must be positive")
+		}
+	}
+	return nil
+}
+
+// Lifetime returns the requested lifetime of the token, or zero when the default applies
+func (req *CreateAPITokenRequest) Lifetime() time.Duration {
+	d, _ := time.ParseDuration(req.ExpiresIn)
+	return d
+}
+
+// validScope checks that a scope has the form of an action pattern
+func validScope(scope string) bool {
+	if scope == "*" {
+		return true
+	}
+	resource, verb, ok := strings.Cut(scope, ":")
+	return ok && resource != "" && verb != "" && !strings.ContainsAny(scope, " \t")
+}
+
 // CreateEnvironmentRequest represents the request to create a new environment
 type CreateEnvironmentRequest struct {
 	Name         string `json:"name"`
@@ -189,7 +441,7 @@ func (req *CreateDataPlaneRequest) Validate() error {
 // Validate validates the PromoteComponentRequest
 func (req *PromoteComponentRequest) Validate() error {
 	// TODO: Implement custom validation using Go stdlib
-	return nil
+	return req.BreakGlass.Validate()
 }
 
 // Sanitize sanitizes the CreateProjectRequest by trimming whitespace
@@ -238,6 +490,7 @@ func (req *CreateDataPlaneRequest) Sanitize() {
 func (req *PromoteComponentRequest) Sanitize() {
 	req.SourceEnvironment = strings.TrimSpace(req.SourceEnvironment)
 	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+	req.BreakGlass.Sanitize()
 }
 
 type BindingReleaseState string
@@ -296,6 +549,21 @@ type PatchReleaseBindingRequest struct {
 	// These values override the workload specification for this specific environment
 	// +optional
 	WorkloadOverrides *WorkloadOverrides `json:"workloadOverrides,omitempty"`
+
+	// BreakGlass applies the change even when the change calendar of the environment is closed
+	// +optional
+	BreakGlass *BreakGlassRequest `json:"breakGlass,omitempty"`
+}
+
+// PreviewReleaseBindingRequest represents the proposed changes to a ReleaseBinding to render without applying.
+// The embedded patch is applied the same way PatchReleaseBinding applies it, on top of the current binding.
+type PreviewReleaseBindingRequest struct {
+	PatchReleaseBindingRequest
+
+	// Parameters replaces the component parameters captured in the component release,
+	// previewing a change to the Component's parameters before a new release is created
+	// +optional
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 // WorkloadOverrides represents environment-specific workload overrides
@@ -41,6 +41,8 @@ type ProjectResponse struct {
 	CreatedAt          time.Time  `json:"createdAt"`
 	Status             string     `json:"status,omitempty"`
 	DeletionTimestamp  *time.Time `json:"deletionTimestamp,omitempty"`
+
+	ResourceUsage []openchoreov1alpha1.EnvironmentResourceUsage `json:"resourceUsage,omitempty"`
 }
 
 // ComponentResponse represents a component in API responses
@@ -327,6 +329,33 @@ type ReleaseBindingResponse struct {
 	WorkloadOverrides         *WorkloadOverrides     `json:"workloadOverrides,omitempty"`
 	CreatedAt                 time.Time              `json:"createdAt"`
 	Status                    string                 `json:"status,omitempty"`
+	History                   []ReleaseHistoryEntry  `json:"history,omitempty"`
+}
+
+// ReleaseHistoryEntry represents a release that was bound to a release binding
+type ReleaseHistoryEntry struct {
+	ReleaseName string    `json:"releaseName"`
+	BoundAt     time.Time `json:"boundAt"`
+	BoundBy     string    `json:"boundBy,omitempty"`
+	Ready       bool      `json:"ready"`
+}
+
+// PromotionRequestResponse represents a PromotionRequest in API responses
+type PromotionRequestResponse struct {
+	Name              string     `json:"name"`
+	ComponentName     string     `json:"componentName"`
+	ProjectName       string     `json:"projectName"`
+	OrgName           string     `json:"orgName"`
+	SourceEnvironment string     `json:"sourceEnv"`
+	TargetEnvironment string     `json:"targetEnv"`
+	ReleaseName       string     `json:"releaseName"`
+	RequestedBy       string     `json:"requestedBy,omitempty"`
+	Decision          string     `json:"decision,omitempty"`
+	DecidedBy         string     `json:"decidedBy,omitempty"`
+	DecidedAt         *time.Time `json:"decidedAt,omitempty"`
+	Comment           string     `json:"comment,omitempty"`
+	Phase             string     `json:"phase,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
 }
 
 // ReleaseResponse represents a Release in API responses
@@ -335,6 +364,74 @@ type ReleaseResponse struct {
 	Status openchoreov1alpha1.ReleaseStatus `json:"status"`
 }
 
+// ReleaseBindingPreviewResponse represents the resources a ReleaseBinding would render to
+// and how they differ from the Releases currently applied
+type ReleaseBindingPreviewResponse struct {
+	BindingName string                    `json:"bindingName"`
+	Environment string                    `json:"environment"`
+	ReleaseName string                    `json:"releaseName"`
+	Resources   []PreviewResource         `json:"resources"`
+	Diff        []ResourceDiff            `json:"diff"`
+	Summary     ReleaseBindingDiffSummary `json:"summary"`
+}
+
+// PreviewResource represents a rendered resource and the plane it targets
+type PreviewResource struct {
+	TargetPlane string         `json:"targetPlane"`
+	ID          string         `json:"id"`
+	Object      map[string]any `json:"object"`
+}
+
+// ResourceDiff represents a resource that would be added, removed or changed
+type ResourceDiff struct {
+	TargetPlane string        `json:"targetPlane"`
+	ID          string        `json:"id"`
+	Kind        string        `json:"kind"`
+	Name        string        `json:"name"`
+	Namespace   string        `json:"namespace,omitempty"`
+	Action      string        `json:"action"`
+	Changes     []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange represents a single changed field of a resource.
+// Current is omitted for added fields and Proposed for removed ones.
+type FieldChange struct {
+	Path     string `json:"path"`
+	Current  any    `json:"current,omitempty"`
+	Proposed any    `json:"proposed,omitempty"`
+}
+
+// ReleaseBindingDiffSummary counts the resources of a preview by diff action
+type ReleaseBindingDiffSummary struct {
+	Added     int `json:"added"`
+	Changed   int `json:"changed"`
+	Removed   int `json:"removed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// DriftReportResponse represents the drift of a component's resources in an environment
+type DriftReportResponse struct {
+	ComponentName string                    `json:"componentName"`
+	Environment   string                    `json:"environment"`
+	Policy        string                    `json:"policy"`
+	Drifted       bool                      `json:"drifted"`
+	Resources     []DriftedResourceResponse `json:"resources"`
+}
+
+// DriftedResourceResponse represents a resource whose live state differs from its Release
+type DriftedResourceResponse struct {
+	Release    string    `json:"release"`
+	ID         string    `json:"id"`
+	Group      string    `json:"group"`
+	Version    string    `json:"version"`
+	Kind       string    `json:"kind"`
+	Name       string    `json:"name"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Fields     []string  `json:"fields"`
+	DetectedAt time.Time `json:"detectedAt"`
+	Reverted   bool      `json:"reverted"`
+}
+
 // SecretReferenceResponse represents a SecretReference in API responses
 type SecretReferenceResponse struct {
 	Name            string                 `json:"name"`
@@ -387,6 +484,33 @@ type ObservabilityPlaneResponse struct {
 	Status      string    `json:"status,omitempty"`
 }
 
+// APITokenResponse represents an API token in API responses. The token itself is never returned after creation.
+// The entitlement values of a personal access token that carries all entitlements of its creator are empty.
+type APITokenResponse struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description,omitempty"`
+	Kind              string     `json:"kind"`
+	Subject           string     `json:"subject"`
+	SubjectType       string     `json:"subjectType"`
+	EntitlementClaim  string     `json:"entitlementClaim"`
+	EntitlementValues []string   `json:"entitlementValues,omitempty"`
+	Scopes            []string   `json:"scopes,omitempty"`
+	CreatedBy         string     `json:"createdBy"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	ExpiresAt         time.Time  `json:"expiresAt"`
+	LastUsedAt        *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt         *time.Time `json:"revokedAt,omitempty"`
+	// Status is active, expired or revoked
+	Status string `json:"status"`
+}
+
+// CreatedAPITokenResponse represents a newly created API token, the only response that carries the token
+type CreatedAPITokenResponse struct {
+	APITokenResponse
+	Token string `json:"token"`
+}
+
 // VersionResponse represents the server version information in API responses.
 type VersionResponse struct {
 	Name        string `json:"name"`
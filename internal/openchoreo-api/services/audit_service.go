@@ -0,0 +1,73 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// AuditService handles queries of the durable audit trail
+type AuditService struct {
+	store    audit.Store
+	logger   *slog.Logger
+	authzPDP authz.PDP
+}
+
+// NewAuditService creates a new audit service. The store may be nil when no queryable sink is configured.
+func NewAuditService(store audit.Store, logger *slog.Logger, authzPDP authz.PDP) *AuditService {
+	return &AuditService{
+		store:    store,
+		logger:   logger,
+		authzPDP: authzPDP,
+	}
+}
+
+// ListEvents returns the audit events matching the request, newest first
+func (s *AuditService) ListEvents(ctx context.Context, req *models.ListAuditEventsRequest) (*models.ListResponse[*audit.Event], error) {
+	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewAudit, ResourceTypeAudit, "*",
+		authz.ResourceHierarchy{}); err != nil {
+		return nil, err
+	}
+	if s.store == nil {
+		return nil, ErrAuditStoreNotConfigured
+	}
+
+	since, until, err := req.TimeRange()
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.store.Query(ctx, &audit.Query{
+		Actor:        req.Actor,
+		Action:       req.Action,
+		ResourceType: req.ResourceType,
+		ResourceName: req.ResourceName,
+		Result:       audit.Result(req.Result),
+		Since:        since,
+		Until:        until,
+		Limit:        req.Limit,
+		Continue:     req.Continue,
+	})
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidContinueToken) {
+			return nil, ErrInvalidContinueToken
+		}
+		return nil, fmt.Errorf("failed to query audit events: %w", err)
+	}
+
+	s.logger.Debug("Listed audit events", "count", len(result.Events), "hasMore", result.Continue != "")
+	return &models.ListResponse[*audit.Event]{
+		Items: result.Events,
+		Metadata: models.ResponseMetadata{
+			Continue: result.Continue,
+			HasMore:  result.Continue != "",
+		},
+	}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+// deploymentAttributes returns the attributes of deploying a component to an environment that conditions on
+// role mappings can refer to. Resources that cannot be read are left out: a condition that refers to them
+// fails to evaluate, which denies rather than grants access.
+func deploymentAttributes(ctx context.Context, k8sClient client.Client, orgName, componentName, environmentName string) map[string]interface{} {
+	attributes := map[string]interface{}{}
+
+	if componentName != "" {
+		var component openchoreov1alpha1.Component
+		if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err == nil {
+			attributes[authz.ContextAttributeComponent] = componentAttributes(&component)
+		}
+	}
+
+	if environmentName != "" {
+		var environment openchoreov1alpha1.Environment
+		if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environmentName}, &environment); err == nil {
+			attributes[authz.ContextAttributeEnvironment] = environmentAttributes(&environment)
+		}
+	}
+
+	return attributes
+}
+
+func componentAttributes(component *openchoreov1alpha1.Component) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          component.Name,
+		"project":       component.Spec.Owner.ProjectName,
+		"componentType": component.Spec.ComponentType,
+		"labels":        labelsOrEmpty(component.Labels),
+	}
+}
+
+func environmentAttributes(environment *openchoreov1alpha1.Environment) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         environment.Name,
+		"isProduction": environment.Spec.IsProduction,
+		"labels":       labelsOrEmpty(environment.Labels),
+	}
+}
+
+// labelsOrEmpty keeps label lookups in conditions from failing on resources without labels
+func labelsOrEmpty(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+	return labels
+}
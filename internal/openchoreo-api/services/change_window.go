@@ -0,0 +1,66 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/changewindow"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// checkChangeWindow refuses a change to an environment whose change calendar is closed and rejects
+// changes outside its windows. Calendars that queue changes accept them, and the ReleaseBinding
+// controller holds the rollout until the window opens. A break-glass override lets the change through
+// either way, for callers that may break glass on the environment.
+func (s *ComponentService) checkChangeWindow(ctx context.Context, orgName, environment string,
+	breakGlass *models.BreakGlassRequest) error {
+	if breakGlass != nil {
+		return checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionBreakGlassEnvironment, ResourceTypeEnvironment,
+			environment, authz.ResourceHierarchy{Namespace: orgName})
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environment}, env); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			// The ReleaseBinding controller reports bindings to missing environments
+			return nil
+		}
+		return fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	calendar := env.Spec.ChangeCalendar
+	if changewindow.Queues(calendar) {
+		return nil
+	}
+	decision, err := changewindow.Evaluate(calendar, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the change calendar of environment %q: %w", environment, err)
+	}
+	if decision.Open {
+		return nil
+	}
+
+	s.logger.Warn("Change refused outside the change window", "org", orgName, "environment", environment,
+		"reason", decision.Reason)
+	return fmt.Errorf("%w: %s", ErrChangeWindowClosed, decision.Message())
+}
+
+// applyBreakGlass records a break-glass override on the binding, so that the ReleaseBinding controller
+// rolls the change out while the environment's change calendar is closed. Without an override any
+// earlier one is removed.
+func applyBreakGlass(binding *openchoreov1alpha1.ReleaseBinding, breakGlass *models.BreakGlassRequest) {
+	var reason string
+	if breakGlass != nil {
+		reason = breakGlass.Reason
+	}
+	controller.SetBreakGlass(binding, reason)
+}
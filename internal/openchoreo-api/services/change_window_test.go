@@ -0,0 +1,77 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/authz"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func TestComponentService_CheckChangeWindow(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	now := time.Now()
+	freeze := []v1alpha1.BlackoutPeriod{{
+		Name:  "release-freeze",
+		Start: metav1.NewTime(now.Add(-time.Hour)),
+		End:   metav1.NewTime(now.Add(time.Hour)),
+	}}
+	environment := func(name string, action v1alpha1.ChangeWindowAction) *v1alpha1.Environment {
+		return &v1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+			Spec: v1alpha1.EnvironmentSpec{ChangeCalendar: &v1alpha1.ChangeCalendar{
+				Blackouts:           freeze,
+				OutsideWindowAction: action,
+			}},
+		}
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		environment("production", v1alpha1.ChangeWindowActionReject),
+		environment("staging", v1alpha1.ChangeWindowActionQueue),
+		&v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "development", Namespace: "acme"}},
+	).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewComponentService(k8sClient, nil, logger, authz.NewDisabledAuthorizer(logger))
+	ctx := context.Background()
+
+	if err := svc.checkChangeWindow(ctx, "acme", "production", nil); !errors.Is(err, ErrChangeWindowClosed) {
+		t.Errorf("expected ErrChangeWindowClosed during a blackout, got %v", err)
+	}
+	breakGlass := &models.BreakGlassRequest{Reason: "checkout outage"}
+	if err := svc.checkChangeWindow(ctx, "acme", "production", breakGlass); err != nil {
+		t.Errorf("expected a break-glass override to be allowed, got %v", err)
+	}
+	if err := svc.checkChangeWindow(ctx, "acme", "staging", nil); err != nil {
+		t.Errorf("expected a queueing calendar to accept the change, got %v", err)
+	}
+	if err := svc.checkChangeWindow(ctx, "acme", "development", nil); err != nil {
+		t.Errorf("expected an environment without a calendar to accept the change, got %v", err)
+	}
+
+	binding := &v1alpha1.ReleaseBinding{}
+	applyBreakGlass(binding, breakGlass)
+	if got := controller.GetBreakGlass(binding); got != "checkout outage" {
+		t.Errorf("expected the break-glass reason on the binding, got %q", got)
+	}
+	applyBreakGlass(binding, nil)
+	if got := controller.GetBreakGlass(binding); got != "" {
+		t.Errorf("expected the break-glass override to be removed, got %q", got)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
 )
 
@@ -43,6 +44,7 @@ type ComponentService struct {
 	k8sClient           client.Client
 	projectService      *ProjectService
 	specFetcherRegistry *ComponentSpecFetcherRegistry
+	renderPipeline      *componentpipeline.Pipeline
 	logger              *slog.Logger
 	authzPDP            authz.PDP
 }
@@ -105,6 +107,7 @@ func NewComponentService(k8sClient client.Client, projectService *ProjectService
 		k8sClient:           k8sClient,
 		projectService:      projectService,
 		specFetcherRegistry: NewComponentSpecFetcherRegistry(),
+		renderPipeline:      componentpipeline.NewPipeline(),
 		logger:              logger,
 		authzPDP:            authzPDP,
 	}
@@ -205,22 +208,36 @@ func (s *ComponentService) CreateComponentRelease(ctx context.Context, orgName,
 		}
 	}
 
-	traits := make(map[string]openchoreov1alpha1.TraitSpec)
+	// Collect the traits of the component and the traits attached by its ComponentType
+	traitNames := make([]string, 0, len(component.Spec.Traits))
 	for _, componentTrait := range component.Spec.Traits {
+		traitNames = append(traitNames, componentTrait.Name)
+	}
+	if componentTypeSpec != nil {
+		for _, platformTrait := range componentTypeSpec.Traits {
+			traitNames = append(traitNames, platformTrait.Name)
+		}
+	}
+
+	traits := make(map[string]openchoreov1alpha1.TraitSpec)
+	for _, traitName := range traitNames {
+		if _, ok := traits[traitName]; ok {
+			continue
+		}
 		traitKey := client.ObjectKey{
-			Name:      componentTrait.Name,
+			Name:      traitName,
 			Namespace: orgName,
 		}
 		trait := &openchoreov1alpha1.Trait{}
 		if err := s.k8sClient.Get(ctx, traitKey, trait); err != nil {
 			if client.IgnoreNotFound(err) == nil {
-				s.logger.Warn("Trait not found", "trait", componentTrait.Name)
+				s.logger.Warn("Trait not found", "trait", traitName)
 			} else {
 				s.logger.Error("Failed to get Trait", "error", err)
 			}
 			continue
 		}
-		traits[componentTrait.Name] = trait.Spec
+		traits[traitName] = trait.Spec
 	}
 
 	// Build ComponentProfile from Component parameters
@@ -232,6 +249,7 @@ func (s *ComponentService) CreateComponentRelease(ctx context.Context, orgName,
 	if component.Spec.Traits != nil {
 		componentProfile.Traits = component.Spec.Traits
 	}
+	componentProfile.DisabledTraits = component.Spec.DisabledTraits
 
 	// Build workload template spec from workload spec
 	workloadTemplateSpec := openchoreov1alpha1.WorkloadTemplateSpec{
@@ -750,6 +768,90 @@ func (s *ComponentService) GetEnvironmentRelease(ctx context.Context, orgName, p
 	}, nil
 }
 
+// GetComponentDrift reports the resources of a component in an environment whose live state
+// has drifted from the Releases that manage them
+func (s *ComponentService) GetComponentDrift(ctx context.Context, orgName, projectName, componentName, environmentName string) (*models.DriftReportResponse, error) {
+	s.logger.Debug("Getting component drift", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
+
+	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewComponent, ResourceTypeComponent, componentName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
+		return nil, err
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	var environment openchoreov1alpha1.Environment
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environmentName}, &environment); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Environment not found", "org", orgName, "environment", environmentName)
+			return nil, ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	policy := environment.Spec.DriftPolicy
+	if policy == "" {
+		policy = openchoreov1alpha1.DriftPolicyAutoHeal
+	}
+
+	var releaseList openchoreov1alpha1.ReleaseList
+	if err := s.k8sClient.List(ctx, &releaseList, client.InNamespace(orgName), client.MatchingLabels{
+		labels.LabelKeyOrganizationName: orgName,
+		labels.LabelKeyProjectName:      projectName,
+		labels.LabelKeyComponentName:    componentName,
+		labels.LabelKeyEnvironmentName:  environmentName,
+	}); err != nil {
+		s.logger.Error("Failed to list releases", "error", err)
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	if len(releaseList.Items) == 0 {
+		s.logger.Warn("No release found", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName)
+		return nil, ErrReleaseNotFound
+	}
+
+	report := &models.DriftReportResponse{
+		ComponentName: componentName,
+		Environment:   environmentName,
+		Policy:        string(policy),
+		Resources:     make([]models.DriftedResourceResponse, 0),
+	}
+	for _, release := range releaseList.Items {
+		for _, resource := range release.Status.Resources {
+			if resource.Drift == nil {
+				continue
+			}
+			report.Resources = append(report.Resources, models.DriftedResourceResponse{
+				Release:    release.Name,
+				ID:         resource.ID,
+				Group:      resource.Group,
+				Version:    resource.Version,
+				Kind:       resource.Kind,
+				Name:       resource.Name,
+				Namespace:  resource.Namespace,
+				Fields:     resource.Drift.Fields,
+				DetectedAt: resource.Drift.DetectedAt.Time,
+				Reverted:   resource.Drift.Reverted,
+			})
+		}
+	}
+	report.Drifted = len(report.Resources) > 0
+
+	s.logger.Debug("Retrieved component drift successfully", "org", orgName, "project", projectName, "component", componentName, "environment", environmentName, "driftedResources", len(report.Resources))
+	return report, nil
+}
+
 // convertEnvVars converts environment variables from the request model to the CR model
 func (s *ComponentService) convertEnvVars(envVars []models.EnvVar) []openchoreov1alpha1.EnvVar {
 	result := make([]openchoreov1alpha1.EnvVar, len(envVars))
@@ -833,12 +935,6 @@ func (s *ComponentService) applyWorkloadOverrides(binding *openchoreov1alpha1.Re
 func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (*models.ReleaseBindingResponse, error) {
 	s.logger.Debug("Patching release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 
-	// Authorization check
-	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionUpdateReleaseBinding, ResourceTypeReleaseBinding, bindingName,
-		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
-		return nil, err
-	}
-
 	_, err := s.projectService.getProject(ctx, orgName, projectName)
 	if err != nil {
 		if errors.Is(err, ErrProjectNotFound) {
@@ -880,6 +976,17 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		return nil, fmt.Errorf("failed to get release binding: %w", err)
 	}
 
+	// Authorization check, done once the binding's environment is known so that conditions can refer to it
+	environment := binding.Spec.Environment
+	if err != nil {
+		environment = req.Environment
+	}
+	if authzErr := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionUpdateReleaseBinding, ResourceTypeReleaseBinding, bindingName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName},
+		deploymentAttributes(ctx, s.k8sClient, orgName, componentName, environment)); authzErr != nil {
+		return nil, authzErr
+	}
+
 	// Handle binding not found - create new one
 	if err != nil {
 		bindingExists = false
@@ -909,7 +1016,16 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		}
 
 		if req.ReleaseName != "" {
+			requiresApproval, err := s.environmentRequiresApproval(ctx, orgName, projectName, req.Environment)
+			if err != nil {
+				return nil, err
+			}
+			if requiresApproval {
+				s.logger.Warn("Binding a release to this environment requires approval", "org", orgName, "environment", req.Environment)
+				return nil, ErrPromotionApprovalRequired
+			}
 			binding.Spec.ReleaseName = req.ReleaseName
+			controller.SetBoundBy(&binding, subjectIDFromContext(ctx))
 		}
 	}
 
@@ -919,29 +1035,15 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		return nil, ErrReleaseBindingNotFound
 	}
 
-	if req.ComponentTypeEnvOverrides != nil {
-		overridesJSON, err := json.Marshal(req.ComponentTypeEnvOverrides)
-		if err != nil {
-			s.logger.Error("Failed to marshal component type env overrides", "error", err)
-			return nil, fmt.Errorf("failed to marshal component type env overrides: %w", err)
-		}
-		binding.Spec.ComponentTypeEnvOverrides = &runtime.RawExtension{Raw: overridesJSON}
+	if err := s.checkChangeWindow(ctx, orgName, binding.Spec.Environment, req.BreakGlass); err != nil {
+		return nil, err
 	}
+	applyBreakGlass(&binding, req.BreakGlass)
 
-	if req.TraitOverrides != nil {
-		binding.Spec.TraitOverrides = make(map[string]runtime.RawExtension)
-		for instanceName, overrides := range req.TraitOverrides {
-			overridesJSON, err := json.Marshal(overrides)
-			if err != nil {
-				s.logger.Error("Failed to marshal trait overrides", "error", err, "instanceName", instanceName)
-				return nil, fmt.Errorf("failed to marshal trait overrides for %s: %w", instanceName, err)
-			}
-			binding.Spec.TraitOverrides[instanceName] = runtime.RawExtension{Raw: overridesJSON}
-		}
+	if err := s.applyReleaseBindingOverrides(&binding, req); err != nil {
+		return nil, err
 	}
 
-	s.applyWorkloadOverrides(&binding, req)
-
 	// Create or update the binding
 	if bindingExists {
 		if err := s.k8sClient.Update(ctx, &binding); err != nil {
@@ -960,6 +1062,33 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 	return s.toReleaseBindingResponse(&binding, orgName, projectName, componentName), nil
 }
 
+// applyReleaseBindingOverrides sets the overrides given in the patch request on the binding
+func (s *ComponentService) applyReleaseBindingOverrides(binding *openchoreov1alpha1.ReleaseBinding, req *models.PatchReleaseBindingRequest) error {
+	if req.ComponentTypeEnvOverrides != nil {
+		overridesJSON, err := json.Marshal(req.ComponentTypeEnvOverrides)
+		if err != nil {
+			s.logger.Error("Failed to marshal component type env overrides", "error", err)
+			return fmt.Errorf("failed to marshal component type env overrides: %w", err)
+		}
+		binding.Spec.ComponentTypeEnvOverrides = &runtime.RawExtension{Raw: overridesJSON}
+	}
+
+	if req.TraitOverrides != nil {
+		binding.Spec.TraitOverrides = make(map[string]runtime.RawExtension)
+		for instanceName, overrides := range req.TraitOverrides {
+			overridesJSON, err := json.Marshal(overrides)
+			if err != nil {
+				s.logger.Error("Failed to marshal trait overrides", "error", err, "instanceName", instanceName)
+				return fmt.Errorf("failed to marshal trait overrides for %s: %w", instanceName, err)
+			}
+			binding.Spec.TraitOverrides[instanceName] = runtime.RawExtension{Raw: overridesJSON}
+		}
+	}
+
+	s.applyWorkloadOverrides(binding, req)
+	return nil
+}
+
 // toReleaseBindingResponse converts a ReleaseBinding CR to a ReleaseBindingResponse
 func (s *ComponentService) toReleaseBindingResponse(binding *openchoreov1alpha1.ReleaseBinding, orgName, projectName, componentName string) *models.ReleaseBindingResponse {
 	response := &models.ReleaseBindingResponse{
@@ -976,6 +1105,15 @@ func (s *ComponentService) toReleaseBindingResponse(binding *openchoreov1alpha1.
 	// Determine status from conditions
 	response.Status = s.determineReleaseBindingStatus(binding)
 
+	for _, entry := range binding.Status.History {
+		response.History = append(response.History, models.ReleaseHistoryEntry{
+			ReleaseName: entry.ReleaseName,
+			BoundAt:     entry.BoundAt.Time,
+			BoundBy:     entry.BoundBy,
+			Ready:       entry.Ready,
+		})
+	}
+
 	if binding.Spec.ComponentTypeEnvOverrides != nil {
 		var overrides map[string]interface{}
 		if err := json.Unmarshal(binding.Spec.ComponentTypeEnvOverrides.Raw, &overrides); err == nil {
@@ -1191,12 +1329,6 @@ func (s *ComponentService) ListReleaseBindings(ctx context.Context, orgName, pro
 func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest) (*models.ReleaseBindingResponse, error) {
 	s.logger.Debug("Deploying release", "org", orgName, "project", projectName, "component", componentName, "release", req.ReleaseName)
 
-	// Authorization check
-	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionDeployComponent, ResourceTypeComponent, componentName,
-		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
-		return nil, err
-	}
-
 	project, err := s.projectService.getProject(ctx, orgName, projectName)
 	if err != nil {
 		if errors.Is(err, ErrProjectNotFound) {
@@ -1230,6 +1362,13 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 
 	s.logger.Debug("Found lowest environment", "environment", lowestEnv)
 
+	// Authorization check, done once the target environment is known so that conditions can refer to it
+	if err := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionDeployComponent, ResourceTypeComponent, componentName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName},
+		deploymentAttributes(ctx, s.k8sClient, orgName, componentName, lowestEnv)); err != nil {
+		return nil, err
+	}
+
 	// Verify component exists
 	componentKey := client.ObjectKey{
 		Namespace: orgName,
@@ -1269,6 +1408,10 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 		return nil, ErrComponentReleaseNotFound
 	}
 
+	if err := s.checkChangeWindow(ctx, orgName, lowestEnv, req.BreakGlass); err != nil {
+		return nil, err
+	}
+
 	bindingName := fmt.Sprintf("%s-%s", componentName, lowestEnv)
 	bindingKey := client.ObjectKey{
 		Namespace: orgName,
@@ -1289,6 +1432,8 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 	if bindingExists {
 		s.logger.Debug("Updating existing release binding", "binding", bindingName)
 		binding.Spec.ReleaseName = req.ReleaseName
+		controller.SetBoundBy(&binding, subjectIDFromContext(ctx))
+		applyBreakGlass(&binding, req.BreakGlass)
 		if err := s.k8sClient.Update(ctx, &binding); err != nil {
 			s.logger.Error("Failed to update release binding", "error", err)
 			return nil, fmt.Errorf("failed to update release binding: %w", err)
@@ -1313,6 +1458,8 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 				ReleaseName: req.ReleaseName,
 			},
 		}
+		controller.SetBoundBy(&binding, subjectIDFromContext(ctx))
+		applyBreakGlass(&binding, req.BreakGlass)
 		if err := s.k8sClient.Create(ctx, &binding); err != nil {
 			s.logger.Error("Failed to create release binding", "error", err)
 			return nil, fmt.Errorf("failed to create release binding: %w", err)
@@ -1323,6 +1470,107 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 	return s.toReleaseBindingResponse(&binding, orgName, projectName, componentName), nil
 }
 
+// RollbackReleaseBinding points a release binding back at a release it was bound to before.
+// Without a release name it picks the most recent earlier release that reached Ready.
+// Environments that require approval are not rolled back directly: the caller is pointed at
+// raising a PromotionRequest for the earlier release instead.
+func (s *ComponentService) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.RollbackReleaseBindingRequest) (*models.ReleaseBindingResponse, error) {
+	s.logger.Debug("Rolling back release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	var binding openchoreov1alpha1.ReleaseBinding
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: bindingName}, &binding); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Release binding not found", "org", orgName, "binding", bindingName)
+			return nil, ErrReleaseBindingNotFound
+		}
+		s.logger.Error("Failed to get release binding", "error", err)
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	if binding.Spec.Owner.ProjectName != projectName || binding.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release binding does not belong to component", "org", orgName, "component", componentName, "binding", bindingName)
+		return nil, ErrReleaseBindingNotFound
+	}
+
+	// Authorization check, done once the binding's environment is known so that conditions can refer to it
+	if err := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionUpdateReleaseBinding, ResourceTypeReleaseBinding, bindingName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName},
+		deploymentAttributes(ctx, s.k8sClient, orgName, componentName, binding.Spec.Environment)); err != nil {
+		return nil, err
+	}
+
+	target, err := selectRollbackTarget(&binding, req.ReleaseName)
+	if err != nil {
+		s.logger.Warn("Cannot roll back release binding", "binding", bindingName, "release", req.ReleaseName, "error", err)
+		return nil, err
+	}
+
+	var release openchoreov1alpha1.ComponentRelease
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: target}, &release); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component release not found", "org", orgName, "release", target)
+			return nil, ErrComponentReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+
+	if release.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release does not belong to component", "component", componentName, "release", target)
+		return nil, ErrComponentReleaseNotFound
+	}
+
+	// A rollback moves the binding like any other release change, so environments gated
+	// behind an approval need a PromotionRequest for the earlier release instead
+	requiresApproval, err := s.environmentRequiresApproval(ctx, orgName, projectName, binding.Spec.Environment)
+	if err != nil {
+		return nil, err
+	}
+	if requiresApproval {
+		s.logger.Warn("Rolling back in this environment requires approval", "org", orgName, "environment", binding.Spec.Environment)
+		return nil, fmt.Errorf("%w: raise a promotion request for release %q to environment %q to roll back",
+			ErrPromotionApprovalRequired, target, binding.Spec.Environment)
+	}
+
+	if err := s.checkChangeWindow(ctx, orgName, binding.Spec.Environment, req.BreakGlass); err != nil {
+		return nil, err
+	}
+	applyBreakGlass(&binding, req.BreakGlass)
+
+	binding.Spec.ReleaseName = target
+	controller.SetBoundBy(&binding, subjectIDFromContext(ctx))
+	if err := s.k8sClient.Update(ctx, &binding); err != nil {
+		s.logger.Error("Failed to update release binding", "error", err)
+		return nil, fmt.Errorf("failed to update release binding: %w", err)
+	}
+
+	s.logger.Debug("Release binding rolled back", "org", orgName, "binding", bindingName, "release", target)
+	return s.toReleaseBindingResponse(&binding, orgName, projectName, componentName), nil
+}
+
+// selectRollbackTarget picks the release a binding rolls back to from its history
+func selectRollbackTarget(binding *openchoreov1alpha1.ReleaseBinding, releaseName string) (string, error) {
+	current := binding.Spec.ReleaseName
+	for _, entry := range binding.Status.History {
+		if entry.ReleaseName == current {
+			continue
+		}
+		if releaseName != "" {
+			if entry.ReleaseName == releaseName {
+				return releaseName, nil
+			}
+			continue
+		}
+		if entry.Ready {
+			return entry.ReleaseName, nil
+		}
+	}
+	if releaseName != "" {
+		return "", ErrReleaseNotInHistory
+	}
+	return "", ErrNoRollbackTarget
+}
+
 // findLowestEnvironment finds the lowest environment in the deployment pipeline
 // The lowest environment is one that is not a target in any promotion path
 func (s *ComponentService) findLowestEnvironment(promotionPaths []openchoreov1alpha1.PromotionPath) string {
@@ -1387,6 +1635,12 @@ func (s *ComponentService) CreateComponent(ctx context.Context, orgName, project
 		return nil, ErrComponentAlreadyExists
 	}
 
+	// New components need budget left in the project's quota
+	if err := s.checkProjectBudget(ctx, orgName, projectName); err != nil {
+		s.logger.Warn("Project quota exhausted", "org", orgName, "project", projectName, "error", err)
+		return nil, err
+	}
+
 	// Create the component and related resources
 	component, err := s.createComponentResources(ctx, orgName, projectName, req)
 	if err != nil {
@@ -2075,13 +2329,27 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 	s.logger.Debug("Promoting component", "org", req.OrgName, "project", req.ProjectName, "component", req.ComponentName,
 		"source", req.SourceEnvironment, "target", req.TargetEnvironment)
 
-	// Authorization check (promote uses same permission as deploy)
-	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionDeployComponent, ResourceTypeComponent, req.ComponentName,
-		authz.ResourceHierarchy{Namespace: req.OrgName, Project: req.ProjectName, Component: req.ComponentName}); err != nil {
+	// Authorization check (promote uses same permission as deploy), with the target environment as an attribute
+	// so that mappings can, for example, allow promotions to non-production environments only
+	if err := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionDeployComponent, ResourceTypeComponent, req.ComponentName,
+		authz.ResourceHierarchy{Namespace: req.OrgName, Project: req.ProjectName, Component: req.ComponentName},
+		deploymentAttributes(ctx, s.k8sClient, req.OrgName, req.ComponentName, req.TargetEnvironment)); err != nil {
 		return nil, err
 	}
 
-	if err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+	target, err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	// Environments gated behind an approval are only promoted through a PromotionRequest
+	if targetRequiresApproval(target) {
+		s.logger.Warn("Promotion requires approval", "org", req.OrgName, "project", req.ProjectName, "component", req.ComponentName,
+			"target", req.TargetEnvironment)
+		return nil, ErrPromotionApprovalRequired
+	}
+
+	if err := s.checkChangeWindow(ctx, req.OrgName, req.TargetEnvironment, req.BreakGlass); err != nil {
 		return nil, err
 	}
 
@@ -2103,11 +2371,42 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 }
 
 // validatePromotionPath validates that the promotion path is allowed by the deployment pipeline
-func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) error {
+// and returns the matching target environment reference
+func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) (*openchoreov1alpha1.TargetEnvironmentRef, error) {
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Promotion paths", "promotionPaths", pipeline.Spec.PromotionPaths)
+
+	// Check if the promotion path is valid
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef == sourceEnv {
+			s.logger.Info("Source environment", "source", sourceEnv)
+			for i := range path.TargetEnvironmentRefs {
+				target := &path.TargetEnvironmentRefs[i]
+				s.logger.Info("Target environment", "target", target.Name)
+				if target.Name == targetEnv {
+					s.logger.Info("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
+					s.logger.Debug("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
+					return target, nil
+				}
+			}
+		}
+	}
+
+	s.logger.Warn("Invalid promotion path", "source", sourceEnv, "target", targetEnv, "pipeline", pipeline.Name)
+	return nil, ErrInvalidPromotionPath
+}
+
+// getProjectDeploymentPipeline returns the deployment pipeline referenced by the project,
+// falling back to the default pipeline when the project does not reference one
+func (s *ComponentService) getProjectDeploymentPipeline(ctx context.Context, orgName, projectName string) (*openchoreov1alpha1.DeploymentPipeline, error) {
 	// Get the project to determine the deployment pipeline reference
 	project, err := s.projectService.getProject(ctx, orgName, projectName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var pipelineName string
@@ -2117,7 +2416,6 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 		pipelineName = defaultPipeline
 	}
 
-	// Get the deployment pipeline
 	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
 	key := client.ObjectKey{
 		Name:      pipelineName,
@@ -2126,30 +2424,35 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 
 	if err := s.k8sClient.Get(ctx, key, pipeline); err != nil {
 		if client.IgnoreNotFound(err) == nil {
-			return ErrDeploymentPipelineNotFound
+			return nil, ErrDeploymentPipelineNotFound
 		}
-		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
 
-	s.logger.Info("Promotion paths", "promotionPaths", pipeline.Spec.PromotionPaths)
+	return pipeline, nil
+}
+
+// environmentRequiresApproval reports whether any promotion path of the project's deployment
+// pipeline declares that releases entering the given environment need approval
+func (s *ComponentService) environmentRequiresApproval(ctx context.Context, orgName, projectName, environment string) (bool, error) {
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, projectName)
+	if err != nil {
+		return false, err
+	}
 
-	// Check if the promotion path is valid
 	for _, path := range pipeline.Spec.PromotionPaths {
-		if path.SourceEnvironmentRef == sourceEnv {
-			s.logger.Info("Source environment", "source", sourceEnv)
-			for _, target := range path.TargetEnvironmentRefs {
-				s.logger.Info("Target environment", "target", target.Name)
-				if target.Name == targetEnv {
-					s.logger.Info("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
-					s.logger.Debug("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
-					return nil
-				}
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name == environment && targetRequiresApproval(&target) {
+				return true, nil
 			}
 		}
 	}
+	return false, nil
+}
 
-	s.logger.Warn("Invalid promotion path", "source", sourceEnv, "target", targetEnv, "pipeline", pipelineName)
-	return ErrInvalidPromotionPath
+// targetRequiresApproval reports whether the pipeline target is gated behind an approval
+func targetRequiresApproval(target *openchoreov1alpha1.TargetEnvironmentRef) bool {
+	return target.RequiresApproval || target.IsManualApprovalRequired
 }
 
 // getReleaseBinding retrieves a ReleaseBinding for a component in a specific environment
@@ -2223,6 +2526,8 @@ func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req
 		targetBinding = existingTargetBinding
 		targetBinding.Spec.ReleaseName = sourceBinding.Spec.ReleaseName
 	}
+	controller.SetBoundBy(targetBinding, subjectIDFromContext(ctx))
+	applyBreakGlass(targetBinding, req.BreakGlass)
 
 	if existingTargetBinding == nil {
 		// Create new binding
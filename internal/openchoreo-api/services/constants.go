@@ -21,6 +21,10 @@ const (
 	SystemActionUpdateReleaseBinding systemAction = "releasebinding:update"
 	SystemActionViewReleaseBinding   systemAction = "releasebinding:view"
 
+	SystemActionCreatePromotionRequest  systemAction = "promotionrequest:create"
+	SystemActionViewPromotionRequest    systemAction = "promotionrequest:view"
+	SystemActionApprovePromotionRequest systemAction = "promotionrequest:approve"
+
 	SystemActionCreateWorkload systemAction = "workload:create"
 	SystemActionViewWorkload   systemAction = "workload:view"
 
@@ -43,8 +47,9 @@ const (
 
 	SystemActionViewBuildPlane systemAction = "buildplane:view"
 
-	SystemActionCreateEnvironment systemAction = "environment:create"
-	SystemActionViewEnvironment   systemAction = "environment:view"
+	SystemActionCreateEnvironment     systemAction = "environment:create"
+	SystemActionViewEnvironment       systemAction = "environment:view"
+	SystemActionBreakGlassEnvironment systemAction = "environment:breakglass"
 
 	SystemActionViewDeploymentPipeline systemAction = "deploymentpipeline:view"
 
@@ -55,6 +60,12 @@ const (
 	SystemActionViewComponentWorkflowRun systemAction = "componentworkflowrun:view"
 
 	SystemActionViewSecretReference systemAction = "secretreference:view"
+
+	SystemActionViewAudit systemAction = "audit:view"
+
+	SystemActionViewAPIKey   systemAction = "apikey:view"
+	SystemActionCreateAPIKey systemAction = "apikey:create"
+	SystemActionRevokeAPIKey systemAction = "apikey:revoke"
 )
 
 type ResourceType string
@@ -64,6 +75,7 @@ const (
 	ResourceTypeComponent            ResourceType = "component"
 	ResourceTypeComponentRelease     ResourceType = "componentRelease"
 	ResourceTypeReleaseBinding       ResourceType = "releaseBinding"
+	ResourceTypePromotionRequest     ResourceType = "promotionRequest"
 	ResourceTypeWorkload             ResourceType = "workload"
 	ResourceTypeNamespace            ResourceType = "namespace"
 	ResourceTypeRole                 ResourceType = "role"
@@ -78,4 +90,6 @@ const (
 	ResourceTypeComponentWorkflow    ResourceType = "componentWorkflow"
 	ResourceTypeComponentWorkflowRun ResourceType = "componentWorkflowRun"
 	ResourceTypeSecretReference      ResourceType = "secretReference"
+	ResourceTypeAudit                ResourceType = "audit"
+	ResourceTypeAPIKey               ResourceType = "apiKey"
 )
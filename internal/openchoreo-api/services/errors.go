@@ -31,18 +31,37 @@ var (
 	ErrBindingNotFound              = errors.New("binding not found")
 	ErrDeploymentPipelineNotFound   = errors.New("deployment pipeline not found")
 	ErrInvalidPromotionPath         = errors.New("invalid promotion path")
+	ErrPromotionApprovalRequired    = errors.New("promotion to the target environment requires approval")
+	ErrPromotionRequestNotFound     = errors.New("promotion request not found")
+	ErrChangeWindowClosed           = errors.New("the target environment is outside its change window")
+	ErrProjectQuotaExhausted        = errors.New("the project has used up its resource quota")
+	ErrPromotionRequestExists       = errors.New("a pending promotion request already exists for the target environment")
+	ErrPromotionAlreadyDecided      = errors.New("promotion request has already been approved or rejected")
+	ErrSelfApprovalNotAllowed       = errors.New("a promotion request cannot be approved by its requester")
 	ErrWorkflowNotFound             = errors.New("workflow not found")
 	ErrComponentWorkflowNotFound    = errors.New("component workflow not found")
 	ErrComponentWorkflowRunNotFound = errors.New("component workflow run not found")
 	ErrWorkloadNotFound             = errors.New("workload not found")
 	ErrComponentReleaseNotFound     = errors.New("component release not found")
 	ErrReleaseBindingNotFound       = errors.New("release binding not found")
+	ErrNoRollbackTarget             = errors.New("release binding has no previous release to roll back to")
+	ErrReleaseNotInHistory          = errors.New("release is not in the release binding history")
+	ErrReleaseBindingRenderFailed   = errors.New("failed to render release binding")
 	ErrWorkflowSchemaInvalid        = errors.New("workflow schema is invalid")
 	ErrReleaseNotFound              = errors.New("release not found")
 	ErrInvalidCommitSHA             = errors.New("invalid commit SHA format")
 	ErrForbidden                    = errors.New("insufficient permissions to perform this action")
 	ErrDuplicateTraitInstanceName   = errors.New("duplicate trait instance name")
 	ErrInvalidTraitInstance         = errors.New("invalid trait instance")
+	ErrAuditStoreNotConfigured      = errors.New("no queryable audit sink is configured")
+	ErrAPITokensNotEnabled          = errors.New("API tokens are not enabled, they require authorization to be enabled")
+	ErrServiceKeysNotConfigured     = errors.New("service account keys are not configured, no user type has an api_key auth mechanism")
+	ErrAPITokenNotFound             = errors.New("API token not found")
+	ErrAPITokenCreatedWithToken     = errors.New("API tokens cannot be created with an API token")
+	ErrAPITokenEntitlementNotHeld   = errors.New("a personal access token can only carry entitlements of its creator")
+	ErrAPITokenLifetimeExceeded     = errors.New("requested lifetime exceeds the maximum API token lifetime")
+	ErrAPIKeyExceedsCaller          = errors.New("a service account key can only be created by a subject holding every permission of the service account")
+	ErrInvalidAPITokenKind          = errors.New("invalid API token kind")
 
 	// Continue token errors
 	ErrContinueTokenExpired = errors.New("continue token has expired - please restart the list operation from the beginning")
@@ -67,12 +86,22 @@ const (
 	CodeBindingNotFound              = "BINDING_NOT_FOUND"
 	CodeDeploymentPipelineNotFound   = "DEPLOYMENT_PIPELINE_NOT_FOUND"
 	CodeInvalidPromotionPath         = "INVALID_PROMOTION_PATH"
+	CodePromotionApprovalRequired    = "PROMOTION_APPROVAL_REQUIRED"
+	CodePromotionRequestNotFound     = "PROMOTION_REQUEST_NOT_FOUND"
+	CodeChangeWindowClosed           = "CHANGE_WINDOW_CLOSED"
+	CodeProjectQuotaExhausted        = "PROJECT_QUOTA_EXHAUSTED"
+	CodePromotionRequestExists       = "PROMOTION_REQUEST_EXISTS"
+	CodePromotionAlreadyDecided      = "PROMOTION_ALREADY_DECIDED"
+	CodeSelfApprovalNotAllowed       = "SELF_APPROVAL_NOT_ALLOWED"
 	CodeWorkflowNotFound             = "WORKFLOW_NOT_FOUND"
 	CodeComponentWorkflowNotFound    = "COMPONENT_WORKFLOW_NOT_FOUND"
 	CodeComponentWorkflowRunNotFound = "COMPONENT_WORKFLOW_RUN_NOT_FOUND"
 	CodeWorkloadNotFound             = "WORKLOAD_NOT_FOUND"
 	CodeComponentReleaseNotFound     = "COMPONENT_RELEASE_NOT_FOUND"
 	CodeReleaseBindingNotFound       = "RELEASE_BINDING_NOT_FOUND"
+	CodeNoRollbackTarget             = "NO_ROLLBACK_TARGET"
+	CodeReleaseNotInHistory          = "RELEASE_NOT_IN_HISTORY"
+	CodeReleaseBindingRenderFailed   = "RELEASE_BINDING_RENDER_FAILED"
 	CodeReleaseNotFound              = "RELEASE_NOT_FOUND"
 	CodeInvalidInput                 = "INVALID_INPUT"
 	CodeConflict                     = "CONFLICT"
@@ -84,6 +113,9 @@ const (
 	CodeInvalidParams                = "INVALID_PARAMS"
 	CodeDuplicateTraitInstanceName   = "DUPLICATE_TRAIT_INSTANCE_NAME"
 	CodeInvalidTraitInstance         = "INVALID_TRAIT_INSTANCE"
+	CodeAuditStoreNotConfigured      = "AUDIT_STORE_NOT_CONFIGURED"
+	CodeAPITokensNotEnabled          = "API_TOKENS_NOT_ENABLED"
+	CodeAPITokenNotFound             = "API_TOKEN_NOT_FOUND"
 
 	// Continue token error codes
 	CodeContinueTokenExpired = "CONTINUE_TOKEN_EXPIRED" // HTTP 410
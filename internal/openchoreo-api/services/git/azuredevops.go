@@ -0,0 +1,90 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AzureDevOpsProvider implements the Provider interface for Azure DevOps Repos
+type AzureDevOpsProvider struct {
+}
+
+// NewAzureDevOpsProvider creates a new Azure DevOps provider
+func NewAzureDevOpsProvider() *AzureDevOpsProvider {
+	return &AzureDevOpsProvider{}
+}
+
+// ValidateWebhookPayload validates the Azure DevOps service hook credentials.
+// Azure DevOps does not sign payloads; service hooks authenticate with HTTP basic auth,
+// so the password configured on the subscription must match the webhook secret.
+func (p *AzureDevOpsProvider) ValidateWebhookPayload(payload []byte, authorization, secret string) error {
+	if authorization == "" {
+		return fmt.Errorf("missing Authorization header")
+	}
+
+	encoded, ok := strings.CutPrefix(authorization, "Basic ")
+	if !ok {
+		return fmt.Errorf("invalid authorization scheme")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("invalid authorization header: %w", err)
+	}
+
+	_, password, _ := strings.Cut(string(decoded), ":")
+	if subtle.ConstantTimeCompare([]byte(password), []byte(secret)) != 1 {
+		return fmt.Errorf("invalid webhook credentials")
+	}
+
+	return nil
+}
+
+// ParseWebhookPayload parses an Azure DevOps "git.push" service hook payload
+func (p *AzureDevOpsProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	var adoPayload struct {
+		EventType string `json:"eventType"`
+		Resource  struct {
+			RefUpdates []struct {
+				Name        string `json:"name"`
+				NewObjectID string `json:"newObjectId"`
+			} `json:"refUpdates"`
+			Repository struct {
+				RemoteURL string `json:"remoteUrl"`
+			} `json:"repository"`
+		} `json:"resource"`
+	}
+
+	if err := json.Unmarshal(payload, &adoPayload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Azure DevOps payload: %w", err)
+	}
+
+	if adoPayload.EventType != "git.push" {
+		return nil, fmt.Errorf("unsupported Azure DevOps event type: %s", adoPayload.EventType)
+	}
+
+	if len(adoPayload.Resource.RefUpdates) == 0 {
+		return nil, fmt.Errorf("no ref updates in Azure DevOps push event")
+	}
+
+	refUpdate := adoPayload.Resource.RefUpdates[0]
+	branch, tag := parseRef(refUpdate.Name)
+
+	// NOTE: Azure DevOps push events don't include changed file paths,
+	// so all components for the repository are triggered
+	return &WebhookEvent{
+		Provider:      string(ProviderAzureDevOps),
+		RepositoryURL: normalizeRepoURL(adoPayload.Resource.Repository.RemoteURL),
+		Ref:           refUpdate.Name,
+		Commit:        refUpdate.NewObjectID,
+		Branch:        branch,
+		Tag:           tag,
+		ModifiedPaths: []string{},
+	}, nil
+}
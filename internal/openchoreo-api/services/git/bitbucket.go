@@ -4,8 +4,10 @@
 package git
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // BitbucketProvider implements the Provider interface for Bitbucket
@@ -17,14 +19,23 @@ func NewBitbucketProvider() *BitbucketProvider {
 	return &BitbucketProvider{}
 }
 
-// ValidateWebhookPayload validates the Bitbucket webhook
-// Note: Bitbucket signature validation can be implemented later if needed
-// For MVP, we use a simple token-based validation
-func (p *BitbucketProvider) ValidateWebhookPayload(payload []byte, token, secret string) error {
-	// For MVP: Simple token validation
-	// Bitbucket doesn't use HMAC signatures like GitHub
-	// Can configure a secret in Bitbucket webhook settings
-	if secret != "" && token != secret {
+// ValidateWebhookPayload validates the Bitbucket webhook signature.
+// Bitbucket signs payloads with the webhook secret and sends the HMAC in the
+// X-Hub-Signature header as "sha256=<hash>", the same format GitHub uses.
+// Webhooks created before secrets were supported only carry their X-Hook-UUID;
+// those are still accepted when the configured secret is that UUID, so existing
+// webhooks keep working until they are given a secret.
+func (p *BitbucketProvider) ValidateWebhookPayload(payload []byte, signature, secret string) error {
+	if signature == "" {
+		return fmt.Errorf("missing X-Hub-Signature header")
+	}
+
+	if hash, ok := strings.CutPrefix(signature, "sha256="); ok {
+		return validateHMACSHA256(payload, hash, secret)
+	}
+
+	// Legacy hook UUID token
+	if subtle.ConstantTimeCompare([]byte(signature), []byte(secret)) != 1 {
 		return fmt.Errorf("invalid webhook token")
 	}
 	return nil
@@ -32,12 +43,16 @@ func (p *BitbucketProvider) ValidateWebhookPayload(payload []byte, token, secret
 
 // ParseWebhookPayload parses Bitbucket webhook payload
 func (p *BitbucketProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	if event, ok, err := parseBitbucketPullRequest(payload); ok || err != nil {
+		return event, err
+	}
+
 	var bbPayload struct {
 		Push struct {
 			Changes []struct {
 				New struct {
-					Name string `json:"name"` // branch name
-					Type string `json:"type"` // "branch"
+					Name string `json:"name"` // branch or tag name
+					Type string `json:"type"` // "branch" or "tag"
 				} `json:"new"`
 				Commits []struct {
 					Hash string `json:"hash"`
@@ -62,7 +77,15 @@ func (p *BitbucketProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent,
 	}
 
 	change := bbPayload.Push.Changes[0]
-	branch := change.New.Name
+
+	var branch, tag, ref string
+	if change.New.Type == "tag" {
+		tag = change.New.Name
+		ref = tagRefPrefix + tag
+	} else {
+		branch = change.New.Name
+		ref = branchRefPrefix + branch
+	}
 
 	var commit string
 	if len(change.Commits) > 0 {
@@ -77,9 +100,92 @@ func (p *BitbucketProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent,
 	return &WebhookEvent{
 		Provider:      string(ProviderBitbucket),
 		RepositoryURL: normalizeRepoURL(bbPayload.Repository.Links.HTML.Href),
-		Ref:           "refs/heads/" + branch,
+		Ref:           ref,
 		Commit:        commit,
 		Branch:        branch,
+		Tag:           tag,
 		ModifiedPaths: modifiedPaths, // Empty - will trigger all components
 	}, nil
 }
+
+// bitbucketRepository is the repository of a pull request's source or destination
+type bitbucketRepository struct {
+	FullName string `json:"full_name"`
+	Links    struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+// parseBitbucketPullRequest parses a pullrequest:* event.
+// Bitbucket doesn't put the event action in the payload, so it is derived from the pull
+// request state: an open pull request is (re)deployed, any other state tears the preview down.
+// It returns false when the payload is not a pull request event.
+func parseBitbucketPullRequest(payload []byte) (*WebhookEvent, bool, error) {
+	var prPayload struct {
+		PullRequest *struct {
+			ID     int64  `json:"id"`
+			State  string `json:"state"` // OPEN, MERGED, DECLINED or SUPERSEDED
+			Source struct {
+				Branch struct {
+					Name string `json:"name"`
+				} `json:"branch"`
+				Commit struct {
+					Hash string `json:"hash"`
+				} `json:"commit"`
+				Repository bitbucketRepository `json:"repository"`
+			} `json:"source"`
+			Destination struct {
+				Branch struct {
+					Name string `json:"name"`
+				} `json:"branch"`
+				Repository bitbucketRepository `json:"repository"`
+			} `json:"destination"`
+			Links struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"pullrequest"`
+		Repository struct {
+			Links struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"repository"`
+	}
+
+	if err := json.Unmarshal(payload, &prPayload); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal Bitbucket payload: %w", err)
+	}
+	if prPayload.PullRequest == nil {
+		return nil, false, nil
+	}
+
+	pr := prPayload.PullRequest
+	action := PullRequestClosed
+	if pr.State == "OPEN" {
+		action = PullRequestSynchronized
+	}
+
+	return &WebhookEvent{
+		Provider:      string(ProviderBitbucket),
+		RepositoryURL: normalizeRepoURL(prPayload.Repository.Links.HTML.Href),
+		Ref:           branchRefPrefix + pr.Source.Branch.Name,
+		Commit:        pr.Source.Commit.Hash,
+		Branch:        pr.Source.Branch.Name,
+		PullRequest: &PullRequestEvent{
+			Number:            pr.ID,
+			Action:            action,
+			HeadCommit:        pr.Source.Commit.Hash,
+			HeadBranch:        pr.Source.Branch.Name,
+			BaseBranch:        pr.Destination.Branch.Name,
+			URL:               pr.Links.HTML.Href,
+			Merged:            pr.State == "MERGED",
+			HeadRepositoryURL: normalizeRepoURL(pr.Source.Repository.Links.HTML.Href),
+			FromFork:          pr.Source.Repository.FullName != pr.Destination.Repository.FullName,
+		},
+	}, true, nil
+}
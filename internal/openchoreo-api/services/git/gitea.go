@@ -0,0 +1,74 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GiteaProvider implements the Provider interface for Gitea and Forgejo
+type GiteaProvider struct {
+}
+
+// NewGiteaProvider creates a new Gitea provider
+func NewGiteaProvider() *GiteaProvider {
+	return &GiteaProvider{}
+}
+
+// ValidateWebhookPayload validates the Gitea webhook signature.
+// Gitea (and Forgejo, which keeps sending the Gitea header) signs payloads with a bare
+// hex encoded HMAC-SHA256 in X-Gitea-Signature.
+func (p *GiteaProvider) ValidateWebhookPayload(payload []byte, signature, secret string) error {
+	if signature == "" {
+		return fmt.Errorf("missing X-Gitea-Signature header")
+	}
+
+	return validateHMACSHA256(payload, signature, secret)
+}
+
+// ParseWebhookPayload parses Gitea webhook payload
+func (p *GiteaProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	if event, ok, err := parseGitHubPullRequest(payload, ProviderGitea); ok || err != nil {
+		return event, err
+	}
+
+	var gtPayload struct {
+		Ref        string `json:"ref"`
+		After      string `json:"after"`
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repository"`
+		Commits []struct {
+			Added    []string `json:"added"`
+			Modified []string `json:"modified"`
+			Removed  []string `json:"removed"`
+		} `json:"commits"`
+	}
+
+	if err := json.Unmarshal(payload, &gtPayload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Gitea payload: %w", err)
+	}
+
+	branch, tag := parseRef(gtPayload.Ref)
+
+	// Collect all modified paths
+	modifiedPaths := make([]string, 0)
+	for _, commit := range gtPayload.Commits {
+		modifiedPaths = append(modifiedPaths, commit.Added...)
+		modifiedPaths = append(modifiedPaths, commit.Modified...)
+		modifiedPaths = append(modifiedPaths, commit.Removed...)
+	}
+
+	return &WebhookEvent{
+		Provider:      string(ProviderGitea),
+		RepositoryURL: normalizeRepoURL(gtPayload.Repository.CloneURL),
+		Ref:           gtPayload.Ref,
+		Commit:        gtPayload.After,
+		Branch:        branch,
+		Tag:           tag,
+		ModifiedPaths: modifiedPaths,
+	}, nil
+}
@@ -4,9 +4,6 @@
 package git
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -32,21 +29,15 @@ func (p *GitHubProvider) ValidateWebhookPayload(payload []byte, signature, secre
 		return fmt.Errorf("invalid signature format")
 	}
 
-	signature = strings.TrimPrefix(signature, "sha256=")
-
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write(payload)
-	expectedMAC := hex.EncodeToString(mac.Sum(nil))
-
-	if !hmac.Equal([]byte(signature), []byte(expectedMAC)) {
-		return fmt.Errorf("invalid signature")
-	}
-
-	return nil
+	return validateHMACSHA256(payload, strings.TrimPrefix(signature, "sha256="), secret)
 }
 
 // ParseWebhookPayload parses GitHub webhook payload
 func (p *GitHubProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	if event, ok, err := parseGitHubPullRequest(payload, ProviderGitHub); ok || err != nil {
+		return event, err
+	}
+
 	var ghPayload struct {
 		Ref        string `json:"ref"`
 		After      string `json:"after"`
@@ -65,8 +56,8 @@ func (p *GitHubProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 		return nil, fmt.Errorf("failed to unmarshal GitHub payload: %w", err)
 	}
 
-	// Extract branch from ref (refs/heads/main -> main)
-	branch := strings.TrimPrefix(ghPayload.Ref, "refs/heads/")
+	// Extract branch or tag from ref (refs/heads/main -> main, refs/tags/v1.0.0 -> v1.0.0)
+	branch, tag := parseRef(ghPayload.Ref)
 
 	// Collect all modified paths
 	modifiedPaths := make([]string, 0)
@@ -82,10 +73,82 @@ func (p *GitHubProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 		Ref:           ghPayload.Ref,
 		Commit:        ghPayload.After,
 		Branch:        branch,
+		Tag:           tag,
 		ModifiedPaths: modifiedPaths,
 	}, nil
 }
 
+// gitHubPullRequestActions maps pull_request event actions to normalized actions.
+// Gitea reports new commits as "synchronized" where GitHub says "synchronize".
+var gitHubPullRequestActions = map[string]PullRequestAction{
+	"opened":       PullRequestOpened,
+	"reopened":     PullRequestOpened,
+	"synchronize":  PullRequestSynchronized,
+	"synchronized": PullRequestSynchronized,
+	"closed":       PullRequestClosed,
+}
+
+// parseGitHubPullRequest parses a pull_request event in the format shared by GitHub and Gitea.
+// It returns false when the payload is not a pull request event.
+func parseGitHubPullRequest(payload []byte, provider ProviderType) (*WebhookEvent, bool, error) {
+	var prPayload struct {
+		Action      string `json:"action"`
+		Number      int64  `json:"number"`
+		PullRequest *struct {
+			HTMLURL           string `json:"html_url"`
+			Merged            bool   `json:"merged"`
+			AuthorAssociation string `json:"author_association"`
+			Head              struct {
+				Ref  string `json:"ref"`
+				SHA  string `json:"sha"`
+				Repo *struct {
+					CloneURL string `json:"clone_url"`
+				} `json:"repo"`
+			} `json:"head"`
+			Base struct {
+				Ref string `json:"ref"`
+			} `json:"base"`
+		} `json:"pull_request"`
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+		} `json:"repository"`
+	}
+
+	if err := json.Unmarshal(payload, &prPayload); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal %s payload: %w", provider, err)
+	}
+	if prPayload.PullRequest == nil {
+		return nil, false, nil
+	}
+
+	pr := prPayload.PullRequest
+	repoURL := normalizeRepoURL(prPayload.Repository.CloneURL)
+	// The head repository is null when the fork was deleted; treat that as a fork too
+	headRepoURL := ""
+	if pr.Head.Repo != nil {
+		headRepoURL = normalizeRepoURL(pr.Head.Repo.CloneURL)
+	}
+	return &WebhookEvent{
+		Provider:      string(provider),
+		RepositoryURL: repoURL,
+		Ref:           branchRefPrefix + pr.Head.Ref,
+		Commit:        pr.Head.SHA,
+		Branch:        pr.Head.Ref,
+		PullRequest: &PullRequestEvent{
+			Number:            prPayload.Number,
+			Action:            gitHubPullRequestActions[prPayload.Action],
+			HeadCommit:        pr.Head.SHA,
+			HeadBranch:        pr.Head.Ref,
+			BaseBranch:        pr.Base.Ref,
+			URL:               pr.HTMLURL,
+			Merged:            pr.Merged,
+			HeadRepositoryURL: headRepoURL,
+			FromFork:          headRepoURL != repoURL,
+			AuthorAssociation: pr.AuthorAssociation,
+		},
+	}, true, nil
+}
+
 // normalizeRepoURL normalizes repository URLs for comparison
 func normalizeRepoURL(repoURL string) string {
 	// Convert SSH to HTTPS
@@ -6,7 +6,6 @@ package git
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // GitLabProvider implements the Provider interface for GitLab
@@ -33,6 +32,10 @@ func (p *GitLabProvider) ValidateWebhookPayload(payload []byte, token, secret st
 
 // ParseWebhookPayload parses GitLab webhook payload
 func (p *GitLabProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, error) {
+	if event, ok, err := parseGitLabMergeRequest(payload); ok || err != nil {
+		return event, err
+	}
+
 	var glPayload struct {
 		Ref     string `json:"ref"`
 		After   string `json:"after"`
@@ -51,8 +54,8 @@ func (p *GitLabProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 		return nil, fmt.Errorf("failed to unmarshal GitLab payload: %w", err)
 	}
 
-	// Extract branch from ref (refs/heads/main -> main)
-	branch := strings.TrimPrefix(glPayload.Ref, "refs/heads/")
+	// Extract branch or tag from ref; tag pushes use the same payload shape
+	branch, tag := parseRef(glPayload.Ref)
 
 	// Collect all modified paths
 	modifiedPaths := make([]string, 0)
@@ -68,6 +71,70 @@ func (p *GitLabProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 		Ref:           glPayload.Ref,
 		Commit:        glPayload.After,
 		Branch:        branch,
+		Tag:           tag,
 		ModifiedPaths: modifiedPaths,
 	}, nil
 }
+
+// gitLabMergeRequestActions maps merge_request event actions to normalized actions
+var gitLabMergeRequestActions = map[string]PullRequestAction{
+	"open":   PullRequestOpened,
+	"reopen": PullRequestOpened,
+	"update": PullRequestSynchronized,
+	"close":  PullRequestClosed,
+	"merge":  PullRequestClosed,
+}
+
+// parseGitLabMergeRequest parses a merge_request event.
+// It returns false when the payload is not a merge request event.
+func parseGitLabMergeRequest(payload []byte) (*WebhookEvent, bool, error) {
+	var mrPayload struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			IID             int64  `json:"iid"`
+			SourceProjectID int64  `json:"source_project_id"`
+			TargetProjectID int64  `json:"target_project_id"`
+			Action          string `json:"action"`
+			State           string `json:"state"`
+			SourceBranch    string `json:"source_branch"`
+			TargetBranch    string `json:"target_branch"`
+			URL             string `json:"url"`
+			LastCommit      struct {
+				ID string `json:"id"`
+			} `json:"last_commit"`
+			Source struct {
+				GitHTTPURL string `json:"git_http_url"`
+			} `json:"source"`
+		} `json:"object_attributes"`
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+		} `json:"project"`
+	}
+
+	if err := json.Unmarshal(payload, &mrPayload); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal GitLab payload: %w", err)
+	}
+	if mrPayload.ObjectKind != "merge_request" {
+		return nil, false, nil
+	}
+
+	mr := mrPayload.ObjectAttributes
+	return &WebhookEvent{
+		Provider:      string(ProviderGitLab),
+		RepositoryURL: normalizeRepoURL(mrPayload.Project.GitHTTPURL),
+		Ref:           branchRefPrefix + mr.SourceBranch,
+		Commit:        mr.LastCommit.ID,
+		Branch:        mr.SourceBranch,
+		PullRequest: &PullRequestEvent{
+			Number:            mr.IID,
+			Action:            gitLabMergeRequestActions[mr.Action],
+			HeadCommit:        mr.LastCommit.ID,
+			HeadBranch:        mr.SourceBranch,
+			BaseBranch:        mr.TargetBranch,
+			URL:               mr.URL,
+			Merged:            mr.State == "merged",
+			HeadRepositoryURL: normalizeRepoURL(mr.Source.GitHTTPURL),
+			FromFork:          mr.SourceProjectID != mr.TargetProjectID,
+		},
+	}, true, nil
+}
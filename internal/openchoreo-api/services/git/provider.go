@@ -4,7 +4,11 @@
 package git
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 )
 
 // Provider defines the interface for git provider operations
@@ -22,8 +26,44 @@ type WebhookEvent struct {
 	RepositoryURL string
 	Ref           string
 	Commit        string
+	// Branch is set for branch pushes, Tag for tag pushes; never both
 	Branch        string
+	Tag           string
 	ModifiedPaths []string
+	// PullRequest is set for pull request (merge request) events; Commit and Branch then
+	// describe the head of the pull request
+	PullRequest *PullRequestEvent
+}
+
+// PullRequestAction is the normalized lifecycle action of a pull request event
+type PullRequestAction string
+
+const (
+	// PullRequestOpened is reported when a pull request is opened or reopened
+	PullRequestOpened PullRequestAction = "opened"
+	// PullRequestSynchronized is reported when new commits are pushed to a pull request
+	PullRequestSynchronized PullRequestAction = "synchronized"
+	// PullRequestClosed is reported when a pull request is closed or merged
+	PullRequestClosed PullRequestAction = "closed"
+)
+
+// PullRequestEvent describes a pull request event. Action is empty for events that don't
+// change what a preview should run (labels, review comments, title edits).
+type PullRequestEvent struct {
+	Number     int64
+	Action     PullRequestAction
+	HeadCommit string
+	HeadBranch string
+	BaseBranch string
+	URL        string
+	Merged     bool
+	// HeadRepositoryURL is the repository holding the head commit. It differs from the
+	// event's RepositoryURL when the pull request comes from a fork.
+	HeadRepositoryURL string
+	FromFork          bool
+	// AuthorAssociation is the author's relationship with the repository (OWNER, MEMBER,
+	// COLLABORATOR, CONTRIBUTOR, NONE, ...). Only GitHub reports it; it is empty otherwise.
+	AuthorAssociation string
 }
 
 // ProviderType represents the git provider type
@@ -33,6 +73,14 @@ const (
 	ProviderGitHub    ProviderType = "github"
 	ProviderGitLab    ProviderType = "gitlab"
 	ProviderBitbucket ProviderType = "bitbucket"
+	// ProviderGitea covers both Gitea and Forgejo, which share the same webhook format
+	ProviderGitea       ProviderType = "gitea"
+	ProviderAzureDevOps ProviderType = "azuredevops"
+)
+
+const (
+	branchRefPrefix = "refs/heads/"
+	tagRefPrefix    = "refs/tags/"
 )
 
 // GetProvider returns a git provider instance based on the type
@@ -44,7 +92,34 @@ func GetProvider(providerType ProviderType) (Provider, error) {
 		return NewGitLabProvider(), nil
 	case ProviderBitbucket:
 		return NewBitbucketProvider(), nil
+	case ProviderGitea:
+		return NewGiteaProvider(), nil
+	case ProviderAzureDevOps:
+		return NewAzureDevOpsProvider(), nil
 	default:
 		return nil, fmt.Errorf("unsupported provider: %s", providerType)
 	}
 }
+
+// parseRef splits a fully qualified git ref into a branch or tag name
+func parseRef(ref string) (branch, tag string) {
+	switch {
+	case strings.HasPrefix(ref, tagRefPrefix):
+		return "", strings.TrimPrefix(ref, tagRefPrefix)
+	default:
+		return strings.TrimPrefix(ref, branchRefPrefix), ""
+	}
+}
+
+// validateHMACSHA256 checks a hex encoded HMAC-SHA256 signature of the payload
+func validateHMACSHA256(payload []byte, signature, secret string) error {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	expectedMAC := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(signature), []byte(expectedMAC)) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+)
+
+func sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestBitbucketProvider_ValidateWebhookPayload(t *testing.T) {
+	payload := []byte(`{"push":{}}`)
+	p := NewBitbucketProvider()
+
+	if err := p.ValidateWebhookPayload(payload, "sha256="+sign(payload, "s3cret"), "s3cret"); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+	if err := p.ValidateWebhookPayload(payload, "sha256="+sign(payload, "other"), "s3cret"); err == nil {
+		t.Fatal("expected signature with wrong secret to be rejected")
+	}
+	if err := p.ValidateWebhookPayload(payload, "", "s3cret"); err == nil {
+		t.Fatal("expected missing signature to be rejected")
+	}
+
+	// Legacy webhooks without a secret are validated by their hook UUID
+	if err := p.ValidateWebhookPayload(payload, "{1b2c3d4e}", "{1b2c3d4e}"); err != nil {
+		t.Fatalf("expected matching hook UUID to be accepted, got %v", err)
+	}
+	if err := p.ValidateWebhookPayload(payload, "{ffffffff}", "{1b2c3d4e}"); err == nil {
+		t.Fatal("expected different hook UUID to be rejected")
+	}
+}
+
+func TestBitbucketProvider_ParseTagPush(t *testing.T) {
+	payload := []byte(`{
+		"push": {"changes": [{"new": {"name": "v1.0.0", "type": "tag"}, "commits": [{"hash": "abc1234"}]}]},
+		"repository": {"links": {"html": {"href": "https://bitbucket.org/acme/orders"}}}
+	}`)
+
+	event, err := NewBitbucketProvider().ParseWebhookPayload(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Tag != "v1.0.0" || event.Branch != "" || event.Ref != "refs/tags/v1.0.0" {
+		t.Fatalf("unexpected ref fields: %+v", event)
+	}
+}
+
+func TestGiteaProvider(t *testing.T) {
+	payload := []byte(`{
+		"ref": "refs/heads/main",
+		"after": "0123456789abcdef0123456789abcdef01234567",
+		"repository": {"clone_url": "https://git.example.com/acme/orders.git"},
+		"commits": [{"added": ["svc/a.go"], "modified": ["svc/b.go"], "removed": []}]
+	}`)
+	p := NewGiteaProvider()
+
+	if err := p.ValidateWebhookPayload(payload, sign(payload, "s3cret"), "s3cret"); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+	if err := p.ValidateWebhookPayload(payload, sign(payload, "other"), "s3cret"); err == nil {
+		t.Fatal("expected signature with wrong secret to be rejected")
+	}
+
+	event, err := p.ParseWebhookPayload(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Branch != "main" || event.RepositoryURL != "https://git.example.com/acme/orders" || len(event.ModifiedPaths) != 2 {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+}
+
+func TestAzureDevOpsProvider(t *testing.T) {
+	payload := []byte(`{
+		"eventType": "git.push",
+		"resource": {
+			"refUpdates": [{"name": "refs/tags/v2.1.0", "newObjectId": "0123456789abcdef0123456789abcdef01234567"}],
+			"repository": {"remoteUrl": "https://acme@dev.azure.com/acme/shop/_git/orders"}
+		}
+	}`)
+	p := NewAzureDevOpsProvider()
+
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("openchoreo:s3cret"))
+	if err := p.ValidateWebhookPayload(payload, auth, "s3cret"); err != nil {
+		t.Fatalf("expected valid credentials, got %v", err)
+	}
+	wrong := "Basic " + base64.StdEncoding.EncodeToString([]byte("openchoreo:other"))
+	if err := p.ValidateWebhookPayload(payload, wrong, "s3cret"); err == nil {
+		t.Fatal("expected wrong password to be rejected")
+	}
+
+	event, err := p.ParseWebhookPayload(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Tag != "v2.1.0" || event.Branch != "" {
+		t.Fatalf("unexpected ref fields: %+v", event)
+	}
+}
+
+func TestParsePullRequestEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider Provider
+		payload  string
+		want     PullRequestEvent
+	}{
+		{
+			name:     "github synchronize",
+			provider: NewGitHubProvider(),
+			payload: `{"action": "synchronize", "number": 7,
+				"pull_request": {"html_url": "https://github.com/acme/orders/pull/7", "merged": false,
+					"author_association": "MEMBER",
+					"head": {"ref": "feature", "sha": "0123456789abcdef",
+						"repo": {"clone_url": "https://github.com/acme/orders.git"}}, "base": {"ref": "main"}},
+				"repository": {"clone_url": "https://github.com/acme/orders.git"}}`,
+			want: PullRequestEvent{Number: 7, Action: PullRequestSynchronized, HeadCommit: "0123456789abcdef",
+				HeadBranch: "feature", BaseBranch: "main", URL: "https://github.com/acme/orders/pull/7",
+				HeadRepositoryURL: "https://github.com/acme/orders", AuthorAssociation: "MEMBER"},
+		},
+		{
+			name:     "github fork",
+			provider: NewGitHubProvider(),
+			payload: `{"action": "opened", "number": 8,
+				"pull_request": {"author_association": "FIRST_TIME_CONTRIBUTOR",
+					"head": {"ref": "main", "sha": "fedcba9876543210",
+						"repo": {"clone_url": "https://github.com/mallory/orders.git"}}, "base": {"ref": "main"}},
+				"repository": {"clone_url": "https://github.com/acme/orders.git"}}`,
+			want: PullRequestEvent{Number: 8, Action: PullRequestOpened, HeadCommit: "fedcba9876543210",
+				HeadBranch: "main", BaseBranch: "main", HeadRepositoryURL: "https://github.com/mallory/orders",
+				FromFork: true, AuthorAssociation: "FIRST_TIME_CONTRIBUTOR"},
+		},
+		{
+			name:     "gitea labeled is ignored",
+			provider: NewGiteaProvider(),
+			payload: `{"action": "label_updated", "number": 3,
+				"pull_request": {"head": {"ref": "feature", "sha": "abcdef1",
+					"repo": {"clone_url": "https://git.example.com/acme/orders.git"}}, "base": {"ref": "main"}},
+				"repository": {"clone_url": "https://git.example.com/acme/orders.git"}}`,
+			want: PullRequestEvent{Number: 3, HeadCommit: "abcdef1", HeadBranch: "feature", BaseBranch: "main",
+				HeadRepositoryURL: "https://git.example.com/acme/orders"},
+		},
+		{
+			name:     "gitlab merge",
+			provider: NewGitLabProvider(),
+			payload: `{"object_kind": "merge_request",
+				"object_attributes": {"iid": 12, "action": "merge", "state": "merged", "source_branch": "feature",
+					"target_branch": "main", "url": "https://gitlab.com/acme/orders/-/merge_requests/12",
+					"source_project_id": 41, "target_project_id": 40,
+					"source": {"git_http_url": "https://gitlab.com/mallory/orders.git"},
+					"last_commit": {"id": "fedcba9876543210"}},
+				"project": {"git_http_url": "https://gitlab.com/acme/orders.git"}}`,
+			want: PullRequestEvent{Number: 12, Action: PullRequestClosed, HeadCommit: "fedcba9876543210",
+				HeadBranch: "feature", BaseBranch: "main", URL: "https://gitlab.com/acme/orders/-/merge_requests/12", Merged: true,
+				HeadRepositoryURL: "https://gitlab.com/mallory/orders", FromFork: true},
+		},
+		{
+			name:     "bitbucket declined",
+			provider: NewBitbucketProvider(),
+			payload: `{"pullrequest": {"id": 4, "state": "DECLINED",
+					"source": {"branch": {"name": "feature"}, "commit": {"hash": "a1b2c3d4e5f6"},
+						"repository": {"full_name": "acme/orders", "links": {"html": {"href": "https://bitbucket.org/acme/orders"}}}},
+					"destination": {"branch": {"name": "main"},
+						"repository": {"full_name": "acme/orders", "links": {"html": {"href": "https://bitbucket.org/acme/orders"}}}},
+					"links": {"html": {"href": "https://bitbucket.org/acme/orders/pull-requests/4"}}},
+				"repository": {"links": {"html": {"href": "https://bitbucket.org/acme/orders"}}}}`,
+			want: PullRequestEvent{Number: 4, Action: PullRequestClosed, HeadCommit: "a1b2c3d4e5f6",
+				HeadBranch: "feature", BaseBranch: "main", URL: "https://bitbucket.org/acme/orders/pull-requests/4",
+				HeadRepositoryURL: "https://bitbucket.org/acme/orders"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := tt.provider.ParseWebhookPayload([]byte(tt.payload))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if event.PullRequest == nil {
+				t.Fatalf("expected a pull request event, got %+v", event)
+			}
+			if *event.PullRequest != tt.want {
+				t.Fatalf("PullRequest = %+v, want %+v", *event.PullRequest, tt.want)
+			}
+			if event.Commit != tt.want.HeadCommit || event.Branch != tt.want.HeadBranch {
+				t.Fatalf("unexpected head fields: %+v", event)
+			}
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // constructAuthzCheckRequest builds an authorization evaluation request from context and resource details
-func constructAuthzCheckRequest(ctx context.Context, action, resourceType, resourceID string, hierarchy authz.ResourceHierarchy) *authz.EvaluateRequest {
+func constructAuthzCheckRequest(ctx context.Context, action, resourceType, resourceID string, hierarchy authz.ResourceHierarchy, attributes map[string]interface{}) *authz.EvaluateRequest {
 	// Extract SubjectContext from context (set by authentication middleware)
 	authSubjectCtx, _ := auth.GetSubjectContextFromContext(ctx)
 
@@ -28,13 +28,19 @@ func constructAuthzCheckRequest(ctx context.Context, action, resourceType, resou
 			ID:        resourceID,
 			Hierarchy: hierarchy,
 		},
-		Context: authz.Context{},
+		Context: authz.Context{Attributes: attributes},
 	}
 }
 
 // checkAuthorization performs a complete authorization check including request construction and evaluation
 func checkAuthorization(ctx context.Context, logger *slog.Logger, pdp authz.PDP, action systemAction, resourceType ResourceType, resourceID string, hierarchy authz.ResourceHierarchy) error {
-	authzReq := constructAuthzCheckRequest(ctx, string(action), string(resourceType), resourceID, hierarchy)
+	return checkAuthorizationWithAttributes(ctx, logger, pdp, action, resourceType, resourceID, hierarchy, nil)
+}
+
+// checkAuthorizationWithAttributes performs an authorization check that also passes attributes of the resource
+// instance, so that conditions on role mappings can refer to them
+func checkAuthorizationWithAttributes(ctx context.Context, logger *slog.Logger, pdp authz.PDP, action systemAction, resourceType ResourceType, resourceID string, hierarchy authz.ResourceHierarchy, attributes map[string]interface{}) error {
+	authzReq := constructAuthzCheckRequest(ctx, string(action), string(resourceType), resourceID, hierarchy, attributes)
 
 	decision, err := pdp.Evaluate(ctx, authzReq)
 	if err != nil {
@@ -53,3 +59,16 @@ func checkAuthorization(ctx context.Context, logger *slog.Logger, pdp authz.PDP,
 
 	return nil
 }
+
+// subjectIDFromContext returns the identifier of the authenticated subject, or an empty string
+// when the request is not authenticated
+func subjectIDFromContext(ctx context.Context) string {
+	authSubjectCtx, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if subject := authz.GetAuthzSubjectContext(authSubjectCtx); subject != nil {
+		return subject.ID
+	}
+	return ""
+}
@@ -259,6 +259,7 @@ func (s *ProjectService) toProjectResponse(project *openchoreov1alpha1.Project)
 		DeploymentPipeline: project.Spec.DeploymentPipelineRef,
 		CreatedAt:          project.CreationTimestamp.Time,
 		Status:             status,
+		ResourceUsage:      project.Status.ResourceUsage,
 	}
 
 	// Include deletion timestamp if the project is marked for deletion
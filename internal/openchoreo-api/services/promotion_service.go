@@ -0,0 +1,283 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// PromotionService handles promotion requests that gate releases into environments requiring approval.
+// It only records requests and decisions; the PromotionRequest controller moves the target ReleaseBinding.
+type PromotionService struct {
+	k8sClient        client.Client
+	componentService *ComponentService
+	logger           *slog.Logger
+	authzPDP         authz.PDP
+}
+
+// NewPromotionService creates a new promotion service
+func NewPromotionService(k8sClient client.Client, componentService *ComponentService, logger *slog.Logger, authzPDP authz.PDP) *PromotionService {
+	return &PromotionService{
+		k8sClient:        k8sClient,
+		componentService: componentService,
+		logger:           logger,
+		authzPDP:         authzPDP,
+	}
+}
+
+// RequestPromotion raises a PromotionRequest that captures the release currently bound to the source environment
+func (s *PromotionService) RequestPromotion(ctx context.Context, orgName, projectName, componentName string,
+	req *models.CreatePromotionRequestRequest) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Requesting promotion", "org", orgName, "project", projectName, "component", componentName,
+		"source", req.SourceEnvironment, "target", req.TargetEnvironment)
+
+	if err := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionCreatePromotionRequest, ResourceTypePromotionRequest, componentName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName},
+		deploymentAttributes(ctx, s.k8sClient, orgName, componentName, req.TargetEnvironment)); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.componentService.validatePromotionPath(ctx, orgName, projectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	sourceBinding, err := s.componentService.getReleaseBinding(ctx, orgName, projectName, componentName, req.SourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if sourceBinding.Spec.ReleaseName == "" {
+		s.logger.Warn("Source environment has no release bound", "org", orgName, "component", componentName, "source", req.SourceEnvironment)
+		return nil, ErrComponentReleaseNotFound
+	}
+
+	existing, err := s.listPromotionRequests(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range existing {
+		if existing[i].Spec.TargetEnvironment == req.TargetEnvironment && existing[i].Status.Decision == nil {
+			s.logger.Warn("Pending promotion request already exists", "org", orgName, "component", componentName,
+				"target", req.TargetEnvironment, "request", existing[i].Name)
+			return nil, ErrPromotionRequestExists
+		}
+	}
+
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", componentName, req.TargetEnvironment),
+			Namespace:    orgName,
+			Labels: map[string]string{
+				labels.LabelKeyProjectName:   projectName,
+				labels.LabelKeyComponentName: componentName,
+			},
+		},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner: openchoreov1alpha1.ReleaseBindingOwner{
+				ProjectName:   projectName,
+				ComponentName: componentName,
+			},
+			SourceEnvironment: req.SourceEnvironment,
+			TargetEnvironment: req.TargetEnvironment,
+			ReleaseName:       sourceBinding.Spec.ReleaseName,
+			RequestedBy:       subjectIDFromContext(ctx),
+		},
+	}
+
+	if err := s.k8sClient.Create(ctx, promotionRequest); err != nil {
+		s.logger.Error("Failed to create promotion request", "error", err)
+		return nil, fmt.Errorf("failed to create promotion request: %w", err)
+	}
+
+	s.logger.Debug("Promotion request created", "org", orgName, "name", promotionRequest.Name, "release", promotionRequest.Spec.ReleaseName)
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// ListPromotionRequests lists the promotion requests of a component, newest first
+func (s *PromotionService) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string) ([]*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Listing promotion requests", "org", orgName, "project", projectName, "component", componentName)
+
+	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewPromotionRequest, ResourceTypePromotionRequest, componentName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
+		return nil, err
+	}
+
+	items, err := s.listPromotionRequests(ctx, orgName, projectName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[j].CreationTimestamp.Before(&items[i].CreationTimestamp)
+	})
+
+	responses := make([]*models.PromotionRequestResponse, 0, len(items))
+	for i := range items {
+		responses = append(responses, toPromotionRequestResponse(&items[i], orgName))
+	}
+	return responses, nil
+}
+
+// GetPromotionRequest retrieves a single promotion request of a component
+func (s *PromotionService) GetPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Getting promotion request", "org", orgName, "project", projectName, "component", componentName, "request", requestName)
+
+	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewPromotionRequest, ResourceTypePromotionRequest, requestName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
+		return nil, err
+	}
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		return nil, err
+	}
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// ApprovePromotionRequest records an approval on a pending promotion request
+func (s *PromotionService) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string,
+	req *models.PromotionDecisionRequest) (*models.PromotionRequestResponse, error) {
+	return s.decide(ctx, orgName, projectName, componentName, requestName, openchoreov1alpha1.PromotionDecisionApprove, req)
+}
+
+// RejectPromotionRequest records a rejection on a pending promotion request
+func (s *PromotionService) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string,
+	req *models.PromotionDecisionRequest) (*models.PromotionRequestResponse, error) {
+	return s.decide(ctx, orgName, projectName, componentName, requestName, openchoreov1alpha1.PromotionDecisionReject, req)
+}
+
+// decide records the decision of the calling subject on a promotion request
+func (s *PromotionService) decide(ctx context.Context, orgName, projectName, componentName, requestName string,
+	action openchoreov1alpha1.PromotionDecisionAction, req *models.PromotionDecisionRequest) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Deciding promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"request", requestName, "action", action)
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization check, done once the target environment is known so that conditions can refer to it
+	if err := checkAuthorizationWithAttributes(ctx, s.logger, s.authzPDP, SystemActionApprovePromotionRequest, ResourceTypePromotionRequest, requestName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName},
+		deploymentAttributes(ctx, s.k8sClient, orgName, componentName, promotionRequest.Spec.TargetEnvironment)); err != nil {
+		return nil, err
+	}
+
+	if promotionRequest.Status.Decision != nil {
+		return nil, ErrPromotionAlreadyDecided
+	}
+
+	decidedBy := subjectIDFromContext(ctx)
+	if action == openchoreov1alpha1.PromotionDecisionApprove &&
+		decidedBy != "" && decidedBy == promotionRequest.Spec.RequestedBy {
+		s.logger.Warn("Requester attempted to approve own promotion request", "org", orgName, "request", requestName, "subject", decidedBy)
+		return nil, ErrSelfApprovalNotAllowed
+	}
+	if decidedBy == "" {
+		decidedBy = "anonymous"
+	}
+
+	decision := &openchoreov1alpha1.PromotionDecision{
+		Action:    action,
+		DecidedBy: decidedBy,
+		DecidedAt: metav1.Now(),
+	}
+	if req != nil {
+		decision.Comment = req.Comment
+	}
+	promotionRequest.Status.Decision = decision
+
+	// The decision is only writable through the status subresource, which end users are not granted
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		s.logger.Error("Failed to update promotion request", "error", err)
+		return nil, fmt.Errorf("failed to update promotion request: %w", err)
+	}
+
+	s.logger.Debug("Promotion request decided", "org", orgName, "request", requestName, "action", action, "decidedBy", decidedBy)
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// getPromotionRequest fetches a promotion request and verifies it belongs to the component
+func (s *PromotionService) getPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: requestName}, promotionRequest); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrPromotionRequestNotFound
+		}
+		s.logger.Error("Failed to get promotion request", "error", err)
+		return nil, fmt.Errorf("failed to get promotion request: %w", err)
+	}
+
+	if promotionRequest.Spec.Owner.ProjectName != projectName || promotionRequest.Spec.Owner.ComponentName != componentName {
+		return nil, ErrPromotionRequestNotFound
+	}
+	return promotionRequest, nil
+}
+
+// listPromotionRequests lists all promotion requests owned by a component
+func (s *PromotionService) listPromotionRequests(ctx context.Context, orgName, projectName, componentName string) ([]openchoreov1alpha1.PromotionRequest, error) {
+	var list openchoreov1alpha1.PromotionRequestList
+	if err := s.k8sClient.List(ctx, &list,
+		client.InNamespace(orgName),
+		client.MatchingLabelsSelector{Selector: k8slabels.SelectorFromSet(map[string]string{
+			labels.LabelKeyProjectName:   projectName,
+			labels.LabelKeyComponentName: componentName,
+		})},
+	); err != nil {
+		s.logger.Error("Failed to list promotion requests", "error", err)
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+
+	items := make([]openchoreov1alpha1.PromotionRequest, 0, len(list.Items))
+	for i := range list.Items {
+		owner := list.Items[i].Spec.Owner
+		if owner.ProjectName == projectName && owner.ComponentName == componentName {
+			items = append(items, list.Items[i])
+		}
+	}
+	return items, nil
+}
+
+// toPromotionRequestResponse converts a PromotionRequest CR to a PromotionRequestResponse
+func toPromotionRequestResponse(pr *openchoreov1alpha1.PromotionRequest, orgName string) *models.PromotionRequestResponse {
+	response := &models.PromotionRequestResponse{
+		Name:              pr.Name,
+		ComponentName:     pr.Spec.Owner.ComponentName,
+		ProjectName:       pr.Spec.Owner.ProjectName,
+		OrgName:           orgName,
+		SourceEnvironment: pr.Spec.SourceEnvironment,
+		TargetEnvironment: pr.Spec.TargetEnvironment,
+		ReleaseName:       pr.Spec.ReleaseName,
+		RequestedBy:       pr.Spec.RequestedBy,
+		Phase:             string(pr.Status.Phase),
+		CreatedAt:         pr.CreationTimestamp.Time,
+	}
+
+	if d := pr.Status.Decision; d != nil {
+		response.Decision = string(d.Action)
+		response.DecidedBy = d.DecidedBy
+		response.Comment = d.Comment
+		if !d.DecidedAt.IsZero() {
+			decidedAt := d.DecidedAt.Time
+			response.DecidedAt = &decidedAt
+		}
+	}
+
+	if response.Phase == "" {
+		response.Phase = string(openchoreov1alpha1.PromotionRequestPhasePending)
+	}
+	return response
+}
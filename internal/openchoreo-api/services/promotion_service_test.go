@@ -0,0 +1,176 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/authz"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+func newTestPromotionService(t *testing.T, objs ...client.Object) (*PromotionService, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.PromotionRequest{}).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewPromotionService(k8sClient, nil, logger, authz.NewDisabledAuthorizer(logger)), k8sClient
+}
+
+func pendingPromotionRequest() *v1alpha1.PromotionRequest {
+	return &v1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-production-x1", Namespace: "acme"},
+		Spec: v1alpha1.PromotionRequestSpec{
+			Owner:             v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "api"},
+			SourceEnvironment: "staging",
+			TargetEnvironment: "production",
+			ReleaseName:       "api-20250101-1",
+			RequestedBy:       "alice",
+		},
+	}
+}
+
+func TestPromotionService_Decide(t *testing.T) {
+	tests := []struct {
+		name       string
+		subjectID  string
+		approve    bool
+		existing   func() *v1alpha1.PromotionRequest
+		wantErr    error
+		wantAction v1alpha1.PromotionDecisionAction
+	}{
+		{
+			name:       "approval is recorded against the calling subject",
+			subjectID:  "bob",
+			approve:    true,
+			existing:   pendingPromotionRequest,
+			wantAction: v1alpha1.PromotionDecisionApprove,
+		},
+		{
+			name:       "requester may reject own request",
+			subjectID:  "alice",
+			approve:    false,
+			existing:   pendingPromotionRequest,
+			wantAction: v1alpha1.PromotionDecisionReject,
+		},
+		{
+			name:      "requester cannot approve own request",
+			subjectID: "alice",
+			approve:   true,
+			existing:  pendingPromotionRequest,
+			wantErr:   ErrSelfApprovalNotAllowed,
+		},
+		{
+			name:      "decision cannot be changed",
+			subjectID: "bob",
+			approve:   true,
+			existing: func() *v1alpha1.PromotionRequest {
+				pr := pendingPromotionRequest()
+				pr.Status.Decision = &v1alpha1.PromotionDecision{Action: v1alpha1.PromotionDecisionReject, DecidedBy: "carol"}
+				return pr
+			},
+			wantErr: ErrPromotionAlreadyDecided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, k8sClient := newTestPromotionService(t, tt.existing())
+			ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: tt.subjectID, Type: "user"})
+			req := &models.PromotionDecisionRequest{Comment: "CHG-42"}
+
+			var (
+				resp *models.PromotionRequestResponse
+				err  error
+			)
+			if tt.approve {
+				resp, err = svc.ApprovePromotionRequest(ctx, "acme", "shop", "api", "api-production-x1", req)
+			} else {
+				resp, err = svc.RejectPromotionRequest(ctx, "acme", "shop", "api", "api-production-x1", req)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Decision != string(tt.wantAction) || resp.DecidedBy != tt.subjectID || resp.Comment != "CHG-42" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+
+			stored := &v1alpha1.PromotionRequest{}
+			if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "api-production-x1"}, stored); err != nil {
+				t.Fatalf("failed to get stored request: %v", err)
+			}
+			if stored.Status.Decision == nil || stored.Status.Decision.DecidedBy != tt.subjectID {
+				t.Errorf("decision not persisted: %+v", stored.Status.Decision)
+			}
+		})
+	}
+}
+
+func TestPromotionService_GetPromotionRequestOwnership(t *testing.T) {
+	svc, _ := newTestPromotionService(t, pendingPromotionRequest())
+
+	if _, err := svc.GetPromotionRequest(context.Background(), "acme", "shop", "web", "api-production-x1"); !errors.Is(err, ErrPromotionRequestNotFound) {
+		t.Errorf("expected ErrPromotionRequestNotFound for a different component, got %v", err)
+	}
+	resp, err := svc.GetPromotionRequest(context.Background(), "acme", "shop", "api", "api-production-x1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Phase != string(v1alpha1.PromotionRequestPhasePending) {
+		t.Errorf("expected Pending phase for an unreconciled request, got %q", resp.Phase)
+	}
+}
+
+func TestPromotionService_PassesTargetEnvironmentAttributes(t *testing.T) {
+	production := &v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "acme"},
+		Spec:       v1alpha1.EnvironmentSpec{IsProduction: true},
+	}
+	svc, _ := newTestPromotionService(t, pendingPromotionRequest(), production)
+	svc.authzPDP = productionDenyingPDP{PDP: svc.authzPDP}
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "bob", Type: "user"})
+
+	if _, err := svc.RequestPromotion(ctx, "acme", "shop", "api", &models.CreatePromotionRequestRequest{
+		SourceEnvironment: "staging", TargetEnvironment: "production",
+	}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected a promotion to production to be forbidden, got %v", err)
+	}
+	if _, err := svc.ApprovePromotionRequest(ctx, "acme", "shop", "api", "api-production-x1", nil); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected an approval in production to be forbidden, got %v", err)
+	}
+}
+
+func TestTargetRequiresApproval(t *testing.T) {
+	if targetRequiresApproval(&v1alpha1.TargetEnvironmentRef{Name: "dev"}) {
+		t.Error("expected no approval for a plain target")
+	}
+	if !targetRequiresApproval(&v1alpha1.TargetEnvironmentRef{Name: "prod", RequiresApproval: true}) {
+		t.Error("expected approval when RequiresApproval is set")
+	}
+	if !targetRequiresApproval(&v1alpha1.TargetEnvironmentRef{Name: "prod", IsManualApprovalRequired: true}) {
+		t.Error("expected approval when IsManualApprovalRequired is set")
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Diff actions of a previewed resource
+const (
+	diffActionAdded   = "added"
+	diffActionChanged = "changed"
+	diffActionRemoved = "removed"
+)
+
+// redactedValue replaces the values of Secret data in previews
+const redactedValue = "<redacted>"
+
+// PreviewReleaseBinding renders a release binding with the proposed changes applied and compares the
+// result with the Releases currently applied for it. Nothing is persisted. A binding that does not exist
+// yet can be previewed by giving the environment and release in the request.
+func (s *ComponentService) PreviewReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string,
+	req *models.PreviewReleaseBindingRequest) (*models.ReleaseBindingPreviewResponse, error) {
+	s.logger.Debug("Previewing release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	// Authorization check
+	if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewReleaseBinding, ResourceTypeReleaseBinding, bindingName,
+		authz.ResourceHierarchy{Namespace: orgName, Project: projectName, Component: componentName}); err != nil {
+		return nil, err
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			return nil, ErrComponentNotFound
+		}
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	if component.Spec.Owner.ProjectName != projectName {
+		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
+		return nil, ErrComponentNotFound
+	}
+
+	var binding openchoreov1alpha1.ReleaseBinding
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: bindingName}, &binding); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			s.logger.Error("Failed to get release binding", "error", err)
+			return nil, fmt.Errorf("failed to get release binding: %w", err)
+		}
+		if req.Environment == "" {
+			s.logger.Warn("Release binding not found", "org", orgName, "binding", bindingName)
+			return nil, ErrReleaseBindingNotFound
+		}
+		binding = openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: orgName},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: projectName, ComponentName: componentName},
+				Environment: req.Environment,
+			},
+		}
+	}
+	if binding.Spec.Owner.ProjectName != projectName || binding.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release binding does not belong to component", "org", orgName, "component", componentName, "binding", bindingName)
+		return nil, ErrReleaseBindingNotFound
+	}
+
+	if req.ReleaseName != "" {
+		binding.Spec.ReleaseName = req.ReleaseName
+	}
+	if binding.Spec.ReleaseName == "" {
+		s.logger.Warn("Release binding has no release to preview", "org", orgName, "binding", bindingName)
+		return nil, ErrComponentReleaseNotFound
+	}
+	if err := s.applyReleaseBindingOverrides(&binding, &req.PatchReleaseBindingRequest); err != nil {
+		return nil, err
+	}
+
+	var componentRelease openchoreov1alpha1.ComponentRelease
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: binding.Spec.ReleaseName}, &componentRelease); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component release not found", "org", orgName, "release", binding.Spec.ReleaseName)
+			return nil, ErrComponentReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+	if componentRelease.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release does not belong to component", "component", componentName, "release", binding.Spec.ReleaseName)
+		return nil, ErrComponentReleaseNotFound
+	}
+
+	if req.Parameters != nil {
+		parametersJSON, err := json.Marshal(req.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal parameters: %w", err)
+		}
+		componentRelease.Spec.ComponentProfile.Parameters = &runtime.RawExtension{Raw: parametersJSON}
+	}
+
+	renderer := &releasebinding.Reconciler{
+		Client:   s.k8sClient,
+		Scheme:   s.k8sClient.Scheme(),
+		Pipeline: s.renderPipeline,
+	}
+	preview, err := renderer.RenderPreview(ctx, &binding, &componentRelease)
+	if err != nil {
+		s.logger.Warn("Failed to render release binding preview", "binding", bindingName, "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrReleaseBindingRenderFailed, err)
+	}
+
+	currentDataPlane, err := s.getReleaseResources(ctx, orgName, preview.DataPlaneReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	currentObservability, err := s.getReleaseResources(ctx, orgName, preview.ObservabilityReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	currentCanary, err := s.getReleaseResources(ctx, orgName, preview.CanaryReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.ReleaseBindingPreviewResponse{
+		BindingName: bindingName,
+		Environment: binding.Spec.Environment,
+		ReleaseName: binding.Spec.ReleaseName,
+		Resources:   make([]models.PreviewResource, 0, len(preview.DataPlaneResources)+len(preview.ObservabilityResources)),
+		Diff:        make([]models.ResourceDiff, 0),
+	}
+	for _, plane := range []struct {
+		targetPlane string
+		current     []openchoreov1alpha1.Resource
+		proposed    []openchoreov1alpha1.Resource
+	}{
+		{openchoreov1alpha1.TargetPlaneDataPlane, currentDataPlane, preview.DataPlaneResources},
+		{openchoreov1alpha1.TargetPlaneObservabilityPlane, currentObservability, preview.ObservabilityResources},
+		{openchoreov1alpha1.TargetPlaneDataPlane, currentCanary, preview.CanaryResources},
+	} {
+		proposed, err := decodeReleaseResources(plane.proposed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode rendered resources: %w", err)
+		}
+		current, err := decodeReleaseResources(plane.current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode applied resources: %w", err)
+		}
+		for _, resource := range plane.proposed {
+			response.Resources = append(response.Resources, models.PreviewResource{
+				TargetPlane: plane.targetPlane,
+				ID:          resource.ID,
+				Object:      redactSecret(proposed[resource.ID]),
+			})
+		}
+		diff, unchanged := diffReleaseResources(plane.targetPlane, current, proposed)
+		response.Diff = append(response.Diff, diff...)
+		response.Summary.Unchanged += unchanged
+	}
+
+	for _, resourceDiff := range response.Diff {
+		switch resourceDiff.Action {
+		case diffActionAdded:
+			response.Summary.Added++
+		case diffActionChanged:
+			response.Summary.Changed++
+		case diffActionRemoved:
+			response.Summary.Removed++
+		}
+	}
+
+	s.logger.Debug("Release binding previewed successfully", "org", orgName, "binding", bindingName,
+		"added", response.Summary.Added, "changed", response.Summary.Changed, "removed", response.Summary.Removed)
+	return response, nil
+}
+
+// getReleaseResources returns the resources of a Release, or nil if the Release does not exist
+func (s *ComponentService) getReleaseResources(ctx context.Context, orgName, releaseName string) ([]openchoreov1alpha1.Resource, error) {
+	var release openchoreov1alpha1.Release
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: releaseName}, &release); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, nil
+		}
+		s.logger.Error("Failed to get release", "release", releaseName, "error", err)
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+	return release.Spec.Resources, nil
+}
+
+// decodeReleaseResources decodes the objects of Release resources keyed by resource ID
+func decodeReleaseResources(resources []openchoreov1alpha1.Resource) (map[string]map[string]any, error) {
+	objects := make(map[string]map[string]any, len(resources))
+	for _, resource := range resources {
+		if resource.Object == nil {
+			continue
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(resource.Object.Raw, &obj); err != nil {
+			return nil, fmt.Errorf("resource %s: %w", resource.ID, err)
+		}
+		objects[resource.ID] = obj
+	}
+	return objects, nil
+}
+
+// isSecret reports whether a decoded object is a Secret
+func isSecret(obj map[string]any) bool {
+	kind, _ := obj["kind"].(string)
+	apiVersion, _ := obj["apiVersion"].(string)
+	return kind == "Secret" && apiVersion == "v1"
+}
+
+// redactSecret returns a Secret with the values of its data and stringData replaced, so that previews
+// never expose secret values. Other objects are returned as they are.
+func redactSecret(obj map[string]any) map[string]any {
+	if !isSecret(obj) {
+		return obj
+	}
+	redacted := make(map[string]any, len(obj))
+	for key, value := range obj {
+		redacted[key] = value
+	}
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := obj[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		redactedValues := make(map[string]any, len(values))
+		for key := range values {
+			redactedValues[key] = redactedValue
+		}
+		redacted[field] = redactedValues
+	}
+	return redacted
+}
+
+// redactSecretChanges replaces the values of the changed Secret data fields, keeping the paths so that
+// the preview still shows which keys change
+func redactSecretChanges(changes []models.FieldChange) {
+	for i := range changes {
+		field, _, _ := strings.Cut(changes[i].Path, ".")
+		if field != "data" && field != "stringData" {
+			continue
+		}
+		if changes[i].Current != nil {
+			changes[i].Current = redactedValue
+		}
+		if changes[i].Proposed != nil {
+			changes[i].Proposed = redactedValue
+		}
+	}
+}
+
+// diffReleaseResources compares the applied and the proposed resources of a plane by resource ID.
+// It returns the resources that would be added, changed or removed, and the number left unchanged.
+// The labels the Release controller adds when applying are not part of the Release spec, so they
+// never show up as changes.
+func diffReleaseResources(targetPlane string, current, proposed map[string]map[string]any) ([]models.ResourceDiff, int) {
+	ids := make([]string, 0, len(current)+len(proposed))
+	for id := range proposed {
+		ids = append(ids, id)
+	}
+	for id := range current {
+		if _, found := proposed[id]; !found {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	diffs := make([]models.ResourceDiff, 0)
+	unchanged := 0
+	for _, id := range ids {
+		currentObj, inCurrent := current[id]
+		proposedObj, inProposed := proposed[id]
+
+		var resourceDiff models.ResourceDiff
+		switch {
+		case !inCurrent:
+			resourceDiff = newResourceDiff(targetPlane, id, proposedObj, diffActionAdded)
+		case !inProposed:
+			resourceDiff = newResourceDiff(targetPlane, id, currentObj, diffActionRemoved)
+		default:
+			var changes []models.FieldChange
+			diffValues(currentObj, proposedObj, "", &changes)
+			if len(changes) == 0 {
+				unchanged++
+				continue
+			}
+			if isSecret(currentObj) || isSecret(proposedObj) {
+				redactSecretChanges(changes)
+			}
+			resourceDiff = newResourceDiff(targetPlane, id, proposedObj, diffActionChanged)
+			resourceDiff.Changes = changes
+		}
+		diffs = append(diffs, resourceDiff)
+	}
+	return diffs, unchanged
+}
+
+// newResourceDiff describes the given object in a resource diff
+func newResourceDiff(targetPlane, id string, obj map[string]any, action string) models.ResourceDiff {
+	resourceDiff := models.ResourceDiff{TargetPlane: targetPlane, ID: id, Action: action}
+	resourceDiff.Kind, _ = obj["kind"].(string)
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		resourceDiff.Name, _ = metadata["name"].(string)
+		resourceDiff.Namespace, _ = metadata["namespace"].(string)
+	}
+	return resourceDiff
+}
+
+// diffValues records the field changes between two decoded JSON values. Lists whose entries all
+// have a name are compared entry by entry by name, other lists by index.
+func diffValues(current, proposed any, path string, changes *[]models.FieldChange) {
+	currentMap, currentIsMap := current.(map[string]any)
+	proposedMap, proposedIsMap := proposed.(map[string]any)
+	if currentIsMap && proposedIsMap {
+		keys := make([]string, 0, len(currentMap)+len(proposedMap))
+		for key := range proposedMap {
+			keys = append(keys, key)
+		}
+		for key := range currentMap {
+			if _, found := proposedMap[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffChild(currentMap, proposedMap, key, joinFieldPath(path, key), changes)
+		}
+		return
+	}
+
+	currentList, currentIsList := current.([]any)
+	proposedList, proposedIsList := proposed.([]any)
+	if currentIsList && proposedIsList {
+		currentByName, currentNamed := listEntriesByName(currentList)
+		proposedByName, proposedNamed := listEntriesByName(proposedList)
+		if currentNamed && proposedNamed {
+			names := make([]string, 0, len(currentByName)+len(proposedByName))
+			for _, item := range proposedList {
+				names = append(names, item.(map[string]any)["name"].(string))
+			}
+			for _, item := range currentList {
+				name := item.(map[string]any)["name"].(string)
+				if _, found := proposedByName[name]; !found {
+					names = append(names, name)
+				}
+			}
+			for _, name := range names {
+				diffChild(currentByName, proposedByName, name, fmt.Sprintf("%s[%s]", path, name), changes)
+			}
+			return
+		}
+
+		for i := 0; i < len(currentList) || i < len(proposedList); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(currentList):
+				*changes = append(*changes, models.FieldChange{Path: childPath, Proposed: proposedList[i]})
+			case i >= len(proposedList):
+				*changes = append(*changes, models.FieldChange{Path: childPath, Current: currentList[i]})
+			default:
+				diffValues(currentList[i], proposedList[i], childPath, changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(current, proposed) {
+		*changes = append(*changes, models.FieldChange{Path: path, Current: current, Proposed: proposed})
+	}
+}
+
+// diffChild compares the entry of two maps under the same key
+func diffChild[V any](current, proposed map[string]V, key, path string, changes *[]models.FieldChange) {
+	currentValue, inCurrent := current[key]
+	proposedValue, inProposed := proposed[key]
+	switch {
+	case !inCurrent:
+		*changes = append(*changes, models.FieldChange{Path: path, Proposed: proposedValue})
+	case !inProposed:
+		*changes = append(*changes, models.FieldChange{Path: path, Current: currentValue})
+	default:
+		diffValues(currentValue, proposedValue, path, changes)
+	}
+}
+
+// listEntriesByName indexes the entries of a list by name when every entry is an object with a unique name
+func listEntriesByName(list []any) (map[string]any, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+	byName := make(map[string]any, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, duplicate := byName[name]; duplicate {
+			return nil, false
+		}
+		byName[name] = item
+	}
+	return byName, true
+}
+
+// joinFieldPath appends a key to a dotted field path
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,309 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/authz"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func TestDiffReleaseResources(t *testing.T) {
+	current := map[string]map[string]any{
+		"deployment-api": {
+			"kind":     "Deployment",
+			"metadata": map[string]any{"name": "api", "namespace": "dp"},
+			"spec": map[string]any{
+				"replicas": float64(1),
+				"template": map[string]any{"spec": map[string]any{"containers": []any{
+					map[string]any{"name": "main", "image": "api:v1"},
+					map[string]any{"name": "proxy", "image": "proxy:v1"},
+				}}},
+			},
+		},
+		"service-api":   {"kind": "Service", "metadata": map[string]any{"name": "api"}},
+		"configmap-old": {"kind": "ConfigMap", "metadata": map[string]any{"name": "old"}},
+	}
+	proposed := map[string]map[string]any{
+		"deployment-api": {
+			"kind":     "Deployment",
+			"metadata": map[string]any{"name": "api", "namespace": "dp"},
+			"spec": map[string]any{
+				"replicas": float64(3),
+				"template": map[string]any{"spec": map[string]any{"containers": []any{
+					map[string]any{"name": "proxy", "image": "proxy:v1"},
+					map[string]any{"name": "main", "image": "api:v2"},
+				}}},
+			},
+		},
+		"service-api": {"kind": "Service", "metadata": map[string]any{"name": "api"}},
+		"hpa-api":     {"kind": "HorizontalPodAutoscaler", "metadata": map[string]any{"name": "api"}},
+	}
+
+	diffs, unchanged := diffReleaseResources(v1alpha1.TargetPlaneDataPlane, current, proposed)
+	if unchanged != 1 {
+		t.Errorf("expected 1 unchanged resource, got %d", unchanged)
+	}
+	if len(diffs) != 3 {
+		t.Fatalf("expected 3 resource diffs, got %+v", diffs)
+	}
+
+	// Diffs are ordered by resource ID
+	if diffs[0].ID != "configmap-old" || diffs[0].Action != diffActionRemoved || diffs[0].Name != "old" {
+		t.Errorf("expected configmap-old to be removed, got %+v", diffs[0])
+	}
+	if diffs[2].ID != "hpa-api" || diffs[2].Action != diffActionAdded || diffs[2].Kind != "HorizontalPodAutoscaler" {
+		t.Errorf("expected hpa-api to be added, got %+v", diffs[2])
+	}
+
+	deployment := diffs[1]
+	if deployment.Action != diffActionChanged || deployment.Namespace != "dp" {
+		t.Fatalf("expected the deployment to be changed, got %+v", deployment)
+	}
+	want := []models.FieldChange{
+		{Path: "spec.replicas", Current: float64(1), Proposed: float64(3)},
+		{Path: "spec.template.spec.containers[main].image", Current: "api:v1", Proposed: "api:v2"},
+	}
+	if len(deployment.Changes) != len(want) {
+		t.Fatalf("expected changes %+v, got %+v", want, deployment.Changes)
+	}
+	for i := range want {
+		if deployment.Changes[i] != want[i] {
+			t.Errorf("expected change %+v, got %+v", want[i], deployment.Changes[i])
+		}
+	}
+}
+
+func TestDiffValuesUnnamedLists(t *testing.T) {
+	var changes []models.FieldChange
+	diffValues(
+		map[string]any{"args": []any{"--port", "80"}},
+		map[string]any{"args": []any{"--port", "8080", "--debug"}},
+		"", &changes)
+
+	want := []models.FieldChange{
+		{Path: "args[1]", Current: "80", Proposed: "8080"},
+		{Path: "args[2]", Proposed: "--debug"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected changes %+v, got %+v", want, changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("expected change %+v, got %+v", want[i], changes[i])
+		}
+	}
+}
+
+func previewTestObjects() []runtime.Object {
+	ns := "acme"
+	return []runtime.Object{
+		&v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: ns, UID: "project-uid"}},
+		&v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: ns, UID: "component-uid"},
+			Spec: v1alpha1.ComponentSpec{
+				Owner:         v1alpha1.ComponentOwner{ProjectName: "shop"},
+				ComponentType: "deployment/service",
+			},
+		},
+		&v1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: ns, UID: "environment-uid"},
+			Spec:       v1alpha1.EnvironmentSpec{DataPlaneRef: "default"},
+		},
+		&v1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: ns, UID: "dataplane-uid"}},
+		&v1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-1", Namespace: ns},
+			Spec: v1alpha1.ComponentReleaseSpec{
+				Owner: v1alpha1.ComponentReleaseOwner{ProjectName: "shop", ComponentName: "api"},
+				ComponentType: v1alpha1.ComponentTypeSpec{
+					WorkloadType: "deployment",
+					Schema: v1alpha1.ComponentTypeSchema{
+						Parameters: &runtime.RawExtension{Raw: []byte(`{"replicas":"integer | default=1"}`)},
+					},
+					Resources: []v1alpha1.ResourceTemplate{{
+						ID: "deployment",
+						Template: &runtime.RawExtension{Raw: []byte(`{
+							"apiVersion": "apps/v1",
+							"kind": "Deployment",
+							"metadata": {"name": "${metadata.name}", "namespace": "${metadata.namespace}"},
+							"spec": {"replicas": "${parameters.replicas}"}
+						}`)},
+					}},
+				},
+				ComponentProfile: v1alpha1.ComponentProfile{
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"replicas":1}`)},
+				},
+			},
+		},
+		&v1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: ns},
+			Spec: v1alpha1.ReleaseBindingSpec{
+				Owner:       v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "api"},
+				Environment: "production",
+				ReleaseName: "api-1",
+			},
+		},
+	}
+}
+
+func TestComponentService_PreviewReleaseBinding(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(previewTestObjects()...).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewComponentService(k8sClient, nil, logger, authz.NewDisabledAuthorizer(logger))
+	ctx := context.Background()
+
+	if _, err := svc.PreviewReleaseBinding(ctx, "acme", "shop", "api", "api-staging",
+		&models.PreviewReleaseBindingRequest{}); !errors.Is(err, ErrReleaseBindingNotFound) {
+		t.Errorf("expected ErrReleaseBindingNotFound for a missing binding without environment, got %v", err)
+	}
+
+	// Nothing is applied yet, so every rendered resource is new
+	preview, err := svc.PreviewReleaseBinding(ctx, "acme", "shop", "api", "api-production", &models.PreviewReleaseBindingRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Only the rendered Deployment, since neither the environment nor the project isolates the component
+	if len(preview.Resources) != 1 || preview.Summary.Added != 1 {
+		t.Fatalf("expected one added resource, got %+v", preview)
+	}
+
+	// Record the rendered resources as the applied Release and preview a parameter change
+	applied := &v1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: "acme"},
+	}
+	for _, resource := range preview.Resources {
+		raw, err := json.Marshal(resource.Object)
+		if err != nil {
+			t.Fatalf("failed to encode resource: %v", err)
+		}
+		applied.Spec.Resources = append(applied.Spec.Resources,
+			v1alpha1.Resource{ID: resource.ID, Object: &runtime.RawExtension{Raw: raw}})
+	}
+	if err := k8sClient.Create(ctx, applied); err != nil {
+		t.Fatalf("failed to create release: %v", err)
+	}
+
+	preview, err = svc.PreviewReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.PreviewReleaseBindingRequest{Parameters: map[string]interface{}{"replicas": 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if preview.Summary.Changed != 1 || len(preview.Diff) != 1 {
+		t.Fatalf("expected one changed resource, got %+v", preview)
+	}
+	changes := preview.Diff[0].Changes
+	if len(changes) != 1 || changes[0].Path != "spec.replicas" || changes[0].Current != float64(1) || changes[0].Proposed != float64(3) {
+		t.Errorf("expected spec.replicas to change from 1 to 3, got %+v", changes)
+	}
+
+	stored := &v1alpha1.ComponentRelease{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "api-1"}, stored); err != nil {
+		t.Fatalf("failed to get component release: %v", err)
+	}
+	if string(stored.Spec.ComponentProfile.Parameters.Raw) != `{"replicas":1}` {
+		t.Errorf("expected the component release to be left unchanged, got %s", stored.Spec.ComponentProfile.Parameters.Raw)
+	}
+}
+
+func TestComponentService_PreviewReleaseBindingRollout(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	objects := previewTestObjects()
+	var next *v1alpha1.ComponentRelease
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *v1alpha1.ComponentRelease:
+			next = o.DeepCopy()
+			next.Name = "api-2"
+			next.Spec.ComponentProfile.Parameters = &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}
+		case *v1alpha1.ReleaseBinding:
+			o.Spec.Rollout = &v1alpha1.RolloutStrategy{Type: v1alpha1.RolloutStrategyCanary}
+			o.Status.Rollout = &v1alpha1.RolloutStatus{Phase: v1alpha1.RolloutPhaseSucceeded, StableRelease: "api-1"}
+		}
+	}
+	objects = append(objects, next)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewComponentService(k8sClient, nil, logger, authz.NewDisabledAuthorizer(logger))
+
+	preview, err := svc.PreviewReleaseBinding(context.Background(), "acme", "shop", "api", "api-production",
+		&models.PreviewReleaseBindingRequest{
+			PatchReleaseBindingRequest: models.PatchReleaseBindingRequest{ReleaseName: "api-2"},
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The main Release keeps the stable workload and the new release starts as a canary
+	var replicas []any
+	for _, resource := range preview.Resources {
+		if resource.Object["kind"] != "Deployment" {
+			continue
+		}
+		spec := resource.Object["spec"].(map[string]any)
+		podLabels := spec["template"].(map[string]any)["metadata"].(map[string]any)["labels"].(map[string]any)
+		replicas = append(replicas, spec["replicas"])
+		if spec["replicas"] == float64(1) && podLabels["openchoreo.dev/rollout-track"] != "stable" {
+			t.Errorf("expected the stable deployment to be on the stable track, got %v", podLabels)
+		}
+		if spec["replicas"] == float64(2) && podLabels["openchoreo.dev/rollout-track"] != "canary" {
+			t.Errorf("expected the canary deployment to be on the canary track, got %v", podLabels)
+		}
+	}
+	if len(replicas) != 2 {
+		t.Fatalf("expected a stable and a canary deployment, got replicas %v", replicas)
+	}
+}
+
+func TestRedactSecret(t *testing.T) {
+	secret := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "db"},
+		"data":       map[string]any{"password": "c2VjcmV0"},
+		"stringData": map[string]any{"user": "admin"},
+	}
+	redacted := redactSecret(secret)
+	if redacted["data"].(map[string]any)["password"] != redactedValue || redacted["stringData"].(map[string]any)["user"] != redactedValue {
+		t.Errorf("expected the secret values to be redacted, got %+v", redacted)
+	}
+	if secret["data"].(map[string]any)["password"] != "c2VjcmV0" {
+		t.Error("expected the original secret to be left unchanged")
+	}
+
+	changed := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "db"},
+		"data":       map[string]any{"password": "bmV3"},
+	}
+	diffs, _ := diffReleaseResources(v1alpha1.TargetPlaneDataPlane,
+		map[string]map[string]any{"secret-db": secret}, map[string]map[string]any{"secret-db": changed})
+	if len(diffs) != 1 {
+		t.Fatalf("expected the secret to change, got %+v", diffs)
+	}
+	for _, change := range diffs[0].Changes {
+		if change.Current == "c2VjcmV0" || change.Proposed == "bmV3" || change.Current == "admin" {
+			t.Errorf("expected the changed secret values to be redacted, got %+v", change)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/authz"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+func boundProductionBinding() *v1alpha1.ReleaseBinding {
+	return &v1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: "acme"},
+		Spec: v1alpha1.ReleaseBindingSpec{
+			Owner:       v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "api"},
+			Environment: "production",
+			ReleaseName: "api-3",
+		},
+		Status: v1alpha1.ReleaseBindingStatus{
+			History: []v1alpha1.ReleaseHistoryEntry{
+				{ReleaseName: "api-3", BoundBy: "alice"},
+				{ReleaseName: "api-2", BoundBy: "alice"},
+				{ReleaseName: "api-1", BoundBy: "bob", Ready: true},
+			},
+		},
+	}
+}
+
+func componentRelease(name string) *v1alpha1.ComponentRelease {
+	return &v1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+		Spec: v1alpha1.ComponentReleaseSpec{
+			Owner: v1alpha1.ComponentReleaseOwner{ProjectName: "shop", ComponentName: "api"},
+		},
+	}
+}
+
+func TestSelectRollbackTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		releaseName string
+		want        string
+		wantErr     error
+	}{
+		{name: "defaults to the previous ready release", want: "api-1"},
+		{name: "accepts any earlier release from the history", releaseName: "api-2", want: "api-2"},
+		{name: "rejects the current release", releaseName: "api-3", wantErr: ErrReleaseNotInHistory},
+		{name: "rejects releases outside the history", releaseName: "api-0", wantErr: ErrReleaseNotInHistory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectRollbackTarget(boundProductionBinding(), tt.releaseName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected target %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	binding := boundProductionBinding()
+	binding.Status.History = binding.Status.History[:2]
+	if _, err := selectRollbackTarget(binding, ""); !errors.Is(err, ErrNoRollbackTarget) {
+		t.Errorf("expected ErrNoRollbackTarget without an earlier ready release, got %v", err)
+	}
+}
+
+func rollbackPipeline(requiresApproval bool) *v1alpha1.DeploymentPipeline {
+	return &v1alpha1.DeploymentPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "acme"},
+		Spec: v1alpha1.DeploymentPipelineSpec{
+			PromotionPaths: []v1alpha1.PromotionPath{{
+				SourceEnvironmentRef: "staging",
+				TargetEnvironmentRefs: []v1alpha1.TargetEnvironmentRef{
+					{Name: "production", RequiresApproval: requiresApproval},
+				},
+			}},
+		},
+	}
+}
+
+func newRollbackTestService(t *testing.T, objs ...client.Object) (*ComponentService, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	project := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "acme"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(append(objs, project, boundProductionBinding(), componentRelease("api-1"))...).
+		WithStatusSubresource(&v1alpha1.ReleaseBinding{}).
+		Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	authzPDP := authz.NewDisabledAuthorizer(logger)
+	return NewComponentService(k8sClient, NewProjectService(k8sClient, logger, authzPDP), logger, authzPDP), k8sClient
+}
+
+func TestComponentService_RollbackReleaseBinding(t *testing.T) {
+	svc, k8sClient := newRollbackTestService(t, rollbackPipeline(false))
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "carol", Type: "user"})
+
+	if _, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "web", "api-production",
+		&models.RollbackReleaseBindingRequest{}); !errors.Is(err, ErrReleaseBindingNotFound) {
+		t.Errorf("expected ErrReleaseBindingNotFound for a different component, got %v", err)
+	}
+	if _, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.RollbackReleaseBindingRequest{ReleaseName: "api-2"}); !errors.Is(err, ErrComponentReleaseNotFound) {
+		t.Errorf("expected ErrComponentReleaseNotFound for a pruned release, got %v", err)
+	}
+
+	resp, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production", &models.RollbackReleaseBindingRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.ReleaseName != "api-1" || len(resp.History) != 3 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	stored := &v1alpha1.ReleaseBinding{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "api-production"}, stored); err != nil {
+		t.Fatalf("failed to get stored binding: %v", err)
+	}
+	if stored.Spec.ReleaseName != "api-1" {
+		t.Errorf("expected binding to point at api-1, got %q", stored.Spec.ReleaseName)
+	}
+	if got := controller.GetBoundBy(stored); got != "carol" {
+		t.Errorf("expected bound-by carol, got %q", got)
+	}
+}
+
+func TestComponentService_RollbackReleaseBindingRequiresApproval(t *testing.T) {
+	svc, k8sClient := newRollbackTestService(t, rollbackPipeline(true))
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "carol", Type: "user"})
+
+	_, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production", &models.RollbackReleaseBindingRequest{})
+	if !errors.Is(err, ErrPromotionApprovalRequired) {
+		t.Fatalf("expected ErrPromotionApprovalRequired, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `raise a promotion request for release "api-1" to environment "production"`) {
+		t.Errorf("expected the error to point at a promotion request for the earlier release, got %v", err)
+	}
+
+	stored := &v1alpha1.ReleaseBinding{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "api-production"}, stored); err != nil {
+		t.Fatalf("failed to get stored binding: %v", err)
+	}
+	if stored.Spec.ReleaseName != "api-3" {
+		t.Errorf("expected binding to stay on api-3, got %q", stored.Spec.ReleaseName)
+	}
+}
+
+func TestComponentService_RollbackReleaseBindingChangeWindow(t *testing.T) {
+	now := time.Now()
+	production := &v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "acme"},
+		Spec: v1alpha1.EnvironmentSpec{ChangeCalendar: &v1alpha1.ChangeCalendar{
+			Blackouts: []v1alpha1.BlackoutPeriod{{
+				Name:  "release-freeze",
+				Start: metav1.NewTime(now.Add(-time.Hour)),
+				End:   metav1.NewTime(now.Add(time.Hour)),
+			}},
+			OutsideWindowAction: v1alpha1.ChangeWindowActionReject,
+		}},
+	}
+	svc, k8sClient := newRollbackTestService(t, rollbackPipeline(false), production)
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "carol", Type: "user"})
+
+	if _, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.RollbackReleaseBindingRequest{}); !errors.Is(err, ErrChangeWindowClosed) {
+		t.Fatalf("expected ErrChangeWindowClosed during a blackout, got %v", err)
+	}
+
+	resp, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.RollbackReleaseBindingRequest{BreakGlass: &models.BreakGlassRequest{Reason: "bad release"}})
+	if err != nil {
+		t.Fatalf("expected a break-glass rollback to be allowed, got %v", err)
+	}
+	if resp.ReleaseName != "api-1" {
+		t.Errorf("expected the binding to roll back to api-1, got %q", resp.ReleaseName)
+	}
+
+	stored := &v1alpha1.ReleaseBinding{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "acme", Name: "api-production"}, stored); err != nil {
+		t.Fatalf("failed to get stored binding: %v", err)
+	}
+	if got := controller.GetBreakGlass(stored); got != "bad release" {
+		t.Errorf("expected the break-glass reason on the binding, got %q", got)
+	}
+}
+
+// productionDenyingPDP denies requests whose environment attribute is a production environment, like a role
+// mapping with the condition "!context.environment.isProduction"
+type productionDenyingPDP struct {
+	authzcore.PDP
+}
+
+func (p productionDenyingPDP) Evaluate(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+	environment, ok := request.Context.Attributes[authzcore.ContextAttributeEnvironment].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("no such key: environment")
+	}
+	if environment["isProduction"] == true {
+		return &authzcore.Decision{Decision: false, Context: &authzcore.DecisionContext{Reason: "production"}}, nil
+	}
+	return p.PDP.Evaluate(ctx, request)
+}
+
+func TestComponentService_ReleaseBindingChangesPassEnvironmentAttributes(t *testing.T) {
+	production := &v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "acme"},
+		Spec:       v1alpha1.EnvironmentSpec{IsProduction: true},
+	}
+	staging := &v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: "acme"}}
+	component := &v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "acme"},
+		Spec:       v1alpha1.ComponentSpec{Owner: v1alpha1.ComponentOwner{ProjectName: "shop"}},
+	}
+	svc, _ := newRollbackTestService(t, rollbackPipeline(false), production, staging, component)
+	svc.authzPDP = productionDenyingPDP{PDP: svc.authzPDP}
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "carol", Type: "user"})
+
+	if _, err := svc.RollbackReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.RollbackReleaseBindingRequest{}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected a rollback in production to be forbidden, got %v", err)
+	}
+	if _, err := svc.PatchReleaseBinding(ctx, "acme", "shop", "api", "api-production",
+		&models.PatchReleaseBindingRequest{}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected a patch in production to be forbidden, got %v", err)
+	}
+	if _, err := svc.PatchReleaseBinding(ctx, "acme", "shop", "api", "api-staging",
+		&models.PatchReleaseBindingRequest{Environment: "staging"}); err != nil {
+		t.Errorf("expected a new binding in staging to be allowed, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/resourcepolicy"
+)
+
+// checkProjectBudget refuses new components in a project that has no budget left in one of its
+// environments, since the components would not fit once deployed there. The usage comes from the
+// project status, which the Project controller keeps up to date with the project's releases.
+func (s *ComponentService) checkProjectBudget(ctx context.Context, orgName, projectName string) error {
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: projectName}, project); err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var exhausted []string
+	for _, usage := range project.Status.ResourceUsage {
+		if names := resourcepolicy.Exhausted(usage.Hard, usage.Used); len(names) > 0 {
+			exhausted = append(exhausted, fmt.Sprintf("environment %q (%s)", usage.Environment,
+				resourcepolicy.Describe(usage.Hard, usage.Used, names)))
+		}
+	}
+	if len(exhausted) > 0 {
+		return fmt.Errorf("%w: %s", ErrProjectQuotaExhausted, strings.Join(exhausted, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/authz"
+)
+
+func TestComponentService_CheckProjectBudget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	project := func(name, usedCPU string) *v1alpha1.Project {
+		return &v1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+			Status: v1alpha1.ProjectStatus{ResourceUsage: []v1alpha1.EnvironmentResourceUsage{{
+				Environment: "production",
+				Hard:        corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+				Used:        corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse(usedCPU)},
+			}}},
+		}
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		project("checkout", "4"),
+		project("catalog", "2500m"),
+		&v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "search", Namespace: "acme"}},
+	).Build()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewComponentService(k8sClient, nil, logger, authz.NewDisabledAuthorizer(logger))
+	ctx := context.Background()
+
+	if err := svc.checkProjectBudget(ctx, "acme", "checkout"); !errors.Is(err, ErrProjectQuotaExhausted) {
+		t.Errorf("expected ErrProjectQuotaExhausted for a project at its quota, got %v", err)
+	}
+	if err := svc.checkProjectBudget(ctx, "acme", "catalog"); err != nil {
+		t.Errorf("expected a project with budget left to be allowed, got %v", err)
+	}
+	if err := svc.checkProjectBudget(ctx, "acme", "search"); err != nil {
+		t.Errorf("expected a project without a quota to be allowed, got %v", err)
+	}
+}
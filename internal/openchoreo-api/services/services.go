@@ -10,11 +10,14 @@ import (
 
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/tokens"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 )
 
 type Services struct {
 	ProjectService            *ProjectService
 	ComponentService          *ComponentService
+	PromotionService          *PromotionService
 	ComponentTypeService      *ComponentTypeService
 	WorkflowService           *WorkflowService
 	ComponentWorkflowService  *ComponentWorkflowService
@@ -29,17 +32,22 @@ type Services struct {
 	WebhookService            *WebhookService
 	AuthzService              *AuthzService
 	ObservabilityPlaneService *ObservabilityPlaneService
+	AuditService              *AuditService
+	TokenService              *TokenService
 	k8sClient                 client.Client // Direct access to K8s client for apply operations
 }
 
 // NewServices creates and initializes all services
-func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeMultiClientManager, authzPAP authz.PAP, authzPDP authz.PDP, logger *slog.Logger) *Services {
+func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeMultiClientManager, authzPAP authz.PAP, authzPDP authz.PDP, auditStore audit.Store, tokenStore *tokens.Store, tokenPolicy TokenPolicy, logger *slog.Logger) *Services {
 	// Create project service
 	projectService := NewProjectService(k8sClient, logger.With("service", "project"), authzPDP)
 
 	// Create component service (depends on project service)
 	componentService := NewComponentService(k8sClient, projectService, logger.With("service", "component"), authzPDP)
 
+	// Create promotion service (depends on component service)
+	promotionService := NewPromotionService(k8sClient, componentService, logger.With("service", "promotion"), authzPDP)
+
 	// Create organization service
 	organizationService := NewOrganizationService(k8sClient, logger.With("service", "organization"), authzPDP)
 
@@ -82,9 +90,16 @@ func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeM
 	// Create ObservabilityPlane service
 	observabilityPlaneService := NewObservabilityPlaneService(k8sClient, logger.With("service", "observabilityplane"))
 
+	// Create Audit service
+	auditService := NewAuditService(auditStore, logger.With("service", "audit"), authzPDP)
+
+	// Create API token service
+	tokenService := NewTokenService(tokenStore, tokenPolicy, logger.With("service", "token"), authzPDP)
+
 	return &Services{
 		ProjectService:            projectService,
 		ComponentService:          componentService,
+		PromotionService:          promotionService,
 		ComponentTypeService:      componentTypeService,
 		WorkflowService:           workflowService,
 		ComponentWorkflowService:  componentWorkflowService,
@@ -99,6 +114,8 @@ func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeM
 		WebhookService:            webhookService,
 		AuthzService:              authzService,
 		ObservabilityPlaneService: observabilityPlaneService,
+		AuditService:              auditService,
+		TokenService:              tokenService,
 		k8sClient:                 k8sClient,
 	}
 }
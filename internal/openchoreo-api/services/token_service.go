@@ -0,0 +1,355 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/tokens"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
+)
+
+// API token statuses
+const (
+	apiTokenStatusActive  = "active"
+	apiTokenStatusExpired = "expired"
+	apiTokenStatusRevoked = "revoked"
+)
+
+// entitlementsRecordInterval bounds how often the unchanged entitlements of a subject are recorded, so that
+// requests do not cause a database write each
+const entitlementsRecordInterval = time.Minute
+
+// TokenPolicy holds the lifetimes of API tokens and the subject that service account keys authenticate as
+type TokenPolicy struct {
+	DefaultLifetime time.Duration
+	MaxLifetime     time.Duration
+	// ServiceAccountType and ServiceAccountClaim are empty when service account keys are not configured
+	ServiceAccountType  string
+	ServiceAccountClaim string
+}
+
+// TokenService manages personal access tokens and service account keys.
+// Personal access tokens are managed by their owners; service account keys require the apikey actions.
+type TokenService struct {
+	store    *tokens.Store
+	policy   TokenPolicy
+	logger   *slog.Logger
+	authzPDP authz.PDP
+	now      func() time.Time
+
+	// recorded holds the entitlements last recorded for each subject
+	recorded sync.Map
+}
+
+type recordedEntitlements struct {
+	fingerprint string
+	at          time.Time
+}
+
+var _ apikey.Authenticator = (*TokenService)(nil)
+
+// NewTokenService creates a new token service. The store is nil when API tokens are not enabled.
+func NewTokenService(store *tokens.Store, policy TokenPolicy, logger *slog.Logger, authzPDP authz.PDP) *TokenService {
+	return &TokenService{
+		store:    store,
+		policy:   policy,
+		logger:   logger,
+		authzPDP: authzPDP,
+		now:      time.Now,
+	}
+}
+
+// Enabled reports whether API tokens can be created and used
+func (s *TokenService) Enabled() bool {
+	return s.store != nil
+}
+
+// Authenticate implements apikey.Authenticator
+func (s *TokenService) Authenticate(ctx context.Context, token string) (*apikey.Principal, error) {
+	if s.store == nil {
+		return nil, ErrAPITokensNotEnabled
+	}
+	return s.store.Authenticate(ctx, token)
+}
+
+// RecordEntitlements records the entitlements a subject authenticated with outside API tokens. Personal access
+// tokens resolve their entitlements from the recorded ones when used, so that entitlements removed from the
+// subject are removed from its tokens as soon as the subject next authenticates.
+func (s *TokenService) RecordEntitlements(ctx context.Context, subject *auth.SubjectContext) error {
+	if s.store == nil || subject == nil || subject.ID == "" {
+		return nil
+	}
+
+	fingerprint := subject.EntitlementClaim + "\x00" + strings.Join(subject.EntitlementValues, "\x00")
+	now := s.now()
+	if previous, ok := s.recorded.Load(subject.ID); ok {
+		recorded := previous.(recordedEntitlements)
+		if recorded.fingerprint == fingerprint && now.Sub(recorded.at) < entitlementsRecordInterval {
+			return nil
+		}
+	}
+
+	if err := s.store.RecordEntitlements(ctx, subject.ID, subject.EntitlementClaim, subject.EntitlementValues); err != nil {
+		return err
+	}
+	s.recorded.Store(subject.ID, recordedEntitlements{fingerprint: fingerprint, at: now})
+	return nil
+}
+
+// CreateToken creates an API token and returns it. The token cannot be retrieved again.
+func (s *TokenService) CreateToken(ctx context.Context, req *models.CreateAPITokenRequest) (*models.CreatedAPITokenResponse, error) {
+	if s.store == nil {
+		return nil, ErrAPITokensNotEnabled
+	}
+	// A token must not be able to mint tokens that outlive it
+	if _, ok := apikey.GetTokenIDFromContext(ctx); ok {
+		return nil, ErrAPITokenCreatedWithToken
+	}
+	caller, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || caller == nil || caller.ID == "" {
+		return nil, ErrForbidden
+	}
+
+	lifetime := req.Lifetime()
+	if lifetime == 0 {
+		lifetime = s.policy.DefaultLifetime
+	}
+	if lifetime > s.policy.MaxLifetime {
+		return nil, fmt.Errorf("%w: %s is more than %s", ErrAPITokenLifetimeExceeded, lifetime, s.policy.MaxLifetime)
+	}
+
+	now := s.now().UTC()
+	token := &tokens.Token{
+		ID:          uuid.NewString(),
+		Name:        req.Name,
+		Description: req.Description,
+		Kind:        req.Kind,
+		OwnerID:     caller.ID,
+		Scopes:      req.Scopes,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(lifetime),
+	}
+
+	switch req.Kind {
+	case models.APITokenKindService:
+		if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionCreateAPIKey, ResourceTypeAPIKey, req.ServiceAccount,
+			authz.ResourceHierarchy{}); err != nil {
+			return nil, err
+		}
+		if s.policy.ServiceAccountType == "" {
+			return nil, ErrServiceKeysNotConfigured
+		}
+		token.SubjectID = req.ServiceAccount
+		token.SubjectType = s.policy.ServiceAccountType
+		token.EntitlementClaim = s.policy.ServiceAccountClaim
+		token.EntitlementValues = []string{req.ServiceAccount}
+		if err := s.checkKeyWithinCaller(ctx, caller, token); err != nil {
+			return nil, err
+		}
+	default:
+		for _, entitlement := range req.Entitlements {
+			if !slices.Contains(caller.EntitlementValues, entitlement) {
+				return nil, fmt.Errorf("%w: %q", ErrAPITokenEntitlementNotHeld, entitlement)
+			}
+		}
+		// The entitlements are resolved from those the creator holds when the token is used, so only the
+		// selection the token is limited to is stored
+		if err := s.RecordEntitlements(ctx, caller); err != nil {
+			return nil, err
+		}
+		token.SubjectID = caller.ID
+		token.SubjectType = caller.Type
+		token.EntitlementClaim = caller.EntitlementClaim
+		token.EntitlementValues = slices.Clone(req.Entitlements)
+		// A personal access token keeps the scopes its creator is limited to
+		if len(caller.Scopes) > 0 && len(token.Scopes) == 0 {
+			token.Scopes = slices.Clone(caller.Scopes)
+		}
+	}
+
+	plaintext, err := apikey.Generate(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	token.Hash = apikey.Hash(plaintext)
+
+	if err := s.store.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("API token created", "id", token.ID, "kind", token.Kind, "subject", token.SubjectID, "owner", token.OwnerID,
+		"expiresAt", token.ExpiresAt)
+	return &models.CreatedAPITokenResponse{
+		APITokenResponse: s.toTokenResponse(token),
+		Token:            plaintext,
+	}, nil
+}
+
+// checkKeyWithinCaller ensures that the caller holds every permission a service account key would have, so
+// that apikey:create does not let the caller act with the permissions of a more privileged service account
+func (s *TokenService) checkKeyWithinCaller(ctx context.Context, caller *auth.SubjectContext, token *tokens.Token) error {
+	key := &authz.SubjectContext{
+		ID:                token.SubjectID,
+		Type:              token.SubjectType,
+		EntitlementClaim:  token.EntitlementClaim,
+		EntitlementValues: token.EntitlementValues,
+		Scopes:            token.Scopes,
+	}
+	profile, err := s.authzPDP.GetSubjectProfile(ctx, &authz.ProfileRequest{SubjectContext: key})
+	if err != nil {
+		return fmt.Errorf("failed to get the permissions of service account %q: %w", token.SubjectID, err)
+	}
+
+	actions := make([]string, 0, len(profile.Capabilities))
+	for action := range profile.Capabilities {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	// Each permission is evaluated for the key and for the caller. The profile ignores the scopes of the key,
+	// so permissions the key is not allowed within its scopes do not need to be held by the caller.
+	callerSubject := authz.GetAuthzSubjectContext(caller)
+	var requests []authz.EvaluateRequest
+	for _, action := range actions {
+		resourceType, _, _ := strings.Cut(action, ":")
+		for _, resource := range profile.Capabilities[action].Allowed {
+			target := authz.Resource{Type: resourceType, Hierarchy: resource.Hierarchy}
+			requests = append(requests,
+				authz.EvaluateRequest{SubjectContext: key, Action: action, Resource: target},
+				authz.EvaluateRequest{SubjectContext: callerSubject, Action: action, Resource: target})
+		}
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	response, err := s.authzPDP.BatchEvaluate(ctx, &authz.BatchEvaluateRequest{Requests: requests})
+	if err != nil {
+		return fmt.Errorf("authorization evaluation failed: %w", err)
+	}
+	if len(response.Decisions) != len(requests) {
+		return fmt.Errorf("authorization evaluation returned %d decisions for %d requests", len(response.Decisions), len(requests))
+	}
+	for i := 0; i < len(requests); i += 2 {
+		if response.Decisions[i].Decision && !response.Decisions[i+1].Decision {
+			s.logger.Debug("service account key exceeds the permissions of its creator", "serviceAccount", token.SubjectID,
+				"action", requests[i].Action, "hierarchy", requests[i].Resource.Hierarchy)
+			return fmt.Errorf("%w: the caller is not allowed %s", ErrAPIKeyExceedsCaller, requests[i].Action)
+		}
+	}
+	return nil
+}
+
+// ListTokens returns the personal access tokens of the caller, or all service account keys when kind is service
+func (s *TokenService) ListTokens(ctx context.Context, kind string) ([]*models.APITokenResponse, error) {
+	if s.store == nil {
+		return nil, ErrAPITokensNotEnabled
+	}
+
+	filter := tokens.ListFilter{Kind: kind}
+	switch kind {
+	case models.APITokenKindService:
+		if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionViewAPIKey, ResourceTypeAPIKey, "*",
+			authz.ResourceHierarchy{}); err != nil {
+			return nil, err
+		}
+	case "", models.APITokenKindPersonal:
+		filter.Kind = models.APITokenKindPersonal
+		filter.OwnerID = subjectIDFromContext(ctx)
+		if filter.OwnerID == "" {
+			return nil, ErrForbidden
+		}
+	default:
+		return nil, fmt.Errorf("%w: kind must be %s or %s", ErrInvalidAPITokenKind, models.APITokenKindPersonal, models.APITokenKindService)
+	}
+
+	stored, err := s.store.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*models.APITokenResponse, 0, len(stored))
+	for _, token := range stored {
+		response := s.toTokenResponse(token)
+		items = append(items, &response)
+	}
+	return items, nil
+}
+
+// RevokeToken revokes a token. Owners revoke their personal access tokens; revoking service account keys
+// and the tokens of other subjects requires apikey:revoke.
+func (s *TokenService) RevokeToken(ctx context.Context, id string) error {
+	if s.store == nil {
+		return ErrAPITokensNotEnabled
+	}
+
+	token, err := s.store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, tokens.ErrTokenNotFound) {
+			return ErrAPITokenNotFound
+		}
+		return err
+	}
+
+	callerID := subjectIDFromContext(ctx)
+	if token.Kind != models.APITokenKindPersonal || callerID == "" || token.OwnerID != callerID {
+		if err := checkAuthorization(ctx, s.logger, s.authzPDP, SystemActionRevokeAPIKey, ResourceTypeAPIKey, token.SubjectID,
+			authz.ResourceHierarchy{}); err != nil {
+			// The personal access tokens of others are not disclosed
+			if errors.Is(err, ErrForbidden) && token.Kind == models.APITokenKindPersonal {
+				return ErrAPITokenNotFound
+			}
+			return err
+		}
+	}
+
+	if err := s.store.Revoke(ctx, id); err != nil {
+		if errors.Is(err, tokens.ErrTokenNotFound) {
+			return ErrAPITokenNotFound
+		}
+		return err
+	}
+	s.logger.Info("API token revoked", "id", id, "kind", token.Kind, "subject", token.SubjectID, "revokedBy", callerID)
+	return nil
+}
+
+func (s *TokenService) toTokenResponse(token *tokens.Token) models.APITokenResponse {
+	status := apiTokenStatusActive
+	switch {
+	case token.Revoked():
+		status = apiTokenStatusRevoked
+	case token.Expired(s.now()):
+		status = apiTokenStatusExpired
+	}
+	return models.APITokenResponse{
+		ID:                token.ID,
+		Name:              token.Name,
+		Description:       token.Description,
+		Kind:              token.Kind,
+		Subject:           token.SubjectID,
+		SubjectType:       token.SubjectType,
+		EntitlementClaim:  token.EntitlementClaim,
+		EntitlementValues: token.EntitlementValues,
+		Scopes:            token.Scopes,
+		CreatedBy:         token.OwnerID,
+		CreatedAt:         token.CreatedAt,
+		ExpiresAt:         token.ExpiresAt,
+		LastUsedAt:        token.LastUsedAt,
+		RevokedAt:         token.RevokedAt,
+		Status:            status,
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/tokens"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// grantsPDP allows the actions granted to each entitlement value on every resource of the acme namespace
+type grantsPDP struct {
+	authz.PDP
+	grants map[string][]string
+}
+
+func (p grantsPDP) Evaluate(_ context.Context, request *authz.EvaluateRequest) (*authz.Decision, error) {
+	subject := request.SubjectContext
+	if len(subject.Scopes) > 0 && !slices.Contains(subject.Scopes, request.Action) {
+		return &authz.Decision{Decision: false, Context: &authz.DecisionContext{Reason: "out of scope"}}, nil
+	}
+	for _, value := range subject.EntitlementValues {
+		if slices.Contains(p.grants[value], request.Action) {
+			return &authz.Decision{Decision: true, Context: &authz.DecisionContext{Reason: "granted"}}, nil
+		}
+	}
+	return &authz.Decision{Decision: false, Context: &authz.DecisionContext{Reason: "not granted"}}, nil
+}
+
+func (p grantsPDP) BatchEvaluate(ctx context.Context, request *authz.BatchEvaluateRequest) (*authz.BatchEvaluateResponse, error) {
+	response := &authz.BatchEvaluateResponse{}
+	for i := range request.Requests {
+		decision, err := p.Evaluate(ctx, &request.Requests[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Decisions = append(response.Decisions, *decision)
+	}
+	return response, nil
+}
+
+func (p grantsPDP) GetSubjectProfile(_ context.Context, request *authz.ProfileRequest) (*authz.UserCapabilitiesResponse, error) {
+	capabilities := map[string]*authz.ActionCapability{}
+	for _, value := range request.SubjectContext.EntitlementValues {
+		for _, action := range p.grants[value] {
+			capabilities[action] = &authz.ActionCapability{
+				Allowed: []*authz.CapabilityResource{{Path: "ns/acme", Hierarchy: authz.ResourceHierarchy{Namespace: "acme"}}},
+			}
+		}
+	}
+	return &authz.UserCapabilitiesResponse{User: request.SubjectContext, Capabilities: capabilities}, nil
+}
+
+func newTestTokenService(t *testing.T, grants map[string][]string) *TokenService {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "tokens.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	store, err := tokens.NewStore(db)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	policy := TokenPolicy{
+		DefaultLifetime:     time.Hour,
+		MaxLifetime:         24 * time.Hour,
+		ServiceAccountType:  "service_account",
+		ServiceAccountClaim: "service_account",
+	}
+	return NewTokenService(store, policy, slog.New(slog.DiscardHandler), grantsPDP{grants: grants})
+}
+
+func TestTokenService_ServiceKeysStayWithinTheCaller(t *testing.T) {
+	svc := newTestTokenService(t, map[string][]string{
+		"platform": {"apikey:create", "component:view"},
+		"viewer":   {"component:view"},
+		"deployer": {"component:view", "component:deploy"},
+	})
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{
+		ID: "alice", Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"platform"},
+	})
+
+	tests := []struct {
+		name           string
+		serviceAccount string
+		scopes         []string
+		wantErr        error
+	}{
+		{name: "service account within the caller", serviceAccount: "viewer"},
+		{name: "service account beyond the caller", serviceAccount: "deployer", wantErr: ErrAPIKeyExceedsCaller},
+		{name: "key scoped within the caller", serviceAccount: "deployer", scopes: []string{"component:view"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateToken(ctx, &models.CreateAPITokenRequest{
+				Name:           tt.name,
+				Kind:           models.APITokenKindService,
+				ServiceAccount: tt.serviceAccount,
+				Scopes:         tt.scopes,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateToken() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenService_PersonalTokensFollowTheOwner(t *testing.T) {
+	svc := newTestTokenService(t, nil)
+	owner := &auth.SubjectContext{
+		ID: "alice", Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"developers", "admins"},
+	}
+	ctx := auth.SetSubjectContext(context.Background(), owner)
+
+	created, err := svc.CreateToken(ctx, &models.CreateAPITokenRequest{Name: "cli", Kind: models.APITokenKindPersonal})
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+	principal, err := svc.Authenticate(context.Background(), created.Token)
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if got := principal.Subject.EntitlementValues; len(got) != 2 {
+		t.Errorf("entitlements = %v, want [developers admins]", got)
+	}
+
+	// The owner loses the admins group in the identity provider and signs in again
+	if err := svc.RecordEntitlements(context.Background(), &auth.SubjectContext{
+		ID: "alice", Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"developers"},
+	}); err != nil {
+		t.Fatalf("RecordEntitlements() error = %v", err)
+	}
+	principal, err = svc.Authenticate(context.Background(), created.Token)
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if got := principal.Subject.EntitlementValues; len(got) != 1 || got[0] != "developers" {
+		t.Errorf("entitlements = %v, want [developers]", got)
+	}
+}
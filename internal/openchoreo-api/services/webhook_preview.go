@@ -0,0 +1,222 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+// previewEnvironmentName returns the name of the PreviewEnvironment for a component and pull request
+func previewEnvironmentName(componentName string, number int64) string {
+	return fmt.Sprintf("%s-pr-%d", componentName, number)
+}
+
+// processPullRequest creates, updates or deletes the PreviewEnvironments of the components built
+// from the pull request's base branch. It returns the previews that were changed.
+func (s *WebhookService) processPullRequest(ctx context.Context, event *git.WebhookEvent) ([]string, error) {
+	logger := log.FromContext(ctx)
+	pr := event.PullRequest
+
+	if pr.Action == "" {
+		logger.V(1).Info("Ignoring pull request event that does not change the head commit", "number", pr.Number)
+		return []string{}, nil
+	}
+
+	components, err := s.findPreviewComponents(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find components for pull request: %w", err)
+	}
+
+	changed := make([]string, 0)
+	for _, comp := range components {
+		name := previewEnvironmentName(comp.Name, pr.Number)
+
+		if pr.Action == git.PullRequestClosed {
+			deleted, err := s.deletePreview(ctx, comp.Namespace, name)
+			if err != nil {
+				logger.Error(err, "Failed to delete preview environment", "preview", name)
+				continue
+			}
+			if deleted {
+				logger.Info("Deleted preview environment", "preview", name, "merged", pr.Merged)
+				changed = append(changed, fmt.Sprintf("%s/%s", comp.Namespace, name))
+			}
+			continue
+		}
+
+		project := &v1alpha1.Project{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{
+			Name:      comp.Spec.Owner.ProjectName,
+			Namespace: comp.Namespace,
+		}, project); err != nil {
+			logger.Error(err, "Failed to get project for preview", "component", comp.Name)
+			continue
+		}
+		if project.Spec.Previews == nil || !project.Spec.Previews.Enabled {
+			continue
+		}
+		if !isTrustedPullRequest(pr) && !project.Spec.Previews.AllowUntrustedPullRequests {
+			logger.Info("Skipping preview for untrusted pull request",
+				"preview", name, "fromFork", pr.FromFork, "authorAssociation", pr.AuthorAssociation)
+			continue
+		}
+
+		applied, err := s.applyPreview(ctx, project, comp, name, event)
+		if err != nil {
+			logger.Error(err, "Failed to apply preview environment", "preview", name)
+			continue
+		}
+		if applied {
+			changed = append(changed, fmt.Sprintf("%s/%s", comp.Namespace, name))
+		}
+	}
+
+	return changed, nil
+}
+
+// trustedAuthorAssociations are the GitHub author associations of people with write access
+// to the repository
+var trustedAuthorAssociations = map[string]bool{
+	"OWNER":        true,
+	"MEMBER":       true,
+	"COLLABORATOR": true,
+}
+
+// isTrustedPullRequest reports whether the pull request comes from the repository itself and,
+// where the provider reports it, from an author with write access to the repository
+func isTrustedPullRequest(pr *git.PullRequestEvent) bool {
+	if pr.FromFork {
+		return false
+	}
+	return pr.AuthorAssociation == "" || trustedAuthorAssociations[pr.AuthorAssociation]
+}
+
+// findPreviewComponents finds the components in the pull request's repository that build
+// from the branch the pull request targets
+func (s *WebhookService) findPreviewComponents(ctx context.Context, event *git.WebhookEvent) ([]*v1alpha1.Component, error) {
+	componentList := &v1alpha1.ComponentList{}
+	if err := s.k8sClient.List(ctx, componentList); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+
+	base := &git.WebhookEvent{Branch: event.PullRequest.BaseBranch}
+	matched := make([]*v1alpha1.Component, 0)
+	for i := range componentList.Items {
+		comp := &componentList.Items[i]
+
+		repoURL, _, err := s.extractRepoInfoFromComponent(comp)
+		if err != nil || !s.matchesRepository(repoURL, event.RepositoryURL) {
+			continue
+		}
+		if !s.matchesRevision(comp.Spec.Workflow.SystemParameters.Repository.Revision, base) {
+			continue
+		}
+		matched = append(matched, comp)
+	}
+
+	return matched, nil
+}
+
+// applyPreview creates the PreviewEnvironment or points it at the new head commit.
+// New previews are not created once the project reaches its concurrent preview cap.
+func (s *WebhookService) applyPreview(ctx context.Context, project *v1alpha1.Project, comp *v1alpha1.Component,
+	name string, event *git.WebhookEvent) (bool, error) {
+	pr := event.PullRequest
+	pullRequest := v1alpha1.PullRequestRef{
+		Number:     pr.Number,
+		HeadCommit: pr.HeadCommit,
+		HeadBranch: pr.HeadBranch,
+		BaseBranch: pr.BaseBranch,
+		Provider:   event.Provider,
+		URL:        pr.URL,
+	}
+	if pr.FromFork {
+		pullRequest.RepositoryURL = pr.HeadRepositoryURL
+	}
+
+	preview := &v1alpha1.PreviewEnvironment{}
+	err := s.k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: comp.Namespace}, preview)
+	if err == nil {
+		if preview.Spec.PullRequest == pullRequest {
+			return false, nil
+		}
+		preview.Spec.PullRequest = pullRequest
+		if err := s.k8sClient.Update(ctx, preview); err != nil {
+			return false, fmt.Errorf("failed to update preview environment: %w", err)
+		}
+		return true, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get preview environment: %w", err)
+	}
+
+	previews := &v1alpha1.PreviewEnvironmentList{}
+	if err := s.k8sClient.List(ctx, previews,
+		client.InNamespace(project.Namespace),
+		client.MatchingLabels{labels.LabelKeyProjectName: project.Name},
+	); err != nil {
+		return false, fmt.Errorf("failed to list preview environments: %w", err)
+	}
+	// Previews of closed pull requests that are still being torn down do not count.
+	// The controller enforces the cap again for previews created concurrently.
+	var active int32
+	for i := range previews.Items {
+		if previews.Items[i].DeletionTimestamp.IsZero() {
+			active++
+		}
+	}
+	if limit := project.Spec.Previews.MaxConcurrent; limit > 0 && active >= limit {
+		log.FromContext(ctx).Info("Project reached its preview limit, skipping preview",
+			"project", project.Name, "limit", limit, "preview", name)
+		return false, nil
+	}
+
+	preview = &v1alpha1.PreviewEnvironment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: comp.Namespace,
+			Labels: map[string]string{
+				labels.LabelKeyOrganizationName: comp.Namespace,
+				labels.LabelKeyProjectName:      project.Name,
+				labels.LabelKeyComponentName:    comp.Name,
+			},
+		},
+		Spec: v1alpha1.PreviewEnvironmentSpec{
+			Owner: v1alpha1.ReleaseBindingOwner{
+				ProjectName:   project.Name,
+				ComponentName: comp.Name,
+			},
+			PullRequest: pullRequest,
+			TTL:         project.Spec.Previews.TTL,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, preview); err != nil {
+		return false, fmt.Errorf("failed to create preview environment: %w", err)
+	}
+	return true, nil
+}
+
+// deletePreview deletes the PreviewEnvironment, reporting whether it existed
+func (s *WebhookService) deletePreview(ctx context.Context, namespace, name string) (bool, error) {
+	preview := &v1alpha1.PreviewEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	if err := s.k8sClient.Delete(ctx, preview); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
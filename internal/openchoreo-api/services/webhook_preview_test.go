@@ -0,0 +1,186 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+func newTestWebhookService(t *testing.T, objs ...client.Object) (*WebhookService, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return NewWebhookService(k8sClient, nil), k8sClient
+}
+
+func previewProject(maxConcurrent int32) *v1alpha1.Project {
+	return &v1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "acme"},
+		Spec: v1alpha1.ProjectSpec{
+			Previews: &v1alpha1.ProjectPreviewConfig{
+				Enabled:       true,
+				TTL:           &metav1.Duration{Duration: time.Hour},
+				MaxConcurrent: maxConcurrent,
+			},
+		},
+	}
+}
+
+func previewComponent(name string) *v1alpha1.Component {
+	comp := &v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+		Spec: v1alpha1.ComponentSpec{
+			Owner:    v1alpha1.ComponentOwner{ProjectName: "shop"},
+			Workflow: &v1alpha1.ComponentWorkflowRunConfig{},
+		},
+	}
+	comp.Spec.Workflow.SystemParameters.Repository.URL = "https://github.com/acme/orders.git"
+	comp.Spec.Workflow.SystemParameters.Repository.Revision.Branch = "main"
+	return comp
+}
+
+func pullRequestEvent(number int64, action git.PullRequestAction, commit string) *git.WebhookEvent {
+	return &git.WebhookEvent{
+		Provider:      string(git.ProviderGitHub),
+		RepositoryURL: "https://github.com/acme/orders",
+		PullRequest: &git.PullRequestEvent{
+			Number:     number,
+			Action:     action,
+			HeadCommit: commit,
+			HeadBranch: "feature",
+			BaseBranch: "main",
+		},
+	}
+}
+
+func TestWebhookService_PullRequestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s, k8sClient := newTestWebhookService(t, previewProject(5), previewComponent("api"))
+	key := client.ObjectKey{Name: "api-pr-7", Namespace: "acme"}
+
+	changed, err := s.processPullRequest(ctx, pullRequestEvent(7, git.PullRequestOpened, "aaaaaaa"))
+	if err != nil || len(changed) != 1 {
+		t.Fatalf("expected one preview to be created, got %v, %v", changed, err)
+	}
+	preview := &v1alpha1.PreviewEnvironment{}
+	if err := k8sClient.Get(ctx, key, preview); err != nil {
+		t.Fatalf("expected preview to exist: %v", err)
+	}
+	if preview.Spec.TTL == nil || preview.Labels[labels.LabelKeyProjectName] != "shop" {
+		t.Fatalf("expected project TTL and labels on preview, got %+v", preview)
+	}
+
+	if _, err := s.processPullRequest(ctx, pullRequestEvent(7, git.PullRequestSynchronized, "bbbbbbb")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := k8sClient.Get(ctx, key, preview); err != nil {
+		t.Fatalf("expected preview to exist: %v", err)
+	}
+	if preview.Spec.PullRequest.HeadCommit != "bbbbbbb" {
+		t.Fatalf("expected head commit to be updated, got %q", preview.Spec.PullRequest.HeadCommit)
+	}
+
+	if _, err := s.processPullRequest(ctx, pullRequestEvent(7, git.PullRequestClosed, "bbbbbbb")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := k8sClient.Get(ctx, key, preview); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected preview to be deleted, got %v", err)
+	}
+}
+
+func TestWebhookService_PreviewLimit(t *testing.T) {
+	ctx := context.Background()
+	s, k8sClient := newTestWebhookService(t, previewProject(1), previewComponent("api"))
+
+	if _, err := s.processPullRequest(ctx, pullRequestEvent(1, git.PullRequestOpened, "aaaaaaa")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed, err := s.processPullRequest(ctx, pullRequestEvent(2, git.PullRequestOpened, "bbbbbbb"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changed) != 0 {
+		t.Fatalf("expected the second preview to be skipped, got %v", changed)
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "api-pr-2", Namespace: "acme"}, &v1alpha1.PreviewEnvironment{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected no preview over the limit, got %v", err)
+	}
+}
+
+func TestWebhookService_PreviewLimitSkipsTerminatingPreviews(t *testing.T) {
+	ctx := context.Background()
+	closing := &v1alpha1.PreviewEnvironment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "api-pr-1",
+			Namespace:         "acme",
+			Labels:            map[string]string{labels.LabelKeyProjectName: "shop"},
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			Finalizers:        []string{"openchoreo.dev/test"},
+		},
+	}
+	s, _ := newTestWebhookService(t, previewProject(1), previewComponent("api"), closing)
+
+	changed, err := s.processPullRequest(ctx, pullRequestEvent(2, git.PullRequestOpened, "bbbbbbb"))
+	if err != nil || len(changed) != 1 {
+		t.Fatalf("expected the preview to be created while the closed one is torn down, got %v, %v", changed, err)
+	}
+}
+
+func TestWebhookService_PreviewsDisabled(t *testing.T) {
+	ctx := context.Background()
+	project := previewProject(5)
+	project.Spec.Previews.Enabled = false
+	s, _ := newTestWebhookService(t, project, previewComponent("api"))
+
+	changed, err := s.processPullRequest(ctx, pullRequestEvent(7, git.PullRequestOpened, "aaaaaaa"))
+	if err != nil || len(changed) != 0 {
+		t.Fatalf("expected no previews for a project with previews disabled, got %v, %v", changed, err)
+	}
+}
+
+func TestWebhookService_UntrustedPullRequests(t *testing.T) {
+	ctx := context.Background()
+	fork := pullRequestEvent(7, git.PullRequestOpened, "aaaaaaa")
+	fork.PullRequest.FromFork = true
+	fork.PullRequest.HeadRepositoryURL = "https://github.com/mallory/orders"
+	outsider := pullRequestEvent(8, git.PullRequestOpened, "bbbbbbb")
+	outsider.PullRequest.AuthorAssociation = "CONTRIBUTOR"
+
+	s, _ := newTestWebhookService(t, previewProject(5), previewComponent("api"))
+	for _, event := range []*git.WebhookEvent{fork, outsider} {
+		changed, err := s.processPullRequest(ctx, event)
+		if err != nil || len(changed) != 0 {
+			t.Fatalf("expected no preview for an untrusted pull request, got %v, %v", changed, err)
+		}
+	}
+
+	project := previewProject(5)
+	project.Spec.Previews.AllowUntrustedPullRequests = true
+	s, k8sClient := newTestWebhookService(t, project, previewComponent("api"))
+	if _, err := s.processPullRequest(ctx, fork); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	preview := &v1alpha1.PreviewEnvironment{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "api-pr-7", Namespace: "acme"}, preview); err != nil {
+		t.Fatalf("expected preview for an allowed fork pull request: %v", err)
+	}
+	if preview.Spec.PullRequest.RepositoryURL != "https://github.com/mallory/orders" {
+		t.Fatalf("expected the preview to build from the fork, got %q", preview.Spec.PullRequest.RepositoryURL)
+	}
+}
@@ -6,6 +6,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,10 +41,22 @@ func (s *WebhookService) ProcessWebhook(ctx context.Context, provider git.Provid
 		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
 	}
 
+	// Pull request events drive preview environments instead of regular builds
+	if event.PullRequest != nil {
+		logger.Info("Processing pull request event",
+			"provider", event.Provider,
+			"repository", event.RepositoryURL,
+			"number", event.PullRequest.Number,
+			"action", event.PullRequest.Action,
+			"commit", event.PullRequest.HeadCommit)
+		return s.processPullRequest(ctx, event)
+	}
+
 	logger.Info("Processing webhook event",
 		"provider", event.Provider,
 		"repository", event.RepositoryURL,
 		"branch", event.Branch,
+		"tag", event.Tag,
 		"commit", event.Commit,
 		"modifiedPaths", len(event.ModifiedPaths))
 
@@ -126,8 +139,18 @@ func (s *WebhookService) findAffectedComponents(ctx context.Context, event *git.
 			continue
 		}
 
+		// Check if the pushed branch or tag is the one the component builds from
+		revision := comp.Spec.Workflow.SystemParameters.Repository.Revision
+		if !s.matchesRevision(revision, event) {
+			logger.V(1).Info("Webhook ref does not match component revision",
+				"component", comp.Name,
+				"revision", revision.Branch,
+				"ref", event.Ref)
+			continue
+		}
+
 		// Check if modified paths affect this component
-		// If no modified paths (e.g., Bitbucket), trigger all components for the repo
+		// If no modified paths (e.g., Bitbucket, Azure DevOps), trigger all components for the repo
 		if len(event.ModifiedPaths) == 0 || s.isComponentAffected(appPath, event.ModifiedPaths) {
 			logger.Info("Component is affected by webhook event",
 				"component", comp.Name,
@@ -167,6 +190,29 @@ func (s *WebhookService) matchesRepository(componentRepoURL, webhookRepoURL stri
 	return componentRepoURL == webhookRepoURL
 }
 
+// matchesRevision checks if the pushed ref matches the component's configured revision.
+// A plain branch name (or refs/heads/<branch>) matches pushes to that branch only.
+// A refs/tags/<pattern> value matches tag pushes whose name matches the glob pattern,
+// e.g. refs/tags/v* builds every version tag.
+func (s *WebhookService) matchesRevision(revision v1alpha1.RepositoryRevisionValues, event *git.WebhookEvent) bool {
+	configured := strings.TrimSpace(revision.Branch)
+	if configured == "" {
+		// No revision configured, keep building every push
+		return true
+	}
+
+	if pattern, ok := strings.CutPrefix(configured, "refs/tags/"); ok {
+		if event.Tag == "" {
+			return false
+		}
+		matched, err := path.Match(pattern, event.Tag)
+		return err == nil && matched
+	}
+
+	branch := strings.TrimPrefix(configured, "refs/heads/")
+	return event.Tag == "" && event.Branch == branch
+}
+
 // isComponentAffected checks if any modified path affects the component
 func (s *WebhookService) isComponentAffected(appPath string, modifiedPaths []string) bool {
 	// If no specific path filter, component is always affected
@@ -204,6 +250,13 @@ func normalizeRepoURL(repoURL string) string {
 		repoURL = strings.Replace(repoURL, "git@bitbucket.org:", "https://bitbucket.org/", 1)
 	}
 
+	// Drop user info (Azure DevOps clone URLs embed the organization as the user)
+	if scheme, rest, ok := strings.Cut(repoURL, "://"); ok {
+		if at := strings.Index(rest, "@"); at >= 0 && at < strings.Index(rest+"/", "/") {
+			repoURL = scheme + "://" + rest[at+1:]
+		}
+	}
+
 	// Remove .git suffix
 	repoURL = strings.TrimSuffix(repoURL, ".git")
 
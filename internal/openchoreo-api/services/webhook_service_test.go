@@ -0,0 +1,48 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"testing"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+func TestWebhookService_MatchesRevision(t *testing.T) {
+	s := &WebhookService{}
+
+	tests := []struct {
+		name       string
+		configured string
+		event      git.WebhookEvent
+		want       bool
+	}{
+		{"same branch", "main", git.WebhookEvent{Branch: "main"}, true},
+		{"other branch", "main", git.WebhookEvent{Branch: "feature/x"}, false},
+		{"qualified branch", "refs/heads/main", git.WebhookEvent{Branch: "main"}, true},
+		{"tag push for branch revision", "main", git.WebhookEvent{Tag: "main"}, false},
+		{"matching tag pattern", "refs/tags/v*", git.WebhookEvent{Tag: "v1.2.0"}, true},
+		{"non matching tag pattern", "refs/tags/v*", git.WebhookEvent{Tag: "nightly"}, false},
+		{"branch push for tag revision", "refs/tags/v*", git.WebhookEvent{Branch: "v1"}, false},
+		{"no revision configured", "", git.WebhookEvent{Branch: "anything"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision := v1alpha1.RepositoryRevisionValues{Branch: tt.configured}
+			if got := s.matchesRevision(revision, &tt.event); got != tt.want {
+				t.Errorf("matchesRevision(%q, %+v) = %v, want %v", tt.configured, tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRepoURL_StripsUserInfo(t *testing.T) {
+	got := normalizeRepoURL("https://acme@dev.azure.com/acme/shop/_git/orders")
+	want := "https://dev.azure.com/acme/shop/_git/orders"
+	if got != want {
+		t.Errorf("normalizeRepoURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
+)
+
+// ErrTokenNotFound is returned when no token has the requested ID
+var ErrTokenNotFound = errors.New("API token not found")
+
+// lastUsedInterval bounds how often the last used time of a token is written, so that a busy
+// token does not cause a database write on every request
+const lastUsedInterval = time.Minute
+
+// Token is a stored API token. Only the digest of the token is kept.
+type Token struct {
+	ID          string `gorm:"primaryKey;type:text"`
+	Name        string `gorm:"type:text;not null"`
+	Kind        string `gorm:"type:text;index;not null"`
+	Hash        string `gorm:"type:text;uniqueIndex;not null"`
+	Description string `gorm:"type:text"`
+
+	// OwnerID is the subject that created the token
+	OwnerID string `gorm:"type:text;index;not null"`
+
+	// The authz subject the token acts as. The entitlements of a personal access token are the subset of
+	// its owner's entitlements it was limited to, and are empty when it carries all of them.
+	SubjectID         string   `gorm:"type:text;not null"`
+	SubjectType       string   `gorm:"type:text;not null"`
+	EntitlementClaim  string   `gorm:"type:text;not null"`
+	EntitlementValues []string `gorm:"serializer:json"`
+	Scopes            []string `gorm:"serializer:json"`
+
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// TableName keeps the table name stable regardless of the struct name
+func (Token) TableName() string {
+	return "api_tokens"
+}
+
+// Expired reports whether the token has expired at the given time
+func (t *Token) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Revoked reports whether the token has been revoked
+func (t *Token) Revoked() bool {
+	return t.RevokedAt != nil
+}
+
+// SubjectEntitlements are the entitlements a subject presented in its most recent JWT. Personal access
+// tokens resolve their entitlements from them when used, so that entitlements removed in the identity
+// provider are removed from the tokens of the subject as well.
+type SubjectEntitlements struct {
+	SubjectID         string   `gorm:"primaryKey;type:text"`
+	EntitlementClaim  string   `gorm:"type:text;not null"`
+	EntitlementValues []string `gorm:"serializer:json"`
+	UpdatedAt         time.Time
+}
+
+// TableName keeps the table name stable regardless of the struct name
+func (SubjectEntitlements) TableName() string {
+	return "api_token_subject_entitlements"
+}
+
+// ListFilter selects the tokens to list. Empty fields match every token.
+type ListFilter struct {
+	Kind    string
+	OwnerID string
+}
+
+// Store persists API tokens
+type Store struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+var _ apikey.Authenticator = (*Store)(nil)
+
+// NewStore creates a token store on the given database, creating its tables when missing
+func NewStore(db *gorm.DB) (*Store, error) {
+	if err := db.AutoMigrate(&Token{}, &SubjectEntitlements{}); err != nil {
+		return nil, fmt.Errorf("failed to auto-migrate API token table: %w", err)
+	}
+	return &Store{db: db, now: time.Now}, nil
+}
+
+// Create stores a new token
+func (s *Store) Create(ctx context.Context, token *Token) error {
+	if err := s.db.WithContext(ctx).Create(token).Error; err != nil {
+		return fmt.Errorf("failed to store API token: %w", err)
+	}
+	return nil
+}
+
+// Get returns the token with the given ID
+func (s *Store) Get(ctx context.Context, id string) (*Token, error) {
+	var token Token
+	err := s.db.WithContext(ctx).Where("id = ?", id).First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API token: %w", err)
+	}
+	return &token, nil
+}
+
+// List returns the tokens matching the filter, newest first
+func (s *Store) List(ctx context.Context, filter ListFilter) ([]*Token, error) {
+	query := s.db.WithContext(ctx).Order("created_at DESC")
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.OwnerID != "" {
+		query = query.Where("owner_id = ?", filter.OwnerID)
+	}
+
+	var tokens []*Token
+	if err := query.Find(&tokens).Error; err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Revoke marks the token with the given ID as revoked. Revoking a revoked token keeps the original time.
+func (s *Store) Revoke(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Model(&Token{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", s.now().UTC())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke API token: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if _, err := s.Get(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordEntitlements stores the entitlements a subject currently holds, replacing those recorded before
+func (s *Store) RecordEntitlements(ctx context.Context, subjectID, claim string, values []string) error {
+	record := &SubjectEntitlements{
+		SubjectID:         subjectID,
+		EntitlementClaim:  claim,
+		EntitlementValues: values,
+		UpdatedAt:         s.now().UTC(),
+	}
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "subject_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"entitlement_claim", "entitlement_values", "updated_at"}),
+	}).Create(record).Error
+	if err != nil {
+		return fmt.Errorf("failed to record subject entitlements: %w", err)
+	}
+	return nil
+}
+
+// Authenticate implements apikey.Authenticator. It looks the token up by its digest and records its use.
+func (s *Store) Authenticate(ctx context.Context, plaintext string) (*apikey.Principal, error) {
+	var token Token
+	err := s.db.WithContext(ctx).Where("hash = ?", apikey.Hash(plaintext)).First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apikey.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up API token: %w", err)
+	}
+
+	now := s.now().UTC()
+	if token.Revoked() {
+		return nil, apikey.ErrTokenRevoked
+	}
+	if token.Expired(now) {
+		return nil, apikey.ErrTokenExpired
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedInterval {
+		// Failing to record the use must not fail the request
+		_ = s.db.WithContext(ctx).Model(&Token{}).Where("id = ?", token.ID).Update("last_used_at", now).Error
+	}
+
+	entitlements := token.EntitlementValues
+	if token.Kind == apikey.KindPersonal {
+		entitlements, err = s.currentEntitlements(ctx, &token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &apikey.Principal{
+		TokenID: token.ID,
+		Subject: &auth.SubjectContext{
+			ID:                token.SubjectID,
+			Type:              token.SubjectType,
+			EntitlementClaim:  token.EntitlementClaim,
+			EntitlementValues: entitlements,
+			Scopes:            token.Scopes,
+		},
+	}, nil
+}
+
+// currentEntitlements resolves the entitlements of a personal access token from those its owner holds now.
+// A token limited to a subset keeps the entitlements of the subset the owner still holds. Tokens of owners
+// with no recorded entitlements keep the entitlements they were created with.
+func (s *Store) currentEntitlements(ctx context.Context, token *Token) ([]string, error) {
+	var record SubjectEntitlements
+	err := s.db.WithContext(ctx).Where("subject_id = ?", token.SubjectID).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return token.EntitlementValues, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up subject entitlements: %w", err)
+	}
+
+	// Entitlements of another claim are not comparable, so the token holds none of them
+	if record.EntitlementClaim != token.EntitlementClaim {
+		return nil, nil
+	}
+	if len(token.EntitlementValues) == 0 {
+		return record.EntitlementValues, nil
+	}
+	entitlements := make([]string, 0, len(token.EntitlementValues))
+	for _, entitlement := range token.EntitlementValues {
+		if slices.Contains(record.EntitlementValues, entitlement) {
+			entitlements = append(entitlements, entitlement)
+		}
+	}
+	return entitlements, nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tokens
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/apikey"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "tokens.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	store, err := NewStore(db)
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	return store
+}
+
+func createToken(t *testing.T, store *Store, id string, expiresIn time.Duration) string {
+	t.Helper()
+	plaintext, err := apikey.Generate(apikey.KindPersonal)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	now := store.now().UTC()
+	err = store.Create(context.Background(), &Token{
+		ID:                id,
+		Name:              id,
+		Kind:              apikey.KindPersonal,
+		Hash:              apikey.Hash(plaintext),
+		OwnerID:           "alice",
+		SubjectID:         "alice",
+		SubjectType:       "user",
+		EntitlementClaim:  "groups",
+		EntitlementValues: []string{"developers"},
+		Scopes:            []string{"component:view"},
+		CreatedAt:         now,
+		ExpiresAt:         now.Add(expiresIn),
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return plaintext
+}
+
+func TestStore_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	plaintext := createToken(t, store, "tok-1", time.Hour)
+
+	principal, err := store.Authenticate(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if principal.TokenID != "tok-1" || principal.Subject.ID != "alice" {
+		t.Errorf("principal = %+v, want token tok-1 of alice", principal)
+	}
+	if len(principal.Subject.EntitlementValues) != 1 || principal.Subject.EntitlementValues[0] != "developers" {
+		t.Errorf("entitlements = %v, want [developers]", principal.Subject.EntitlementValues)
+	}
+	if len(principal.Subject.Scopes) != 1 || principal.Subject.Scopes[0] != "component:view" {
+		t.Errorf("scopes = %v, want [component:view]", principal.Subject.Scopes)
+	}
+
+	stored, err := store.Get(ctx, "tok-1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if stored.LastUsedAt == nil {
+		t.Error("last used time should be recorded")
+	}
+
+	if _, err := store.Authenticate(ctx, apikey.PersonalTokenPrefix+"unknown"); !errors.Is(err, apikey.ErrInvalidToken) {
+		t.Errorf("Authenticate() of an unknown token error = %v, want %v", err, apikey.ErrInvalidToken)
+	}
+}
+
+func TestStore_AuthenticateExpired(t *testing.T) {
+	store := newTestStore(t)
+	plaintext := createToken(t, store, "tok-1", time.Hour)
+
+	store.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := store.Authenticate(context.Background(), plaintext); !errors.Is(err, apikey.ErrTokenExpired) {
+		t.Errorf("Authenticate() error = %v, want %v", err, apikey.ErrTokenExpired)
+	}
+}
+
+func TestStore_Revoke(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	plaintext := createToken(t, store, "tok-1", time.Hour)
+
+	if err := store.Revoke(ctx, "tok-1"); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	// Revoking again is not an error
+	if err := store.Revoke(ctx, "tok-1"); err != nil {
+		t.Fatalf("second Revoke() error = %v", err)
+	}
+	if _, err := store.Authenticate(ctx, plaintext); !errors.Is(err, apikey.ErrTokenRevoked) {
+		t.Errorf("Authenticate() error = %v, want %v", err, apikey.ErrTokenRevoked)
+	}
+	if err := store.Revoke(ctx, "missing"); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Revoke() of a missing token error = %v, want %v", err, ErrTokenNotFound)
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	createToken(t, store, "tok-1", time.Hour)
+	createToken(t, store, "tok-2", time.Hour)
+
+	tokens, err := store.List(ctx, ListFilter{OwnerID: "alice", Kind: apikey.KindPersonal})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("List() returned %d tokens, want 2", len(tokens))
+	}
+
+	tokens, err = store.List(ctx, ListFilter{OwnerID: "bob"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(tokens) != 0 {
+		t.Errorf("List() for another owner returned %d tokens, want 0", len(tokens))
+	}
+}
+
+func TestStore_AuthenticateResolvesCurrentEntitlements(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	// createToken limits the token to the developers entitlement of alice
+	limited := createToken(t, store, "tok-1", time.Hour)
+	following, err := apikey.Generate(apikey.KindPersonal)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	now := store.now().UTC()
+	if err := store.Create(ctx, &Token{
+		ID: "tok-2", Name: "tok-2", Kind: apikey.KindPersonal, Hash: apikey.Hash(following),
+		OwnerID: "alice", SubjectID: "alice", SubjectType: "user", EntitlementClaim: "groups",
+		CreatedAt: now, ExpiresAt: now.Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	entitlementsOf := func(plaintext string) []string {
+		t.Helper()
+		principal, err := store.Authenticate(ctx, plaintext)
+		if err != nil {
+			t.Fatalf("Authenticate() error = %v", err)
+		}
+		return principal.Subject.EntitlementValues
+	}
+
+	// Without a record the stored entitlements apply
+	if got := entitlementsOf(limited); len(got) != 1 || got[0] != "developers" {
+		t.Errorf("entitlements = %v, want [developers]", got)
+	}
+
+	if err := store.RecordEntitlements(ctx, "alice", "groups", []string{"developers", "admins"}); err != nil {
+		t.Fatalf("RecordEntitlements() error = %v", err)
+	}
+	if got := entitlementsOf(following); len(got) != 2 {
+		t.Errorf("entitlements of a token following its owner = %v, want [developers admins]", got)
+	}
+	if got := entitlementsOf(limited); len(got) != 1 || got[0] != "developers" {
+		t.Errorf("entitlements of a limited token = %v, want [developers]", got)
+	}
+
+	// Entitlements removed from the owner are removed from the tokens
+	if err := store.RecordEntitlements(ctx, "alice", "groups", []string{"admins"}); err != nil {
+		t.Fatalf("RecordEntitlements() error = %v", err)
+	}
+	if got := entitlementsOf(following); len(got) != 1 || got[0] != "admins" {
+		t.Errorf("entitlements of a token following its owner = %v, want [admins]", got)
+	}
+	if got := entitlementsOf(limited); len(got) != 0 {
+		t.Errorf("entitlements of a limited token = %v, want none", got)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// BuildMetadataContext computes the names, labels and pod selectors of the resources a component renders
+// into an environment, following the platform naming conventions.
+func BuildMetadataContext(input *MetadataContextInput) MetadataContext {
+	return MetadataContext{
+		// Format: {component}-{env}-{hash}
+		Name: kubernetes.GenerateK8sName(input.ComponentName, input.EnvironmentName),
+		// Format: dp-{org}-{project}-{env}-{hash}
+		Namespace: kubernetes.GenerateK8sNameWithLengthLimit(
+			kubernetes.MaxNamespaceNameLength,
+			"dp", input.Namespace, input.ProjectName, input.EnvironmentName,
+		),
+		Labels: map[string]string{
+			labels.LabelKeyOrganizationName: input.Namespace,
+			labels.LabelKeyProjectName:      input.ProjectName,
+			labels.LabelKeyComponentName:    input.ComponentName,
+			labels.LabelKeyEnvironmentName:  input.EnvironmentName,
+		},
+		Annotations: map[string]string{},
+		PodSelectors: map[string]string{
+			labels.LabelKeyComponentUID:   input.ComponentUID,
+			labels.LabelKeyEnvironmentUID: input.EnvironmentUID,
+			labels.LabelKeyProjectUID:     input.ProjectUID,
+		},
+		ComponentName:   input.ComponentName,
+		ComponentUID:    input.ComponentUID,
+		ProjectName:     input.ProjectName,
+		ProjectUID:      input.ProjectUID,
+		DataPlaneName:   input.DataPlaneName,
+		DataPlaneUID:    input.DataPlaneUID,
+		EnvironmentName: input.EnvironmentName,
+		EnvironmentUID:  input.EnvironmentUID,
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestBuildMetadataContext(t *testing.T) {
+	got := BuildMetadataContext(&MetadataContextInput{
+		Namespace:       "acme",
+		ComponentName:   "api",
+		ComponentUID:    "component-uid",
+		ProjectName:     "shop",
+		ProjectUID:      "project-uid",
+		EnvironmentName: "production",
+		EnvironmentUID:  "environment-uid",
+		DataPlaneName:   "default",
+		DataPlaneUID:    "dataplane-uid",
+	})
+
+	if !strings.HasPrefix(got.Name, "api-production-") {
+		t.Errorf("Name = %q, want the {component}-{env}-{hash} format", got.Name)
+	}
+	if !strings.HasPrefix(got.Namespace, "dp-acme-shop-production-") {
+		t.Errorf("Namespace = %q, want the dp-{org}-{project}-{env}-{hash} format", got.Namespace)
+	}
+
+	wantSelectors := map[string]string{
+		labels.LabelKeyComponentUID:   "component-uid",
+		labels.LabelKeyEnvironmentUID: "environment-uid",
+		labels.LabelKeyProjectUID:     "project-uid",
+	}
+	if diff := cmp.Diff(wantSelectors, got.PodSelectors); diff != "" {
+		t.Errorf("PodSelectors mismatch (-want +got):\n%s", diff)
+	}
+	wantLabels := map[string]string{
+		labels.LabelKeyOrganizationName: "acme",
+		labels.LabelKeyProjectName:      "shop",
+		labels.LabelKeyComponentName:    "api",
+		labels.LabelKeyEnvironmentName:  "production",
+	}
+	if diff := cmp.Diff(wantLabels, got.Labels); diff != "" {
+		t.Errorf("Labels mismatch (-want +got):\n%s", diff)
+	}
+	if got.DataPlaneName != "default" || got.DataPlaneUID != "dataplane-uid" {
+		t.Errorf("DataPlane = %q/%q, want default/dataplane-uid", got.DataPlaneName, got.DataPlaneUID)
+	}
+}
@@ -137,6 +137,26 @@ type TraitContextInput struct {
 	DataPlane *v1alpha1.DataPlane `validate:"required"`
 }
 
+// MetadataContextInput identifies the component, project, environment and data plane that resources are rendered for.
+// The UIDs are supplied by the caller: the controller reads them from the cluster, while occ derives them for
+// resources that have none.
+type MetadataContextInput struct {
+	// Namespace is the control plane namespace of the component.
+	Namespace string
+
+	ComponentName string
+	ComponentUID  string
+
+	ProjectName string
+	ProjectUID  string
+
+	EnvironmentName string
+	EnvironmentUID  string
+
+	DataPlaneName string
+	DataPlaneUID  string
+}
+
 // SchemaInput contains schema information for building structural and JSON schemas.
 type SchemaInput struct {
 	// Types defines reusable type definitions.
@@ -7,11 +7,15 @@
 // to generate fully resolved Kubernetes resource manifests by:
 //   - Building CEL evaluation contexts with parameters, overrides, and defaults
 //   - Rendering base resources from ComponentType
+//   - Merging the traits attached by the ComponentType and the Environment
 //   - Processing traits (creates and patches)
 //   - Post-processing (validation, labels, annotations)
+//   - Validating the rendered resources against the schemas of their kinds
+//   - Checking the rendered resources against the guardrail rules of render policies
 package component
 
 import (
+	gocontext "context"
 	"fmt"
 	"sort"
 
@@ -22,6 +26,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
+	"github.com/openchoreo/openchoreo/internal/schema/resourceschema"
 	"github.com/openchoreo/openchoreo/internal/template"
 )
 
@@ -41,6 +46,9 @@ func NewPipeline(opts ...Option) *Pipeline {
 			template.WithCELExtensions(context.CELExtensions()...),
 		)
 	}
+	if p.resourceValidator == nil {
+		p.resourceValidator = resourceschema.NewValidator()
+	}
 	return p
 }
 
@@ -50,12 +58,21 @@ func NewPipeline(opts ...Option) *Pipeline {
 //   - Validate input
 //   - Build component context (parameters + overrides + defaults)
 //   - Render base resources from ComponentType
+//   - Merge the traits of the ComponentType and the Environment into the traits of the component
 //   - Process traits (creates and patches)
 //   - Post-process (validate, add labels/annotations, sort)
+//   - Validate the resources against the schemas of their kinds
+//   - Evaluate the rules of render policies on the resources
 //   - Return output
 //
 // Returns an error if any step fails.
 func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
+	return p.RenderWithContext(gocontext.Background(), input)
+}
+
+// RenderWithContext renders like Render. The context bounds the lookups of the schemas that the rendered
+// resources are validated against, e.g. in the data plane.
+func (p *Pipeline) RenderWithContext(ctx gocontext.Context, input *RenderInput) (*RenderOutput, error) {
 	// Validate input
 	if err := p.validateInput(input); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
@@ -115,8 +132,14 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	// Create schema cache for trait reuse within this render
 	schemaCache := make(map[string]*context.SchemaBundle)
 
-	// Process each trait instance from the component
-	for _, traitInstance := range input.Component.Spec.Traits {
+	// Merge the traits attached by the ComponentType and the Environment into the traits of the component
+	traitInstances, err := EffectiveTraits(input.Component, input.ComponentType, input.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge platform traits: %w", err)
+	}
+
+	// Process each trait instance
+	for _, traitInstance := range traitInstances {
 		t, ok := traitMap[traitInstance.Name]
 		if !ok {
 			return nil, fmt.Errorf("trait %s referenced but not found in traits list", traitInstance.Name)
@@ -147,6 +170,10 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 				traitInstance.Name, traitInstance.InstanceName, err)
 		}
 
+		for i := beforeCount; i < len(renderedResources); i++ {
+			renderedResources[i].Source = fmt.Sprintf("trait %s/%s", traitInstance.Name, traitInstance.InstanceName)
+		}
+
 		metadata.TraitCount++
 		metadata.TraitResourceCount += len(renderedResources) - beforeCount
 	}
@@ -159,8 +186,16 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	if err := p.validateResourceSchemas(ctx, renderedResources, input.DataPlane, metadata); err != nil {
+		return nil, err
+	}
+
 	sortRenderedResources(renderedResources)
 
+	if err := p.evaluatePolicies(renderedResources, input, componentContext, metadata); err != nil {
+		return nil, err
+	}
+
 	metadata.ResourceCount = len(renderedResources)
 
 	return &RenderOutput{
@@ -4,6 +4,8 @@
 package component
 
 import (
+	gocontext "context"
+	"errors"
 	"os"
 	"path/filepath"
 	"sort"
@@ -11,6 +13,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -744,6 +748,461 @@ spec:
 	}
 }
 
+func TestPipeline_ResourceSchemaValidation(t *testing.T) {
+	metadata := context.MetadataContext{
+		Name: "test", Namespace: "ns", ComponentName: "app", ComponentUID: "uid1",
+		ProjectName: "proj", ProjectUID: "uid2", DataPlaneName: "dp", DataPlaneUID: "uid3",
+		EnvironmentName: "dev", EnvironmentUID: "uid4",
+		Labels: map[string]string{}, Annotations: map[string]string{},
+		PodSelectors: map[string]string{"k": "v"},
+	}
+
+	tests := []struct {
+		name              string
+		componentTypeYAML string
+		componentYAML     string
+		traitsYAML        string
+		validator         ResourceValidator
+		wantViolations    []string
+		wantWarning       string
+	}{
+		{
+			name: "misspelled field in a ComponentType resource",
+			componentTypeYAML: `
+spec:
+  resources:
+    - id: pod
+      template: {apiVersion: v1, kind: Pod, metadata: {name: x}, spec: {contaienrs: []}}
+`,
+			componentYAML: `spec: {}`,
+			wantViolations: []string{
+				`Pod/x (from resource "pod"): spec.contaienrs: Invalid value: field not declared in schema`,
+			},
+		},
+		{
+			name: "misspelled field in a resource created by a trait",
+			componentTypeYAML: `
+spec:
+  resources:
+    - id: pod
+      template: {apiVersion: v1, kind: Pod, metadata: {name: x}}
+`,
+			componentYAML: `
+spec:
+  traits:
+    - name: config
+      instanceName: settings
+`,
+			traitsYAML: `
+- metadata: {name: config}
+  spec:
+    creates:
+      - template: {apiVersion: v1, kind: ConfigMap, metadata: {name: settings}, dat: {enabled: "true"}}
+`,
+			wantViolations: []string{
+				"ConfigMap/settings (from trait config/settings): dat: Invalid value: field not declared in schema",
+			},
+		},
+		{
+			name: "resource whose schema cannot be loaded",
+			componentTypeYAML: `
+spec:
+  resources:
+    - id: widget
+      template: {apiVersion: example.com/v1, kind: Widget, metadata: {name: x}}
+`,
+			componentYAML: `spec: {}`,
+			validator:     failingValidator{},
+			wantWarning:   `Widget/x (from resource "widget") was not validated: data plane unreachable`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var componentType v1alpha1.ComponentType
+			if err := yaml.Unmarshal([]byte(tt.componentTypeYAML), &componentType); err != nil {
+				t.Fatalf("Failed to parse componentType: %v", err)
+			}
+			var component v1alpha1.Component
+			if err := yaml.Unmarshal([]byte(tt.componentYAML), &component); err != nil {
+				t.Fatalf("Failed to parse component: %v", err)
+			}
+			var traits []v1alpha1.Trait
+			if tt.traitsYAML != "" {
+				if err := yaml.Unmarshal([]byte(tt.traitsYAML), &traits); err != nil {
+					t.Fatalf("Failed to parse traits: %v", err)
+				}
+			}
+
+			var opts []Option
+			if tt.validator != nil {
+				opts = append(opts, WithResourceValidator(tt.validator))
+			}
+			output, err := NewPipeline(opts...).Render(&RenderInput{
+				ComponentType: &componentType,
+				Component:     &component,
+				Traits:        traits,
+				Workload:      &v1alpha1.Workload{},
+				Environment:   &v1alpha1.Environment{},
+				DataPlane:     &v1alpha1.DataPlane{},
+				Metadata:      metadata,
+			})
+
+			if len(tt.wantViolations) > 0 {
+				var validationErr *ResourceValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected a ResourceValidationError, got %v", err)
+				}
+				if diff := cmp.Diff(tt.wantViolations, validationErr.Violations); diff != "" {
+					t.Errorf("Violations mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if diff := cmp.Diff([]string{tt.wantWarning}, output.Metadata.Warnings); diff != "" {
+				t.Errorf("Warnings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// failingValidator is a ResourceValidator that cannot load any schema
+type failingValidator struct{}
+
+func (failingValidator) Validate(_ gocontext.Context, _ *v1alpha1.DataPlane, _ map[string]any) (field.ErrorList, error) {
+	return nil, errors.New("data plane unreachable")
+}
+
+func TestPipeline_RenderPolicies(t *testing.T) {
+	componentTypeYAML := `
+spec:
+  resources:
+    - id: deployment
+      template:
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata: {name: app}
+        spec:
+          replicas: 1
+          selector: {matchLabels: {app: app}}
+          template:
+            metadata: {labels: {app: app}}
+            spec:
+              containers:
+                - name: main
+                  image: docker.io/library/nginx:1.27
+                  securityContext: {privileged: true}
+    - id: service
+      template: {apiVersion: v1, kind: Service, metadata: {name: app}, spec: {ports: [{port: 80}]}}
+`
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(componentTypeYAML), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+
+	tests := []struct {
+		name                 string
+		clusterPoliciesYAML  string
+		policiesYAML         string
+		production           bool
+		wantEnforced         []string
+		wantWarnings         []string
+		wantPolicyViolations []string
+	}{
+		{
+			name: "enforced rules block the render",
+			clusterPoliciesYAML: `
+- metadata: {name: baseline}
+  spec:
+    match: {kinds: [Deployment]}
+    rules:
+      - name: no-privileged-containers
+        expression: ${resource.spec.template.spec.containers.all(c, !has(c.securityContext.privileged) || !c.securityContext.privileged)}
+        message: containers must not run privileged
+`,
+			policiesYAML: `
+- metadata: {name: registries}
+  spec:
+    mode: Enforce
+    match: {kinds: [Deployment]}
+    rules:
+      - name: trusted-registry
+        expression: ${resource.spec.template.spec.containers.all(c, c.image.startsWith("registry.example.com/"))}
+`,
+			wantEnforced: []string{
+				`Deployment/app (from resource "deployment") violates rule "no-privileged-containers" of ClusterRenderPolicy/baseline: containers must not run privileged`,
+				`Deployment/app (from resource "deployment") violates rule "trusted-registry" of RenderPolicy/registries: ${resource.spec.template.spec.containers.all(c, c.image.startsWith("registry.example.com/"))}`,
+			},
+		},
+		{
+			name: "warned rules only apply to matching environments",
+			policiesYAML: `
+- metadata: {name: production}
+  spec:
+    mode: Warn
+    match: {kinds: [Deployment], environments: [prod]}
+    rules:
+      - name: replicas
+        expression: ${!environment.isProduction || resource.spec.replicas >= 2}
+        message: production needs at least 2 replicas
+- metadata: {name: other-environments}
+  spec:
+    mode: Warn
+    match: {environments: [staging]}
+    rules:
+      - name: never
+        expression: ${false}
+`,
+			production: true,
+			wantWarnings: []string{
+				`Deployment/app (from resource "deployment") violates rule "replicas" of RenderPolicy/production: production needs at least 2 replicas`,
+			},
+			wantPolicyViolations: []string{
+				`Deployment/app (from resource "deployment") violates rule "replicas" of RenderPolicy/production: production needs at least 2 replicas`,
+			},
+		},
+		{
+			name: "audited rules are recorded without warnings",
+			policiesYAML: `
+- metadata: {name: limits}
+  spec:
+    mode: Audit
+    rules:
+      - name: resource-limits
+        expression: ${resource.kind != "Deployment" || resource.spec.template.spec.containers.all(c, has(c.resources) && has(c.resources.limits))}
+        message: every container needs resource limits
+      - name: not-a-condition
+        expression: ${resource.kind}
+`,
+			wantPolicyViolations: []string{
+				`Deployment/app (from resource "deployment") violates rule "resource-limits" of RenderPolicy/limits: every container needs resource limits`,
+				`Deployment/app (from resource "deployment") violates rule "not-a-condition" of RenderPolicy/limits: rule must evaluate to a boolean, got string`,
+				`Service/app (from resource "service") violates rule "not-a-condition" of RenderPolicy/limits: rule must evaluate to a boolean, got string`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clusterPolicies []v1alpha1.ClusterRenderPolicy
+			if err := yaml.Unmarshal([]byte(tt.clusterPoliciesYAML), &clusterPolicies); err != nil {
+				t.Fatalf("Failed to parse cluster policies: %v", err)
+			}
+			var policies []v1alpha1.RenderPolicy
+			if err := yaml.Unmarshal([]byte(tt.policiesYAML), &policies); err != nil {
+				t.Fatalf("Failed to parse policies: %v", err)
+			}
+			environment := &v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{IsProduction: tt.production}}
+			environment.Name = "dev"
+			if tt.production {
+				environment.Name = "prod"
+			}
+
+			output, err := NewPipeline().Render(&RenderInput{
+				ComponentType:         componentType.DeepCopy(),
+				Component:             &v1alpha1.Component{},
+				Workload:              &v1alpha1.Workload{},
+				Environment:           environment,
+				DataPlane:             &v1alpha1.DataPlane{},
+				RenderPolicies:        policies,
+				ClusterRenderPolicies: clusterPolicies,
+				Metadata: context.MetadataContext{
+					Name: "test", Namespace: "ns", ComponentName: "app", ComponentUID: "uid1",
+					ProjectName: "proj", ProjectUID: "uid2", DataPlaneName: "dp", DataPlaneUID: "uid3",
+					EnvironmentName: environment.Name, EnvironmentUID: "uid4",
+					Labels: map[string]string{}, Annotations: map[string]string{},
+					PodSelectors: map[string]string{"k": "v"},
+				},
+			})
+
+			violationStrings := func(violations []PolicyViolation) []string {
+				var out []string
+				for _, v := range violations {
+					out = append(out, v.String())
+				}
+				return out
+			}
+
+			if len(tt.wantEnforced) > 0 {
+				var policyErr *PolicyViolationError
+				if !errors.As(err, &policyErr) {
+					t.Fatalf("expected a PolicyViolationError, got %v", err)
+				}
+				if diff := cmp.Diff(tt.wantEnforced, violationStrings(policyErr.Violations)); diff != "" {
+					t.Errorf("Enforced violations mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantWarnings, output.Metadata.Warnings, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Warnings mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPolicyViolations, violationStrings(output.Metadata.PolicyViolations)); diff != "" {
+				t.Errorf("PolicyViolations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipeline_InvalidRenderPoliciesAreSkipped(t *testing.T) {
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(`
+spec:
+  resources:
+    - id: service
+      template: {apiVersion: v1, kind: Service, metadata: {name: app}, spec: {ports: [{port: 80}]}}
+`), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	policy := v1alpha1.ClusterRenderPolicy{Spec: v1alpha1.RenderPolicySpec{
+		Mode: v1alpha1.RenderPolicyModeEnforce,
+		Rules: []v1alpha1.RenderPolicyRule{
+			{Name: "valid", Expression: "${resource.kind != 'Secret'}"},
+			{Name: "typo", Expression: "${resource.spec.ports.all(p, p.port >)}"},
+		},
+	}}
+	policy.Name = "broken"
+
+	pipeline := NewPipeline()
+	err := pipeline.ValidateRenderPolicy(&policy.Spec)
+	if err == nil || !strings.Contains(err.Error(), `rule "typo"`) || strings.Contains(err.Error(), `rule "valid"`) {
+		t.Errorf("ValidateRenderPolicy() error = %v, want it to name only the typo rule", err)
+	}
+
+	output, err := pipeline.Render(&RenderInput{
+		ComponentType:         componentType.DeepCopy(),
+		Component:             &v1alpha1.Component{},
+		Workload:              &v1alpha1.Workload{},
+		Environment:           &v1alpha1.Environment{},
+		DataPlane:             &v1alpha1.DataPlane{},
+		ClusterRenderPolicies: []v1alpha1.ClusterRenderPolicy{policy},
+		Metadata: context.MetadataContext{
+			Name: "test", Namespace: "ns", ComponentName: "app", ComponentUID: "uid1",
+			ProjectName: "proj", ProjectUID: "uid2", DataPlaneName: "dp", DataPlaneUID: "uid3",
+			EnvironmentName: "dev", EnvironmentUID: "uid4",
+			Labels: map[string]string{}, Annotations: map[string]string{},
+			PodSelectors: map[string]string{"k": "v"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	if len(output.Metadata.Warnings) != 1 || !strings.HasPrefix(output.Metadata.Warnings[0], "ClusterRenderPolicy/broken was skipped") {
+		t.Errorf("Warnings = %q, want the broken policy to be skipped", output.Metadata.Warnings)
+	}
+}
+
+func TestPipeline_PlatformTraits(t *testing.T) {
+	componentTypeYAML := `
+spec:
+  resources:
+    - id: deployment
+      template: {apiVersion: apps/v1, kind: Deployment, metadata: {name: app}}
+  traits:
+    - name: pdb
+      instanceName: pdb
+      required: true
+      parameters: {minAvailable: "1"}
+`
+	environmentYAML := `
+metadata: {name: prod}
+spec:
+  traits:
+    - name: alerts
+      instanceName: alerts
+      lockedParameters: {severity: critical}
+`
+	traitsYAML := `
+- metadata: {name: pdb}
+  spec:
+    schema:
+      parameters: {minAvailable: "string"}
+    creates:
+      - template: {apiVersion: v1, kind: ConfigMap, metadata: {name: pdb}, data: {minAvailable: "${parameters.minAvailable}"}}
+- metadata: {name: alerts}
+  spec:
+    schema:
+      parameters: {severity: "string"}
+    creates:
+      - template: {apiVersion: v1, kind: ConfigMap, metadata: {name: alerts}, data: {severity: "${parameters.severity}"}}
+`
+
+	tests := []struct {
+		name          string
+		componentYAML string
+		want          map[string]string
+	}{
+		{
+			name:          "platform traits are rendered with their default and locked parameters",
+			componentYAML: `spec: {traits: [{name: alerts, instanceName: alerts, parameters: {severity: info}}]}`,
+			want:          map[string]string{"pdb": "1", "alerts": "critical"},
+		},
+		{
+			name:          "default traits can be disabled",
+			componentYAML: `spec: {traits: [{name: pdb, instanceName: pdb, parameters: {minAvailable: "2"}}], disabledTraits: [alerts, pdb]}`,
+			want:          map[string]string{"pdb": "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var componentType v1alpha1.ComponentType
+			if err := yaml.Unmarshal([]byte(componentTypeYAML), &componentType); err != nil {
+				t.Fatalf("Failed to parse componentType: %v", err)
+			}
+			var environment v1alpha1.Environment
+			if err := yaml.Unmarshal([]byte(environmentYAML), &environment); err != nil {
+				t.Fatalf("Failed to parse environment: %v", err)
+			}
+			var traits []v1alpha1.Trait
+			if err := yaml.Unmarshal([]byte(traitsYAML), &traits); err != nil {
+				t.Fatalf("Failed to parse traits: %v", err)
+			}
+			var component v1alpha1.Component
+			if err := yaml.Unmarshal([]byte(tt.componentYAML), &component); err != nil {
+				t.Fatalf("Failed to parse component: %v", err)
+			}
+
+			output, err := NewPipeline().Render(&RenderInput{
+				ComponentType: &componentType,
+				Component:     &component,
+				Traits:        traits,
+				Workload:      &v1alpha1.Workload{},
+				Environment:   &environment,
+				DataPlane:     &v1alpha1.DataPlane{},
+				Metadata: context.MetadataContext{
+					Name: "test", Namespace: "ns", ComponentName: "app", ComponentUID: "uid1",
+					ProjectName: "proj", ProjectUID: "uid2", DataPlaneName: "dp", DataPlaneUID: "uid3",
+					EnvironmentName: environment.Name, EnvironmentUID: "uid4",
+					Labels: map[string]string{}, Annotations: map[string]string{},
+					PodSelectors: map[string]string{"k": "v"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+
+			got := map[string]string{}
+			for _, rr := range output.Resources {
+				if rr.Resource["kind"] != "ConfigMap" {
+					continue
+				}
+				name := rr.Resource["metadata"].(map[string]any)["name"].(string)
+				for _, value := range rr.Resource["data"].(map[string]any) {
+					got[name] = value.(string)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Rendered trait resources mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // compareByKey compares two items by their key field ("name" or "secretKey").
 // Returns true if i should come before j in sorted order.
 func compareByKey(i, j any, getKey func(any) (string, bool)) bool {
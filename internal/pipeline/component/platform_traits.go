@@ -0,0 +1,191 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// EffectiveTraits returns the trait instances applied to a component: the traits the component lists,
+// followed by the traits attached by the ComponentType and then by the Environment. The environment
+// may be nil to only merge the traits of the ComponentType.
+//
+// An environment trait replaces a ComponentType trait with the same instance name. When the component
+// lists an instance of a platform trait, its parameters are merged over the defaults of the platform
+// trait and the locked parameters are applied last. Default traits named in spec.disabledTraits are
+// skipped, required traits are always applied.
+func EffectiveTraits(comp *v1alpha1.Component, ct *v1alpha1.ComponentType,
+	env *v1alpha1.Environment) ([]v1alpha1.ComponentTrait, error) {
+	platformTraits := PlatformTraits(ct, env)
+	if len(platformTraits) == 0 {
+		return comp.Spec.Traits, nil
+	}
+
+	platformByInstance := make(map[string]v1alpha1.PlatformTrait, len(platformTraits))
+	for _, pt := range platformTraits {
+		platformByInstance[pt.InstanceName] = pt
+	}
+
+	traits := make([]v1alpha1.ComponentTrait, 0, len(comp.Spec.Traits)+len(platformTraits))
+	listed := make(map[string]bool, len(comp.Spec.Traits))
+	for _, instance := range comp.Spec.Traits {
+		listed[instance.InstanceName] = true
+		pt, ok := platformByInstance[instance.InstanceName]
+		if !ok {
+			traits = append(traits, instance)
+			continue
+		}
+		if instance.Name != pt.Name {
+			return nil, fmt.Errorf("trait instance %q is an instance of trait %q attached by the platform, not %q",
+				instance.InstanceName, pt.Name, instance.Name)
+		}
+		if !pt.Required && slices.Contains(comp.Spec.DisabledTraits, pt.InstanceName) {
+			continue
+		}
+		merged, err := mergeTraitParameters(pt.Parameters, instance.Parameters, pt.LockedParameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge the parameters of trait instance %q: %w", pt.InstanceName, err)
+		}
+		traits = append(traits, v1alpha1.ComponentTrait{Name: pt.Name, InstanceName: pt.InstanceName, Parameters: merged})
+	}
+
+	for _, pt := range platformTraits {
+		if listed[pt.InstanceName] {
+			continue
+		}
+		if !pt.Required && slices.Contains(comp.Spec.DisabledTraits, pt.InstanceName) {
+			continue
+		}
+		merged, err := mergeTraitParameters(pt.Parameters, nil, pt.LockedParameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge the parameters of trait instance %q: %w", pt.InstanceName, err)
+		}
+		traits = append(traits, v1alpha1.ComponentTrait{Name: pt.Name, InstanceName: pt.InstanceName, Parameters: merged})
+	}
+	return traits, nil
+}
+
+// PlatformTraits returns the traits attached by the ComponentType and the Environment, in that order.
+// An environment trait replaces a ComponentType trait with the same instance name. Either may be nil.
+func PlatformTraits(ct *v1alpha1.ComponentType, env *v1alpha1.Environment) []v1alpha1.PlatformTrait {
+	var ctTraits, envTraits []v1alpha1.PlatformTrait
+	if ct != nil {
+		ctTraits = ct.Spec.Traits
+	}
+	if env != nil {
+		envTraits = env.Spec.Traits
+	}
+
+	traits := make([]v1alpha1.PlatformTrait, 0, len(ctTraits)+len(envTraits))
+	for _, pt := range ctTraits {
+		if !slices.ContainsFunc(envTraits, func(e v1alpha1.PlatformTrait) bool { return e.InstanceName == pt.InstanceName }) {
+			traits = append(traits, pt)
+		}
+	}
+	return append(traits, envTraits...)
+}
+
+// ConflictingPlatformTrait returns the trait that traits attach with the instance name of pt when it is an
+// instance of a different trait. The environment trait would replace the ComponentType trait, so no component
+// of the type could list that instance.
+func ConflictingPlatformTrait(pt v1alpha1.PlatformTrait, traits []v1alpha1.PlatformTrait) (v1alpha1.PlatformTrait, bool) {
+	for _, other := range traits {
+		if other.InstanceName == pt.InstanceName && other.Name != pt.Name {
+			return other, true
+		}
+	}
+	return v1alpha1.PlatformTrait{}, false
+}
+
+// LockedParameterConflicts returns the paths of the locked parameters that the given parameters set to
+// a different value, sorted. A parameter that is not set does not conflict.
+func LockedParameterConflicts(parameters, locked *runtime.RawExtension) ([]string, error) {
+	params, err := rawToMap(parameters)
+	if err != nil {
+		return nil, err
+	}
+	lockedParams, err := rawToMap(locked)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	var walk func(prefix string, locked, params map[string]any)
+	walk = func(prefix string, locked, params map[string]any) {
+		for key, lockedValue := range locked {
+			value, ok := params[key]
+			if !ok {
+				continue
+			}
+			lockedMap, lockedIsMap := lockedValue.(map[string]any)
+			valueMap, valueIsMap := value.(map[string]any)
+			if lockedIsMap && valueIsMap {
+				walk(prefix+key+".", lockedMap, valueMap)
+				continue
+			}
+			if !reflect.DeepEqual(lockedValue, value) {
+				conflicts = append(conflicts, prefix+key)
+			}
+		}
+	}
+	walk("", lockedParams, params)
+	sort.Strings(conflicts)
+	return conflicts, nil
+}
+
+// mergeTraitParameters deep merges the parameters of a component over the defaults of a platform trait,
+// and the locked parameters over the result.
+func mergeTraitParameters(defaults, parameters, locked *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if defaults == nil && locked == nil {
+		return parameters, nil
+	}
+
+	merged := map[string]any{}
+	for _, raw := range []*runtime.RawExtension{defaults, parameters, locked} {
+		values, err := rawToMap(raw)
+		if err != nil {
+			return nil, err
+		}
+		mergeParameters(merged, values)
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: data}, nil
+}
+
+// mergeParameters merges src into dst. Nested objects are merged, other values are replaced.
+func mergeParameters(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeParameters(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// rawToMap decodes the parameters of a trait instance
+func rawToMap(raw *runtime.RawExtension) (map[string]any, error) {
+	values := map[string]any{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(raw.Raw, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse trait parameters: %w", err)
+	}
+	return values, nil
+}
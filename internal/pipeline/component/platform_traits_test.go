@@ -0,0 +1,184 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEffectiveTraits(t *testing.T) {
+	componentTypeYAML := `
+spec:
+  traits:
+    - name: pdb
+      instanceName: pdb
+      required: true
+      parameters: {minAvailable: 1, labels: {tier: backend}}
+      lockedParameters: {enabled: true}
+    - name: network-policy
+      instanceName: network-policy
+      parameters: {ingress: same-namespace}
+    - name: alerts
+      instanceName: alerts
+      parameters: {severity: warning}
+`
+	environmentYAML := `
+spec:
+  traits:
+    - name: alerts
+      instanceName: alerts
+      required: true
+      lockedParameters: {severity: critical}
+`
+
+	tests := []struct {
+		name            string
+		componentYAML   string
+		withEnvironment bool
+		wantTraitsYAML  string
+		wantErr         string
+	}{
+		{
+			name:          "platform traits are added after the traits of the component",
+			componentYAML: `spec: {traits: [{name: mysql, instanceName: db, parameters: {database: orders}}]}`,
+			wantTraitsYAML: `
+- {name: mysql, instanceName: db, parameters: {database: orders}}
+- {name: pdb, instanceName: pdb, parameters: {enabled: true, labels: {tier: backend}, minAvailable: 1}}
+- {name: network-policy, instanceName: network-policy, parameters: {ingress: same-namespace}}
+- {name: alerts, instanceName: alerts, parameters: {severity: warning}}
+`,
+		},
+		{
+			name: "component parameters override defaults but not locked parameters",
+			componentYAML: `
+spec:
+  traits:
+    - name: pdb
+      instanceName: pdb
+      parameters: {minAvailable: 2, enabled: false, labels: {team: payments}}
+`,
+			wantTraitsYAML: `
+- {name: pdb, instanceName: pdb, parameters: {enabled: true, labels: {team: payments, tier: backend}, minAvailable: 2}}
+- {name: network-policy, instanceName: network-policy, parameters: {ingress: same-namespace}}
+- {name: alerts, instanceName: alerts, parameters: {severity: warning}}
+`,
+		},
+		{
+			name:            "environment traits replace component type traits",
+			componentYAML:   `spec: {disabledTraits: [network-policy, alerts]}`,
+			withEnvironment: true,
+			wantTraitsYAML: `
+- {name: pdb, instanceName: pdb, parameters: {enabled: true, labels: {tier: backend}, minAvailable: 1}}
+- {name: alerts, instanceName: alerts, parameters: {severity: critical}}
+`,
+		},
+		{
+			name:          "required traits cannot be disabled",
+			componentYAML: `spec: {disabledTraits: [pdb]}`,
+			wantTraitsYAML: `
+- {name: pdb, instanceName: pdb, parameters: {enabled: true, labels: {tier: backend}, minAvailable: 1}}
+- {name: network-policy, instanceName: network-policy, parameters: {ingress: same-namespace}}
+- {name: alerts, instanceName: alerts, parameters: {severity: warning}}
+`,
+		},
+		{
+			name:          "instance of a different trait",
+			componentYAML: `spec: {traits: [{name: mysql, instanceName: pdb}]}`,
+			wantErr:       `trait instance "pdb" is an instance of trait "pdb" attached by the platform, not "mysql"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var componentType v1alpha1.ComponentType
+			if err := yaml.Unmarshal([]byte(componentTypeYAML), &componentType); err != nil {
+				t.Fatalf("Failed to parse componentType: %v", err)
+			}
+			var component v1alpha1.Component
+			if err := yaml.Unmarshal([]byte(tt.componentYAML), &component); err != nil {
+				t.Fatalf("Failed to parse component: %v", err)
+			}
+			var environment *v1alpha1.Environment
+			if tt.withEnvironment {
+				environment = &v1alpha1.Environment{}
+				if err := yaml.Unmarshal([]byte(environmentYAML), environment); err != nil {
+					t.Fatalf("Failed to parse environment: %v", err)
+				}
+			}
+
+			traits, err := EffectiveTraits(&component, &componentType, environment)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("EffectiveTraits() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EffectiveTraits() error: %v", err)
+			}
+
+			var want []v1alpha1.ComponentTrait
+			if err := yaml.Unmarshal([]byte(tt.wantTraitsYAML), &want); err != nil {
+				t.Fatalf("Failed to parse want traits: %v", err)
+			}
+			if diff := cmp.Diff(traitsToMaps(t, want), traitsToMaps(t, traits)); diff != "" {
+				t.Errorf("EffectiveTraits() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLockedParameterConflicts(t *testing.T) {
+	locked := &runtime.RawExtension{Raw: []byte(`{"enabled":true,"limits":{"cpu":"1","memory":"1Gi"}}`)}
+	params := &runtime.RawExtension{Raw: []byte(`{"enabled":true,"limits":{"cpu":"2"},"replicas":3}`)}
+
+	conflicts, err := LockedParameterConflicts(params, locked)
+	if err != nil {
+		t.Fatalf("LockedParameterConflicts() error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"limits.cpu"}, conflicts); diff != "" {
+		t.Errorf("LockedParameterConflicts() mismatch (-want +got):\n%s", diff)
+	}
+
+	conflicts, err = LockedParameterConflicts(nil, locked)
+	if err != nil || len(conflicts) != 0 {
+		t.Errorf("LockedParameterConflicts() = %v, %v, want no conflicts", conflicts, err)
+	}
+}
+
+func TestConflictingPlatformTrait(t *testing.T) {
+	envTraits := []v1alpha1.PlatformTrait{
+		{Name: "pdb", InstanceName: "pdb"},
+		{Name: "alerts", InstanceName: "monitoring"},
+	}
+
+	if _, ok := ConflictingPlatformTrait(v1alpha1.PlatformTrait{Name: "pdb", InstanceName: "pdb"}, envTraits); ok {
+		t.Errorf("ConflictingPlatformTrait() reported a conflict for an instance of the same trait")
+	}
+	conflict, ok := ConflictingPlatformTrait(v1alpha1.PlatformTrait{Name: "metrics", InstanceName: "monitoring"}, envTraits)
+	if !ok || conflict.Name != "alerts" {
+		t.Errorf("ConflictingPlatformTrait() = %v, %v, want the alerts trait", conflict, ok)
+	}
+}
+
+// traitsToMaps decodes the parameters of trait instances so they compare independently of key order
+func traitsToMaps(t *testing.T, traits []v1alpha1.ComponentTrait) []map[string]any {
+	t.Helper()
+	out := make([]map[string]any, 0, len(traits))
+	for _, trait := range traits {
+		params, err := rawToMap(trait.Parameters)
+		if err != nil {
+			t.Fatalf("Failed to decode parameters of %s: %v", trait.InstanceName, err)
+		}
+		out = append(out, map[string]any{"name": trait.Name, "instanceName": trait.InstanceName, "parameters": params})
+	}
+	return out
+}
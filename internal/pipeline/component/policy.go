@@ -0,0 +1,174 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// PolicyViolation is a rendered resource that does not satisfy a rule of a render policy.
+type PolicyViolation struct {
+	// Policy is the kind and name of the policy, e.g. "ClusterRenderPolicy/no-privileged-containers".
+	Policy string
+
+	// Rule is the name of the violated rule.
+	Rule string
+
+	// Mode is the mode of the policy.
+	Mode v1alpha1.RenderPolicyMode
+
+	// Resource identifies the resource and the template that produced it.
+	Resource string
+
+	// Message describes the violation.
+	Message string
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("%s violates rule %q of %s: %s", v.Resource, v.Rule, v.Policy, v.Message)
+}
+
+// PolicyViolationError is returned when rendered resources violate render policies in Enforce mode.
+type PolicyViolationError struct {
+	Violations []PolicyViolation
+}
+
+func (e *PolicyViolationError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, v.String())
+	}
+	return fmt.Sprintf("rendered resources violate render policies: %s", strings.Join(violations, "; "))
+}
+
+// policyVariables are the variables the rules of render policies are evaluated with
+var policyVariables = []string{"metadata", "environment", "resource"}
+
+// ValidateRenderPolicy compiles the rules of a render policy in the CEL environment they are evaluated in.
+// It returns an error naming the rules that do not compile.
+func (p *Pipeline) ValidateRenderPolicy(spec *v1alpha1.RenderPolicySpec) error {
+	var invalid []string
+	for _, rule := range spec.Rules {
+		if err := p.templateEngine.Compile(rule.Expression, policyVariables...); err != nil {
+			invalid = append(invalid, fmt.Sprintf("rule %q: %v", rule.Name, err))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid rules: %s", strings.Join(invalid, "; "))
+	}
+	return nil
+}
+
+// renderPolicy is a RenderPolicy or ClusterRenderPolicy, named by its kind and name
+type renderPolicy struct {
+	name string
+	spec *v1alpha1.RenderPolicySpec
+}
+
+// evaluatePolicies checks the rendered resources against the rules of the render policies that apply
+// to the environment. Violations of policies in Enforce mode fail the render with a PolicyViolationError,
+// the others are recorded in the metadata, and reported as warnings in Warn mode. Policies with rules that
+// do not compile are skipped with a warning, so that a broken policy does not block every render.
+func (p *Pipeline) evaluatePolicies(resources []renderer.RenderedResource, input *RenderInput,
+	componentContext *context.ComponentContext, metadata *RenderMetadata) error {
+	policies := make([]renderPolicy, 0, len(input.ClusterRenderPolicies)+len(input.RenderPolicies))
+	for i := range input.ClusterRenderPolicies {
+		policy := &input.ClusterRenderPolicies[i]
+		policies = append(policies, renderPolicy{name: "ClusterRenderPolicy/" + policy.Name, spec: &policy.Spec})
+	}
+	for i := range input.RenderPolicies {
+		policy := &input.RenderPolicies[i]
+		policies = append(policies, renderPolicy{name: "RenderPolicy/" + policy.Name, spec: &policy.Spec})
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	policyContext := map[string]any{
+		"metadata": componentContext.ToMap()["metadata"],
+		"environment": map[string]any{
+			"name":         input.Environment.Name,
+			"isProduction": input.Environment.Spec.IsProduction,
+		},
+	}
+
+	var enforced []PolicyViolation
+	for _, policy := range policies {
+		match := policy.spec.Match
+		if len(match.Environments) > 0 && !slices.Contains(match.Environments, input.Environment.Name) {
+			continue
+		}
+		if err := p.ValidateRenderPolicy(policy.spec); err != nil {
+			metadata.Warnings = append(metadata.Warnings, fmt.Sprintf("%s was skipped: %v", policy.name, err))
+			continue
+		}
+		mode := policy.spec.Mode
+		if mode == "" {
+			mode = v1alpha1.RenderPolicyModeEnforce
+		}
+
+		for _, rr := range resources {
+			kind, _ := rr.Resource["kind"].(string)
+			if len(match.Kinds) > 0 && !slices.Contains(match.Kinds, kind) {
+				continue
+			}
+			policyContext["resource"] = rr.Resource
+
+			for _, rule := range policy.spec.Rules {
+				message, complies := p.evaluateRule(rule, policyContext)
+				if complies {
+					continue
+				}
+				violation := PolicyViolation{
+					Policy:   policy.name,
+					Rule:     rule.Name,
+					Mode:     mode,
+					Resource: describeResource(rr),
+					Message:  message,
+				}
+				switch mode {
+				case v1alpha1.RenderPolicyModeEnforce:
+					enforced = append(enforced, violation)
+				case v1alpha1.RenderPolicyModeWarn:
+					metadata.Warnings = append(metadata.Warnings, violation.String())
+					metadata.PolicyViolations = append(metadata.PolicyViolations, violation)
+				default:
+					metadata.PolicyViolations = append(metadata.PolicyViolations, violation)
+				}
+			}
+		}
+	}
+
+	if len(enforced) > 0 {
+		return &PolicyViolationError{Violations: enforced}
+	}
+	return nil
+}
+
+// evaluateRule evaluates a rule on the resource bound in the policy context. It returns whether the
+// resource complies, and the message of the violation if it does not. A rule that cannot be evaluated
+// counts as violated, so that a broken rule does not silently let resources through.
+func (p *Pipeline) evaluateRule(rule v1alpha1.RenderPolicyRule, policyContext map[string]any) (string, bool) {
+	result, err := p.templateEngine.Render(rule.Expression, policyContext)
+	if err != nil {
+		return fmt.Sprintf("rule could not be evaluated: %v", err), false
+	}
+	complies, ok := result.(bool)
+	if !ok {
+		return fmt.Sprintf("rule must evaluate to a boolean, got %T", result), false
+	}
+	if complies {
+		return "", true
+	}
+	if rule.Message != "" {
+		return rule.Message, false
+	}
+	return rule.Expression, false
+}
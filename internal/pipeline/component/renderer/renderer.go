@@ -34,6 +34,20 @@ type RenderedResource struct {
 
 	// TargetPlane indicates which plane this resource should be deployed to.
 	TargetPlane string
+
+	// Source describes the template that produced the resource, e.g. resource "deployment"
+	// for a ComponentType resource, so that errors about the resource can point back to it.
+	Source string
+}
+
+// KindAndName returns the kind and the name of a rendered resource manifest, empty when they are not set.
+func KindAndName(resource map[string]any) (string, string) {
+	kind, _ := resource["kind"].(string)
+	name := ""
+	if metadata, ok := resource["metadata"].(map[string]any); ok {
+		name, _ = metadata["name"].(string)
+	}
+	return kind, name
 }
 
 // RenderResources renders all resources from a ComponentType.
@@ -77,6 +91,7 @@ func (r *Renderer) RenderResources(
 				resources = append(resources, RenderedResource{
 					Resource:    res,
 					TargetPlane: tmpl.TargetPlane,
+					Source:      resourceSource(tmpl.ID),
 				})
 			}
 			continue
@@ -90,12 +105,18 @@ func (r *Renderer) RenderResources(
 		resources = append(resources, RenderedResource{
 			Resource:    rendered,
 			TargetPlane: tmpl.TargetPlane,
+			Source:      resourceSource(tmpl.ID),
 		})
 	}
 
 	return resources, nil
 }
 
+// resourceSource describes a ComponentType resource template as the source of a rendered resource
+func resourceSource(resourceID string) string {
+	return fmt.Sprintf("resource %q", resourceID)
+}
+
 // renderWithForEach handles ResourceTemplate.forEach iteration.
 // Delegates to the shared EvalForEach helper for iteration logic.
 func (r *Renderer) renderWithForEach(
@@ -14,7 +14,8 @@ import (
 // It combines Component, ComponentType, Traits, Workload and ReleaseBinding
 // to generate fully resolved Kubernetes resource manifests.
 type Pipeline struct {
-	templateEngine *template.Engine
+	templateEngine    *template.Engine
+	resourceValidator ResourceValidator
 }
 
 // RenderInput contains all inputs needed to render a component's resources.
@@ -27,7 +28,8 @@ type RenderInput struct {
 	// Required.
 	Component *v1alpha1.Component `validate:"required"`
 
-	// Traits is the list of trait definitions used by the component.
+	// Traits is the list of trait definitions used by the component, including the definitions
+	// of the traits attached by the ComponentType and the Environment.
 	// Optional - if nil or empty, no traits are processed.
 	Traits []v1alpha1.Trait
 
@@ -54,6 +56,14 @@ type RenderInput struct {
 	// Metadata provides structured naming information.
 	// Required - controller must compute and provide this.
 	Metadata pipelinecontext.MetadataContext `validate:"required"`
+
+	// RenderPolicies are the guardrail policies of the organization that the rendered resources must follow.
+	// Optional.
+	RenderPolicies []v1alpha1.RenderPolicy
+
+	// ClusterRenderPolicies are the guardrail policies of the cluster that the rendered resources must follow.
+	// Optional.
+	ClusterRenderPolicies []v1alpha1.ClusterRenderPolicy
 }
 
 // ApplyTargetPlaneDefaults normalizes empty targetPlane fields to "dataplane".
@@ -109,4 +119,8 @@ type RenderMetadata struct {
 
 	// Warnings contains non-fatal issues encountered during rendering.
 	Warnings []string
+
+	// PolicyViolations lists the violations of render policies in Warn and Audit mode,
+	// which do not block the render.
+	PolicyViolations []PolicyViolation
 }
@@ -0,0 +1,82 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// ResourceValidator validates rendered resources against the schemas of their kinds.
+// Custom resources are validated against the schemas of the given data plane, which is
+// nil for resources of other planes.
+type ResourceValidator interface {
+	// Validate returns the schema violations of a resource, or an error if its schema
+	// could not be loaded, in which case the resource was not validated.
+	Validate(ctx context.Context, dataPlane *v1alpha1.DataPlane, resource map[string]any) (field.ErrorList, error)
+}
+
+// ResourceValidationError is returned when rendered resources do not match the schemas of their kinds.
+type ResourceValidationError struct {
+	// Violations lists the schema violations, each prefixed with the resource and the
+	// template that produced it.
+	Violations []string
+}
+
+func (e *ResourceValidationError) Error() string {
+	return fmt.Sprintf("rendered resources do not match their schemas: %s", strings.Join(e.Violations, "; "))
+}
+
+// WithResourceValidator sets the validator of the rendered resources.
+// Defaults to a validator of the built-in Kubernetes kinds, which leaves custom resources unvalidated.
+func WithResourceValidator(validator ResourceValidator) Option {
+	return func(p *Pipeline) {
+		p.resourceValidator = validator
+	}
+}
+
+// validateResourceSchemas validates the rendered resources against the schemas of their kinds.
+// Violations fail the render with a ResourceValidationError. Resources whose schema could not be
+// loaded are not validated, which is reported as a warning.
+func (p *Pipeline) validateResourceSchemas(ctx context.Context, resources []renderer.RenderedResource,
+	dataPlane *v1alpha1.DataPlane, metadata *RenderMetadata) error {
+	var violations []string
+	for _, rr := range resources {
+		resourceID := describeResource(rr)
+
+		// Custom resources are only looked up in the data plane they are deployed to
+		var plane *v1alpha1.DataPlane
+		if rr.TargetPlane == v1alpha1.TargetPlaneDataPlane {
+			plane = dataPlane
+		}
+		errs, err := p.resourceValidator.Validate(ctx, plane, rr.Resource)
+		if err != nil {
+			metadata.Warnings = append(metadata.Warnings, fmt.Sprintf("%s was not validated: %v", resourceID, err))
+			continue
+		}
+		for _, fieldErr := range errs {
+			violations = append(violations, fmt.Sprintf("%s: %s", resourceID, fieldErr.Error()))
+		}
+	}
+	if len(violations) > 0 {
+		return &ResourceValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// describeResource identifies a rendered resource by its kind and name, and the template that produced it
+func describeResource(rr renderer.RenderedResource) string {
+	kind, name := renderer.KindAndName(rr.Resource)
+	resourceID := fmt.Sprintf("%s/%s", kind, name)
+	if rr.Source != "" {
+		resourceID = fmt.Sprintf("%s (from %s)", resourceID, rr.Source)
+	}
+	return resourceID
+}
@@ -0,0 +1,313 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourcepolicy computes the quotas and container limits of projects and the
+// resources their rendered workloads request against them.
+package resourcepolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// ResourceQuotaName is the name of the ResourceQuota rendered into a project's data plane namespace
+	ResourceQuotaName = "openchoreo-project-quota"
+	// LimitRangeName is the name of the LimitRange rendered into a project's data plane namespace
+	LimitRangeName = "openchoreo-container-limits"
+)
+
+// Effective returns the quota and container limits of a project in an environment.
+// Both are empty when the project has no resource policy.
+func Effective(policy *openchoreov1alpha1.ProjectResourcePolicy, environment string) (corev1.ResourceList,
+	*openchoreov1alpha1.ContainerLimits) {
+	if policy == nil {
+		return nil, nil
+	}
+	hard := policy.Quota.DeepCopy()
+	limits := policy.ContainerLimits
+	for _, override := range policy.Environments {
+		if override.Environment != environment {
+			continue
+		}
+		if len(override.Quota) > 0 && hard == nil {
+			hard = corev1.ResourceList{}
+		}
+		for name, quantity := range override.Quota {
+			hard[name] = quantity.DeepCopy()
+		}
+		if override.ContainerLimits != nil {
+			limits = override.ContainerLimits
+		}
+	}
+	if limits != nil {
+		limits = limits.DeepCopy()
+	}
+	return hard, limits
+}
+
+// MakeResourceQuota builds the ResourceQuota of a project's data plane namespace
+func MakeResourceQuota(namespace string, hard corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourceQuotaName,
+			Namespace: namespace,
+			Labels:    map[string]string{labels.LabelKeyManagedBy: labels.LabelValueManagedBy},
+		},
+		Spec: corev1.ResourceQuotaSpec{Hard: hard},
+	}
+}
+
+// MakeLimitRange builds the LimitRange of a project's data plane namespace
+func MakeLimitRange(namespace string, limits *openchoreov1alpha1.ContainerLimits) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LimitRangeName,
+			Namespace: namespace,
+			Labels:    map[string]string{labels.LabelKeyManagedBy: labels.LabelValueManagedBy},
+		},
+		Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+			Type:           corev1.LimitTypeContainer,
+			Default:        limits.Default,
+			DefaultRequest: limits.DefaultRequest,
+			Max:            limits.Max,
+			Min:            limits.Min,
+		}}},
+	}
+}
+
+// Usage sums the quota resources of the pods the rendered resources run, as the data plane would
+// count them against a ResourceQuota once the container limits fill in missing requests and limits.
+func Usage(resources []map[string]any, limits *openchoreov1alpha1.ContainerLimits) (corev1.ResourceList, error) {
+	used := corev1.ResourceList{}
+	for _, obj := range resources {
+		template, replicas, err := podTemplate(obj)
+		if err != nil {
+			return nil, err
+		}
+		if template == nil || replicas == 0 {
+			continue
+		}
+		requests, podLimits := podResources(&template.Spec, limits)
+		for name, quantity := range requests {
+			add(used, name, quantity, replicas)
+			add(used, corev1.ResourceName("requests."+string(name)), quantity, replicas)
+		}
+		for name, quantity := range podLimits {
+			add(used, corev1.ResourceName("limits."+string(name)), quantity, replicas)
+		}
+		add(used, corev1.ResourcePods, *resource.NewQuantity(1, resource.DecimalSI), replicas)
+	}
+	return used, nil
+}
+
+// ReleaseUsage sums the quota resources of the pods the resources of a Release run
+func ReleaseUsage(resources []openchoreov1alpha1.Resource, limits *openchoreov1alpha1.ContainerLimits) (corev1.ResourceList, error) {
+	objects := make([]map[string]any, 0, len(resources))
+	for _, r := range resources {
+		if r.Object == nil {
+			continue
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(r.Object.Raw, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode resource %s: %w", r.ID, err)
+		}
+		objects = append(objects, obj)
+	}
+	return Usage(objects, limits)
+}
+
+// Sum adds up usages
+func Sum(usages ...corev1.ResourceList) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, usage := range usages {
+		for name, quantity := range usage {
+			add(total, name, quantity, 1)
+		}
+	}
+	return total
+}
+
+// Filter keeps the resources of the usage that the quota limits, so that every limited resource is present
+func Filter(used, hard corev1.ResourceList) corev1.ResourceList {
+	filtered := make(corev1.ResourceList, len(hard))
+	for name := range hard {
+		quantity, ok := used[name]
+		if !ok {
+			quantity = *resource.NewQuantity(0, hard[name].Format)
+		}
+		filtered[name] = quantity.DeepCopy()
+	}
+	return filtered
+}
+
+// Exceeded returns the resources of the quota that the usage goes over, sorted by name
+func Exceeded(hard, used corev1.ResourceList) []corev1.ResourceName {
+	var exceeded []corev1.ResourceName
+	for name, limit := range hard {
+		if quantity, ok := used[name]; ok && quantity.Cmp(limit) > 0 {
+			exceeded = append(exceeded, name)
+		}
+	}
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+	return exceeded
+}
+
+// Exhausted returns the resources of the quota that the usage leaves no room in, sorted by name
+func Exhausted(hard, used corev1.ResourceList) []corev1.ResourceName {
+	var exhausted []corev1.ResourceName
+	for name, limit := range hard {
+		if quantity, ok := used[name]; ok && quantity.Cmp(limit) >= 0 {
+			exhausted = append(exhausted, name)
+		}
+	}
+	sort.Slice(exhausted, func(i, j int) bool { return exhausted[i] < exhausted[j] })
+	return exhausted
+}
+
+// Describe lists the usage and quota of the given resources for messages
+func Describe(hard, used corev1.ResourceList, names []corev1.ResourceName) string {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := used[name]
+		limit := hard[name]
+		parts = append(parts, fmt.Sprintf("%s %s of %s", name, quantity.String(), limit.String()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// podTemplate extracts the pod template of a workload and the number of pods it runs.
+// It returns a nil template for resources that do not run pods or run a number of pods
+// that depends on the cluster, such as DaemonSets.
+func podTemplate(obj map[string]any) (*corev1.PodTemplateSpec, int64, error) {
+	kind, _ := obj["kind"].(string)
+	spec, _ := obj["spec"].(map[string]any)
+	if spec == nil {
+		return nil, 0, nil
+	}
+
+	var templateObj map[string]any
+	replicas := int64(1)
+	switch kind {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		templateObj, _ = spec["template"].(map[string]any)
+		replicas = intField(spec, "replicas", 1)
+	case "Job":
+		templateObj, _ = spec["template"].(map[string]any)
+		replicas = intField(spec, "parallelism", 1)
+	case "CronJob":
+		jobTemplate, _ := spec["jobTemplate"].(map[string]any)
+		jobSpec, _ := jobTemplate["spec"].(map[string]any)
+		templateObj, _ = jobSpec["template"].(map[string]any)
+		replicas = intField(jobSpec, "parallelism", 1)
+	case "Pod":
+		templateObj = map[string]any{"spec": spec}
+	default:
+		return nil, 0, nil
+	}
+	if templateObj == nil {
+		return nil, 0, nil
+	}
+
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, template); err != nil {
+		metadata, _ := obj["metadata"].(map[string]any)
+		name, _ := metadata["name"].(string)
+		return nil, 0, fmt.Errorf("failed to read the pod template of %s %q: %w", kind, name, err)
+	}
+	return template, replicas, nil
+}
+
+// podResources returns the effective requests and limits of a pod: the larger of the sum of its
+// containers and the largest of its init containers
+func podResources(spec *corev1.PodSpec, limits *openchoreov1alpha1.ContainerLimits) (corev1.ResourceList, corev1.ResourceList) {
+	requests, podLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	for i := range spec.Containers {
+		containerRequests, containerLimits := containerResources(&spec.Containers[i], limits)
+		for name, quantity := range containerRequests {
+			add(requests, name, quantity, 1)
+		}
+		for name, quantity := range containerLimits {
+			add(podLimits, name, quantity, 1)
+		}
+	}
+	for i := range spec.InitContainers {
+		containerRequests, containerLimits := containerResources(&spec.InitContainers[i], limits)
+		maxInto(requests, containerRequests)
+		maxInto(podLimits, containerLimits)
+	}
+	return requests, podLimits
+}
+
+// containerResources applies the container limits to a container the way a LimitRange does:
+// a missing limit takes the default, and a missing request takes the default request or else the limit
+func containerResources(container *corev1.Container, limits *openchoreov1alpha1.ContainerLimits) (corev1.ResourceList,
+	corev1.ResourceList) {
+	requests := container.Resources.Requests.DeepCopy()
+	containerLimits := container.Resources.Limits.DeepCopy()
+	if requests == nil {
+		requests = corev1.ResourceList{}
+	}
+	if containerLimits == nil {
+		containerLimits = corev1.ResourceList{}
+	}
+	if limits != nil {
+		for name, quantity := range limits.Default {
+			if _, ok := containerLimits[name]; !ok {
+				containerLimits[name] = quantity.DeepCopy()
+			}
+		}
+		for name, quantity := range limits.DefaultRequest {
+			if _, ok := requests[name]; !ok {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	for name, quantity := range containerLimits {
+		if _, ok := requests[name]; !ok {
+			requests[name] = quantity.DeepCopy()
+		}
+	}
+	return requests, containerLimits
+}
+
+func add(list corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity, times int64) {
+	scaled := quantity.DeepCopy()
+	scaled.Mul(times)
+	if total, ok := list[name]; ok {
+		scaled.Add(total)
+	}
+	list[name] = scaled
+}
+
+func maxInto(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := list[name]; !ok || quantity.Cmp(current) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func intField(obj map[string]any, field string, defaultValue int64) int64 {
+	switch v := obj[field].(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+	return defaultValue
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcepolicy
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEffective(t *testing.T) {
+	t.Parallel()
+
+	projectLimits := &openchoreov1alpha1.ContainerLimits{Default: resources("memory", "512Mi")}
+	productionLimits := &openchoreov1alpha1.ContainerLimits{Default: resources("memory", "1Gi")}
+	policy := &openchoreov1alpha1.ProjectResourcePolicy{
+		Quota:           resources("requests.cpu", "4", "pods", "20"),
+		ContainerLimits: projectLimits,
+		Environments: []openchoreov1alpha1.EnvironmentResourcePolicy{{
+			Environment:     "production",
+			Quota:           resources("requests.cpu", "8"),
+			ContainerLimits: productionLimits,
+		}},
+	}
+
+	hard, limits := Effective(policy, "development")
+	assertResources(t, "development quota", hard, resources("requests.cpu", "4", "pods", "20"))
+	assertResources(t, "development limits", limits.Default, projectLimits.Default)
+
+	hard, limits = Effective(policy, "production")
+	assertResources(t, "production quota", hard, resources("requests.cpu", "8", "pods", "20"))
+	assertResources(t, "production limits", limits.Default, productionLimits.Default)
+
+	if hard, limits := Effective(nil, "production"); hard != nil || limits != nil {
+		t.Errorf("Effective(nil) = %v, %v, want no policy", hard, limits)
+	}
+	assertResources(t, "project quota after overrides", policy.Quota, resources("requests.cpu", "4", "pods", "20"))
+}
+
+func TestUsage(t *testing.T) {
+	t.Parallel()
+
+	container := func(resources map[string]any) map[string]any {
+		return map[string]any{"name": "main", "image": "api:v1", "resources": resources}
+	}
+	workload := func(kind string, replicas any, containers ...any) map[string]any {
+		spec := map[string]any{"template": map[string]any{"spec": map[string]any{"containers": containers}}}
+		if replicas != nil {
+			spec["replicas"] = replicas
+		}
+		return map[string]any{"kind": kind, "metadata": map[string]any{"name": "api"}, "spec": spec}
+	}
+	limits := &openchoreov1alpha1.ContainerLimits{
+		Default:        resources("memory", "512Mi"),
+		DefaultRequest: resources("cpu", "100m"),
+	}
+
+	tests := []struct {
+		name      string
+		resources []map[string]any
+		limits    *openchoreov1alpha1.ContainerLimits
+		want      corev1.ResourceList
+	}{
+		{
+			name: "replicas multiply the pod resources",
+			resources: []map[string]any{workload("Deployment", int64(3), container(map[string]any{
+				"requests": map[string]any{"cpu": "250m", "memory": "256Mi"},
+				"limits":   map[string]any{"memory": "512Mi"},
+			}))},
+			want: resources("cpu", "750m", "requests.cpu", "750m", "memory", "768Mi", "requests.memory", "768Mi",
+				"limits.memory", "1536Mi", "pods", "3"),
+		},
+		{
+			name:      "container limits fill in missing requests and limits",
+			resources: []map[string]any{workload("Deployment", nil, container(map[string]any{}))},
+			limits:    limits,
+			want: resources("cpu", "100m", "requests.cpu", "100m", "memory", "512Mi", "requests.memory", "512Mi",
+				"limits.memory", "512Mi", "pods", "1"),
+		},
+		{
+			name: "resources without pods are not counted",
+			resources: []map[string]any{
+				{"kind": "Service", "spec": map[string]any{"ports": []any{}}},
+				workload("DaemonSet", nil, container(map[string]any{"requests": map[string]any{"cpu": "1"}})),
+			},
+			want: corev1.ResourceList{},
+		},
+		{
+			name:      "scaled to zero",
+			resources: []map[string]any{workload("Deployment", int64(0), container(map[string]any{"requests": map[string]any{"cpu": "1"}}))},
+			want:      corev1.ResourceList{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Usage(tt.resources, tt.limits)
+			if err != nil {
+				t.Fatalf("Usage() error = %v", err)
+			}
+			assertResources(t, "Usage()", got, tt.want)
+		})
+	}
+}
+
+func TestExceeded(t *testing.T) {
+	t.Parallel()
+
+	hard := resources("requests.cpu", "4", "pods", "10", "limits.memory", "8Gi")
+	used := resources("requests.cpu", "4500m", "pods", "10")
+
+	exceeded := Exceeded(hard, used)
+	if len(exceeded) != 1 || exceeded[0] != corev1.ResourceRequestsCPU {
+		t.Errorf("Exceeded() = %v, want [requests.cpu]", exceeded)
+	}
+	exhausted := Exhausted(hard, used)
+	if len(exhausted) != 2 || exhausted[0] != corev1.ResourcePods || exhausted[1] != corev1.ResourceRequestsCPU {
+		t.Errorf("Exhausted() = %v, want [pods requests.cpu]", exhausted)
+	}
+	if got, want := Describe(hard, used, exceeded), "requests.cpu 4500m of 4"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+	assertResources(t, "Filter()", Filter(used, hard), resources("requests.cpu", "4500m", "pods", "10", "limits.memory", "0"))
+}
+
+// resources builds a ResourceList from name and quantity pairs
+func resources(pairs ...string) corev1.ResourceList {
+	list := corev1.ResourceList{}
+	for i := 0; i < len(pairs); i += 2 {
+		list[corev1.ResourceName(pairs[i])] = resource.MustParse(pairs[i+1])
+	}
+	return list
+}
+
+func assertResources(t *testing.T, what string, got, want corev1.ResourceList) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s = %v, want %v", what, got, want)
+		return
+	}
+	for name, quantity := range want {
+		if actual, ok := got[name]; !ok || actual.Cmp(quantity) != 0 {
+			t.Errorf("%s[%s] = %v, want %v", what, name, got[name], quantity.String())
+		}
+	}
+}
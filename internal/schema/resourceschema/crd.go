@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceschema
+
+import (
+	"fmt"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// crdSchema is the compiled schema of a version of a CRD
+type crdSchema struct {
+	structural *apiextschema.Structural
+	validator  validation.SchemaCreateValidator
+}
+
+// compileCRDSchema compiles the schema of a version of a CRD. It returns nil if the version has no schema.
+func compileCRDSchema(crd *apiextensionsv1.CustomResourceDefinition, version string) (*crdSchema, error) {
+	var crdVersion *apiextensionsv1.CustomResourceDefinitionVersion
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Name == version {
+			crdVersion = &crd.Spec.Versions[i]
+			break
+		}
+	}
+	if crdVersion == nil || !crdVersion.Served {
+		return nil, fmt.Errorf("version %s of %s is not served by CRD %s", version, crd.Spec.Names.Kind, crd.Name)
+	}
+	if crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+		return nil, nil
+	}
+
+	internalSchema := &apiext.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(
+		crdVersion.Schema.OpenAPIV3Schema, internalSchema, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert the schema of CRD %s: %w", crd.Name, err)
+	}
+	structural, err := apiextschema.NewStructural(internalSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the structural schema of CRD %s: %w", crd.Name, err)
+	}
+	validator, _, err := validation.NewSchemaValidator(internalSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the validator of CRD %s: %w", crd.Name, err)
+	}
+	return &crdSchema{structural: structural, validator: validator}, nil
+}
+
+// validate reports the fields of a resource that are not declared in the schema, and the
+// values that do not match it. The resource must hold the types of decoded JSON.
+func (s *crdSchema) validate(resource map[string]any) field.ErrorList {
+	allErrs := validation.ValidateCustomResource(nil, resource, s.validator)
+
+	// Pruning a copy finds the unknown fields, which the API server would otherwise drop silently
+	pruned := pruning.PruneWithOptions(runtime.DeepCopyJSON(resource), s.structural, true,
+		apiextschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+	for _, path := range pruned {
+		allErrs = append(allErrs, schemaViolation(field.NewPath(path), "field not declared in schema"))
+	}
+	return allErrs
+}
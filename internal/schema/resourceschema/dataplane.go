@@ -0,0 +1,110 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceschema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+const (
+	// DefaultCRDCacheTTL is how long the CRDs of a data plane are cached before they are listed again
+	DefaultCRDCacheTTL = 5 * time.Minute
+
+	// crdListTimeout bounds the listing of the CRDs of a data plane
+	crdListTimeout = 10 * time.Second
+
+	// crdListFailureTTL is how long a failure to list the CRDs of a data plane is cached, so that renders
+	// do not wait for an unreachable data plane once per custom resource
+	crdListFailureTTL = 30 * time.Second
+)
+
+// DataPlaneCRDs looks up CRDs in data planes through the cluster gateway. The CRDs of a data plane are
+// listed at once and cached, so a kind that is not installed in the data plane is only looked up again
+// once the cache expires. A failed listing is cached for a shorter time.
+type DataPlaneCRDs struct {
+	ttl time.Duration
+	now func() time.Time
+
+	// list lists the CRDs of a data plane
+	list func(ctx context.Context, dataPlane *v1alpha1.DataPlane) ([]apiextensionsv1.CustomResourceDefinition, error)
+
+	mu    sync.Mutex
+	cache map[string]*dataPlaneCRDs
+}
+
+// dataPlaneCRDs are the cached CRDs of a data plane, keyed by group and kind, or the error listing them
+type dataPlaneCRDs struct {
+	crds      map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition
+	err       error
+	expiresAt time.Time
+}
+
+var _ CRDSource = &DataPlaneCRDs{}
+
+// NewDataPlaneCRDs creates a CRD source that lists the CRDs of data planes through the cluster gateway,
+// caching them for the given duration
+func NewDataPlaneCRDs(clientMgr *kubernetesClient.KubeMultiClientManager, gatewayURL string, ttl time.Duration) *DataPlaneCRDs {
+	return &DataPlaneCRDs{
+		ttl: ttl,
+		now: time.Now,
+		list: func(ctx context.Context, dataPlane *v1alpha1.DataPlane) ([]apiextensionsv1.CustomResourceDefinition, error) {
+			dpClient, err := kubernetesClient.GetK8sClientFromDataPlane(clientMgr, dataPlane, gatewayURL)
+			if err != nil {
+				return nil, err
+			}
+			crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+			if err := dpClient.List(ctx, crdList); err != nil {
+				return nil, err
+			}
+			return crdList.Items, nil
+		},
+		cache: make(map[string]*dataPlaneCRDs),
+	}
+}
+
+// CustomResourceDefinition implements CRDSource
+func (d *DataPlaneCRDs) CustomResourceDefinition(ctx context.Context, dataPlane *v1alpha1.DataPlane,
+	gk schema.GroupKind) (*apiextensionsv1.CustomResourceDefinition, error) {
+	key := dataPlane.Namespace + "/" + dataPlane.Name
+
+	d.mu.Lock()
+	cached, found := d.cache[key]
+	d.mu.Unlock()
+	if found && d.now().Before(cached.expiresAt) {
+		return cached.crds[gk], cached.err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, crdListTimeout)
+	defer cancel()
+	items, err := d.list(ctx, dataPlane)
+	if err != nil {
+		cached = &dataPlaneCRDs{
+			err:       fmt.Errorf("failed to list the CRDs of data plane %s: %w", dataPlane.Name, err),
+			expiresAt: d.now().Add(crdListFailureTTL),
+		}
+	} else {
+		cached = &dataPlaneCRDs{
+			crds:      make(map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition, len(items)),
+			expiresAt: d.now().Add(d.ttl),
+		}
+		for i := range items {
+			crd := &items[i]
+			cached.crds[schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}] = crd
+		}
+	}
+
+	d.mu.Lock()
+	d.cache[key] = cached
+	d.mu.Unlock()
+	return cached.crds[gk], cached.err
+}
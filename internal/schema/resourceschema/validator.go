@@ -0,0 +1,280 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourceschema validates rendered Kubernetes resources against the OpenAPI schemas of their kinds.
+//
+// Built-in kinds are validated against the schemas bundled with client-go, so unknown fields and values of
+// the wrong type are caught without a cluster. Custom resources are validated against the structural schemas
+// of their CustomResourceDefinitions, which a CRDSource looks up, e.g. in the data plane the resources are
+// deployed to.
+package resourceschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/applyconfigurations"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/structured-merge-diff/v4/typed"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// objectMetaType is the name of ObjectMeta in the bundled schemas
+const objectMetaType = "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"
+
+// CRDSource looks up the CustomResourceDefinitions of custom resources
+type CRDSource interface {
+	// CustomResourceDefinition returns the CRD of a group and kind in a data plane,
+	// or nil if the data plane has no such CRD.
+	CustomResourceDefinition(ctx context.Context, dataPlane *v1alpha1.DataPlane,
+		gk schema.GroupKind) (*apiextensionsv1.CustomResourceDefinition, error)
+}
+
+// SchemaNotFoundError is returned when a resource is of a kind that has no known schema
+type SchemaNotFoundError struct {
+	GroupVersionKind schema.GroupVersionKind
+	DataPlane        string
+}
+
+func (e *SchemaNotFoundError) Error() string {
+	return fmt.Sprintf("no schema for %s, %s in data plane %s",
+		e.GroupVersionKind.GroupVersion(), e.GroupVersionKind.Kind, e.DataPlane)
+}
+
+// Validator validates resources against the schemas of their kinds.
+// It is safe for concurrent use.
+type Validator struct {
+	kinds     *runtime.Scheme
+	converter *clienttesting.TypeConverter
+	crds      CRDSource
+
+	mu sync.Mutex
+	// crdSchemas caches the compiled schemas of CRD versions, keyed by CRD UID and version. An entry is
+	// replaced when its CRD is updated.
+	crdSchemas map[string]*cachedCRDSchema
+}
+
+// Option configures a Validator
+type Option func(*Validator)
+
+// WithCRDSource validates custom resources against the CRDs looked up with the given source.
+// Without a source, resources of kinds other than the built-in ones are not validated.
+func WithCRDSource(source CRDSource) Option {
+	return func(v *Validator) {
+		v.crds = source
+	}
+}
+
+// NewValidator creates a validator of resources
+func NewValidator(opts ...Option) *Validator {
+	kinds := runtime.NewScheme()
+	// The client-go scheme only holds the built-in kinds, all of which have a bundled schema
+	_ = clientgoscheme.AddToScheme(kinds)
+
+	v := &Validator{
+		kinds:      kinds,
+		converter:  applyconfigurations.NewTypeConverter(kinds),
+		crdSchemas: make(map[string]*cachedCRDSchema),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Validate validates a resource against the schema of its kind. Custom resources are validated against
+// the CRDs of the given data plane, or not at all if the data plane is nil.
+//
+// The returned list holds the schema violations of the resource. An error is returned if the schema of
+// the resource could not be found or loaded, in which case the resource was not validated.
+func (v *Validator) Validate(ctx context.Context, dataPlane *v1alpha1.DataPlane, resource map[string]any) (field.ErrorList, error) {
+	// Rendered values may be of any Go type, which the schemas only understand in their JSON form
+	normalized, err := toJSONObject(resource)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: normalized}
+	gvk := obj.GroupVersionKind()
+
+	if v.kinds.Recognizes(gvk) {
+		_, err := v.converter.ObjectToTyped(obj)
+		return fieldErrors(nil, err)
+	}
+
+	if v.crds == nil || dataPlane == nil {
+		return nil, nil
+	}
+	crd, err := v.crds.CustomResourceDefinition(ctx, dataPlane, gvk.GroupKind())
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the CRD of %s: %w", gvk.GroupKind(), err)
+	}
+	if crd == nil {
+		return nil, &SchemaNotFoundError{GroupVersionKind: gvk, DataPlane: dataPlane.Name}
+	}
+	s, err := v.crdSchema(crd, gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, &SchemaNotFoundError{GroupVersionKind: gvk, DataPlane: dataPlane.Name}
+	}
+
+	allErrs := s.validate(normalized)
+
+	// CRD schemas leave metadata to the API server, which validates it as ObjectMeta
+	if metadata, ok := normalized["metadata"].(map[string]any); ok {
+		_, err := v.converter.TypeResolver.Type(objectMetaType).FromUnstructured(metadata)
+		metadataErrs, err := fieldErrors(field.NewPath("metadata"), err)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, metadataErrs...)
+	}
+	return allErrs, nil
+}
+
+// ValidateTemplate validates a resource template of a ComponentType against the schema of its kind,
+// before it is rendered. Fields whose value is a single CEL expression, and fields with an expression
+// in their name, are left out, since their rendered values are not known yet. Only built-in kinds are
+// validated, as the data plane of the rendered resources is not known either.
+func (v *Validator) ValidateTemplate(tmpl map[string]any) field.ErrorList {
+	obj := &unstructured.Unstructured{Object: tmpl}
+	gvk := obj.GroupVersionKind()
+	if isExpression(gvk.Kind) || isExpression(obj.GetAPIVersion()) || !v.kinds.Recognizes(gvk) {
+		return nil
+	}
+
+	static, _ := withoutExpressions(tmpl).(map[string]any)
+	_, err := v.converter.ObjectToTyped(&unstructured.Unstructured{Object: static})
+	allErrs, err := fieldErrors(nil, err)
+	if err != nil {
+		// The template cannot be checked statically, its rendered resources are validated instead
+		return nil
+	}
+	return allErrs
+}
+
+// cachedCRDSchema is the compiled schema of a version of a CRD at a resourceVersion
+type cachedCRDSchema struct {
+	resourceVersion string
+	schema          *crdSchema
+}
+
+// crdSchema returns the compiled schema of a version of a CRD, or nil if the version has no schema
+func (v *Validator) crdSchema(crd *apiextensionsv1.CustomResourceDefinition, version string) (*crdSchema, error) {
+	key := fmt.Sprintf("%s/%s", crd.UID, version)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if cached, found := v.crdSchemas[key]; found && cached.resourceVersion == crd.ResourceVersion {
+		return cached.schema, nil
+	}
+
+	s, err := compileCRDSchema(crd, version)
+	if err != nil {
+		return nil, err
+	}
+	v.crdSchemas[key] = &cachedCRDSchema{resourceVersion: crd.ResourceVersion, schema: s}
+	return s, nil
+}
+
+// fieldErrors converts the validation errors of the bundled schemas to field errors under a path.
+// Other errors are returned as they are.
+func fieldErrors(base *field.Path, err error) (field.ErrorList, error) {
+	if err == nil {
+		return nil, nil
+	}
+	validationErrs, ok := err.(typed.ValidationErrors)
+	if !ok {
+		return nil, fmt.Errorf("failed to validate resource: %w", err)
+	}
+
+	allErrs := make(field.ErrorList, 0, len(validationErrs))
+	for _, validationErr := range validationErrs {
+		allErrs = append(allErrs, schemaViolation(joinPath(base, validationErr.Path), validationErr.ErrorMessage))
+	}
+	return allErrs, nil
+}
+
+// schemaViolation reports a field that does not match its schema
+func schemaViolation(path *field.Path, detail string) *field.Error {
+	return field.Invalid(path, field.OmitValueType{}, detail)
+}
+
+// joinPath appends a path of the form .spec.containers[name="app"].image to a field path
+func joinPath(base *field.Path, path string) *field.Path {
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return base
+	}
+	if base == nil {
+		return field.NewPath(path)
+	}
+	return base.Child(path)
+}
+
+// toJSONObject converts a resource to the types of decoded JSON
+func toJSONObject(resource map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource: %w", err)
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to decode resource: %w", err)
+	}
+	return obj, nil
+}
+
+// withoutExpressions copies a template, leaving out values that are a single CEL expression
+// and map entries with an expression in their key
+func withoutExpressions(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			if strings.Contains(key, "${") || isExpression(item) {
+				continue
+			}
+			out[key] = withoutExpressions(item)
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			if isExpression(item) {
+				continue
+			}
+			out = append(out, withoutExpressions(item))
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// isExpression reports whether a value is a string made of a single CEL expression,
+// which renders to a value of any type
+func isExpression(value any) bool {
+	str, ok := value.(string)
+	if !ok {
+		return false
+	}
+	expressions, err := template.FindCELExpressions(str)
+	if err != nil {
+		// Malformed expressions are reported by the CEL validation of the template
+		return true
+	}
+	return len(expressions) == 1 && expressions[0].FullExpr == strings.TrimSpace(str)
+}
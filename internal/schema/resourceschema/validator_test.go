@@ -0,0 +1,339 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceschema
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const widgetCRD = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+  uid: 1234
+  resourceVersion: "1"
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              required: [size]
+              properties:
+                size:
+                  type: integer
+                color:
+                  type: string
+                  enum: [red, blue]
+`
+
+// fakeCRDs is a CRDSource with a fixed set of CRDs
+type fakeCRDs struct {
+	crds    map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition
+	lookups int
+}
+
+func (f *fakeCRDs) CustomResourceDefinition(_ context.Context, _ *v1alpha1.DataPlane,
+	gk schema.GroupKind) (*apiextensionsv1.CustomResourceDefinition, error) {
+	f.lookups++
+	return f.crds[gk], nil
+}
+
+func loadCRD(t *testing.T) *apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal([]byte(widgetCRD), crd); err != nil {
+		t.Fatalf("failed to parse CRD: %v", err)
+	}
+	return crd
+}
+
+func parseResource(t *testing.T, content string) map[string]any {
+	t.Helper()
+	obj := map[string]any{}
+	if err := yaml.Unmarshal([]byte(content), &obj); err != nil {
+		t.Fatalf("failed to parse resource: %v", err)
+	}
+	return obj
+}
+
+func errorStrings(errs field.ErrorList) []string {
+	out := make([]string, 0, len(errs))
+	for _, err := range errs {
+		out = append(out, err.Error())
+	}
+	return out
+}
+
+func TestValidateBuiltInKinds(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name     string
+		resource string
+		want     []string
+	}{
+		{
+			name: "valid deployment",
+			resource: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: greeter
+  labels:
+    app: greeter
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+        - name: app
+          image: greeter:v1
+          resources:
+            limits:
+              cpu: 500m
+              memory: 256Mi
+`,
+		},
+		{
+			name: "misspelled field and wrong type",
+			resource: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: greeter
+spec:
+  replicas: two
+  template:
+    spec:
+      contaienrs: []
+`,
+			want: []string{
+				"spec.replicas: Invalid value: expected numeric (int or float), got string",
+				"spec.template.spec.contaienrs: Invalid value: field not declared in schema",
+			},
+		},
+		{
+			name: "misspelled field in a list entry",
+			resource: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: greeter
+spec:
+  ports:
+    - name: http
+      port: 80
+      targetPrt: 8080
+`,
+			want: []string{`spec.ports[port=80,protocol="TCP"].targetPrt: Invalid value: field not declared in schema`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validator.Validate(context.Background(), nil, parseResource(t, tt.resource))
+			if err != nil {
+				t.Fatalf("Validate() error: %v", err)
+			}
+			got := errorStrings(errs)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateCustomResources(t *testing.T) {
+	crds := &fakeCRDs{crds: map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition{
+		{Group: "example.com", Kind: "Widget"}: loadCRD(t),
+	}}
+	validator := NewValidator(WithCRDSource(crds))
+	dataPlane := &v1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}}
+
+	widget := parseResource(t, `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: greeter
+  annotatons: {}
+spec:
+  color: green
+  shape: round
+`)
+	errs, err := validator.Validate(context.Background(), dataPlane, widget)
+	if err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	got := strings.Join(errorStrings(errs), "\n")
+	for _, want := range []string{
+		`spec.color: Unsupported value: "green": supported values: "red", "blue"`,
+		"spec.size: Required value",
+		"spec.shape: Invalid value: field not declared in schema",
+		"metadata.annotatons: Invalid value: field not declared in schema",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Validate() =\n%s\nwant it to contain %q", got, want)
+		}
+	}
+
+	// The compiled schema is reused
+	if _, err := validator.Validate(context.Background(), dataPlane, widget); err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	if len(validator.crdSchemas) != 1 {
+		t.Errorf("expected 1 compiled CRD schema, got %d", len(validator.crdSchemas))
+	}
+
+	// An updated CRD replaces the schema compiled from its previous version
+	updated := loadCRD(t)
+	updated.ResourceVersion = "2"
+	crds.crds[schema.GroupKind{Group: "example.com", Kind: "Widget"}] = updated
+	if _, err := validator.Validate(context.Background(), dataPlane, widget); err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	if cached := validator.crdSchemas[string(updated.UID)+"/v1"]; len(validator.crdSchemas) != 1 || cached.resourceVersion != "2" {
+		t.Errorf("expected the compiled schema to be replaced, got %d schemas", len(validator.crdSchemas))
+	}
+
+	// Kinds without a CRD in the data plane are not validated
+	gadget := parseResource(t, "apiVersion: example.com/v1\nkind: Gadget\nmetadata:\n  name: greeter\n")
+	_, err = validator.Validate(context.Background(), dataPlane, gadget)
+	var notFound *SchemaNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected a SchemaNotFoundError, got %v", err)
+	}
+
+	// Without a data plane, custom resources are not looked up
+	lookups := crds.lookups
+	errs, err = validator.Validate(context.Background(), nil, widget)
+	if err != nil || len(errs) != 0 || crds.lookups != lookups {
+		t.Errorf("expected the widget not to be validated without a data plane, got %v, %v", errs, err)
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	validator := NewValidator()
+
+	tmpl := parseResource(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ${metadata.name}
+  labels: ${metadata.labels}
+spec:
+  replicas: ${parameters.replicas}
+  selector:
+    matchLabels:
+      ${metadata.podSelectors}: ""
+  template:
+    spec:
+      contaienrs:
+        - name: app
+          image: ${workload.containers["app"].image}
+          args: ["--port=${parameters.port}", "${parameters.extraArg}"]
+          ports: ${parameters.ports}
+`)
+	got := errorStrings(validator.ValidateTemplate(tmpl))
+	want := []string{"spec.template.spec.contaienrs: Invalid value: field not declared in schema"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ValidateTemplate() = %q, want %q", got, want)
+	}
+
+	// Templates of custom resources and of kinds set by expressions are not validated
+	for _, content := range []string{
+		"apiVersion: example.com/v1\nkind: Widget\nspec:\n  unknown: 1\n",
+		"apiVersion: apps/v1\nkind: ${parameters.kind}\nspec:\n  unknown: 1\n",
+	} {
+		if errs := validator.ValidateTemplate(parseResource(t, content)); len(errs) != 0 {
+			t.Errorf("ValidateTemplate() = %v, want no errors", errs)
+		}
+	}
+}
+
+func TestDataPlaneCRDsCaching(t *testing.T) {
+	now := time.Now()
+	lists := 0
+	source := &DataPlaneCRDs{
+		ttl: time.Minute,
+		now: func() time.Time { return now },
+		list: func(context.Context, *v1alpha1.DataPlane) ([]apiextensionsv1.CustomResourceDefinition, error) {
+			lists++
+			return []apiextensionsv1.CustomResourceDefinition{*loadCRD(t)}, nil
+		},
+		cache: make(map[string]*dataPlaneCRDs),
+	}
+	dataPlane := &v1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}}
+	widget := schema.GroupKind{Group: "example.com", Kind: "Widget"}
+	gadget := schema.GroupKind{Group: "example.com", Kind: "Gadget"}
+
+	lookup := func(gk schema.GroupKind) *apiextensionsv1.CustomResourceDefinition {
+		t.Helper()
+		crd, err := source.CustomResourceDefinition(context.Background(), dataPlane, gk)
+		if err != nil {
+			t.Fatalf("CustomResourceDefinition() error: %v", err)
+		}
+		return crd
+	}
+
+	if crd := lookup(widget); crd == nil || crd.Name != "widgets.example.com" {
+		t.Fatalf("expected the widget CRD, got %v", crd)
+	}
+	if crd := lookup(gadget); crd != nil {
+		t.Fatalf("expected no gadget CRD, got %v", crd)
+	}
+	if lists != 1 {
+		t.Errorf("expected the CRDs to be listed once, got %d", lists)
+	}
+
+	now = now.Add(2 * time.Minute)
+	lookup(widget)
+	if lists != 2 {
+		t.Errorf("expected the CRDs to be listed again after the cache expired, got %d lists", lists)
+	}
+
+	// A failed listing is retried once it expires, rather than on every lookup
+	listErr := errors.New("data plane unreachable")
+	source.list = func(context.Context, *v1alpha1.DataPlane) ([]apiextensionsv1.CustomResourceDefinition, error) {
+		lists++
+		return nil, listErr
+	}
+	now = now.Add(2 * time.Minute)
+	for range 2 {
+		if _, err := source.CustomResourceDefinition(context.Background(), dataPlane, widget); !errors.Is(err, listErr) {
+			t.Fatalf("CustomResourceDefinition() error = %v, want %v", err, listErr)
+		}
+	}
+	if lists != 3 {
+		t.Errorf("expected the failed listing to be cached, got %d lists", lists)
+	}
+	now = now.Add(crdListFailureTTL)
+	_, _ = source.CustomResourceDefinition(context.Background(), dataPlane, widget)
+	if lists != 4 {
+		t.Errorf("expected the CRDs to be listed again after the failure expired, got %d lists", lists)
+	}
+}
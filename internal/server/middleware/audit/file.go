@@ -0,0 +1,222 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// DefaultFileMaxSizeBytes is the size at which the audit file is rotated when the sink does not set one
+	DefaultFileMaxSizeBytes = 100 * 1024 * 1024
+	// DefaultFileMaxBackups is the number of rotated audit files kept when the sink does not set one
+	DefaultFileMaxBackups = 10
+)
+
+// FileSinkConfig configures a file sink
+type FileSinkConfig struct {
+	// Path is the file the events are appended to as JSON lines
+	Path string
+	// MaxSizeBytes is the size after which the file is rotated to Path.1, Path.2, ...
+	MaxSizeBytes int64
+	// MaxBackups is the number of rotated files kept, the oldest are removed
+	MaxBackups int
+}
+
+// FileSink appends audit events to a local file as JSON lines and rotates it by size.
+// The current and rotated files can be queried, which makes it suitable for single replica installations.
+type FileSink struct {
+	config FileSinkConfig
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+var _ Store = (*FileSink)(nil)
+
+// NewFileSink opens the audit file for appending, creating it and its directory when missing
+func NewFileSink(config FileSinkConfig) (*FileSink, error) {
+	if config.Path == "" {
+		return nil, errors.New("audit file path is required")
+	}
+	if config.MaxSizeBytes <= 0 {
+		config.MaxSizeBytes = DefaultFileMaxSizeBytes
+	}
+	if config.MaxBackups <= 0 {
+		config.MaxBackups = DefaultFileMaxBackups
+	}
+	s := &FileSink{config: config}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name implements Sink
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+// Write implements Sink
+func (s *FileSink) Write(_ context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size > 0 && s.size+int64(len(line)) > s.config.MaxSizeBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	return nil
+}
+
+// Query implements Store by scanning the current and rotated files. The files are opened under the lock,
+// so that a rotation does not move them in between, and read without it so that writes are not held up.
+func (s *FileSink) Query(_ context.Context, query *Query) (*QueryResult, error) {
+	collector, err := newPageCollector(query)
+	if err != nil {
+		return nil, err
+	}
+	readers, err := s.openFiles()
+	if err != nil {
+		return nil, err
+	}
+	defer closeReaders(readers)
+
+	for _, reader := range readers {
+		if err := reader.scan(collector); err != nil {
+			return nil, err
+		}
+	}
+	return collector.result(), nil
+}
+
+// fileReader reads the events an audit file held when it was opened
+type fileReader struct {
+	file *os.File
+	size int64
+}
+
+// openFiles opens the current file followed by the rotated files that exist
+func (s *FileSink) openFiles() ([]*fileReader, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var readers []*fileReader
+	for i := 0; i <= s.config.MaxBackups; i++ {
+		path := s.config.Path
+		if i > 0 {
+			path = backupPath(s.config.Path, i)
+		}
+		file, err := os.Open(path) // #nosec G304 -- the path comes from the sink configuration
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				break
+			}
+			closeReaders(readers)
+			return nil, fmt.Errorf("failed to open audit file: %w", err)
+		}
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			closeReaders(readers)
+			return nil, fmt.Errorf("failed to stat audit file: %w", err)
+		}
+		readers = append(readers, &fileReader{file: file, size: info.Size()})
+	}
+	return readers, nil
+}
+
+func closeReaders(readers []*fileReader) {
+	for _, reader := range readers {
+		_ = reader.file.Close()
+	}
+}
+
+// scan hands the events of the file to the collector, skipping lines that are not events
+func (r *fileReader) scan(collector *pageCollector) error {
+	scanner := bufio.NewScanner(io.LimitReader(r.file, r.size))
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		event := &Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil || event.EventID == "" {
+			continue
+		}
+		collector.add(event)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read audit file %s: %w", r.file.Name(), err)
+	}
+	return nil
+}
+
+// Close closes the audit file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+func (s *FileSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.config.Path), 0o750); err != nil {
+		return fmt.Errorf("failed to create audit directory: %w", err)
+	}
+	file, err := os.OpenFile(s.config.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// rotate shifts Path.N to Path.N+1, dropping the oldest backup, and starts a new file
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	oldest := backupPath(s.config.Path, s.config.MaxBackups)
+	if err := os.Remove(oldest); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove audit file %s: %w", oldest, err)
+	}
+	for i := s.config.MaxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(s.config.Path, i), backupPath(s.config.Path, i+1)); err != nil &&
+			!errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to rotate audit file: %w", err)
+		}
+	}
+	if err := os.Rename(s.config.Path, backupPath(s.config.Path, 1)); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	return s.open()
+}
+
+func backupPath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
@@ -4,24 +4,172 @@
 package audit
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// Logger handles emitting audit log events using structured logging
+const (
+	// sinkQueueSize is the number of events buffered for each sink before events are dropped
+	sinkQueueSize = 1024
+	// sinkQueueHighWater is the queue length from which a sink is reported as falling behind
+	sinkQueueHighWater = sinkQueueSize * 3 / 4
+	// sinkEnqueueTimeout bounds the time a request waits for room in the queue of a sink that fell behind
+	sinkEnqueueTimeout = 50 * time.Millisecond
+	// sinkWriteTimeout bounds the time a sink takes to persist a single event
+	sinkWriteTimeout = 10 * time.Second
+	// sinkWriteAttempts bounds the writes of an event to a sink that keeps failing
+	sinkWriteAttempts = 3
+	// sinkRetryBackoff is the wait before the first retry of a failed write, doubled for every further retry
+	sinkRetryBackoff = 500 * time.Millisecond
+)
+
+// Logger handles emitting audit log events using structured logging and persisting them to sinks
 type Logger struct {
 	slogger     *slog.Logger
 	serviceName string
+
+	// Each sink persists events in the background from its own queue, so that a slow or unreachable
+	// sink neither delays responses nor holds up the other sinks
+	mu      sync.RWMutex
+	closed  bool
+	workers []*sinkWorker
+	wg      sync.WaitGroup
+}
+
+// sinkWorker writes the queued events to a sink
+type sinkWorker struct {
+	sink    Sink
+	queue   chan *Event
+	dropped atomic.Uint64
 }
 
-// NewLogger creates a new audit logger
-func NewLogger(slogger *slog.Logger, serviceName string) *Logger {
-	return &Logger{
+// NewLogger creates a new audit logger that also persists the events to the given sinks
+func NewLogger(slogger *slog.Logger, serviceName string, sinks ...Sink) *Logger {
+	l := &Logger{
 		slogger:     slogger,
 		serviceName: serviceName,
 	}
+	for _, sink := range sinks {
+		worker := &sinkWorker{sink: sink, queue: make(chan *Event, sinkQueueSize)}
+		l.workers = append(l.workers, worker)
+		l.wg.Add(1)
+		go l.run(worker)
+	}
+	return l
+}
+
+// Close waits until the queued events are written to the sinks and then closes the sinks that hold resources.
+// Events logged afterwards only reach the service log.
+func (l *Logger) Close() {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.closed = true
+	for _, worker := range l.workers {
+		close(worker.queue)
+	}
+	l.mu.Unlock()
+	l.wg.Wait()
+
+	for _, worker := range l.workers {
+		if closer, ok := worker.sink.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				l.slogger.Error("Failed to close audit sink", slog.String("sink", worker.sink.Name()), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Dropped returns the number of events the sinks missed because their queue was full
+func (l *Logger) Dropped() uint64 {
+	var dropped uint64
+	for _, worker := range l.workers {
+		dropped += worker.dropped.Load()
+	}
+	return dropped
+}
+
+// Check returns an error while a sink falls behind, so that the readiness probe takes the server out of
+// rotation before the queue of the sink fills up and events are dropped
+func (l *Logger) Check() error {
+	for _, worker := range l.workers {
+		if queued := len(worker.queue); queued >= sinkQueueHighWater {
+			return fmt.Errorf("audit sink %s is falling behind: %d events queued, %d dropped",
+				worker.sink.Name(), queued, worker.dropped.Load())
+		}
+	}
+	return nil
+}
+
+func (l *Logger) run(worker *sinkWorker) {
+	defer l.wg.Done()
+	for event := range worker.queue {
+		if err := l.write(worker, event); err != nil {
+			l.slogger.Error("Failed to write audit event to sink",
+				slog.String("sink", worker.sink.Name()),
+				slog.String("event_id", event.EventID),
+				slog.Int("attempts", sinkWriteAttempts),
+				slog.Any("error", err))
+		}
+	}
+}
+
+// write persists the event to the sink, retrying a failed write with exponential backoff
+func (l *Logger) write(worker *sinkWorker, event *Event) error {
+	backoff := sinkRetryBackoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), sinkWriteTimeout)
+		err := worker.sink.Write(ctx, event)
+		cancel()
+		if err == nil || attempt == sinkWriteAttempts {
+			return err
+		}
+		l.slogger.Warn("Retrying audit event write",
+			slog.String("sink", worker.sink.Name()),
+			slog.String("event_id", event.EventID),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err))
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// enqueue hands the event to the sinks. A request waits up to sinkEnqueueTimeout for room in a full queue,
+// after which the sink misses the event, which is still in the service log, and the drop is counted.
+func (l *Logger) enqueue(event *Event) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		return
+	}
+	for _, worker := range l.workers {
+		select {
+		case worker.queue <- event:
+			continue
+		default:
+		}
+
+		timer := time.NewTimer(sinkEnqueueTimeout)
+		select {
+		case worker.queue <- event:
+		case <-timer.C:
+			dropped := worker.dropped.Add(1)
+			l.slogger.Error("Dropped audit event, the sink queue is full",
+				slog.String("sink", worker.sink.Name()),
+				slog.String("event_id", event.EventID),
+				slog.Uint64("dropped_total", dropped))
+		}
+		timer.Stop()
+	}
 }
 
 // LogEvent emits an audit log event using slog
@@ -102,4 +250,6 @@ func (l *Logger) LogEvent(event *Event) {
 
 	// Emit the audit log
 	l.slogger.Info("AUDIT-LOG", attrs...)
+
+	l.enqueue(event)
 }
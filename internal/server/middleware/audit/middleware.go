@@ -72,7 +72,7 @@ func (m *Middleware) Handler(next http.Handler) http.Handler {
 		}
 
 		// Extract actor from authentication context
-		actor := m.extractActor(r)
+		actor := ActorFromContext(r.Context())
 
 		// Get request ID from logger context
 		requestID := getRequestID(r)
@@ -106,10 +106,10 @@ func (m *Middleware) Handler(next http.Handler) http.Handler {
 	})
 }
 
-// extractActor extracts actor information from the authentication context
-func (m *Middleware) extractActor(r *http.Request) Actor {
+// ActorFromContext extracts actor information from the authentication context
+func ActorFromContext(ctx context.Context) Actor {
 	// Try to get subject context from authentication middleware
-	subjectCtx, ok := auth.GetSubjectContextFromContext(r.Context())
+	subjectCtx, ok := auth.GetSubjectContextFromContext(ctx)
 	if !ok || subjectCtx == nil {
 		return Actor{
 			Type: "anonymous",
@@ -140,8 +140,13 @@ func (m *Middleware) extractActor(r *http.Request) Actor {
 
 // getRequestID extracts or generates the request ID
 func getRequestID(r *http.Request) string {
+	return RequestIDFromHeader(r.Header)
+}
+
+// RequestIDFromHeader returns the X-Request-ID header, or a new ID when the header is not set
+func RequestIDFromHeader(header http.Header) string {
 	// Try to get it from X-Request-ID header (set by logger middleware)
-	requestID := r.Header.Get("X-Request-ID")
+	requestID := header.Get("X-Request-ID")
 	if requestID == "" {
 		// Generate a new UUID v7 for request correlation
 		if id, err := uuid.NewV7(); err == nil {
@@ -156,8 +161,13 @@ func getRequestID(r *http.Request) string {
 
 // getSourceIP extracts the client IP address from the request
 func getSourceIP(r *http.Request) string {
+	return SourceIPFromHeader(r.Header, r.RemoteAddr)
+}
+
+// SourceIPFromHeader returns the client IP address from the proxy headers, falling back to remoteAddr
+func SourceIPFromHeader(header http.Header, remoteAddr string) string {
 	// Check X-Forwarded-For header first (proxy/load balancer)
-	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+	if xff := header.Get("X-Forwarded-For"); xff != "" {
 		// Take the first IP in the list
 		if idx := strings.Index(xff, ","); idx != -1 {
 			return strings.TrimSpace(xff[:idx])
@@ -166,12 +176,12 @@ func getSourceIP(r *http.Request) string {
 	}
 
 	// Check X-Real-IP header
-	if xri := r.Header.Get("X-Real-IP"); xri != "" {
+	if xri := header.Get("X-Real-IP"); xri != "" {
 		return xri
 	}
 
 	// Fall back to RemoteAddr
-	return r.RemoteAddr
+	return remoteAddr
 }
 
 // determineResult maps HTTP status code to audit result
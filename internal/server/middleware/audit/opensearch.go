@@ -0,0 +1,184 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+// DefaultOpenSearchIndex is the index the events are written to when the sink does not set one
+const DefaultOpenSearchIndex = "openchoreo-audit"
+
+// openSearchIndexBody maps the fields the queries filter on as keywords. The actor entitlements and the
+// metadata are kept in the documents but not indexed, since their values differ in type between actions.
+var openSearchIndexBody = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"properties": map[string]interface{}{
+			"event_id":  map[string]interface{}{"type": "keyword"},
+			"timestamp": map[string]interface{}{"type": "date_nanos"},
+			"actor": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"type":         map[string]interface{}{"type": "keyword"},
+					"id":           map[string]interface{}{"type": "keyword"},
+					"entitlements": map[string]interface{}{"type": "object", "enabled": false},
+				},
+			},
+			"action":   map[string]interface{}{"type": "keyword"},
+			"category": map[string]interface{}{"type": "keyword"},
+			"resource": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{"type": "keyword"},
+					"id":   map[string]interface{}{"type": "keyword"},
+					"name": map[string]interface{}{"type": "keyword"},
+				},
+			},
+			"result":     map[string]interface{}{"type": "keyword"},
+			"request_id": map[string]interface{}{"type": "keyword"},
+			"source_ip":  map[string]interface{}{"type": "keyword"},
+			"service":    map[string]interface{}{"type": "keyword"},
+			"metadata":   map[string]interface{}{"type": "object", "enabled": false},
+		},
+	},
+}
+
+// OpenSearchSink indexes audit events in OpenSearch through the observer's client and queries them back.
+// It suits installations that already run the observability plane and need the trail shared across replicas.
+type OpenSearchSink struct {
+	client *opensearch.Client
+	index  string
+}
+
+var _ Store = (*OpenSearchSink)(nil)
+
+// NewOpenSearchSink creates the audit index when it is missing
+func NewOpenSearchSink(ctx context.Context, client *opensearch.Client, index string) (*OpenSearchSink, error) {
+	if client == nil {
+		return nil, errors.New("OpenSearch client is required")
+	}
+	if index == "" {
+		index = DefaultOpenSearchIndex
+	}
+	if err := client.EnsureIndex(ctx, index, openSearchIndexBody); err != nil {
+		return nil, fmt.Errorf("failed to prepare audit index %s: %w", index, err)
+	}
+	return &OpenSearchSink{client: client, index: index}, nil
+}
+
+// Name implements Sink
+func (s *OpenSearchSink) Name() string {
+	return "opensearch"
+}
+
+// Write implements Sink. The event ID is the document ID, so retried writes do not duplicate events.
+func (s *OpenSearchSink) Write(ctx context.Context, event *Event) error {
+	return s.client.WriteDocument(ctx, s.index, event.EventID, event)
+}
+
+// Query implements Store
+func (s *OpenSearchSink) Query(ctx context.Context, query *Query) (*QueryResult, error) {
+	body, err := buildOpenSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.client.Search(ctx, []string{s.index}, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit events: %w", err)
+	}
+
+	limit := query.EffectiveLimit()
+	events := make([]*Event, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		data, err := json.Marshal(hit.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit event %s: %w", hit.ID, err)
+		}
+		event := &Event{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("failed to read audit event %s: %w", hit.ID, err)
+		}
+		events = append(events, event)
+	}
+
+	// One more event than the limit is fetched to tell whether another page exists
+	result := &QueryResult{Events: events}
+	if len(events) > limit {
+		result.Events = events[:limit]
+		result.Continue = encodeCursor(events[limit-1])
+	}
+	return result, nil
+}
+
+// buildOpenSearchQuery translates a query into a search body ordered newest first
+func buildOpenSearchQuery(query *Query) (map[string]interface{}, error) {
+	position, err := decodeCursor(query.Continue)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []map[string]interface{}{}
+	terms := []struct{ field, value string }{
+		{"actor.id", query.Actor},
+		{"action", query.Action},
+		{"resource.type", query.ResourceType},
+		{"result", string(query.Result)},
+	}
+	for _, term := range terms {
+		if term.value != "" {
+			filters = append(filters, map[string]interface{}{"term": map[string]interface{}{term.field: term.value}})
+		}
+	}
+	if query.ResourceName != "" {
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"term": map[string]interface{}{"resource.name": query.ResourceName}},
+					{"term": map[string]interface{}{"resource.id": query.ResourceName}},
+				},
+				"minimum_should_match": 1,
+			},
+		})
+	}
+	if !query.Since.IsZero() || !query.Until.IsZero() {
+		timeRange := map[string]interface{}{}
+		if !query.Since.IsZero() {
+			timeRange["gte"] = query.Since.UTC().Format(time.RFC3339Nano)
+		}
+		if !query.Until.IsZero() {
+			timeRange["lt"] = query.Until.UTC().Format(time.RFC3339Nano)
+		}
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{"timestamp": timeRange}})
+	}
+	if position != nil {
+		timestamp := position.timestamp.UTC().Format(time.RFC3339Nano)
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"range": map[string]interface{}{"timestamp": map[string]interface{}{"lt": timestamp}}},
+					{"bool": map[string]interface{}{
+						"filter": []map[string]interface{}{
+							{"term": map[string]interface{}{"timestamp": timestamp}},
+							{"range": map[string]interface{}{"event_id": map[string]interface{}{"lt": position.eventID}}},
+						},
+					}},
+				},
+				"minimum_should_match": 1,
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"size":  query.EffectiveLimit() + 1,
+		"query": map[string]interface{}{"bool": map[string]interface{}{"filter": filters}},
+		"sort": []map[string]interface{}{
+			{"timestamp": map[string]interface{}{"order": "desc"}},
+			{"event_id": map[string]interface{}{"order": "desc"}},
+		},
+	}, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"container/heap"
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sink persists audit events beyond the service log
+type Sink interface {
+	// Name identifies the sink in error logs
+	Name() string
+	// Write persists a single event
+	Write(ctx context.Context, event *Event) error
+}
+
+// Store is a sink that can be queried for the events it persisted
+type Store interface {
+	Sink
+	// Query returns the events matching the query, newest first
+	Query(ctx context.Context, query *Query) (*QueryResult, error)
+}
+
+// ErrInvalidContinueToken is returned when a query carries a continue token that was not issued by a store
+var ErrInvalidContinueToken = errors.New("invalid audit continue token")
+
+const (
+	// DefaultQueryLimit is the number of events a query returns when it does not set a limit
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the largest number of events a single query returns
+	MaxQueryLimit = 1000
+)
+
+// Query selects audit events. Empty fields match every event.
+type Query struct {
+	// Actor matches the actor ID
+	Actor string
+	// Action matches the semantic action name, e.g. "promote_component"
+	Action string
+	// ResourceType matches the type of the target resource
+	ResourceType string
+	// ResourceName matches the name or ID of the target resource
+	ResourceName string
+	// Result matches the outcome of the action
+	Result Result
+	// Since and Until bound the event timestamp, inclusive of Since and exclusive of Until
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of events to return
+	Limit int
+	// Continue is the token of the previous page
+	Continue string
+}
+
+// QueryResult is a page of audit events
+type QueryResult struct {
+	Events []*Event
+	// Continue is the token of the next page, empty on the last page
+	Continue string
+}
+
+// Matches reports whether the event satisfies the filters of the query
+func (q *Query) Matches(event *Event) bool {
+	if q.Actor != "" && event.Actor.ID != q.Actor {
+		return false
+	}
+	if q.Action != "" && event.Action != q.Action {
+		return false
+	}
+	if q.ResourceType != "" && (event.Resource == nil || event.Resource.Type != q.ResourceType) {
+		return false
+	}
+	if q.ResourceName != "" &&
+		(event.Resource == nil || (event.Resource.Name != q.ResourceName && event.Resource.ID != q.ResourceName)) {
+		return false
+	}
+	if q.Result != "" && event.Result != q.Result {
+		return false
+	}
+	if !q.Since.IsZero() && event.Timestamp.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !event.Timestamp.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// EffectiveLimit returns the limit of the query within the default and maximum page sizes
+func (q *Query) EffectiveLimit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultQueryLimit
+	case q.Limit > MaxQueryLimit:
+		return MaxQueryLimit
+	}
+	return q.Limit
+}
+
+// cursor is the position of the last event of a page in the newest-first order of events
+type cursor struct {
+	timestamp time.Time
+	eventID   string
+}
+
+func encodeCursor(event *Event) string {
+	raw := event.Timestamp.UTC().Format(time.RFC3339Nano) + "|" + event.EventID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(token string) (*cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidContinueToken
+	}
+	timestamp, eventID, ok := strings.Cut(string(raw), "|")
+	if !ok || eventID == "" {
+		return nil, ErrInvalidContinueToken
+	}
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return nil, ErrInvalidContinueToken
+	}
+	return &cursor{timestamp: t, eventID: eventID}, nil
+}
+
+// after reports whether the event comes after the cursor in the newest-first order
+func (c *cursor) after(event *Event) bool {
+	if !event.Timestamp.Equal(c.timestamp) {
+		return event.Timestamp.Before(c.timestamp)
+	}
+	return event.EventID < c.eventID
+}
+
+// newer reports whether a comes before b in the newest-first order of events
+func newer(a, b *Event) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	return a.EventID > b.EventID
+}
+
+// pageCollector keeps the events of the page a query asks for, plus one to tell whether another page follows,
+// so that a store scanning its events holds a single page in memory
+type pageCollector struct {
+	query    *Query
+	position *cursor
+	limit    int
+	// events is a heap with the oldest kept event on top
+	events []*Event
+}
+
+func newPageCollector(query *Query) (*pageCollector, error) {
+	position, err := decodeCursor(query.Continue)
+	if err != nil {
+		return nil, err
+	}
+	limit := query.EffectiveLimit()
+	return &pageCollector{
+		query:    query,
+		position: position,
+		limit:    limit,
+		events:   make([]*Event, 0, limit+1),
+	}, nil
+}
+
+func (c *pageCollector) Len() int           { return len(c.events) }
+func (c *pageCollector) Less(i, j int) bool { return newer(c.events[j], c.events[i]) }
+func (c *pageCollector) Swap(i, j int)      { c.events[i], c.events[j] = c.events[j], c.events[i] }
+func (c *pageCollector) Push(x any)         { c.events = append(c.events, x.(*Event)) }
+func (c *pageCollector) Pop() any {
+	last := c.events[len(c.events)-1]
+	c.events = c.events[:len(c.events)-1]
+	return last
+}
+
+// add keeps the event when it matches the query and belongs to the page
+func (c *pageCollector) add(event *Event) {
+	if !c.query.Matches(event) || (c.position != nil && !c.position.after(event)) {
+		return
+	}
+	heap.Push(c, event)
+	if len(c.events) > c.limit+1 {
+		heap.Pop(c)
+	}
+}
+
+// result returns the collected page newest first
+func (c *pageCollector) result() *QueryResult {
+	page := c.events
+	sort.Slice(page, func(i, j int) bool { return newer(page[i], page[j]) })
+	if len(page) > c.limit {
+		page = page[:c.limit]
+		return &QueryResult{Events: page, Continue: encodeCursor(page[len(page)-1])}
+	}
+	return &QueryResult{Events: page}
+}
+
+// paginate returns the page of the matching events the query asks for
+func paginate(events []*Event, query *Query) (*QueryResult, error) {
+	collector, err := newPageCollector(query)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		collector.add(event)
+	}
+	return collector.result(), nil
+}
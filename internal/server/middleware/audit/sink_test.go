@@ -0,0 +1,476 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testEventTime = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestEvent(id string, offset time.Duration, actor, action string, result Result) *Event {
+	return &Event{
+		EventID:   id,
+		Timestamp: testEventTime.Add(offset),
+		Actor:     Actor{Type: "user", ID: actor},
+		Action:    action,
+		Category:  CategoryResource,
+		Resource:  &Resource{Type: "component", Name: "greeter"},
+		Result:    result,
+		Service:   "openchoreo-api",
+	}
+}
+
+func eventIDs(events []*Event) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.EventID)
+	}
+	return ids
+}
+
+func TestQuery_Matches(t *testing.T) {
+	event := newTestEvent("e1", 0, "alice", "promote_component", ResultSuccess)
+	event.Resource.ID = "greeter-id"
+
+	tests := []struct {
+		name  string
+		query Query
+		want  bool
+	}{
+		{"empty query", Query{}, true},
+		{"matching actor", Query{Actor: "alice"}, true},
+		{"other actor", Query{Actor: "bob"}, false},
+		{"other action", Query{Action: "delete_component"}, false},
+		{"matching resource name", Query{ResourceType: "component", ResourceName: "greeter"}, true},
+		{"matching resource id", Query{ResourceName: "greeter-id"}, true},
+		{"other resource type", Query{ResourceType: "project"}, false},
+		{"other result", Query{Result: ResultDenied}, false},
+		{"since is inclusive", Query{Since: testEventTime}, true},
+		{"until is exclusive", Query{Until: testEventTime}, false},
+		{"within range", Query{Since: testEventTime.Add(-time.Hour), Until: testEventTime.Add(time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Matches(event); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_MatchesEventWithoutResource(t *testing.T) {
+	event := newTestEvent("e1", 0, "alice", "login", ResultSuccess)
+	event.Resource = nil
+
+	if (&Query{ResourceName: "greeter"}).Matches(event) {
+		t.Error("expected an event without a resource not to match a resource filter")
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	events := []*Event{
+		newTestEvent("a", 0, "alice", "create_component", ResultSuccess),
+		newTestEvent("c", time.Minute, "alice", "create_component", ResultSuccess),
+		newTestEvent("b", time.Minute, "alice", "create_component", ResultSuccess),
+		newTestEvent("d", 2*time.Minute, "alice", "create_component", ResultSuccess),
+		newTestEvent("e", -time.Minute, "alice", "create_component", ResultSuccess),
+	}
+
+	var pages [][]string
+	query := &Query{Limit: 2}
+	for {
+		result, err := paginate(events, query)
+		if err != nil {
+			t.Fatalf("paginate() error = %v", err)
+		}
+		pages = append(pages, eventIDs(result.Events))
+		if result.Continue == "" {
+			break
+		}
+		query = &Query{Limit: 2, Continue: result.Continue}
+	}
+
+	want := fmt.Sprint([][]string{{"d", "c"}, {"b", "a"}, {"e"}})
+	if got := fmt.Sprint(pages); got != want {
+		t.Errorf("pages = %s, want %s", got, want)
+	}
+}
+
+func TestPaginate_InvalidContinueToken(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "YmFkfGlk"} {
+		if _, err := paginate(nil, &Query{Continue: token}); !errors.Is(err, ErrInvalidContinueToken) {
+			t.Errorf("paginate(%q) error = %v, want ErrInvalidContinueToken", token, err)
+		}
+	}
+}
+
+func TestFileSink_WriteAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "events.log")
+	sink, err := NewFileSink(FileSinkConfig{Path: path})
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	ctx := context.Background()
+	writes := []*Event{
+		newTestEvent("e1", 0, "alice", "create_component", ResultSuccess),
+		newTestEvent("e2", time.Minute, "bob", "promote_component", ResultDenied),
+		newTestEvent("e3", 2*time.Minute, "alice", "promote_component", ResultSuccess),
+	}
+	for _, event := range writes {
+		if err := sink.Write(ctx, event); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{"all events newest first", Query{}, []string{"e3", "e2", "e1"}},
+		{"by actor", Query{Actor: "alice"}, []string{"e3", "e1"}},
+		{"by action and result", Query{Action: "promote_component", Result: ResultDenied}, []string{"e2"}},
+		{"by time range", Query{Since: testEventTime.Add(time.Minute), Until: testEventTime.Add(2 * time.Minute)},
+			[]string{"e2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sink.Query(ctx, &tt.query)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if got := eventIDs(result.Events); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileSink_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	line, err := json.Marshal(newTestEvent("e0", 0, "alice", "create_component", ResultSuccess))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each file holds two events, so five writes leave the current file and two full backups
+	// of which only the newest is kept.
+	sink, err := NewFileSink(FileSinkConfig{Path: path, MaxSizeBytes: int64(2 * (len(line) + 1)), MaxBackups: 1})
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	ctx := context.Background()
+	for i := range 5 {
+		event := newTestEvent(fmt.Sprintf("e%d", i), time.Duration(i)*time.Minute, "alice", "create_component",
+			ResultSuccess)
+		if err := sink.Write(ctx, event); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Errorf("expected rotated file: %v", err)
+	}
+	if _, err := os.Stat(path + ".2"); !os.IsNotExist(err) {
+		t.Errorf("expected only one backup to be kept, stat error = %v", err)
+	}
+
+	result, err := sink.Query(ctx, &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if got, want := fmt.Sprint(eventIDs(result.Events)), fmt.Sprint([]string{"e4", "e3", "e2"}); got != want {
+		t.Errorf("Query() = %s, want %s", got, want)
+	}
+}
+
+func TestFileSink_SkipsInvalidLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	if err := os.WriteFile(path, []byte("not json\n{}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewFileSink(FileSinkConfig{Path: path})
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	ctx := context.Background()
+	if err := sink.Write(ctx, newTestEvent("e1", 0, "alice", "create_component", ResultSuccess)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	result, err := sink.Query(ctx, &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if got := eventIDs(result.Events); len(got) != 1 || got[0] != "e1" {
+		t.Errorf("Query() = %v, want [e1]", got)
+	}
+}
+
+func TestWebhookSink_Write(t *testing.T) {
+	var received Event
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(WebhookSinkConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatalf("NewWebhookSink() error = %v", err)
+	}
+	if err := sink.Write(context.Background(), newTestEvent("e1", 0, "alice", "create_component",
+		ResultSuccess)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if received.EventID != "e1" || received.Actor.ID != "alice" {
+		t.Errorf("received event %+v, want e1 by alice", received)
+	}
+	if authorization != "Bearer token" {
+		t.Errorf("Authorization header = %q, want configured header", authorization)
+	}
+}
+
+func TestWebhookSink_WriteFailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(WebhookSinkConfig{URL: server.URL})
+	if err != nil {
+		t.Fatalf("NewWebhookSink() error = %v", err)
+	}
+	if err := sink.Write(context.Background(), newTestEvent("e1", 0, "alice", "create_component",
+		ResultSuccess)); err == nil {
+		t.Error("expected an error for a 503 response")
+	}
+}
+
+func TestBuildOpenSearchQuery(t *testing.T) {
+	query := &Query{
+		Actor:        "alice",
+		ResourceName: "greeter",
+		Result:       ResultDenied,
+		Since:        testEventTime,
+		Limit:        10,
+		Continue:     encodeCursor(newTestEvent("e5", 0, "alice", "create_component", ResultSuccess)),
+	}
+	body, err := buildOpenSearchQuery(query)
+	if err != nil {
+		t.Fatalf("buildOpenSearchQuery() error = %v", err)
+	}
+
+	if body["size"] != 11 {
+		t.Errorf("size = %v, want one more than the limit", body["size"])
+	}
+	filters := body["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]map[string]interface{})
+	// actor, result, resource name, time range and cursor
+	if len(filters) != 5 {
+		t.Fatalf("len(filters) = %d, want 5: %v", len(filters), filters)
+	}
+	data, err := json.Marshal(filters[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"term":{"actor.id":"alice"}}` {
+		t.Errorf("first filter = %s, want the actor term", data)
+	}
+
+	if _, err := buildOpenSearchQuery(&Query{Continue: "%%%"}); !errors.Is(err, ErrInvalidContinueToken) {
+		t.Errorf("expected ErrInvalidContinueToken, got %v", err)
+	}
+}
+
+// recordingSink collects the events written to it
+type recordingSink struct {
+	mu     sync.Mutex
+	events []*Event
+	closed bool
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Write(_ context.Context, event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *recordingSink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestLogger_WritesEventsToSinks(t *testing.T) {
+	sink := &recordingSink{}
+	logger := NewLogger(slog.New(slog.NewTextHandler(io.Discard, nil)), "openchoreo-api", sink)
+
+	for range 3 {
+		logger.LogEvent(newTestEvent("", 0, "alice", "create_component", ResultSuccess))
+	}
+	logger.Close()
+
+	if len(sink.events) != 3 {
+		t.Errorf("sink received %d events, want 3", len(sink.events))
+	}
+	if !sink.closed {
+		t.Error("expected the sink to be closed with the logger")
+	}
+}
+
+// blockedSink holds every write until it is released
+type blockedSink struct {
+	release chan struct{}
+}
+
+func (s *blockedSink) Name() string { return "blocked" }
+
+func (s *blockedSink) Write(ctx context.Context, _ *Event) error {
+	select {
+	case <-s.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestLogger_DropsEventsWhenASinkIsStuck(t *testing.T) {
+	stuck := &blockedSink{release: make(chan struct{})}
+	healthy := &recordingSink{}
+	logger := NewLogger(slog.New(slog.NewTextHandler(io.Discard, nil)), "openchoreo-api", stuck, healthy)
+
+	// One event is held by the stuck sink's write and the queue holds sinkQueueSize more
+	const events = sinkQueueSize + 11
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range events {
+			logger.LogEvent(newTestEvent("", 0, "alice", "create_component", ResultSuccess))
+			// The healthy sink keeps up, so that only the stuck sink's queue fills
+			for healthy.count() <= i {
+				runtime.Gosched()
+			}
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("LogEvent blocked on a stuck sink")
+	}
+	if err := logger.Check(); err == nil {
+		t.Error("Check() = nil, want an error while the stuck sink falls behind")
+	}
+
+	close(stuck.release)
+	logger.Close()
+
+	if len(healthy.events) != events {
+		t.Errorf("healthy sink received %d events, want %d", len(healthy.events), events)
+	}
+	if dropped := logger.Dropped(); dropped < 10 || dropped > 11 {
+		t.Errorf("Dropped() = %d, want the events beyond the stuck sink's queue", dropped)
+	}
+}
+
+// flakySink fails the first writes and then records the events
+type flakySink struct {
+	recordingSink
+	failures int
+	attempts int
+}
+
+func (s *flakySink) Write(ctx context.Context, event *Event) error {
+	s.attempts++
+	if s.attempts <= s.failures {
+		return errors.New("sink unavailable")
+	}
+	return s.recordingSink.Write(ctx, event)
+}
+
+func TestLogger_RetriesFailedWrites(t *testing.T) {
+	sink := &flakySink{failures: sinkWriteAttempts - 1}
+	logger := NewLogger(slog.New(slog.NewTextHandler(io.Discard, nil)), "openchoreo-api", sink)
+
+	logger.LogEvent(newTestEvent("", 0, "alice", "create_component", ResultSuccess))
+	if err := logger.Check(); err != nil {
+		t.Errorf("Check() error = %v, want nil", err)
+	}
+	logger.Close()
+
+	if sink.attempts != sinkWriteAttempts {
+		t.Errorf("sink was written %d times, want %d", sink.attempts, sinkWriteAttempts)
+	}
+	if len(sink.events) != 1 {
+		t.Errorf("sink received %d events, want 1", len(sink.events))
+	}
+}
+
+func TestFileSink_QueryKeepsOnePage(t *testing.T) {
+	sink, err := NewFileSink(FileSinkConfig{Path: filepath.Join(t.TempDir(), "events.log")})
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	for i := range 50 {
+		event := newTestEvent(fmt.Sprintf("e%02d", i), time.Duration(i)*time.Second, "alice", "create_component", ResultSuccess)
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	var pages [][]string
+	query := &Query{Limit: 20}
+	for {
+		result, err := sink.Query(context.Background(), query)
+		if err != nil {
+			t.Fatalf("Query() error = %v", err)
+		}
+		if len(result.Events) > 20 {
+			t.Fatalf("Query() returned %d events, want at most 20", len(result.Events))
+		}
+		pages = append(pages, eventIDs(result.Events))
+		if result.Continue == "" {
+			break
+		}
+		query = &Query{Limit: 20, Continue: result.Continue}
+	}
+
+	if len(pages) != 3 || len(pages[2]) != 10 || pages[0][0] != "e49" || pages[2][9] != "e00" {
+		t.Errorf("unexpected pages %v", pages)
+	}
+}
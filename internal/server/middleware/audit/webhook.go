@@ -0,0 +1,83 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultWebhookTimeout bounds a single webhook delivery when the sink does not set a timeout
+const defaultWebhookTimeout = 5 * time.Second
+
+// WebhookSinkConfig configures a webhook sink
+type WebhookSinkConfig struct {
+	// URL receives each event as a JSON POST request
+	URL string
+	// Headers are added to every request, e.g. an Authorization header expected by the receiver
+	Headers map[string]string
+	// Timeout bounds a single delivery
+	Timeout time.Duration
+}
+
+// WebhookSink forwards audit events to an HTTP endpoint such as a SIEM collector
+type WebhookSink struct {
+	config WebhookSinkConfig
+	client *http.Client
+}
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink creates a webhook sink
+func NewWebhookSink(config WebhookSinkConfig) (*WebhookSink, error) {
+	if config.URL == "" {
+		return nil, errors.New("audit webhook URL is required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultWebhookTimeout
+	}
+	return &WebhookSink{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Name implements Sink
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Write implements Sink
+func (s *WebhookSink) Write(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("audit webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import "context"
+
+// contextKey is a custom type for context keys to avoid collisions
+type contextKey string
+
+// tokenIDContextKey is the key used to store the ID of the authenticating token in the request context
+const tokenIDContextKey contextKey = "api_token_id"
+
+// GetTokenIDFromContext returns the ID of the API token that authenticated the request, if any
+func GetTokenIDFromContext(ctx context.Context) (string, bool) {
+	tokenID, ok := ctx.Value(tokenIDContextKey).(string)
+	return tokenID, ok && tokenID != ""
+}
+
+// SetTokenID stores the ID of the authenticating API token in the context
+func SetTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDContextKey, tokenID)
+}
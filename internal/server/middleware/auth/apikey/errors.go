@@ -0,0 +1,36 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// API token authentication errors
+var (
+	ErrInvalidToken = errors.New("invalid, expired or revoked API token")
+	ErrTokenExpired = errors.New("API token has expired")
+	ErrTokenRevoked = errors.New("API token has been revoked")
+)
+
+// CodeInvalidToken is the error code of rejected API tokens, shared with the JWT middleware
+const CodeInvalidToken = "INVALID_TOKEN"
+
+// errorResponse represents the structure of an error response
+type errorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// writeErrorResponse writes a JSON error response
+func writeErrorResponse(w http.ResponseWriter, statusCode int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(errorResponse{
+		Error:   code,
+		Message: message,
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// Principal is the result of authenticating an API token
+type Principal struct {
+	// TokenID identifies the token, so that it can be told apart from other tokens of the same subject
+	TokenID string
+	// Subject is the authz subject the token acts as
+	Subject *auth.SubjectContext
+}
+
+// Authenticator validates API tokens
+type Authenticator interface {
+	// Authenticate returns the principal of a token, or an error when the token is unknown, expired or revoked
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// Config holds the configuration for the API token middleware
+type Config struct {
+	// Authenticator validates the tokens. When nil, API tokens are rejected.
+	Authenticator Authenticator
+
+	// Logger is an optional slog logger for logging authentication events
+	Logger *slog.Logger
+}
+
+// Middleware authenticates requests that carry an API token as a bearer token. Every other request is
+// passed to the fallback middleware, which is usually the JWT middleware.
+func Middleware(config Config, fallback func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return func(next http.Handler) http.Handler {
+		fallbackHandler := fallback(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok || !IsAPIToken(token) {
+				fallbackHandler.ServeHTTP(w, r)
+				return
+			}
+
+			if config.Authenticator == nil {
+				config.Logger.Debug("API token presented but API tokens are not enabled",
+					"path", r.URL.Path,
+					"method", r.Method,
+				)
+				writeErrorResponse(w, http.StatusUnauthorized, ErrInvalidToken.Error(), CodeInvalidToken)
+				return
+			}
+
+			principal, err := config.Authenticator.Authenticate(r.Context(), token)
+			if err != nil {
+				config.Logger.Debug("API token validation failed",
+					"error", err,
+					"path", r.URL.Path,
+					"method", r.Method,
+				)
+				writeErrorResponse(w, http.StatusUnauthorized, ErrInvalidToken.Error(), CodeInvalidToken)
+				return
+			}
+
+			ctx := auth.SetSubjectContext(r.Context(), principal.Subject)
+			ctx = SetTokenID(ctx, principal.TokenID)
+
+			config.Logger.Debug("API token authentication successful",
+				"path", r.URL.Path,
+				"method", r.Method,
+				"subject", principal.Subject.ID,
+				"subject_type", principal.Subject.Type,
+				"token_id", principal.TokenID,
+			)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// bearerToken extracts the token of a Bearer Authorization header (case-insensitive scheme)
+func bearerToken(r *http.Request) (string, bool) {
+	const bearerPrefix = "bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return header[len(bearerPrefix):], true
+}
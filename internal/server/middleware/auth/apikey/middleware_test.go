@@ -0,0 +1,145 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+type staticAuthenticator struct {
+	token     string
+	principal *Principal
+}
+
+func (a *staticAuthenticator) Authenticate(_ context.Context, token string) (*Principal, error) {
+	if token != a.token {
+		return nil, ErrInvalidToken
+	}
+	return a.principal, nil
+}
+
+func TestGenerate(t *testing.T) {
+	personal, err := Generate(KindPersonal)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(personal, PersonalTokenPrefix) || !IsAPIToken(personal) {
+		t.Errorf("Generate(personal) = %q, want prefix %q", personal, PersonalTokenPrefix)
+	}
+
+	service, err := Generate(KindService)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(service, ServiceKeyPrefix) || !IsAPIToken(service) {
+		t.Errorf("Generate(service) = %q, want prefix %q", service, ServiceKeyPrefix)
+	}
+
+	if _, err := Generate("other"); err == nil {
+		t.Error("Generate() with an unknown kind should fail")
+	}
+	if Hash(personal) == Hash(service) {
+		t.Error("different tokens should have different hashes")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	const validToken = PersonalTokenPrefix + "valid"
+	authenticator := &staticAuthenticator{
+		token: validToken,
+		principal: &Principal{
+			TokenID: "tok-1",
+			Subject: &auth.SubjectContext{ID: "alice", Type: "user", Scopes: []string{"component:view"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		authenticator Authenticator
+		header        string
+		wantStatus    int
+		wantFallback  bool
+		wantSubject   string
+	}{
+		{
+			name:          "JWT is passed to the fallback",
+			authenticator: authenticator,
+			header:        "Bearer eyJhbGciOiJSUzI1NiJ9.e30.sig",
+			wantStatus:    http.StatusOK,
+			wantFallback:  true,
+		},
+		{
+			name:          "missing header is passed to the fallback",
+			authenticator: authenticator,
+			wantStatus:    http.StatusOK,
+			wantFallback:  true,
+		},
+		{
+			name:          "valid API token",
+			authenticator: authenticator,
+			header:        "bearer " + validToken,
+			wantStatus:    http.StatusOK,
+			wantSubject:   "alice",
+		},
+		{
+			name:          "unknown API token",
+			authenticator: authenticator,
+			header:        "Bearer " + ServiceKeyPrefix + "unknown",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:       "API tokens not enabled",
+			header:     "Bearer " + validToken,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fallbackUsed := false
+			fallback := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fallbackUsed = true
+					next.ServeHTTP(w, r)
+				})
+			}
+
+			var gotSubject, gotTokenID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if subject, ok := auth.GetSubjectContext(r); ok {
+					gotSubject = subject.ID
+				}
+				gotTokenID, _ = GetTokenIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			handler := Middleware(Config{Authenticator: tt.authenticator}, fallback)(next)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if fallbackUsed != tt.wantFallback {
+				t.Errorf("fallback used = %v, want %v", fallbackUsed, tt.wantFallback)
+			}
+			if gotSubject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", gotSubject, tt.wantSubject)
+			}
+			if tt.wantSubject != "" && gotTokenID != "tok-1" {
+				t.Errorf("token ID = %q, want %q", gotTokenID, "tok-1")
+			}
+		})
+	}
+}
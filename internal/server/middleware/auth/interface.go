@@ -14,6 +14,7 @@ type SubjectContext struct {
 	Type              string   // Type of subject (user, service_account, etc.)
 	EntitlementClaim  string   // The claim name used for entitlements (e.g., "groups", "scopes")
 	EntitlementValues []string // The entitlement values extracted from the claim
+	Scopes            []string // Actions the subject is limited to (e.g., "component:view"), empty when unrestricted
 }
 
 // Middleware defines the interface that all authentication middlewares must implement
@@ -0,0 +1,71 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// auditedArguments are the tool arguments recorded in the metadata of the audit event
+var auditedArguments = []string{"org_name", "project_name", "component_name", "binding_name", "environment"}
+
+// AuditTools returns an MCP middleware that emits an audit event for each call of the tools in actions,
+// which maps a tool name to the action it performs. Calls of other tools and other methods are not audited.
+func AuditTools(logger *audit.Logger, actions map[string]audit.ActionDefinition) mcpsdk.Middleware {
+	return func(next mcpsdk.MethodHandler) mcpsdk.MethodHandler {
+		return func(ctx context.Context, method string, req mcpsdk.Request) (mcpsdk.Result, error) {
+			call, ok := req.(*mcpsdk.CallToolRequest)
+			if !ok || call.Params == nil {
+				return next(ctx, method, req)
+			}
+			actionDef, ok := actions[call.Params.Name]
+			if !ok {
+				return next(ctx, method, req)
+			}
+
+			result, err := next(ctx, method, req)
+
+			event := &audit.Event{
+				Actor:    audit.ActorFromContext(ctx),
+				Action:   actionDef.Action,
+				Category: actionDef.Category,
+				Result:   audit.ResultSuccess,
+				Metadata: toolCallMetadata(call.Params),
+			}
+			if toolResult, ok := result.(*mcpsdk.CallToolResult); err != nil || (ok && toolResult.IsError) {
+				event.Result = audit.ResultFailure
+			}
+			var header http.Header
+			if call.Extra != nil {
+				header = call.Extra.Header
+			}
+			event.RequestID = audit.RequestIDFromHeader(header)
+			event.SourceIP = audit.SourceIPFromHeader(header, "")
+			logger.LogEvent(event)
+
+			return result, err
+		}
+	}
+}
+
+// toolCallMetadata records the tool and the arguments that identify the resource it acted upon
+func toolCallMetadata(params *mcpsdk.CallToolParamsRaw) map[string]any {
+	metadata := map[string]any{"tool": params.Name}
+	var args map[string]any
+	if err := json.Unmarshal(params.Arguments, &args); err != nil {
+		return metadata
+	}
+	for _, name := range auditedArguments {
+		if value, ok := args[name].(string); ok && value != "" {
+			metadata[name] = value
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"testing"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// recordingSink collects the audit events written to it
+type recordingSink struct {
+	mu     sync.Mutex
+	events []*audit.Event
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Write(_ context.Context, event *audit.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestAuditTools(t *testing.T) {
+	sink := &recordingSink{}
+	logger := audit.NewLogger(slog.New(slog.NewTextHandler(io.Discard, nil)), "openchoreo-api", sink)
+	middleware := AuditTools(logger, map[string]audit.ActionDefinition{
+		"patch_release_binding": {Action: "patch_release_binding", Category: audit.CategoryResource},
+	})
+
+	handler := middleware(func(_ context.Context, _ string, req mcpsdk.Request) (mcpsdk.Result, error) {
+		args := req.(*mcpsdk.CallToolRequest).Params.Arguments
+		return &mcpsdk.CallToolResult{IsError: string(args) == `{"binding_name":"broken"}`}, nil
+	})
+	call := func(tool, args string) {
+		t.Helper()
+		req := &mcpsdk.CallToolRequest{
+			Params: &mcpsdk.CallToolParamsRaw{Name: tool, Arguments: json.RawMessage(args)},
+			Extra:  &mcpsdk.RequestExtra{Header: http.Header{"X-Forwarded-For": {"10.0.0.1"}}},
+		}
+		if _, err := handler(context.Background(), "tools/call", req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	call("list_components", `{"org_name":"acme"}`)
+	call("patch_release_binding", `{"org_name":"acme","binding_name":"api-production","release_name":"api-2"}`)
+	call("patch_release_binding", `{"binding_name":"broken"}`)
+	logger.Close()
+
+	if len(sink.events) != 2 {
+		t.Fatalf("expected only the patch_release_binding calls to be audited, got %d events", len(sink.events))
+	}
+	event := sink.events[0]
+	if event.Action != "patch_release_binding" || event.Result != audit.ResultSuccess || event.SourceIP != "10.0.0.1" {
+		t.Errorf("unexpected event %+v", event)
+	}
+	want := map[string]any{"tool": "patch_release_binding", "org_name": "acme", "binding_name": "api-production"}
+	if len(event.Metadata) != len(want) {
+		t.Errorf("metadata = %v, want %v", event.Metadata, want)
+	}
+	for key, value := range want {
+		if event.Metadata[key] != value {
+			t.Errorf("metadata[%s] = %v, want %v", key, event.Metadata[key], value)
+		}
+	}
+	if event.Actor.ID != "anonymous" {
+		t.Errorf("expected an unauthenticated call to be audited as anonymous, got %+v", event.Actor)
+	}
+	if sink.events[1].Result != audit.ResultFailure {
+		t.Errorf("expected a failed tool call to be audited as a failure, got %s", sink.events[1].Result)
+	}
+}
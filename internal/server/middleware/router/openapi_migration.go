@@ -38,7 +38,7 @@ const (
 //
 // Example usage:
 //
-//	legacyHandler := legacyhandlers.New(services, cfg, logger).Routes()
+//	legacyHandler := legacyhandlers.New(services, cfg, auditLogger, logger).Routes()
 //	openapiHandler := gen.HandlerWithOptions(strictHandler, options)
 //
 //	handler := router.OpenAPIMigrationRouter(openapiHandler, legacyHandler)
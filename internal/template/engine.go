@@ -287,6 +287,29 @@ func normalizeCELResult(result any, err error) (any, error) {
 	return result, nil
 }
 
+// Compile compiles the CEL expressions of a template string without evaluating them, declaring the given
+// variables, so that syntax errors and unknown functions are found before the template is rendered.
+func (e *Engine) Compile(str string, variables ...string) error {
+	expressions, err := FindCELExpressions(str)
+	if err != nil {
+		return err
+	}
+	inputs := make(map[string]any, len(variables))
+	for _, variable := range variables {
+		inputs[variable] = nil
+	}
+	env, err := e.getOrCreateEnv(inputs)
+	if err != nil {
+		return fmt.Errorf("failed to build CEL environment: %w", err)
+	}
+	for _, match := range expressions {
+		if _, issues := env.Compile(match.InnerExpr); issues != nil && issues.Err() != nil {
+			return fmt.Errorf("CEL compilation error in expression '%s': %w", match.InnerExpr, issues.Err())
+		}
+	}
+	return nil
+}
+
 func (e *Engine) evaluateCEL(expression string, inputs map[string]any) (any, error) {
 	env, err := e.getOrCreateEnv(inputs)
 	if err != nil {
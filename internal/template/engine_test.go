@@ -965,6 +965,23 @@ item2: ${metadata.name}-${item}
 	t.Logf("Program cache contains %d entries", cacheSize)
 }
 
+func TestEngineCompile(t *testing.T) {
+	engine := NewEngine()
+
+	if err := engine.Compile("${resource.spec.replicas >= 2 && size(resource.metadata.name) > 0}", "resource"); err != nil {
+		t.Errorf("Compile() error = %v, want none", err)
+	}
+	for _, str := range []string{
+		"${resource.spec.replicas >=}",
+		"${unknownFunction(resource)}",
+		"${spec.replicas}",
+	} {
+		if err := engine.Compile(str, "resource"); err == nil {
+			t.Errorf("Compile(%q) succeeded, want an error", str)
+		}
+	}
+}
+
 func TestFindCELExpressions(t *testing.T) {
 	t.Parallel()
 
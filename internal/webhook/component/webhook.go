@@ -6,7 +6,9 @@ package component
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 )
 
 // nolint:unused
@@ -84,6 +87,13 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 	// Validate unique trait instance names
 	allErrs = append(allErrs, validateUniqueTraitInstanceNames(component)...)
 
+	// Validate that the traits attached by the platform are not removed or reconfigured
+	platformErrs, err := v.validatePlatformTraits(ctx, component)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, platformErrs...)
+
 	if len(allErrs) > 0 {
 		return warnings, allErrs.ToAggregate()
 	}
@@ -93,7 +103,7 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Component.
 func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	_, ok := oldObj.(*openchoreodevv1alpha1.Component)
+	oldComponent, ok := oldObj.(*openchoreodevv1alpha1.Component)
 	if !ok {
 		return nil, fmt.Errorf("expected a Component object for the oldObj but got %T", oldObj)
 	}
@@ -114,6 +124,17 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 	// Validate unique trait instance names
 	allErrs = append(allErrs, validateUniqueTraitInstanceNames(newComponent)...)
 
+	// Validate that the traits attached by the platform are not removed or reconfigured. Writes that do not
+	// change the spec, such as removing the finalizer of a deleted component, are not held to traits the
+	// platform attached after the component was admitted.
+	if newComponent.DeletionTimestamp.IsZero() && !equality.Semantic.DeepEqual(oldComponent.Spec, newComponent.Spec) {
+		platformErrs, err := v.validatePlatformTraits(ctx, newComponent)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, platformErrs...)
+	}
+
 	if len(allErrs) > 0 {
 		return warnings, allErrs.ToAggregate()
 	}
@@ -149,3 +170,76 @@ func validateUniqueTraitInstanceNames(component *openchoreodevv1alpha1.Component
 
 	return allErrs
 }
+
+// validatePlatformTraits validates the component against the traits attached by its ComponentType and by
+// the environments of its namespace. Required traits cannot be disabled, and a trait instance listed by
+// the component must use the same trait and must not override locked parameters.
+func (v *Validator) validatePlatformTraits(ctx context.Context, component *openchoreodevv1alpha1.Component) (field.ErrorList, error) {
+	if v.Client == nil {
+		return nil, nil
+	}
+
+	type platformTraits struct {
+		owner  string
+		traits []openchoreodevv1alpha1.PlatformTrait
+	}
+	var sources []platformTraits
+
+	// A missing ComponentType is reported by the controller
+	if _, ctName, ok := strings.Cut(component.Spec.ComponentType, "/"); ok {
+		ct := &openchoreodevv1alpha1.ComponentType{}
+		err := v.Client.Get(ctx, client.ObjectKey{Namespace: component.Namespace, Name: ctName}, ct)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get ComponentType %q: %w", ctName, err)
+		}
+		if err == nil {
+			sources = append(sources, platformTraits{owner: "ComponentType " + ct.Name, traits: ct.Spec.Traits})
+		}
+	}
+
+	environments := &openchoreodevv1alpha1.EnvironmentList{}
+	if err := v.Client.List(ctx, environments, client.InNamespace(component.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Environments: %w", err)
+	}
+	for _, env := range environments.Items {
+		sources = append(sources, platformTraits{owner: "Environment " + env.Name, traits: env.Spec.Traits})
+	}
+
+	allErrs := field.ErrorList{}
+	for _, source := range sources {
+		for _, pt := range source.traits {
+			if pt.Required {
+				for i, disabled := range component.Spec.DisabledTraits {
+					if disabled == pt.InstanceName {
+						allErrs = append(allErrs, field.Forbidden(
+							field.NewPath("spec", "disabledTraits").Index(i),
+							fmt.Sprintf("trait instance %q is required by %s", pt.InstanceName, source.owner)))
+					}
+				}
+			}
+
+			for i, instance := range component.Spec.Traits {
+				if instance.InstanceName != pt.InstanceName {
+					continue
+				}
+				path := field.NewPath("spec", "traits").Index(i)
+				if instance.Name != pt.Name {
+					allErrs = append(allErrs, field.Invalid(path.Child("name"), instance.Name,
+						fmt.Sprintf("trait instance %q is an instance of trait %q attached by %s",
+							pt.InstanceName, pt.Name, source.owner)))
+					continue
+				}
+				conflicts, err := componentpipeline.LockedParameterConflicts(instance.Parameters, pt.LockedParameters)
+				if err != nil {
+					allErrs = append(allErrs, field.InternalError(path.Child("parameters"), err))
+					continue
+				}
+				if len(conflicts) > 0 {
+					allErrs = append(allErrs, field.Forbidden(path.Child("parameters"),
+						fmt.Sprintf("parameters %s are locked by %s", strings.Join(conflicts, ", "), source.owner)))
+				}
+			}
+		}
+	}
+	return allErrs, nil
+}
@@ -4,8 +4,14 @@
 package component
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -70,4 +76,83 @@ var _ = Describe("Component Webhook", func() {
 		// })
 	})
 
+	Context("When the ComponentType and Environments attach traits", func() {
+		BeforeEach(func() {
+			componentType := &openchoreodevv1alpha1.ComponentType{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-app", Namespace: "default"},
+				Spec: openchoreodevv1alpha1.ComponentTypeSpec{
+					WorkloadType: "deployment",
+					Traits: []openchoreodevv1alpha1.PlatformTrait{
+						{
+							Name:             "pdb",
+							InstanceName:     "pdb",
+							Required:         true,
+							LockedParameters: &runtime.RawExtension{Raw: []byte(`{"enabled":true}`)},
+						},
+						{Name: "network-policy", InstanceName: "network-policy"},
+					},
+				},
+			}
+			environment := &openchoreodevv1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "default"},
+				Spec: openchoreodevv1alpha1.EnvironmentSpec{
+					Traits: []openchoreodevv1alpha1.PlatformTrait{
+						{Name: "alerts", InstanceName: "alerts", Required: true},
+					},
+				},
+			}
+			validator = Validator{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+				WithObjects(componentType, environment).Build()}
+
+			obj.Namespace = "default"
+			obj.Spec.ComponentType = "deployment/web-app"
+		})
+
+		It("Should admit disabling default traits and setting unlocked parameters", func() {
+			obj.Spec.DisabledTraits = []string{"network-policy"}
+			obj.Spec.Traits = []openchoreodevv1alpha1.ComponentTrait{
+				{Name: "pdb", InstanceName: "pdb", Parameters: &runtime.RawExtension{Raw: []byte(`{"enabled":true,"minAvailable":2}`)}},
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny disabling required traits", func() {
+			obj.Spec.DisabledTraits = []string{"pdb", "alerts"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`trait instance "pdb" is required by ComponentType web-app`))
+			Expect(err.Error()).To(ContainSubstring(`trait instance "alerts" is required by Environment production`))
+		})
+
+		It("Should deny overriding locked parameters", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.ComponentTrait{
+				{Name: "pdb", InstanceName: "pdb", Parameters: &runtime.RawExtension{Raw: []byte(`{"enabled":false}`)}},
+			}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameters enabled are locked by ComponentType web-app"))
+		})
+
+		It("Should deny reusing the instance name of a platform trait for another trait", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.ComponentTrait{{Name: "mysql", InstanceName: "alerts"}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`trait instance "alerts" is an instance of trait "alerts" attached by Environment production`))
+		})
+
+		It("Should admit updates that do not change the spec", func() {
+			obj.Spec.DisabledTraits = []string{"pdb"}
+			oldObj = obj.DeepCopy()
+			obj.Labels = map[string]string{"team": "payments"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit updates of a component being deleted", func() {
+			oldObj = obj.DeepCopy()
+			obj.Spec.DisabledTraits = []string{"pdb"}
+			obj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+
 })
@@ -5,16 +5,21 @@ package componenttype
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/schema/resourceschema"
 	"github.com/openchoreo/openchoreo/internal/validation/component"
 	"github.com/openchoreo/openchoreo/internal/validation/schemautil"
 )
@@ -23,10 +28,13 @@ import (
 // log is for logging in this package.
 var componenttypelog = logf.Log.WithName("componenttype-resource")
 
+// resourceValidator validates resource templates against the schemas of the built-in Kubernetes kinds
+var resourceValidator = resourceschema.NewValidator()
+
 // SetupComponentTypeWebhookWithManager registers the webhook for ComponentType in the manager.
 func SetupComponentTypeWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.ComponentType{}).
-		WithValidator(&Validator{}).
+		WithValidator(&Validator{Client: mgr.GetClient()}).
 		Complete()
 }
 
@@ -34,12 +42,14 @@ func SetupComponentTypeWebhookWithManager(mgr ctrl.Manager) error {
 
 // Validator validates ComponentType resources
 // +kubebuilder:object:generate=false
-type Validator struct{}
+type Validator struct {
+	Client client.Client
+}
 
 var _ webhook.CustomValidator = &Validator{}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ComponentType.
-func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	componenttype, ok := obj.(*openchoreodevv1alpha1.ComponentType)
 	if !ok {
 		return nil, fmt.Errorf("expected a ComponentType object but got %T", obj)
@@ -65,6 +75,16 @@ func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 	resourceErrs := validateResourceStructure(componenttype)
 	allErrs = append(allErrs, resourceErrs...)
 
+	// Validate resource templates against the schemas of their kinds
+	allErrs = append(allErrs, validateResourceSchemas(componenttype)...)
+
+	// Validate that the attached traits do not conflict with the traits attached by environments
+	traitErrs, err := v.validatePlatformTraits(ctx, componenttype)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, traitErrs...)
+
 	if len(allErrs) > 0 {
 		return nil, allErrs.ToAggregate()
 	}
@@ -73,8 +93,8 @@ func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ComponentType.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	_, ok := oldObj.(*openchoreodevv1alpha1.ComponentType)
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldComponentType, ok := oldObj.(*openchoreodevv1alpha1.ComponentType)
 	if !ok {
 		return nil, fmt.Errorf("expected a ComponentType object for the oldObj but got %T", oldObj)
 	}
@@ -106,6 +126,18 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	resourceErrs := validateResourceStructure(newComponentType)
 	allErrs = append(allErrs, resourceErrs...)
 
+	// Validate resource templates against the schemas of their kinds
+	allErrs = append(allErrs, validateResourceSchemas(newComponentType)...)
+
+	// Validate that the attached traits do not conflict with the traits attached by environments
+	if !equality.Semantic.DeepEqual(oldComponentType.Spec.Traits, newComponentType.Spec.Traits) {
+		traitErrs, err := v.validatePlatformTraits(ctx, newComponentType)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, traitErrs...)
+	}
+
 	if len(allErrs) > 0 {
 		return nil, allErrs.ToAggregate()
 	}
@@ -132,3 +164,55 @@ func validateResourceStructure(ct *openchoreodevv1alpha1.ComponentType) field.Er
 		ct.Spec.Resources,
 		field.NewPath("spec", "resources"))
 }
+
+// validateResourceSchemas validates the fields of the resource templates that are not set by CEL expressions
+// against the schemas of their kinds, so that misspelled fields and values of the wrong type are rejected
+// before a component is rendered
+func validateResourceSchemas(ct *openchoreodevv1alpha1.ComponentType) field.ErrorList {
+	allErrs := field.ErrorList{}
+	basePath := field.NewPath("spec", "resources")
+	for i, resource := range ct.Spec.Resources {
+		if resource.Template == nil {
+			continue
+		}
+		var tmpl map[string]any
+		if err := json.Unmarshal(resource.Template.Raw, &tmpl); err != nil {
+			// Malformed templates are reported by the structure validation
+			continue
+		}
+		templatePath := basePath.Index(i).Child("template")
+		for _, schemaErr := range resourceValidator.ValidateTemplate(tmpl) {
+			schemaErr.Field = templatePath.Child(schemaErr.Field).String()
+			schemaErr.Detail = fmt.Sprintf("resource %q: %s", resource.ID, schemaErr.Detail)
+			allErrs = append(allErrs, schemaErr)
+		}
+	}
+	return allErrs
+}
+
+// validatePlatformTraits validates that the traits attached by the ComponentType do not reuse the instance
+// name of a different trait attached by an environment of its namespace. The environment trait would replace
+// the trait of the ComponentType, so no component of the type could list that instance.
+func (v *Validator) validatePlatformTraits(ctx context.Context, ct *openchoreodevv1alpha1.ComponentType) (field.ErrorList, error) {
+	if v.Client == nil || len(ct.Spec.Traits) == 0 {
+		return nil, nil
+	}
+
+	environments := &openchoreodevv1alpha1.EnvironmentList{}
+	if err := v.Client.List(ctx, environments, client.InNamespace(ct.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Environments: %w", err)
+	}
+
+	allErrs := field.ErrorList{}
+	basePath := field.NewPath("spec", "traits")
+	for i, pt := range ct.Spec.Traits {
+		for _, env := range environments.Items {
+			if conflict, ok := componentpipeline.ConflictingPlatformTrait(pt, env.Spec.Traits); ok {
+				allErrs = append(allErrs, field.Invalid(basePath.Index(i).Child("name"), pt.Name,
+					fmt.Sprintf("trait instance %q is an instance of trait %q attached by Environment %s",
+						pt.InstanceName, conflict.Name, env.Name)))
+			}
+		}
+	}
+	return allErrs, nil
+}
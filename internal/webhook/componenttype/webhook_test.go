@@ -8,7 +8,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -485,4 +488,102 @@ var _ = Describe("ComponentType Webhook", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Context("Resource Template Schema Validation", func() {
+		BeforeEach(func() {
+			obj.Spec.WorkloadType = workloadTypeDeployment
+		})
+
+		It("should reject a misspelled field of a built-in kind", func() {
+			obj.Spec.Resources = []openchoreodevv1alpha1.ResourceTemplate{
+				{
+					ID: "deployment",
+					Template: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "test"},
+							"spec": {"template": {"spec": {"contaienrs": [{"name": "app", "image": "${workload.containers['app'].image}"}]}}}}`),
+					},
+				},
+			}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].template.spec.template.spec.contaienrs"))
+			Expect(err.Error()).To(ContainSubstring(`resource "deployment": field not declared in schema`))
+		})
+
+		It("should reject a literal value of the wrong type", func() {
+			obj.Spec.Resources = []openchoreodevv1alpha1.ResourceTemplate{
+				{
+					ID: "deployment",
+					Template: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "test"}, "spec": {"replicas": "two"}}`),
+					},
+				},
+			}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].template.spec.replicas"))
+		})
+
+		It("should reject schema violations on update", func() {
+			oldObj.Spec.WorkloadType = workloadTypeDeployment
+			oldObj.Spec.Resources = []openchoreodevv1alpha1.ResourceTemplate{
+				{ID: "deployment", Template: validDeploymentTemplate()},
+			}
+			obj.Spec.Resources = []openchoreodevv1alpha1.ResourceTemplate{
+				{
+					ID: "deployment",
+					Template: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "test", "labelz": {}}}`),
+					},
+				},
+			}
+
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].template.metadata.labelz"))
+		})
+	})
+
+	Context("Platform Trait Tests", func() {
+		BeforeEach(func() {
+			environment := &openchoreodevv1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "default"},
+				Spec: openchoreodevv1alpha1.EnvironmentSpec{
+					Traits: []openchoreodevv1alpha1.PlatformTrait{{Name: "alerts", InstanceName: "monitoring"}},
+				},
+			}
+			validator = Validator{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+				WithObjects(environment).Build()}
+
+			obj.Namespace = "default"
+			obj.Spec.WorkloadType = workloadTypeDeployment
+			obj.Spec.Resources = []openchoreodevv1alpha1.ResourceTemplate{
+				{ID: "deployment", Template: validDeploymentTemplate()},
+			}
+		})
+
+		It("should admit traits that an environment attaches under the same instance name", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "alerts", InstanceName: "monitoring"}}
+
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should reject reusing the instance name of a different environment trait", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "metrics", InstanceName: "monitoring"}}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				`trait instance "monitoring" is an instance of trait "alerts" attached by Environment production`))
+		})
+
+		It("should not check traits that an update leaves unchanged", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "metrics", InstanceName: "monitoring"}}
+			oldObj = obj.DeepCopy()
+
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,129 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var environmentlog = logf.Log.WithName("environment-resource")
+
+// SetupEnvironmentWebhookWithManager registers the webhook for Environment in the manager.
+func SetupEnvironmentWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.Environment{}).
+		WithValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-environment,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=environments,verbs=create;update,versions=v1alpha1,name=venvironment-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates Environment resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	environment, ok := obj.(*openchoreodevv1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object but got %T", obj)
+	}
+	environmentlog.Info("Validation for Environment upon creation", "name", environment.GetName())
+
+	allErrs, err := v.validatePlatformTraits(ctx, environment)
+	if err != nil {
+		return nil, err
+	}
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldEnvironment, ok := oldObj.(*openchoreodevv1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object for the oldObj but got %T", oldObj)
+	}
+
+	newEnvironment, ok := newObj.(*openchoreodevv1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object for the newObj but got %T", newObj)
+	}
+	environmentlog.Info("Validation for Environment upon update", "name", newEnvironment.GetName())
+
+	// Only changes of the attached traits are validated
+	if equality.Semantic.DeepEqual(oldEnvironment.Spec.Traits, newEnvironment.Spec.Traits) {
+		return nil, nil
+	}
+
+	allErrs, err := v.validatePlatformTraits(ctx, newEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if len(allErrs) > 0 {
+		return nil, allErrs.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	environment, ok := obj.(*openchoreodevv1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object but got %T", obj)
+	}
+	environmentlog.Info("Validation for Environment upon deletion", "name", environment.GetName())
+
+	// No special validation needed for deletion
+	return nil, nil
+}
+
+// validatePlatformTraits validates that the traits attached by the Environment do not reuse the instance name
+// of a different trait attached by a ComponentType of its namespace. The environment trait would replace the
+// trait of the ComponentType, so no component of that type could list the instance.
+func (v *Validator) validatePlatformTraits(ctx context.Context, env *openchoreodevv1alpha1.Environment) (field.ErrorList, error) {
+	if v.Client == nil || len(env.Spec.Traits) == 0 {
+		return nil, nil
+	}
+
+	componentTypes := &openchoreodevv1alpha1.ComponentTypeList{}
+	if err := v.Client.List(ctx, componentTypes, client.InNamespace(env.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ComponentTypes: %w", err)
+	}
+
+	allErrs := field.ErrorList{}
+	basePath := field.NewPath("spec", "traits")
+	for i, pt := range env.Spec.Traits {
+		for _, ct := range componentTypes.Items {
+			if conflict, ok := componentpipeline.ConflictingPlatformTrait(pt, ct.Spec.Traits); ok {
+				allErrs = append(allErrs, field.Invalid(basePath.Index(i).Child("name"), pt.Name,
+					fmt.Sprintf("trait instance %q is an instance of trait %q attached by ComponentType %s",
+						pt.InstanceName, conflict.Name, ct.Name)))
+			}
+		}
+	}
+	return allErrs, nil
+}
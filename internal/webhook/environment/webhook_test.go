@@ -0,0 +1,66 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("Environment Webhook", func() {
+	var (
+		ctx       context.Context
+		obj       *openchoreodevv1alpha1.Environment
+		oldObj    *openchoreodevv1alpha1.Environment
+		validator Validator
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		componentType := &openchoreodevv1alpha1.ComponentType{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-app", Namespace: "default"},
+			Spec: openchoreodevv1alpha1.ComponentTypeSpec{
+				WorkloadType: "deployment",
+				Traits:       []openchoreodevv1alpha1.PlatformTrait{{Name: "alerts", InstanceName: "monitoring"}},
+			},
+		}
+		validator = Validator{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(componentType).Build()}
+
+		obj = &openchoreodevv1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "default"}}
+		oldObj = &openchoreodevv1alpha1.Environment{}
+	})
+
+	Context("When the ComponentTypes attach traits", func() {
+		It("should admit replacing a trait of a ComponentType with an instance of the same trait", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "alerts", InstanceName: "monitoring", Required: true}}
+
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should reject reusing the instance name of a different ComponentType trait", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "metrics", InstanceName: "monitoring"}}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				`trait instance "monitoring" is an instance of trait "alerts" attached by ComponentType web-app`))
+		})
+
+		It("should not check traits that an update leaves unchanged", func() {
+			obj.Spec.Traits = []openchoreodevv1alpha1.PlatformTrait{{Name: "metrics", InstanceName: "monitoring"}}
+			oldObj = obj.DeepCopy()
+			obj.Labels = map[string]string{"tier": "critical"}
+
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,8 +17,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
+// serviceAccountUsernamePrefix prefixes the usernames of in-cluster service accounts
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
 // nolint:unused
 // log is for logging in this package.
 var releasebindinglog = logf.Log.WithName("releasebinding-resource")
@@ -69,8 +74,9 @@ func (d *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 	}
 
 	// For updates, preserve releaseName from old object if not specified in new object
+	var oldBinding *openchoreodevv1alpha1.ReleaseBinding
 	if req.Operation == "UPDATE" && len(req.OldObject.Raw) > 0 {
-		oldBinding := &openchoreodevv1alpha1.ReleaseBinding{}
+		oldBinding = &openchoreodevv1alpha1.ReleaseBinding{}
 		if err := d.decoder.DecodeRaw(req.OldObject, oldBinding); err != nil {
 			return admission.Errored(http.StatusBadRequest, err)
 		}
@@ -82,6 +88,8 @@ func (d *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 		}
 	}
 
+	stampBoundBy(releasebinding, oldBinding, req.UserInfo.Username)
+
 	// Marshal the modified object
 	marshaledBinding, err := json.Marshal(releasebinding)
 	if err != nil {
@@ -91,6 +99,25 @@ func (d *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledBinding)
 }
 
+// stampBoundBy records the authenticated user as the one who bound the release, so the
+// release history can't be attributed to someone else by editing the annotation.
+// Service accounts keep the annotation they set: the OpenChoreo API and controllers
+// change bindings on behalf of the user who requested the change.
+func stampBoundBy(binding, oldBinding *openchoreodevv1alpha1.ReleaseBinding, username string) {
+	if strings.HasPrefix(username, serviceAccountUsernamePrefix) {
+		return
+	}
+	if oldBinding != nil && oldBinding.Spec.ReleaseName == binding.Spec.ReleaseName {
+		controller.SetBoundBy(binding, controller.GetBoundBy(oldBinding))
+		return
+	}
+	if binding.Spec.ReleaseName == "" {
+		controller.SetBoundBy(binding, "")
+		return
+	}
+	controller.SetBoundBy(binding, username)
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion component.
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
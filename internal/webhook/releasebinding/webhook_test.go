@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 var _ = Describe("ReleaseBinding Webhook", func() {
@@ -35,16 +36,33 @@ var _ = Describe("ReleaseBinding Webhook", func() {
 	})
 
 	Context("When creating ReleaseBinding under Defaulting Webhook", func() {
-		// TODO (user): Add logic for defaulting webhooks
-		// Example:
-		// It("Should apply defaults when a required field is empty", func() {
-		//     By("simulating a scenario where defaults should be applied")
-		//     obj.SomeFieldWithDefault = ""
-		//     By("calling the Default method to apply defaults")
-		//     defaulter.Default(ctx, obj)
-		//     By("checking that the default values are set")
-		//     Expect(obj.SomeFieldWithDefault).To(Equal("default_value"))
-		// })
+		It("Should record the requesting user as the binder of a new release", func() {
+			oldObj.Spec.ReleaseName = "api-1"
+			controller.SetBoundBy(oldObj, "alice")
+			obj.Spec.ReleaseName = "api-2"
+			controller.SetBoundBy(obj, "bob")
+
+			stampBoundBy(obj, oldObj, "mallory")
+			Expect(controller.GetBoundBy(obj)).To(Equal("mallory"))
+		})
+
+		It("Should keep the recorded binder when the release does not change", func() {
+			oldObj.Spec.ReleaseName = "api-1"
+			controller.SetBoundBy(oldObj, "alice")
+			obj.Spec.ReleaseName = "api-1"
+			controller.SetBoundBy(obj, "bob")
+
+			stampBoundBy(obj, oldObj, "mallory")
+			Expect(controller.GetBoundBy(obj)).To(Equal("alice"))
+		})
+
+		It("Should keep the binder set by service accounts acting for a user", func() {
+			obj.Spec.ReleaseName = "api-2"
+			controller.SetBoundBy(obj, "bob")
+
+			stampBoundBy(obj, nil, "system:serviceaccount:openchoreo-control-plane:openchoreo-api")
+			Expect(controller.GetBoundBy(obj)).To(Equal("bob"))
+		})
 	})
 
 	Context("When creating or updating ReleaseBinding under Validating Webhook", func() {
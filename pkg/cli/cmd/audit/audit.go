@@ -0,0 +1,48 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/auth"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewAuditCmd creates the audit command
+func NewAuditCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Audit,
+		Flags: []flags.Flag{
+			flags.AuditActor,
+			flags.AuditAction,
+			flags.AuditResourceType,
+			flags.AuditResource,
+			flags.AuditResult,
+			flags.AuditSince,
+			flags.AuditUntil,
+			flags.AuditLimit,
+			flags.AuditContinue,
+			flags.Output,
+		},
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ListAuditEvents(api.ListAuditEventsParams{
+				Actor:        fg.GetString(flags.AuditActor),
+				Action:       fg.GetString(flags.AuditAction),
+				ResourceType: fg.GetString(flags.AuditResourceType),
+				ResourceName: fg.GetString(flags.AuditResource),
+				Result:       fg.GetString(flags.AuditResult),
+				Since:        fg.GetString(flags.AuditSince),
+				Until:        fg.GetString(flags.AuditUntil),
+				Limit:        fg.GetInt(flags.AuditLimit),
+				Continue:     fg.GetString(flags.AuditContinue),
+				OutputFormat: fg.GetString(flags.Output),
+			})
+		},
+	}).Build()
+}
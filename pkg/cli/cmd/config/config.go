@@ -35,7 +35,7 @@ type Credential struct {
 	ClientSecret string `yaml:"clientSecret,omitempty"`
 	Token        string `yaml:"token,omitempty"`
 	RefreshToken string `yaml:"refreshToken,omitempty"`
-	AuthMethod   string `yaml:"authMethod,omitempty"` // "pkce" or "client_credentials"
+	AuthMethod   string `yaml:"authMethod,omitempty"` // "authorization_code", "client_credentials" or "api_token"
 }
 
 // Context represents a single named configuration context.
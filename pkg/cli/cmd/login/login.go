@@ -20,6 +20,7 @@ func NewLoginCmd(impl api.CommandImplementationInterface) *cobra.Command {
 			flags.ClientCredentials,
 			flags.ClientID,
 			flags.ClientSecret,
+			flags.Token,
 			flags.CredentialName,
 			flags.URL,
 		},
@@ -28,6 +29,7 @@ func NewLoginCmd(impl api.CommandImplementationInterface) *cobra.Command {
 				ClientCredentials: fg.GetBool(flags.ClientCredentials),
 				ClientID:          fg.GetString(flags.ClientID),
 				ClientSecret:      fg.GetString(flags.ClientSecret),
+				Token:             fg.GetString(flags.Token),
 				CredentialName:    fg.GetString(flags.CredentialName),
 				URL:               fg.GetString(flags.URL),
 			})
@@ -25,6 +25,8 @@ func NewReleaseBindingCmd(impl api.CommandImplementationInterface) *cobra.Comman
 	}
 
 	cmd.AddCommand(newGenerateCmd(impl))
+	cmd.AddCommand(newRollbackCmd(impl))
+	cmd.AddCommand(newPreviewCmd(impl))
 	return cmd
 }
 
@@ -0,0 +1,52 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/auth"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// newPreviewCmd creates the release-binding preview command
+func newPreviewCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	cmd := (&builder.CommandBuilder{
+		Command: constants.ReleaseBindingPreview,
+		Flags: []flags.Flag{
+			flags.Organization,
+			flags.Project,
+			flags.Component,
+			flags.Environment,
+			flags.PreviewRelease,
+			flags.PreviewOverridesFile,
+			flags.Output,
+		},
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			args := fg.GetArgs()
+			if len(args) != 1 {
+				return fmt.Errorf("exactly one release binding name is required")
+			}
+
+			return impl.PreviewReleaseBinding(api.PreviewReleaseBindingParams{
+				Organization:  fg.GetString(flags.Organization),
+				ProjectName:   fg.GetString(flags.Project),
+				ComponentName: fg.GetString(flags.Component),
+				BindingName:   args[0],
+				Environment:   fg.GetString(flags.Environment),
+				ReleaseName:   fg.GetString(flags.PreviewRelease),
+				OverridesFile: fg.GetString(flags.PreviewOverridesFile),
+				OutputFormat:  fg.GetString(flags.Output),
+			})
+		},
+	}).Build()
+
+	return cmd
+}
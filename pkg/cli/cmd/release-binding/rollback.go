@@ -0,0 +1,46 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/auth"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// newRollbackCmd creates the release-binding rollback command
+func newRollbackCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	cmd := (&builder.CommandBuilder{
+		Command: constants.ReleaseBindingRollback,
+		Flags: []flags.Flag{
+			flags.Organization,
+			flags.Project,
+			flags.Component,
+			flags.ToRelease,
+		},
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			args := fg.GetArgs()
+			if len(args) != 1 {
+				return fmt.Errorf("exactly one release binding name is required")
+			}
+
+			return impl.RollbackReleaseBinding(api.RollbackReleaseBindingParams{
+				Organization:  fg.GetString(flags.Organization),
+				ProjectName:   fg.GetString(flags.Project),
+				ComponentName: fg.GetString(flags.Component),
+				BindingName:   args[0],
+				ReleaseName:   fg.GetString(flags.ToRelease),
+			})
+		},
+	}).Build()
+
+	return cmd
+}
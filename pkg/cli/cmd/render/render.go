@@ -0,0 +1,52 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewRenderCmd creates the render command
+func NewRenderCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Render,
+		Flags: []flags.Flag{
+			flags.Project,
+			flags.Component,
+			flags.RenderEnvironment,
+			flags.Output,
+			flags.OutputPath,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.Render(api.RenderParams{
+				ProjectName:   fg.GetString(flags.Project),
+				ComponentName: fg.GetString(flags.Component),
+				Environment:   fg.GetString(flags.RenderEnvironment),
+				OutputFormat:  fg.GetString(flags.Output),
+				OutputPath:    fg.GetString(flags.OutputPath),
+			})
+		},
+	}).Build()
+}
+
+// NewTestCmd creates the test command
+func NewTestCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.Test,
+		Flags: []flags.Flag{
+			flags.UpdateGolden,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.RunRenderTests(api.RunRenderTestsParams{
+				Paths:  fg.GetArgs(),
+				Update: fg.GetBool(flags.UpdateGolden),
+			})
+		},
+	}).Build()
+}
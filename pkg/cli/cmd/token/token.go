@@ -0,0 +1,108 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package token
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/auth"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewTokenCmd creates the token command group
+func NewTokenCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.TokenRoot.Use,
+		Short: constants.TokenRoot.Short,
+		Long:  constants.TokenRoot.Long,
+	}
+
+	cmd.AddCommand(newCreateCmd(impl))
+	cmd.AddCommand(newListCmd(impl))
+	cmd.AddCommand(newRevokeCmd(impl))
+	return cmd
+}
+
+// newCreateCmd creates the token create command
+func newCreateCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.TokenCreate,
+		Flags: []flags.Flag{
+			flags.TokenServiceAccount,
+			flags.TokenEntitlements,
+			flags.TokenScopes,
+			flags.TokenExpiresIn,
+			flags.TokenDescription,
+			flags.Output,
+		},
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			args := fg.GetArgs()
+			if len(args) != 1 {
+				return fmt.Errorf("exactly one token name is required")
+			}
+
+			return impl.CreateToken(api.CreateTokenParams{
+				Name:           args[0],
+				Description:    fg.GetString(flags.TokenDescription),
+				ServiceAccount: fg.GetString(flags.TokenServiceAccount),
+				Entitlements:   splitList(fg.GetString(flags.TokenEntitlements)),
+				Scopes:         splitList(fg.GetString(flags.TokenScopes)),
+				ExpiresIn:      fg.GetString(flags.TokenExpiresIn),
+				OutputFormat:   fg.GetString(flags.Output),
+			})
+		},
+	}).Build()
+}
+
+// newListCmd creates the token list command
+func newListCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.TokenList,
+		Flags: []flags.Flag{
+			flags.TokenService,
+			flags.Output,
+		},
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ListTokens(api.ListTokensParams{
+				Service:      fg.GetBool(flags.TokenService),
+				OutputFormat: fg.GetString(flags.Output),
+			})
+		},
+	}).Build()
+}
+
+// newRevokeCmd creates the token revoke command
+func newRevokeCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	return (&builder.CommandBuilder{
+		Command: constants.TokenRevoke,
+		PreRunE: auth.RequireLogin(impl),
+		RunE: func(fg *builder.FlagGetter) error {
+			args := fg.GetArgs()
+			if len(args) != 1 {
+				return fmt.Errorf("exactly one token ID is required")
+			}
+
+			return impl.RevokeToken(api.RevokeTokenParams{TokenID: args[0]})
+		},
+	}).Build()
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
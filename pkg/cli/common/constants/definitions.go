@@ -633,7 +633,7 @@ This command allows you to:
 	ReleaseBindingRoot = Command{
 		Use:   "release-binding",
 		Short: "Manage release bindings",
-		Long:  "Commands for managing release bindings in file-system mode",
+		Long:  "Commands for managing release bindings",
 	}
 
 	ReleaseBindingGenerate = Command{
@@ -662,6 +662,139 @@ This command allows you to:
     --output-path /custom/path`, messages.DefaultCLIName),
 	}
 
+	ReleaseBindingRollback = Command{
+		Use:   "rollback [binding-name]",
+		Short: "Roll a release binding back to an earlier release",
+		Long: "Point a release binding back at a release it was bound to before. Without --to-release the " +
+			"binding returns to the most recent earlier release that reached Ready.",
+		Example: fmt.Sprintf(`  # Roll back to the previous ready release
+  %[1]s release-binding rollback greeter-service-production --project demo-project --component greeter-service
+
+  # Roll back to a specific release from the binding history
+  %[1]s release-binding rollback greeter-service-production --project demo-project --component greeter-service \
+    --to-release greeter-service-20251222-3`, messages.DefaultCLIName),
+	}
+
+	ReleaseBindingPreview = Command{
+		Use:   "preview [binding-name]",
+		Short: "Preview the resources a release binding change would produce",
+		Long: "Render a release binding with proposed changes, without applying anything, and show how the " +
+			"rendered Kubernetes resources differ from the release currently applied. The changes can be a " +
+			"different component release, or parameters and overrides read from a YAML or JSON file with the " +
+			"fields parameters, componentTypeEnvOverrides, traitOverrides and workloadOverrides.",
+		Example: fmt.Sprintf(`  # Preview the effect of new overrides on the production binding
+  %[1]s release-binding preview greeter-service-production --project demo-project --component greeter-service \
+    -f overrides.yaml
+
+  # Preview promoting a new component release
+  %[1]s release-binding preview greeter-service-production --project demo-project --component greeter-service \
+    --release greeter-service-20251222-4
+
+  # Print the full rendered resources as YAML
+  %[1]s release-binding preview greeter-service-production --project demo-project --component greeter-service -o yaml`,
+			messages.DefaultCLIName),
+	}
+
+	Audit = Command{
+		Use:   "audit",
+		Short: "List audit events",
+		Long: "List the audit events recorded by the control plane, newest first, to find out who changed what " +
+			"and when. Events can be filtered by actor, action, resource and result, and limited to a time range. " +
+			"When more events match than the limit, a continue token for the next page is printed.",
+		Example: fmt.Sprintf(`  # List the latest audit events
+  %[1]s audit
+
+  # Show who promoted components during the last day
+  %[1]s audit --action promote_component --since 24h
+
+  # Show denied requests of a user as JSON
+  %[1]s audit --actor alice@example.com --result denied -o json
+
+  # Show changes to a component in a time range
+  %[1]s audit --resource-type component --resource greeter-service \
+    --since 2025-01-01T00:00:00Z --until 2025-01-02T00:00:00Z`, messages.DefaultCLIName),
+	}
+
+	Render = Command{
+		Use:   "render",
+		Short: "Render the resources of a component from the repository",
+		Long: "Render the Kubernetes resources of a component in an environment from the Component, Workload, " +
+			"ComponentType, Traits, Environment and DataPlane in the repository, without a cluster. The " +
+			"overrides of the component's ReleaseBinding for the environment apply when the repository has one. " +
+			"Only file-system mode is supported.",
+		Example: fmt.Sprintf(`  # Print the resources of a component in the development environment
+  %[1]s render --project demo-project --component greeter-service --env development
+
+  # Print the resources as a JSON list
+  %[1]s render --project demo-project --component greeter-service --env production -o json
+
+  # Write one file per resource, grouped by target plane
+  %[1]s render --project demo-project --component greeter-service --env production --output-path ./rendered`,
+			messages.DefaultCLIName),
+	}
+
+	Test = Command{
+		Use:   "test [path...]",
+		Short: "Run render tests of component types and traits",
+		Long: "Run the render tests found in files ending in .test.yaml under the given paths, or the repository " +
+			"root by default. A test renders a component with the ComponentTypes, Traits, Environments and " +
+			"DataPlanes of the repository, and compares the resources with a golden file, field assertions and " +
+			"CEL expectations over the rendered resources. With --update the golden files are written from the " +
+			"rendered resources instead. Only file-system mode is supported.",
+		Example: fmt.Sprintf(`  # Run all render tests in the repository
+  %[1]s test
+
+  # Run the render tests of a component type
+  %[1]s test platform/component-types/web-service/tests
+
+  # Regenerate the golden files after an intended change
+  %[1]s test platform/component-types/web-service/tests --update`, messages.DefaultCLIName),
+	}
+
+	TokenRoot = Command{
+		Use:   "token",
+		Short: "Manage API tokens",
+		Long: "Commands for managing personal access tokens and service account keys. API tokens authenticate " +
+			"CI jobs and scripts without an identity provider, until they expire or are revoked.",
+	}
+
+	TokenCreate = Command{
+		Use:   "create [name]",
+		Short: "Create an API token",
+		Long: "Create a personal access token that acts with your entitlements, or with --service-account a key " +
+			"that authenticates as a service account. The token is printed once and cannot be retrieved again.",
+		Example: fmt.Sprintf(`  # Create a token for a CI job that expires in 30 days
+  %[1]s token create ci-deployer --expires-in 720h
+
+  # Create a read-only token limited to one of your groups
+  %[1]s token create dashboard --entitlements platform-viewers --scopes "component:view,project:view"
+
+  # Create a key for a service account
+  %[1]s token create release-bot --service-account release-bot --scopes "component:*"
+
+  # Use a token with the CLI
+  %[1]s login --token <token>`, messages.DefaultCLIName),
+	}
+
+	TokenList = Command{
+		Use:   "list",
+		Short: "List API tokens",
+		Long:  "List your personal access tokens, or with --service all service account keys, with their expiry and last use.",
+		Example: fmt.Sprintf(`  # List your personal access tokens
+  %[1]s token list
+
+  # List service account keys as JSON
+  %[1]s token list --service -o json`, messages.DefaultCLIName),
+	}
+
+	TokenRevoke = Command{
+		Use:   "revoke [token-id]",
+		Short: "Revoke an API token",
+		Long:  "Revoke an API token by its ID, as shown by the list command. Revoked tokens are rejected immediately.",
+		Example: fmt.Sprintf(`  # Revoke a token
+  %[1]s token revoke 3f2c9a52-8d3e-4b1f-9c55-0f6a2d7e1b44`, messages.DefaultCLIName),
+	}
+
 	// ------------------------------------------------------------------------
 	// Flag Descriptions (Used in config commands)
 	// ------------------------------------------------------------------------
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/apply"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/audit"
 	componentrelease "github.com/openchoreo/openchoreo/pkg/cli/cmd/component-release"
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/create"
@@ -14,7 +15,9 @@ import (
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/login"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/logout"
 	releasebinding "github.com/openchoreo/openchoreo/pkg/cli/cmd/release-binding"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/render"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/scaffold"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/token"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/version"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
@@ -42,6 +45,10 @@ func BuildRootCmd(config *config.CLIConfig, impl api.CommandImplementationInterf
 		version.NewVersionCmd(),
 		componentrelease.NewComponentReleaseCmd(impl),
 		releasebinding.NewReleaseBindingCmd(impl),
+		render.NewRenderCmd(impl),
+		render.NewTestCmd(impl),
+		audit.NewAuditCmd(impl),
+		token.NewTokenCmd(impl),
 	)
 
 	return rootCmd
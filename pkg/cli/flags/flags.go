@@ -360,6 +360,114 @@ var (
 		Usage: "Explicit component release name (only valid with --project and --component)",
 	}
 
+	ToRelease = Flag{
+		Name:  "to-release",
+		Usage: "Release from the binding history to roll back to (defaults to the previous ready release)",
+	}
+
+	PreviewRelease = Flag{
+		Name:  "release",
+		Usage: "Component release to preview instead of the bound release",
+	}
+
+	PreviewOverridesFile = Flag{
+		Name:      "file",
+		Shorthand: "f",
+		Usage:     "YAML or JSON file with the proposed parameters and overrides",
+	}
+
+	RenderEnvironment = Flag{
+		Name:  "env",
+		Usage: "Environment to render the component for",
+	}
+
+	UpdateGolden = Flag{
+		Name:  "update",
+		Usage: "Write the golden files from the rendered resources instead of comparing them",
+		Type:  "bool",
+	}
+
+	// Audit flags
+
+	AuditActor = Flag{
+		Name:  "actor",
+		Usage: "Only show events performed by this user or service account ID",
+	}
+
+	AuditAction = Flag{
+		Name:  "action",
+		Usage: "Only show events of this action (e.g., promote_component)",
+	}
+
+	AuditResourceType = Flag{
+		Name:  "resource-type",
+		Usage: "Only show events on this resource type (e.g., component)",
+	}
+
+	AuditResource = Flag{
+		Name:  "resource",
+		Usage: "Only show events on the resource with this name or ID",
+	}
+
+	AuditResult = Flag{
+		Name:  "result",
+		Usage: "Only show events with this result: success, failure or denied",
+	}
+
+	AuditSince = Flag{
+		Name:  "since",
+		Usage: "Only show events at or after this RFC3339 time or relative duration (e.g., 24h)",
+	}
+
+	AuditUntil = Flag{
+		Name:  "until",
+		Usage: "Only show events before this RFC3339 time or relative duration (e.g., 1h)",
+	}
+
+	AuditLimit = Flag{
+		Name:  "limit",
+		Usage: "Maximum number of events to return (defaults to the server page size of 100)",
+		Type:  "int",
+	}
+
+	AuditContinue = Flag{
+		Name:  "continue",
+		Usage: "Continue token printed by a previous invocation to fetch the next page",
+	}
+
+	// API token flags
+
+	TokenServiceAccount = Flag{
+		Name:  "service-account",
+		Usage: "Create a service account key that authenticates as this service account",
+	}
+
+	TokenEntitlements = Flag{
+		Name:  "entitlements",
+		Usage: "Comma-separated subset of your entitlements (e.g., groups) the token carries, defaults to all you hold when it is used",
+	}
+
+	TokenScopes = Flag{
+		Name:  "scopes",
+		Usage: "Comma-separated actions the token is limited to (e.g., component:view,component:*), defaults to unrestricted",
+	}
+
+	TokenExpiresIn = Flag{
+		Name:  "expires-in",
+		Usage: "Lifetime of the token as a duration (e.g., 720h), defaults to the server default",
+	}
+
+	TokenDescription = Flag{
+		Name:  "description",
+		Usage: "Description of what the token is used for",
+	}
+
+	TokenService = Flag{
+		Name:  "service",
+		Usage: "List service account keys instead of your personal access tokens",
+		Type:  "bool",
+	}
+
 	// Authentication flags
 
 	ClientCredentials = Flag{
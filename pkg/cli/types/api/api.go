@@ -20,6 +20,9 @@ type CommandImplementationInterface interface {
 	ScaffoldAPI
 	ComponentReleaseAPI
 	ReleaseBindingAPI
+	RenderAPI
+	AuditAPI
+	TokenAPI
 }
 
 // OrganizationAPI defines organization-related operations
@@ -94,7 +97,27 @@ type ComponentReleaseAPI interface {
 	GenerateComponentRelease(params GenerateComponentReleaseParams) error
 }
 
-// ReleaseBindingAPI defines release binding operations (file-system mode)
+// ReleaseBindingAPI defines release binding operations
 type ReleaseBindingAPI interface {
 	GenerateReleaseBinding(params GenerateReleaseBindingParams) error
+	RollbackReleaseBinding(params RollbackReleaseBindingParams) error
+	PreviewReleaseBinding(params PreviewReleaseBindingParams) error
+}
+
+// RenderAPI defines offline rendering operations (file-system mode)
+type RenderAPI interface {
+	Render(params RenderParams) error
+	RunRenderTests(params RunRenderTestsParams) error
+}
+
+// AuditAPI defines audit trail operations
+type AuditAPI interface {
+	ListAuditEvents(params ListAuditEventsParams) error
+}
+
+// TokenAPI defines API token operations
+type TokenAPI interface {
+	CreateToken(params CreateTokenParams) error
+	ListTokens(params ListTokensParams) error
+	RevokeToken(params RevokeTokenParams) error
 }
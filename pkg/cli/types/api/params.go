@@ -84,6 +84,7 @@ type LoginParams struct {
 	ClientCredentials bool // Flag to use client credentials flow
 	ClientID          string
 	ClientSecret      string
+	Token             string // API token to authenticate with instead of an OAuth2 flow
 	CredentialName    string // Name to save credential as
 	URL               string // Control plane URL to update
 }
@@ -365,3 +366,75 @@ type GenerateReleaseBindingParams struct {
 	OutputPath       string // Optional: custom output directory
 	DryRun           bool   // Preview without writing files
 }
+
+// RollbackReleaseBindingParams defines parameters for rolling back a release binding
+type RollbackReleaseBindingParams struct {
+	Organization  string
+	ProjectName   string
+	ComponentName string
+	BindingName   string
+	ReleaseName   string // Optional: release from the binding history, defaults to the previous ready release
+}
+
+// PreviewReleaseBindingParams defines parameters for previewing a release binding change
+type PreviewReleaseBindingParams struct {
+	Organization  string
+	ProjectName   string
+	ComponentName string
+	BindingName   string
+	Environment   string // Optional: target environment, required when the binding does not exist yet
+	ReleaseName   string // Optional: component release to preview instead of the bound one
+	OverridesFile string // Optional: file with the proposed parameters and overrides
+	OutputFormat  string // Optional: json or yaml, defaults to a diff summary
+}
+
+// RenderParams defines parameters for rendering the resources of a component (file-system mode)
+type RenderParams struct {
+	ProjectName   string
+	ComponentName string
+	Environment   string
+	OutputFormat  string // Optional: yaml or json, defaults to yaml
+	OutputPath    string // Optional: directory to write one file per resource to, defaults to stdout
+}
+
+// RunRenderTestsParams defines parameters for running render tests (file-system mode)
+type RunRenderTestsParams struct {
+	Paths  []string // Optional: test files or directories to search, defaults to the repository root
+	Update bool     // Write the golden files instead of comparing them
+}
+
+// ListAuditEventsParams defines parameters for listing audit events
+type ListAuditEventsParams struct {
+	Actor        string
+	Action       string
+	ResourceType string
+	ResourceName string
+	Result       string
+	Since        string // Optional: RFC3339 time or a duration before now
+	Until        string // Optional: RFC3339 time or a duration before now
+	Limit        int
+	Continue     string
+	OutputFormat string // Optional: json or yaml, defaults to a table
+}
+
+// CreateTokenParams defines parameters for creating an API token
+type CreateTokenParams struct {
+	Name           string
+	Description    string
+	ServiceAccount string   // Optional: creates a service account key instead of a personal access token
+	Entitlements   []string // Optional: subset of the entitlements of the caller
+	Scopes         []string // Optional: actions the token is limited to
+	ExpiresIn      string   // Optional: lifetime as a duration
+	OutputFormat   string   // Optional: json or yaml, defaults to text
+}
+
+// ListTokensParams defines parameters for listing API tokens
+type ListTokensParams struct {
+	Service      bool   // List service account keys instead of personal access tokens
+	OutputFormat string // Optional: json or yaml, defaults to a table
+}
+
+// RevokeTokenParams defines parameters for revoking an API token
+type RevokeTokenParams struct {
+	TokenID string
+}
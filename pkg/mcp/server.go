@@ -11,11 +11,14 @@ import (
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
-func NewHTTPServer(tools *tools.Toolsets) http.Handler {
+// NewHTTPServer creates the MCP HTTP server for the given toolsets. The middleware receives every request
+// before it reaches the tools, e.g. to audit tool calls.
+func NewHTTPServer(tools *tools.Toolsets, middleware ...mcp.Middleware) http.Handler {
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "openchoreo-api",
 		Version: "1.0.0",
 	}, nil)
+	server.AddReceivingMiddleware(middleware...)
 	tools.Register(server)
 	return mcp.NewStreamableHTTPHandler(func(r *http.Request) *mcp.Server {
 		return server
@@ -0,0 +1,58 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (t *Toolsets) RegisterListAuditEvents(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "list_audit_events",
+		Description: "List audit events recorded by the control plane, newest first. Answers who changed what and " +
+			"when, e.g. who promoted a component or deleted a project. All filters are optional.",
+		InputSchema: createSchema(map[string]any{
+			"actor":         stringProperty("Optional: ID of the user or service account that performed the action"),
+			"action":        stringProperty("Optional: action name (e.g., 'promote_component', 'delete_project')"),
+			"resource_type": stringProperty("Optional: resource type (e.g., 'component', 'project')"),
+			"resource_name": stringProperty("Optional: name or ID of the affected resource"),
+			"result":        stringProperty("Optional: one of 'success', 'failure' or 'denied'"),
+			"since":         stringProperty("Optional: RFC3339 time, only events at or after it are returned"),
+			"until":         stringProperty("Optional: RFC3339 time, only events before it are returned"),
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": "Optional: maximum number of events to return (default 100)",
+			},
+			"continue": stringProperty("Optional: continue token of a previous response to fetch the next page"),
+		}, []string{}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		Actor        string `json:"actor"`
+		Action       string `json:"action"`
+		ResourceType string `json:"resource_type"`
+		ResourceName string `json:"resource_name"`
+		Result       string `json:"result"`
+		Since        string `json:"since"`
+		Until        string `json:"until"`
+		Limit        int    `json:"limit"`
+		Continue     string `json:"continue"`
+	}) (*mcp.CallToolResult, any, error) {
+		auditReq := &models.ListAuditEventsRequest{
+			Actor:        args.Actor,
+			Action:       args.Action,
+			ResourceType: args.ResourceType,
+			ResourceName: args.ResourceName,
+			Result:       args.Result,
+			Since:        args.Since,
+			Until:        args.Until,
+			Limit:        args.Limit,
+			Continue:     args.Continue,
+		}
+		result, err := t.AuditToolset.ListAuditEvents(ctx, auditReq)
+		return handleToolResult(result, err)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// auditToolSpecs returns test specs for audit toolset
+func auditToolSpecs() []toolTestSpec {
+	return []toolTestSpec{
+		{
+			name:                "list_audit_events",
+			toolset:             "audit",
+			descriptionKeywords: []string{"audit", "events"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{},
+			optionalParams: []string{
+				"actor", "action", "resource_type", "resource_name", "result", "since", "until", "limit", "continue",
+			},
+			testArgs: map[string]any{
+				"actor":         "alice@example.com",
+				"resource_type": "component",
+				"result":        "denied",
+				"since":         "2025-01-01T00:00:00Z",
+				"limit":         20,
+			},
+			expectedMethod: "ListAuditEvents",
+			validateCall: func(t *testing.T, args []interface{}) {
+				req, ok := args[0].(*models.ListAuditEventsRequest)
+				if !ok {
+					t.Fatalf("Expected *models.ListAuditEventsRequest, got %T", args[0])
+				}
+				if req.Actor != "alice@example.com" || req.ResourceType != "component" || req.Result != "denied" {
+					t.Errorf("Expected (alice@example.com, component, denied), got (%s, %s, %s)",
+						req.Actor, req.ResourceType, req.Result)
+				}
+				if req.Since != "2025-01-01T00:00:00Z" || req.Limit != 20 {
+					t.Errorf("Expected since 2025-01-01T00:00:00Z and limit 20, got %s and %d", req.Since, req.Limit)
+				}
+			},
+		},
+	}
+}
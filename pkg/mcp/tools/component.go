@@ -383,6 +383,95 @@ func (t *Toolsets) RegisterPatchReleaseBinding(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterRollbackReleaseBinding(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "rollback_release_binding",
+		Description: "Roll a release binding back to a release it was bound to before. Without a release name the " +
+			"binding returns to the most recent earlier release that reached Ready. The binding's history lists the " +
+			"releases that can be chosen.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"binding_name":   defaultStringProperty(),
+			"release_name": stringProperty(
+				"Optional: release from the binding history to roll back to. Use list_release_bindings to see the history"),
+		}, []string{"org_name", "project_name", "component_name", "binding_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		BindingName   string `json:"binding_name"`
+		ReleaseName   string `json:"release_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		rollbackReq := &models.RollbackReleaseBindingRequest{
+			ReleaseName: args.ReleaseName,
+		}
+		result, err := t.ComponentToolset.RollbackReleaseBinding(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName, rollbackReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterPreviewReleaseBinding(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "preview_release_binding",
+		Description: "Preview the Kubernetes resources a release binding would render to with proposed changes, without " +
+			"applying anything. Returns the rendered resources and a structured diff against the currently applied " +
+			"release. Changes can be a different component release, component parameters, environment overrides, " +
+			"trait overrides or workload overrides.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"binding_name":   defaultStringProperty(),
+			"release_name":   stringProperty("Optional: component release to preview instead of the bound one"),
+			"environment":    stringProperty("Optional: target environment, required when the binding does not exist yet"),
+			"parameters": map[string]any{
+				"type":        "object",
+				"description": "Optional: component parameters replacing those captured in the component release",
+			},
+			"component_type_env_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: environment-specific overrides for component type parameters",
+			},
+			"trait_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: environment-specific trait configuration overrides keyed by trait instance name",
+			},
+			"workload_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: workload overrides with env and files per container under 'containers'",
+			},
+		}, []string{"org_name", "project_name", "component_name", "binding_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                   string                            `json:"org_name"`
+		ProjectName               string                            `json:"project_name"`
+		ComponentName             string                            `json:"component_name"`
+		BindingName               string                            `json:"binding_name"`
+		ReleaseName               string                            `json:"release_name"`
+		Environment               string                            `json:"environment"`
+		Parameters                map[string]interface{}            `json:"parameters"`
+		ComponentTypeEnvOverrides map[string]interface{}            `json:"component_type_env_overrides"`
+		TraitOverrides            map[string]map[string]interface{} `json:"trait_overrides"`
+		WorkloadOverrides         *models.WorkloadOverrides         `json:"workload_overrides"`
+	}) (*mcp.CallToolResult, any, error) {
+		previewReq := &models.PreviewReleaseBindingRequest{
+			PatchReleaseBindingRequest: models.PatchReleaseBindingRequest{
+				ReleaseName:               args.ReleaseName,
+				Environment:               args.Environment,
+				ComponentTypeEnvOverrides: args.ComponentTypeEnvOverrides,
+				TraitOverrides:            args.TraitOverrides,
+				WorkloadOverrides:         args.WorkloadOverrides,
+			},
+			Parameters: args.Parameters,
+		}
+		result, err := t.ComponentToolset.PreviewReleaseBinding(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName, previewReq)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterDeployRelease(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "deploy_release",
@@ -437,6 +526,108 @@ func (t *Toolsets) RegisterPromoteComponent(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterRequestPromotion(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "request_promotion",
+		Description: "Request approval to promote the release bound to the source environment into a target " +
+			"environment that requires approval in the deployment pipeline. The promotion is applied only " +
+			"after the request is approved.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"source_env":     stringProperty("Source environment name (e.g., 'staging')"),
+			"target_env":     stringProperty("Target environment name (e.g., 'production')"),
+		}, []string{"org_name", "project_name", "component_name", "source_env", "target_env"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		SourceEnv     string `json:"source_env"`
+		TargetEnv     string `json:"target_env"`
+	}) (*mcp.CallToolResult, any, error) {
+		promotionReq := &models.CreatePromotionRequestRequest{
+			SourceEnvironment: args.SourceEnv,
+			TargetEnvironment: args.TargetEnv,
+		}
+		result, err := t.ComponentToolset.RequestPromotion(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, promotionReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListPromotionRequests(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "list_promotion_requests",
+		Description: "List promotion requests for a component, newest first. Each request shows the release, " +
+			"source and target environments, its phase and who approved or rejected it.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+		}, []string{"org_name", "project_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.ListPromotionRequests(ctx, args.OrgName, args.ProjectName, args.ComponentName)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterApprovePromotionRequest(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "approve_promotion_request",
+		Description: "Approve a pending promotion request. The caller is recorded as the approver and the " +
+			"release is then bound to the target environment. Requesters cannot approve their own requests.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"request_name":   stringProperty("Promotion request name. Use list_promotion_requests to discover valid names"),
+			"comment":        stringProperty("Optional comment recorded with the approval"),
+		}, []string{"org_name", "project_name", "component_name", "request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		RequestName   string `json:"request_name"`
+		Comment       string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		decisionReq := &models.PromotionDecisionRequest{Comment: args.Comment}
+		result, err := t.ComponentToolset.ApprovePromotionRequest(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.RequestName, decisionReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRejectPromotionRequest(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "reject_promotion_request",
+		Description: "Reject a pending promotion request. The caller is recorded as the reviewer and the " +
+			"target environment is left unchanged.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"request_name":   stringProperty("Promotion request name. Use list_promotion_requests to discover valid names"),
+			"comment":        stringProperty("Optional reason recorded with the rejection"),
+		}, []string{"org_name", "project_name", "component_name", "request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		RequestName   string `json:"request_name"`
+		Comment       string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		decisionReq := &models.PromotionDecisionRequest{Comment: args.Comment}
+		result, err := t.ComponentToolset.RejectPromotionRequest(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.RequestName, decisionReq)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterCreateWorkload(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "create_workload",
@@ -607,6 +798,30 @@ func (t *Toolsets) RegisterGetEnvironmentRelease(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterGetComponentDrift(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "get_component_drift",
+		Description: "Get the drift report for a component deployed in a specific environment. Lists the resources " +
+			"whose live state differs from the applied release, the drifted fields, when the drift was detected " +
+			"and whether the environment's drift policy reverted it.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":         defaultStringProperty(),
+			"project_name":     defaultStringProperty(),
+			"component_name":   stringProperty("Use list_components to discover valid names"),
+			"environment_name": stringProperty("Use list_environments to discover valid names"),
+		}, []string{"org_name", "project_name", "component_name", "environment_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName         string `json:"org_name"`
+		ProjectName     string `json:"project_name"`
+		ComponentName   string `json:"component_name"`
+		EnvironmentName string `json:"environment_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.GetComponentDrift(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.EnvironmentName)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterPatchComponent(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "patch_component",
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 const testReleaseName = "release-1"
@@ -285,6 +287,62 @@ func componentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "rollback_release_binding",
+			toolset:             "component",
+			descriptionKeywords: []string{"roll", "release", "binding"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "binding_name"},
+			optionalParams:      []string{"release_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"binding_name":   "binding-1",
+				"release_name":   testReleaseName,
+			},
+			expectedMethod: "RollbackReleaseBinding",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName || args[3] != "binding-1" {
+					t.Errorf("Expected (%s, %s, %s, binding-1), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2], args[3])
+				}
+				req, ok := args[4].(*models.RollbackReleaseBindingRequest)
+				if !ok || req.ReleaseName != testReleaseName {
+					t.Errorf("Expected rollback request for release %s, got %v", testReleaseName, args[4])
+				}
+			},
+		},
+		{
+			name:                "preview_release_binding",
+			toolset:             "component",
+			descriptionKeywords: []string{"preview", "release", "binding", "diff"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "binding_name"},
+			optionalParams: []string{"release_name", "environment", "parameters", "component_type_env_overrides",
+				"trait_overrides", "workload_overrides"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"binding_name":   "binding-1",
+				"parameters":     map[string]any{"replicas": float64(3)},
+				"trait_overrides": map[string]any{
+					"hpa-1": map[string]any{"minReplicas": float64(2)},
+				},
+			},
+			expectedMethod: "PreviewReleaseBinding",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName || args[3] != "binding-1" {
+					t.Errorf("Expected (%s, %s, %s, binding-1), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2], args[3])
+				}
+				req, ok := args[4].(*models.PreviewReleaseBindingRequest)
+				if !ok || req.Parameters["replicas"] != float64(3) || req.TraitOverrides["hpa-1"]["minReplicas"] != float64(2) {
+					t.Errorf("Expected preview request with parameters and trait overrides, got %v", args[4])
+				}
+			},
+		},
 		{
 			name:                "deploy_release",
 			toolset:             "component",
@@ -326,6 +384,99 @@ func componentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "request_promotion",
+			toolset:             "component",
+			descriptionKeywords: []string{"promote", "approval"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "source_env", "target_env"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"source_env":     "staging",
+				"target_env":     "production",
+			},
+			expectedMethod: "RequestPromotion",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+					t.Errorf("Expected (%s, %s, %s), got (%v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2])
+				}
+				req, ok := args[3].(*models.CreatePromotionRequestRequest)
+				if !ok {
+					t.Fatalf("Expected *models.CreatePromotionRequestRequest, got %T", args[3])
+				}
+				if req.SourceEnvironment != "staging" || req.TargetEnvironment != "production" {
+					t.Errorf("Expected staging -> production, got %s -> %s", req.SourceEnvironment, req.TargetEnvironment)
+				}
+			},
+		},
+		{
+			name:                "list_promotion_requests",
+			toolset:             "component",
+			descriptionKeywords: []string{"list", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+			},
+			expectedMethod: "ListPromotionRequests",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+					t.Errorf("Expected (%s, %s, %s), got (%v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2])
+				}
+			},
+		},
+		{
+			name:                "approve_promotion_request",
+			toolset:             "component",
+			descriptionKeywords: []string{"approve", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "request_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"request_name":   "comp-prod-abc12",
+				"comment":        "change ticket CHG-42",
+			},
+			expectedMethod: "ApprovePromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != "comp-prod-abc12" {
+					t.Errorf("Expected request name comp-prod-abc12, got %v", args[3])
+				}
+				req, ok := args[4].(*models.PromotionDecisionRequest)
+				if !ok {
+					t.Fatalf("Expected *models.PromotionDecisionRequest, got %T", args[4])
+				}
+				if req.Comment != "change ticket CHG-42" {
+					t.Errorf("Expected comment to be passed through, got %q", req.Comment)
+				}
+			},
+		},
+		{
+			name:                "reject_promotion_request",
+			toolset:             "component",
+			descriptionKeywords: []string{"reject", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "request_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"request_name":   "comp-prod-abc12",
+			},
+			expectedMethod: "RejectPromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != "comp-prod-abc12" {
+					t.Errorf("Expected request name comp-prod-abc12, got %v", args[3])
+				}
+			},
+		},
 		{
 			name:                "create_workload",
 			toolset:             "component",
@@ -484,6 +635,28 @@ func componentSchemaSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "get_component_drift",
+			toolset:             "component",
+			descriptionKeywords: []string{"drift", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "environment_name"},
+			testArgs: map[string]any{
+				"org_name":         testOrgName,
+				"project_name":     testProjectName,
+				"component_name":   testComponentName,
+				"environment_name": testEnvName,
+			},
+			expectedMethod: "GetComponentDrift",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName ||
+					args[2] != testComponentName || args[3] != testEnvName {
+					t.Errorf("Expected (%s, %s, %s, %s), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, testEnvName,
+						args[0], args[1], args[2], args[3])
+				}
+			},
+		},
 	}
 }
 
@@ -212,6 +212,11 @@ func (m *MockCoreToolsetHandler) ExplainSchema(ctx context.Context, kind, path s
 	return `{"group":"openchoreo.dev","kind":"Component","version":"v1alpha1","type":"Object"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (any, error) {
+	m.recordCall("ListAuditEvents", req)
+	return map[string]any{"items": []any{}}, nil
+}
+
 func (m *MockCoreToolsetHandler) ListComponentReleases(
 	ctx context.Context, orgName, projectName, componentName string,
 ) (any, error) {
@@ -248,6 +253,22 @@ func (m *MockCoreToolsetHandler) PatchReleaseBinding(
 	return `{"status":"updated"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) RollbackReleaseBinding(
+	ctx context.Context, orgName, projectName, componentName, bindingName string,
+	req *models.RollbackReleaseBindingRequest,
+) (any, error) {
+	m.recordCall("RollbackReleaseBinding", orgName, projectName, componentName, bindingName, req)
+	return `{"status":"rolled_back"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) PreviewReleaseBinding(
+	ctx context.Context, orgName, projectName, componentName, bindingName string,
+	req *models.PreviewReleaseBindingRequest,
+) (any, error) {
+	m.recordCall("PreviewReleaseBinding", orgName, projectName, componentName, bindingName, req)
+	return `{"resources":[],"diff":[]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) DeployRelease(
 	ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest,
 ) (any, error) {
@@ -262,6 +283,34 @@ func (m *MockCoreToolsetHandler) PromoteComponent(
 	return `{"environment":"staging"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) RequestPromotion(
+	ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest,
+) (any, error) {
+	m.recordCall("RequestPromotion", orgName, projectName, componentName, req)
+	return `{"name":"comp-prod-abc12","phase":"Pending"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ListPromotionRequests(
+	ctx context.Context, orgName, projectName, componentName string,
+) (any, error) {
+	m.recordCall("ListPromotionRequests", orgName, projectName, componentName)
+	return `[{"name":"comp-prod-abc12","phase":"Pending"}]`, nil
+}
+
+func (m *MockCoreToolsetHandler) ApprovePromotionRequest(
+	ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest,
+) (any, error) {
+	m.recordCall("ApprovePromotionRequest", orgName, projectName, componentName, requestName, req)
+	return `{"name":"comp-prod-abc12","decision":"Approve"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RejectPromotionRequest(
+	ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest,
+) (any, error) {
+	m.recordCall("RejectPromotionRequest", orgName, projectName, componentName, requestName, req)
+	return `{"name":"comp-prod-abc12","decision":"Reject"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateWorkload(
 	ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{},
 ) (any, error) {
@@ -304,6 +353,13 @@ func (m *MockCoreToolsetHandler) GetEnvironmentRelease(
 	return `{"spec":{"resources":[]},"status":{"phase":"Ready"}}`, nil
 }
 
+func (m *MockCoreToolsetHandler) GetComponentDrift(
+	ctx context.Context, orgName, projectName, componentName, environmentName string,
+) (any, error) {
+	m.recordCall("GetComponentDrift", orgName, projectName, componentName, environmentName)
+	return `{"drifted":false,"resources":[]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) PatchComponent(
 	ctx context.Context, orgName, projectName, componentName string, req *models.PatchComponentRequest,
 ) (any, error) {
@@ -41,12 +41,19 @@ func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 		t.RegisterGetComponentReleaseSchema,
 		t.RegisterListReleaseBindings,
 		t.RegisterPatchReleaseBinding,
+		t.RegisterRollbackReleaseBinding,
+		t.RegisterPreviewReleaseBinding,
 		t.RegisterDeployRelease,
 		t.RegisterPromoteComponent,
+		t.RegisterRequestPromotion,
+		t.RegisterListPromotionRequests,
+		t.RegisterApprovePromotionRequest,
+		t.RegisterRejectPromotionRequest,
 		t.RegisterCreateWorkload,
 		t.RegisterListComponentTraits,
 		t.RegisterUpdateComponentTraits,
 		t.RegisterGetEnvironmentRelease,
+		t.RegisterGetComponentDrift,
 		t.RegisterListComponentWorkflows,
 		t.RegisterGetComponentWorkflowSchema,
 		t.RegisterTriggerComponentWorkflow,
@@ -110,6 +117,13 @@ func (t *Toolsets) resourceToolRegistrations() []RegisterFunc {
 	}
 }
 
+// auditToolRegistrations returns the list of audit toolset registration functions
+func (t *Toolsets) auditToolRegistrations() []RegisterFunc {
+	return []RegisterFunc{
+		t.RegisterListAuditEvents,
+	}
+}
+
 func (t *Toolsets) Register(s *mcp.Server) {
 	// Register organization tools if OrganizationToolset is enabled
 	if t.OrganizationToolset != nil {
@@ -166,4 +180,11 @@ func (t *Toolsets) Register(s *mcp.Server) {
 			registerFunc(s)
 		}
 	}
+
+	// Register audit tools if AuditToolset is enabled
+	if t.AuditToolset != nil {
+		for _, registerFunc := range t.auditToolRegistrations() {
+			registerFunc(s)
+		}
+	}
 }
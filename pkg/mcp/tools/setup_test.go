@@ -30,6 +30,7 @@ func setupTestServer(t *testing.T) (*mcp.ClientSession, *MockCoreToolsetHandler)
 		InfrastructureToolset: mockHandler,
 		SchemaToolset:         mockHandler,
 		ResourceToolset:       mockHandler,
+		AuditToolset:          mockHandler,
 	}
 	clientSession := setupTestServerWithToolset(t, toolsets)
 	return clientSession, mockHandler
@@ -73,7 +74,7 @@ type toolTestSpec struct {
 	name string
 
 	// Toolset association
-	toolset string // "organization", "project", "component", "build", "deployment", "infrastructure", "schema", "resource"
+	toolset string // "organization", "project", "component", "build", "deployment", "infrastructure", "schema", "resource", "audit"
 
 	// Description validation
 	descriptionKeywords []string
@@ -100,5 +101,6 @@ var allToolSpecs = func() []toolTestSpec {
 	specs = append(specs, infrastructureToolSpecs()...)
 	specs = append(specs, schemaToolSpecs()...)
 	specs = append(specs, resourceToolSpecs()...)
+	specs = append(specs, auditToolSpecs()...)
 	return specs
 }()
@@ -23,6 +23,7 @@ const (
 	ToolsetInfrastructure ToolsetType = "infrastructure"
 	ToolsetSchema         ToolsetType = "schema"
 	ToolsetResource       ToolsetType = "resource"
+	ToolsetAudit          ToolsetType = "audit"
 )
 
 type Toolsets struct {
@@ -34,6 +35,7 @@ type Toolsets struct {
 	InfrastructureToolset InfrastructureToolsetHandler
 	SchemaToolset         SchemaToolsetHandler
 	ResourceToolset       ResourceToolsetHandler
+	AuditToolset          AuditToolsetHandler
 }
 
 // OrganizationToolsetHandler handles organization operations
@@ -75,6 +77,14 @@ type ComponentToolsetHandler interface {
 		ctx context.Context, orgName, projectName, componentName, bindingName string,
 		req *models.PatchReleaseBindingRequest,
 	) (any, error)
+	RollbackReleaseBinding(
+		ctx context.Context, orgName, projectName, componentName, bindingName string,
+		req *models.RollbackReleaseBindingRequest,
+	) (any, error)
+	PreviewReleaseBinding(
+		ctx context.Context, orgName, projectName, componentName, bindingName string,
+		req *models.PreviewReleaseBindingRequest,
+	) (any, error)
 	// Deployment operations
 	DeployRelease(
 		ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest,
@@ -82,6 +92,17 @@ type ComponentToolsetHandler interface {
 	PromoteComponent(
 		ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
 	) (any, error)
+	// Promotion approval operations
+	RequestPromotion(
+		ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest,
+	) (any, error)
+	ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string) (any, error)
+	ApprovePromotionRequest(
+		ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest,
+	) (any, error)
+	RejectPromotionRequest(
+		ctx context.Context, orgName, projectName, componentName, requestName string, req *models.PromotionDecisionRequest,
+	) (any, error)
 	// Workload operations
 	CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error)
 	// Schema operations
@@ -94,6 +115,7 @@ type ComponentToolsetHandler interface {
 	) (any, error)
 	// Release operations
 	GetEnvironmentRelease(ctx context.Context, orgName, projectName, componentName, environmentName string) (any, error)
+	GetComponentDrift(ctx context.Context, orgName, projectName, componentName, environmentName string) (any, error)
 	// Component patch operations
 	PatchComponent(
 		ctx context.Context, orgName, projectName, componentName string, req *models.PatchComponentRequest,
@@ -169,5 +191,10 @@ type ResourceToolsetHandler interface {
 	DeleteResource(ctx context.Context, resource map[string]interface{}) (any, error)
 }
 
+// AuditToolsetHandler handles audit trail queries
+type AuditToolsetHandler interface {
+	ListAuditEvents(ctx context.Context, req *models.ListAuditEventsRequest) (any, error)
+}
+
 // RegisterFunc is a function type for registering MCP tools
 type RegisterFunc func(s *mcp.Server)